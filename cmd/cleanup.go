@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/kartoza/kartoza-screencaster/internal/cleanup"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/retention"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cleanupApply        bool
+	cleanupIncludeMedia bool
+)
+
+var cleanupCmd = &cobra.Command{
+	Use:   "cleanup",
+	Short: "Remove leftover files that recordings no longer reference",
+	Long: `Scan the recording folders for files that recording.json does not reference.
+
+Intermediate files left behind by processing (filtered audio and video,
+concatenated parts and concat lists) are safe to remove. Audio and video
+files that are not referenced may be raw footage of an interrupted
+recording; they are listed but only removed with --include-media.
+
+Without --apply this is a dry run. Recordings that are being recorded or
+processed are skipped, and other files (notes, logos) are never touched.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if cleanupIncludeMedia && !cleanupApply {
+			return fmt.Errorf("--include-media requires --apply")
+		}
+
+		recordings, err := retention.LoadRecordings(config.GetDefaultVideosDir())
+		if err != nil {
+			return fmt.Errorf("failed to load recordings: %w", err)
+		}
+
+		reports, errs := cleanup.ScanAll(recordings)
+		for _, err := range errs {
+			stderr.Printf("Warning: %v\n", err)
+		}
+		if len(reports) == 0 {
+			stdout.Println("No leftover files found.")
+			return nil
+		}
+
+		intermediate, media := printCleanupReports(reports)
+		if !cleanupApply {
+			stdout.Printf("\nDry run: nothing was removed. Use --apply to remove %s of intermediate files.\n",
+				models.FormatFileSize(intermediate))
+			if media > 0 {
+				stdout.Printf("Add --include-media to also remove %s of unreferenced media. Check it is not needed first.\n",
+					models.FormatFileSize(media))
+			}
+			return nil
+		}
+
+		result := cleanup.Remove(reports, cleanupIncludeMedia)
+		stdout.Printf("\nRemoved %d file(s), reclaimed %s.\n", result.Removed, models.FormatFileSize(result.Reclaimed))
+		for _, err := range result.Errors {
+			stderr.Printf("Warning: %v\n", err)
+		}
+		if len(result.Errors) > 0 {
+			return fmt.Errorf("%d file(s) could not be removed", len(result.Errors))
+		}
+		return nil
+	},
+}
+
+func init() {
+	cleanupCmd.Flags().BoolVar(&cleanupApply, "apply", false, "Remove the files instead of doing a dry run")
+	cleanupCmd.Flags().BoolVar(&cleanupIncludeMedia, "include-media", false, "Also remove unreferenced audio and video files")
+	rootCmd.AddCommand(cleanupCmd)
+}
+
+// printCleanupReports lists the leftover files per recording and returns
+// the total size of the intermediate and media files
+func printCleanupReports(reports []cleanup.Report) (intermediate, media int64) {
+	for _, r := range reports {
+		title := r.Title
+		if title == "" {
+			title = filepath.Base(r.FolderPath)
+		}
+		stdout.Printf("%s (%s)\n", title, r.FolderPath)
+		for _, f := range r.Files {
+			stdout.Printf("  %10s  %-18s  %s\n", models.FormatFileSize(f.Size), f.Kind, filepath.Base(f.Path))
+		}
+		intermediate += r.Size(cleanup.KindIntermediate)
+		media += r.Size(cleanup.KindMedia)
+	}
+	return intermediate, media
+}
@@ -1,8 +1,6 @@
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/charmbracelet/lipgloss"
 	"github.com/kartoza/kartoza-screencaster/internal/deps"
 	"github.com/spf13/cobra"
@@ -22,24 +20,24 @@ var depsCmd = &cobra.Command{
 		cyan := lipgloss.NewStyle().Foreground(lipgloss.Color("#00BCD4"))
 		bold := lipgloss.NewStyle().Bold(true)
 
-		fmt.Println()
+		stdout.Println()
 
 		// Show detected display server
 		displayServer := deps.DetectDisplayServer()
-		fmt.Printf("%s %s\n\n", bold.Render("Display Server:"), cyan.Render(deps.GetDisplayServerName()))
+		stdout.Printf("%s %s\n\n", bold.Render("Display Server:"), cyan.Render(deps.GetDisplayServerName()))
 
 		// Show which screen recording method will be used
 		switch displayServer {
 		case deps.DisplayServerWayland:
-			fmt.Printf("%s wl-screenrec (Wayland native)\n\n", gray.Render("Screen recording:"))
+			stdout.Printf("%s wl-screenrec (Wayland native)\n\n", gray.Render("Screen recording:"))
 		case deps.DisplayServerX11:
-			fmt.Printf("%s ffmpeg x11grab (X11)\n\n", gray.Render("Screen recording:"))
+			stdout.Printf("%s ffmpeg x11grab (X11)\n\n", gray.Render("Screen recording:"))
 		default:
-			fmt.Printf("%s Unknown display server\n\n", gray.Render("Screen recording:"))
+			stdout.Printf("%s Unknown display server\n\n", gray.Render("Screen recording:"))
 		}
 
-		fmt.Println(bold.Render("Required Dependencies:"))
-		fmt.Println()
+		stdout.Println(bold.Render("Required Dependencies:"))
+		stdout.Println()
 
 		allRequiredOk := true
 		for _, r := range required {
@@ -50,16 +48,16 @@ var depsCmd = &cobra.Command{
 				status = red.Render("✗")
 				allRequiredOk = false
 			}
-			fmt.Printf("  %s %s\n", status, bold.Render(r.Dependency.Name))
-			fmt.Printf("    %s\n", gray.Render(r.Dependency.Description))
+			stdout.Printf("  %s %s\n", status, bold.Render(r.Dependency.Name))
+			stdout.Printf("    %s\n", gray.Render(r.Dependency.Description))
 			if r.Available {
-				fmt.Printf("    Path: %s\n", r.Path)
+				stdout.Printf("    Path: %s\n", r.Path)
 			}
-			fmt.Println()
+			stdout.Println()
 		}
 
-		fmt.Println(bold.Render("Optional Dependencies:"))
-		fmt.Println()
+		stdout.Println(bold.Render("Optional Dependencies:"))
+		stdout.Println()
 
 		for _, r := range optional {
 			var status string
@@ -68,21 +66,21 @@ var depsCmd = &cobra.Command{
 			} else {
 				status = gray.Render("○")
 			}
-			fmt.Printf("  %s %s\n", status, bold.Render(r.Dependency.Name))
-			fmt.Printf("    %s\n", gray.Render(r.Dependency.Description))
+			stdout.Printf("  %s %s\n", status, bold.Render(r.Dependency.Name))
+			stdout.Printf("    %s\n", gray.Render(r.Dependency.Description))
 			if r.Available {
-				fmt.Printf("    Path: %s\n", r.Path)
+				stdout.Printf("    Path: %s\n", r.Path)
 			}
-			fmt.Println()
+			stdout.Println()
 		}
 
 		if allRequiredOk {
-			fmt.Println(green.Render("All required dependencies are installed!"))
+			stdout.Println(green.Render("All required dependencies are installed!"))
 		} else {
-			fmt.Println(red.Render("Some required dependencies are missing."))
-			fmt.Println("Please install them before using the application.")
+			stdout.Println(red.Render("Some required dependencies are missing."))
+			stdout.Println("Please install them before using the application.")
 		}
-		fmt.Println()
+		stdout.Println()
 	},
 }
 
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/duplicates"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/retention"
+	"github.com/spf13/cobra"
+)
+
+var duplicatesTrash bool
+
+var duplicatesCmd = &cobra.Command{
+	Use:   "duplicates",
+	Short: "List recordings that were likely made twice",
+	Long: `List groups of recordings that are likely duplicates of each other.
+
+Recordings are grouped when their titles are similar (or one extends the
+other, e.g. "take 2") and so is their duration or total size. Recordings
+without a title must match both duration and size.
+
+The oldest recording of each group is kept. With --trash the others are
+moved to the .trash folder inside the videos directory; nothing is deleted.
+To choose which copy to keep, use the duplicates screen of the history
+(ctrl+d) instead.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		videosDir := config.GetDefaultVideosDir()
+		recordings, err := retention.LoadRecordings(videosDir)
+		if err != nil {
+			return fmt.Errorf("failed to load recordings: %w", err)
+		}
+
+		groups := duplicates.Find(recordings)
+		if len(groups) == 0 {
+			stdout.Println("No likely duplicates found.")
+			return nil
+		}
+		printDuplicateGroups(groups)
+
+		if !duplicatesTrash {
+			stdout.Println("\nNothing was moved. Use --trash to move the extra copies to the trash.")
+			return nil
+		}
+
+		trashDir := filepath.Join(videosDir, config.TrashDirName)
+		moved, failed := 0, 0
+		for _, g := range groups {
+			for _, rec := range g.Recordings[1:] {
+				if _, err := retention.Move(rec.Files.FolderPath, trashDir); err != nil {
+					stderr.Printf("Warning: %v\n", err)
+					failed++
+					continue
+				}
+				moved++
+			}
+		}
+		stdout.Printf("\n%d recording(s) moved to trash.\n", moved)
+		if failed > 0 {
+			return fmt.Errorf("%d recording(s) could not be moved", failed)
+		}
+		return nil
+	},
+}
+
+func init() {
+	duplicatesCmd.Flags().BoolVar(&duplicatesTrash, "trash", false, "Move all but the oldest recording of each group to the trash")
+	rootCmd.AddCommand(duplicatesCmd)
+}
+
+// printDuplicateGroups lists the groups of likely duplicates, marking the
+// recording that is kept
+func printDuplicateGroups(groups []duplicates.Group) {
+	stdout.Printf("%d group(s) of likely duplicates:\n", len(groups))
+	for i, g := range groups {
+		stdout.Printf("\nGroup %d:\n", i+1)
+		for j, rec := range g.Recordings {
+			mark := "extra"
+			if j == 0 {
+				mark = "keep "
+			}
+			title := rec.Metadata.Title
+			if title == "" {
+				title = "(untitled)"
+			}
+			stdout.Printf("  %s  %s  %-40s %8s %10s  %s\n", mark,
+				rec.StartTime.Format("2006-01-02 15:04"), title,
+				models.FormatDuration(rec.Duration), models.FormatFileSize(rec.Files.TotalSize),
+				rec.Files.FolderPath)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/retention"
+	"github.com/spf13/cobra"
+)
+
+var (
+	listJSON   bool
+	listStatus string
+)
+
+// listedRecording is a recording as printed by list --json
+type listedRecording struct {
+	Folder          string  `json:"folder"`
+	Title           string  `json:"title"`
+	Topic           string  `json:"topic"`
+	Status          string  `json:"status"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	TotalSize       int64   `json:"total_size"`
+	YouTubeURL      string  `json:"youtube_url,omitempty"`
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List recordings, optionally as JSON",
+	Long: `List the recordings in the videos directory, newest first.
+
+With --json an array of recordings is printed with the folder, title, topic,
+status, duration in seconds, total size in bytes and YouTube URL of each, for
+use in scripts and dashboards. --status only lists recordings with that
+status.
+
+The command fails if the videos directory does not exist.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if listStatus != "" && !slices.Contains(models.Statuses, listStatus) {
+			return fmt.Errorf("unknown status %q, expected one of: %s", listStatus, strings.Join(models.Statuses, ", "))
+		}
+
+		videosDir := config.GetDefaultVideosDir()
+		if _, err := os.Stat(videosDir); err != nil {
+			return fmt.Errorf("videos directory not found: %w", err)
+		}
+		recordings, err := retention.LoadRecordings(videosDir)
+		if err != nil {
+			return fmt.Errorf("failed to load recordings: %w", err)
+		}
+		sort.SliceStable(recordings, func(i, j int) bool {
+			return recordings[i].StartTime.After(recordings[j].StartTime)
+		})
+
+		var matched []models.RecordingInfo
+		for _, rec := range recordings {
+			if listStatus == "" || rec.Status == listStatus {
+				matched = append(matched, rec)
+			}
+		}
+
+		if listJSON {
+			listed := make([]listedRecording, 0, len(matched))
+			for _, rec := range matched {
+				listed = append(listed, newListedRecording(rec))
+			}
+			data, err := json.MarshalIndent(listed, "", "  ")
+			if err != nil {
+				return err
+			}
+			stdout.Println(string(data))
+			return nil
+		}
+
+		if len(matched) == 0 {
+			stdout.Println("No recordings found.")
+			return nil
+		}
+		for _, rec := range matched {
+			title := rec.Metadata.Title
+			if title == "" {
+				title = "(untitled)"
+			}
+			stdout.Printf("%-14s %-40s %-12s %8s %10s  %s\n", rec.Status, title, rec.Metadata.Topic,
+				models.FormatDuration(rec.Duration), models.FormatFileSize(rec.Files.TotalSize), rec.Files.FolderPath)
+		}
+		return nil
+	},
+}
+
+func init() {
+	listCmd.Flags().BoolVar(&listJSON, "json", false, "Output recordings as JSON")
+	listCmd.Flags().StringVar(&listStatus, "status", "", "Only list recordings with this status ("+strings.Join(models.Statuses, ", ")+")")
+	rootCmd.AddCommand(listCmd)
+}
+
+// newListedRecording returns the listed fields of a recording
+func newListedRecording(rec models.RecordingInfo) listedRecording {
+	listed := listedRecording{
+		Folder:          rec.Files.FolderPath,
+		Title:           rec.Metadata.Title,
+		Topic:           rec.Metadata.Topic,
+		Status:          rec.Status,
+		DurationSeconds: rec.Duration.Seconds(),
+		TotalSize:       rec.Files.TotalSize,
+	}
+	if yt := rec.Metadata.PrimaryYouTube(); yt != nil {
+		listed.YouTubeURL = yt.VideoURL
+		if listed.YouTubeURL == "" && yt.VideoID != "" {
+			listed.YouTubeURL = "https://www.youtube.com/watch?v=" + yt.VideoID
+		}
+	}
+	return listed
+}
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// saveRecording writes a recording to a folder named name in videosDir
+func saveRecording(t *testing.T, videosDir, name string, info models.RecordingInfo) string {
+	t.Helper()
+	info.Files.FolderPath = filepath.Join(videosDir, name)
+	if err := os.MkdirAll(info.Files.FolderPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := info.Save(); err != nil {
+		t.Fatal(err)
+	}
+	return info.Files.FolderPath
+}
+
+// listRecordings saves an older uploaded and a newer failed recording
+func listRecordings(t *testing.T) (videosDir, older, newer string) {
+	t.Helper()
+	videosDir = isolateDirs(t)
+
+	uploaded := models.RecordingInfo{
+		StartTime: time.Now().Add(-48 * time.Hour),
+		Duration:  90 * time.Second,
+		Status:    models.StatusCompleted,
+	}
+	uploaded.Metadata = models.RecordingMetadata{Title: "QGIS Tips", Topic: "QGIS",
+		YouTubeUploads: []models.YouTubeUpload{{VideoID: "abc123"}}}
+	uploaded.Files.TotalSize = 2048
+	older = saveRecording(t, videosDir, "001-qgis-tips", uploaded)
+
+	failed := models.RecordingInfo{
+		StartTime: time.Now().Add(-time.Hour),
+		Duration:  30 * time.Second,
+		Status:    models.StatusFailed,
+	}
+	failed.Metadata.Title = "Sprint Demo"
+	newer = saveRecording(t, videosDir, "002-sprint-demo", failed)
+	return videosDir, older, newer
+}
+
+func TestList_JSON(t *testing.T) {
+	_, older, newer := listRecordings(t)
+
+	out, _, err := runCommand(t, "list", "--json")
+	if err != nil {
+		t.Fatalf("list --json: %v", err)
+	}
+
+	var listed []map[string]interface{}
+	if err := json.Unmarshal([]byte(out), &listed); err != nil {
+		t.Fatalf("output is not a JSON array: %v\n%s", err, out)
+	}
+	want := []map[string]interface{}{
+		{"folder": newer, "title": "Sprint Demo", "topic": "", "status": "failed",
+			"duration_seconds": 30.0, "total_size": 0.0},
+		{"folder": older, "title": "QGIS Tips", "topic": "QGIS", "status": "completed",
+			"duration_seconds": 90.0, "total_size": 2048.0,
+			"youtube_url": "https://www.youtube.com/watch?v=abc123"},
+	}
+	if !reflect.DeepEqual(listed, want) {
+		t.Errorf("list --json =\n%v\nwant newest first\n%v", listed, want)
+	}
+}
+
+func TestList_Status(t *testing.T) {
+	_, older, _ := listRecordings(t)
+
+	out, _, err := runCommand(t, "list", "--json", "--status", models.StatusCompleted)
+	if err != nil {
+		t.Fatalf("list --status: %v", err)
+	}
+	var listed []listedRecording
+	if err := json.Unmarshal([]byte(out), &listed); err != nil {
+		t.Fatal(err)
+	}
+	if len(listed) != 1 || listed[0].Folder != older {
+		t.Errorf("list --status completed = %+v, want only the completed recording", listed)
+	}
+
+	// No match is an empty array, not null
+	out, _, err = runCommand(t, "list", "--json", "--status", models.StatusPaused)
+	if err != nil || strings.TrimSpace(out) != "[]" {
+		t.Errorf("list --status paused = %q, %v, want an empty array", out, err)
+	}
+
+	if _, _, err := runCommand(t, "list", "--status", "done"); err == nil || !strings.Contains(err.Error(), `unknown status "done"`) {
+		t.Errorf("list --status done: error = %v, want an unknown status", err)
+	}
+}
+
+func TestList_Text(t *testing.T) {
+	listRecordings(t)
+
+	out, _, err := runCommand(t, "list")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "failed") || !strings.Contains(lines[1], "QGIS Tips") {
+		t.Errorf("list =\n%s\nwant one line per recording, newest first", out)
+	}
+}
+
+func TestList_MissingVideosDir(t *testing.T) {
+	isolateDirs(t)
+	if _, _, err := runCommand(t, "list", "--json"); err == nil || !strings.Contains(err.Error(), "videos directory not found") {
+		t.Errorf("error = %v, want the videos directory not found", err)
+	}
+}
+
+func TestNewListedRecording_YouTubeURL(t *testing.T) {
+	rec := models.RecordingInfo{}
+	if got := newListedRecording(rec).YouTubeURL; got != "" {
+		t.Errorf("YouTubeURL = %q, want none before an upload", got)
+	}
+	rec.Metadata.YouTubeUploads = []models.YouTubeUpload{{VideoID: "abc123", VideoURL: "https://youtu.be/abc123"}}
+	if got := newListedRecording(rec).YouTubeURL; got != "https://youtu.be/abc123" {
+		t.Errorf("YouTubeURL = %q, want the stored URL", got)
+	}
+}
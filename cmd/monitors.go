@@ -25,7 +25,7 @@ var monitorsCmd = &cobra.Command{
 			if err != nil {
 				return err
 			}
-			fmt.Println(string(data))
+			stdout.Println(string(data))
 			return nil
 		}
 
@@ -39,7 +39,7 @@ var monitorsCmd = &cobra.Command{
 			if m.Name == cursor {
 				cursorMark = " (cursor)"
 			}
-			fmt.Printf("%s: %dx%d at (%d,%d)%s\n",
+			stdout.Printf("%s: %dx%d at (%d,%d)%s\n",
 				m.Name, m.Width, m.Height, m.X, m.Y, cursorMark)
 		}
 
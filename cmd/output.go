@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+
+	"github.com/kartoza/kartoza-screencaster/internal/recorder"
+	"golang.org/x/term"
+)
+
+// NoColorEnvVar disables styled CLI output when set to any non-empty value
+// (see https://no-color.org)
+const NoColorEnvVar = "NO_COLOR"
+
+// noColor is set from the --no-color flag
+var noColor bool
+
+// ansiPattern matches ANSI escape sequences (CSI and OSC)
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(\x07|\x1b\\)`)
+
+// colorEnabled reports whether CLI output may contain colors and styling
+func colorEnabled() bool {
+	return !noColor && os.Getenv(NoColorEnvVar) == ""
+}
+
+// isTerminal reports whether w is a terminal. Piped and redirected output is
+// not, so logs and scripts get plain text.
+var isTerminal = func(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
+// stripANSI removes all ANSI escape sequences from s
+func stripANSI(s string) string {
+	return ansiPattern.ReplaceAllString(s, "")
+}
+
+// cliOutput writes CLI output, stripping styling when color is disabled or
+// the output is not a terminal. Commands should print through stdout and
+// stderr rather than fmt directly so that NO_COLOR and --no-color produce
+// plain, log-friendly text.
+type cliOutput struct {
+	w io.Writer
+}
+
+// stdout and stderr are the formatters for standard output and error
+var (
+	stdout = &cliOutput{w: os.Stdout}
+	stderr = &cliOutput{w: os.Stderr}
+)
+
+// Print writes the operands like fmt.Print
+func (o *cliOutput) Print(args ...interface{}) {
+	o.write(fmt.Sprint(args...))
+}
+
+// Printf formats and writes to the output
+func (o *cliOutput) Printf(format string, args ...interface{}) {
+	o.write(fmt.Sprintf(format, args...))
+}
+
+// Println writes the operands followed by a newline
+func (o *cliOutput) Println(args ...interface{}) {
+	o.write(fmt.Sprintln(args...))
+}
+
+// styled reports whether styling is kept in the output
+func (o *cliOutput) styled() bool {
+	return colorEnabled() && isTerminal(o.w)
+}
+
+func (o *cliOutput) write(s string) {
+	if !o.styled() {
+		s = stripANSI(s)
+	}
+	_, _ = io.WriteString(o.w, s)
+}
+
+// Progress output formats for headless processing commands
+const (
+	progressFormatText = "text"
+	progressFormatJSON = "json"
+)
+
+// validateProgressFormat returns an error if format is not a known progress format
+func validateProgressFormat(format string) error {
+	switch format {
+	case progressFormatText, progressFormatJSON:
+		return nil
+	}
+	return fmt.Errorf("invalid --progress value %q (use %s or %s)", format, progressFormatText, progressFormatJSON)
+}
+
+// jsonProgressHandler returns a progress handler that writes one JSON object
+// per update to w, so wrapping scripts can render their own progress UI
+func jsonProgressHandler(w io.Writer) func(recorder.ProgressUpdate) {
+	enc := json.NewEncoder(w)
+	return func(update recorder.ProgressUpdate) {
+		_ = enc.Encode(update.Event())
+	}
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+)
+
+const styledText = "\x1b[1;38;2;76;175;80mAll installed\x1b[0m \x1b]8;;https://example.com\x07link\x1b]8;;\x07"
+
+// terminalOutput makes every writer count as a terminal, or none, for the test
+func terminalOutput(t *testing.T, terminal bool) {
+	t.Helper()
+	orig := isTerminal
+	isTerminal = func(io.Writer) bool { return terminal }
+	t.Cleanup(func() { isTerminal = orig })
+}
+
+// withNoColorFlag sets the --no-color flag for the test
+func withNoColorFlag(t *testing.T, value bool) {
+	t.Helper()
+	orig := noColor
+	noColor = value
+	t.Cleanup(func() { noColor = orig })
+}
+
+func TestStripANSI(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"plain", "plain"},
+		{"\x1b[1mbold\x1b[0m", "bold"},
+		{"\x1b[38;5;208morange\x1b[m text", "orange text"},
+		{"\x1b[2K\x1b[1Gline", "line"},
+		{"\x1b]0;title\x1b\\after", "after"},
+		{styledText, "All installed link"},
+		{"  [....] Uploading: 50%\r", "  [....] Uploading: 50%\r"},
+	}
+	for _, tt := range tests {
+		if got := stripANSI(tt.in); got != tt.want {
+			t.Errorf("stripANSI(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestColorEnabled(t *testing.T) {
+	t.Setenv(NoColorEnvVar, "")
+	withNoColorFlag(t, false)
+	if !colorEnabled() {
+		t.Error("expected color by default")
+	}
+
+	t.Setenv(NoColorEnvVar, "1")
+	if colorEnabled() {
+		t.Errorf("expected no color with %s set", NoColorEnvVar)
+	}
+
+	t.Setenv(NoColorEnvVar, "")
+	noColor = true
+	if colorEnabled() {
+		t.Error("expected no color with --no-color")
+	}
+}
+
+func TestCLIOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      string
+		flag     bool
+		terminal bool
+		want     string
+	}{
+		{"terminal keeps styling", "", false, true, styledText + "\n"},
+		{"NO_COLOR strips", "1", false, true, "All installed link\n"},
+		{"--no-color strips", "", true, true, "All installed link\n"},
+		{"non-terminal strips", "", false, false, "All installed link\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(NoColorEnvVar, tt.env)
+			withNoColorFlag(t, tt.flag)
+			terminalOutput(t, tt.terminal)
+
+			var buf bytes.Buffer
+			out := &cliOutput{w: &buf}
+			out.Println(styledText)
+			if got := buf.String(); got != tt.want {
+				t.Errorf("output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCLIOutput_PrintFunctions(t *testing.T) {
+	t.Setenv(NoColorEnvVar, "1")
+	var buf bytes.Buffer
+	out := &cliOutput{w: &buf}
+
+	out.Print("\x1b[1mDone\x1b[0m", " ")
+	out.Printf("%d %s\n", 2, "\x1b[32mfiles\x1b[0m")
+	out.Println("a", "b")
+	if got, want := buf.String(), "Done 2 files\na b\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestIsTerminal(t *testing.T) {
+	if isTerminal(&bytes.Buffer{}) {
+		t.Error("expected a buffer not to be a terminal")
+	}
+
+	// A pipe is what output redirected to a file or another program looks like
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+	if isTerminal(w) {
+		t.Error("expected a pipe not to be a terminal")
+	}
+}
@@ -26,14 +26,14 @@ All parts will be concatenated when the recording is stopped.`,
 			return fmt.Errorf("no recording in progress")
 		}
 
-		fmt.Println("Pausing recording...")
+		stdout.Println("Pausing recording...")
 		if err := rec.Pause(); err != nil {
 			return err
 		}
 
 		status := rec.GetStatus()
-		fmt.Printf("Recording paused at part %d.\n", status.CurrentPart-1)
-		fmt.Println("Use 'kartoza-screencaster resume' to continue recording.")
+		stdout.Printf("Recording paused at part %d.\n", status.CurrentPart-1)
+		stdout.Println("Use 'kartoza-screencaster resume' to continue recording.")
 
 		return nil
 	},
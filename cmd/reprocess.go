@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/notify"
+	"github.com/kartoza/kartoza-screencaster/internal/recorder"
+	"github.com/spf13/cobra"
+)
+
+var (
+	reprocessProgressFormat string
+	reprocessPrintCommands  bool
+)
+
+var reprocessCmd = &cobra.Command{
+	Use:   "reprocess <recording-folder>...",
+	Short: "Process existing recordings again",
+	Long: `Run the processing pipeline again on existing recordings without the TUI,
+e.g. to re-render a backlog after updating logos, or from cron.
+
+The recording settings stored in recording.json (logos, output resolution,
+filter presets, vertical video) are used, and the previous processing results
+and errors are cleared first. Folders are processed one after the other; a
+failure does not stop the others.
+
+The command exits non-zero if any processing step failed, after printing the
+errors and traceback of each failed recording to stderr. Use --progress=json
+to print one JSON object per progress update instead of text.
+
+Use --print-commands to print the ffmpeg commands processing would run,
+without running them or changing the recording, e.g. to debug a failing step
+or run it by hand. Steps that probe a file made by an earlier step use the
+file left by a previous run, and the measured loudness is shown as
+placeholders such as <input_i> to fill in from the analysis command.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := validateProgressFormat(reprocessProgressFormat); err != nil {
+			return err
+		}
+		if reprocessPrintCommands {
+			return printCommands(args)
+		}
+
+		// Processing clears the state files of the current session
+		if recorder.New().IsRecording() {
+			return fmt.Errorf("a recording is in progress, stop it before reprocessing")
+		}
+
+		failed := 0
+		for _, folder := range args {
+			if err := reprocess(folder); err != nil {
+				stderr.Printf("Error: %s: %v\n", folder, err)
+				failed++
+			}
+		}
+		if failed > 0 {
+			return fmt.Errorf("%d of %d recording(s) failed to process", failed, len(args))
+		}
+		return nil
+	},
+}
+
+func init() {
+	reprocessCmd.Flags().StringVar(&reprocessProgressFormat, "progress", progressFormatText, "Progress output format: text or json (one JSON object per line)")
+	reprocessCmd.Flags().BoolVar(&reprocessPrintCommands, "print-commands", false, "Print the ffmpeg commands processing would run, without running them")
+	rootCmd.AddCommand(reprocessCmd)
+}
+
+// reprocess runs the processing pipeline on the recording in folder and
+// returns an error if any step failed
+func reprocess(folder string) error {
+	info, err := models.LoadRecordingInfo(folder)
+	if err != nil {
+		return fmt.Errorf("failed to load recording: %w", err)
+	}
+
+	// Clear previous processing status and errors, as the history does
+	info.ResetProcessing()
+	if err := info.Save(); err != nil {
+		return fmt.Errorf("failed to save recording: %w", err)
+	}
+
+	rec := recorder.New()
+	rec.SetRecordingInfo(info)
+	if reprocessProgressFormat == progressFormatJSON {
+		rec.SetProgressHandler(jsonProgressHandler(stdout.w))
+	} else {
+		title := info.Metadata.Title
+		if title == "" {
+			title = folder
+		}
+		stdout.Printf("Processing %s...\n", title)
+	}
+	rec.Process()
+
+	if info.Status != models.StatusFailed && len(info.Processing.Errors) == 0 {
+		if reprocessProgressFormat != progressFormatJSON {
+			stdout.Printf("Processed %s\n", info.Files.MergedFile)
+		}
+		return nil
+	}
+
+	for _, e := range info.Processing.Errors {
+		stderr.Printf("  %s\n", e)
+	}
+	if info.Processing.ErrorDetail != "" {
+		stderr.Printf("\n%s\n", info.Processing.ErrorDetail)
+	}
+	if info.Processing.Traceback != "" {
+		stderr.Printf("\nTraceback:\n%s\n", info.Processing.Traceback)
+	}
+	if info.Processing.LogFile != "" {
+		stderr.Printf("\nProcessing log: %s\n", info.Processing.LogFile)
+	}
+	return fmt.Errorf("processing failed")
+}
+
+// printCommands prints the ffmpeg commands processing would run for each
+// recording folder, without running them or saving the recordings
+func printCommands(folders []string) error {
+	// Planning goes through the steps, which report themselves as
+	// notifications
+	notify.Disabled = true
+
+	failed := 0
+	for _, folder := range folders {
+		info, err := models.LoadRecordingInfo(folder)
+		if err != nil {
+			stderr.Printf("Error: %s: failed to load recording: %v\n", folder, err)
+			failed++
+			continue
+		}
+
+		rec := recorder.New()
+		rec.SetRecordingInfo(info)
+		commands, err := rec.PlanCommands()
+		stdout.Printf("# %s\n", folder)
+		for _, command := range commands {
+			stdout.Printf("%s\n", command)
+		}
+		stdout.Println()
+		if err != nil {
+			stderr.Printf("Error: %s: %v\n", folder, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d recording(s) could not be planned", failed, len(folders))
+	}
+	return nil
+}
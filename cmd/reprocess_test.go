@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/recorder"
+)
+
+// recordedFolder saves a failed recording with a screen and audio file
+func recordedFolder(t *testing.T) string {
+	t.Helper()
+	videosDir := isolateDirs(t)
+	info := models.RecordingInfo{Status: models.StatusFailed}
+	info.Metadata.Title = "Sprint Demo"
+	info.Processing.Errors = []string{"merge failed"}
+	info.Files.VideoFile = "screen.mp4"
+	info.Files.AudioFile = "audio.wav"
+	folder := saveRecording(t, videosDir, "001-sprint-demo", info)
+
+	for _, name := range []string{info.Files.VideoFile, info.Files.AudioFile} {
+		if err := os.WriteFile(filepath.Join(folder, name), []byte("recorded"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return folder
+}
+
+func TestReprocess_InvalidProgress(t *testing.T) {
+	folder := recordedFolder(t)
+	_, _, err := runCommand(t, "reprocess", "--progress", "xml", folder)
+	if err == nil || !strings.Contains(err.Error(), `invalid --progress value "xml"`) {
+		t.Errorf("error = %v, want an invalid --progress value", err)
+	}
+
+	if _, _, err := runCommand(t, "reprocess"); err == nil {
+		t.Error("expected an error without a recording folder")
+	}
+}
+
+func TestReprocess_PrintCommands(t *testing.T) {
+	folder := recordedFolder(t)
+
+	out, errOut, err := runCommand(t, "reprocess", "--print-commands", folder)
+	if err != nil {
+		t.Fatalf("reprocess --print-commands: %v\n%s", err, errOut)
+	}
+	if !strings.HasPrefix(out, "# "+folder+"\n") {
+		t.Errorf("output = %q, want the commands headed by the folder", out)
+	}
+	if !strings.Contains(out, "ffmpeg") || !strings.Contains(out, "screen-merged.mp4") {
+		t.Errorf("output = %q, want the merge command", out)
+	}
+
+	// Nothing is run or reset
+	info, err := models.LoadRecordingInfo(folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Status != models.StatusFailed || len(info.Processing.Errors) != 1 {
+		t.Errorf("status %q, errors %q: want the recording left as it was", info.Status, info.Processing.Errors)
+	}
+	if _, err := os.Stat(filepath.Join(folder, "screen-merged.mp4")); err == nil {
+		t.Error("expected nothing to be processed")
+	}
+
+	// --progress is still checked
+	if _, _, err := runCommand(t, "reprocess", "--print-commands", "--progress", "xml", folder); err == nil {
+		t.Error("expected an invalid --progress value to fail with --print-commands")
+	}
+}
+
+func TestReprocess_PrintCommandsContinuesAfterFailure(t *testing.T) {
+	folder := recordedFolder(t)
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	out, errOut, err := runCommand(t, "reprocess", "--print-commands", missing, folder)
+	if err == nil || err.Error() != "1 of 2 recording(s) could not be planned" {
+		t.Errorf("error = %v, want one of two recordings failed", err)
+	}
+	if !strings.Contains(errOut, "Error: "+missing+": failed to load recording") {
+		t.Errorf("stderr = %q, want the missing folder reported", errOut)
+	}
+	if !strings.Contains(out, "# "+folder) {
+		t.Errorf("output = %q, want the other recording planned", out)
+	}
+}
+
+func TestJSONProgressHandler(t *testing.T) {
+	var buf strings.Builder
+	handle := jsonProgressHandler(&buf)
+	handle(recorder.ProgressUpdate{Step: 2, Percent: 50})
+	handle(recorder.ProgressUpdate{Step: 2, Completed: true, Percent: -1})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("output = %q, want one line per update", buf.String())
+	}
+	var event recorder.ProgressEvent
+	if err := json.Unmarshal([]byte(lines[0]), &event); err != nil {
+		t.Fatal(err)
+	}
+	if event.Step != 2 || event.Status != recorder.ProgressStatusProgress || event.Percent == nil || *event.Percent != 50 {
+		t.Errorf("event = %+v, want step 2 at 50%%", event)
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &event); err != nil {
+		t.Fatal(err)
+	}
+	if event.Status != recorder.ProgressStatusDone {
+		t.Errorf("status = %q, want done", event.Status)
+	}
+}
@@ -21,14 +21,14 @@ recording. All parts will be concatenated when the recording is stopped.`,
 			return fmt.Errorf("no paused recording to resume")
 		}
 
-		fmt.Println("Resuming recording...")
+		stdout.Println("Resuming recording...")
 		if err := rec.Resume(); err != nil {
 			return err
 		}
 
 		status := rec.GetStatus()
-		fmt.Printf("Recording resumed at part %d.\n", status.CurrentPart)
-		fmt.Println("Use 'kartoza-screencaster pause' to pause or 'stop' to finish.")
+		stdout.Printf("Recording resumed at part %d.\n", status.CurrentPart)
+		stdout.Println("Use 'kartoza-screencaster pause' to pause or 'stop' to finish.")
 
 		return nil
 	},
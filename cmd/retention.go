@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/notify"
+	"github.com/kartoza/kartoza-screencaster/internal/retention"
+	"github.com/spf13/cobra"
+)
+
+var retentionApply bool
+
+var retentionCmd = &cobra.Command{
+	Use:   "retention",
+	Short: "Show or apply the retention policy for old recordings",
+	Long: `Show which recordings the retention policy would archive or move to the trash.
+
+The policy is configured in the Options screen (action, age threshold and
+whether only recordings uploaded to YouTube are affected). Archived recordings
+are moved to the .archive folder and trashed recordings to the .trash folder
+inside the videos directory; nothing is deleted.
+
+Without --apply this is a dry run. The policy also runs when the TUI starts,
+but only after its first dry run has been confirmed.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		if !cfg.Retention.Enabled() {
+			stdout.Println("Retention policy is off. Enable it in the Options screen.")
+			return nil
+		}
+
+		videosDir := config.GetDefaultVideosDir()
+		result, err := retention.Run(videosDir, cfg.Retention, !retentionApply)
+		if err != nil {
+			return fmt.Errorf("failed to run retention policy: %w", err)
+		}
+
+		if !retentionApply {
+			printRetentionPlan(result)
+			if len(result.Candidates) > 0 {
+				stdout.Println("\nDry run: nothing was moved. Use --apply to apply the policy.")
+			}
+			return nil
+		}
+
+		if !cfg.Retention.Reviewed {
+			cfg.Retention.Reviewed = true
+			if err := config.Save(cfg); err != nil {
+				return fmt.Errorf("failed to save config: %w", err)
+			}
+		}
+		printRetentionResult(result)
+		if len(result.Errors) > 0 {
+			return fmt.Errorf("%d recording(s) could not be moved", len(result.Errors))
+		}
+		return nil
+	},
+}
+
+func init() {
+	retentionCmd.Flags().BoolVar(&retentionApply, "apply", false, "Move the matching recordings instead of doing a dry run")
+	rootCmd.AddCommand(retentionCmd)
+}
+
+// retentionVerb describes an action in the past tense
+func retentionVerb(action config.RetentionAction) string {
+	if action == config.RetentionActionTrash {
+		return "moved to trash"
+	}
+	return "archived"
+}
+
+// printRetentionPlan lists the recordings the policy would act on
+func printRetentionPlan(result *retention.Result) {
+	if len(result.Candidates) == 0 {
+		stdout.Println("No recordings match the retention policy.")
+		return
+	}
+
+	stdout.Printf("%d recording(s) would be %s:\n", len(result.Candidates), retentionVerb(result.Action))
+	for _, c := range result.Candidates {
+		uploaded := ""
+		if c.Uploaded {
+			uploaded = ", on YouTube"
+		}
+		stdout.Printf("  %s  %s (%d days old%s)\n", c.RecordedAt.Format("2006-01-02"), c.Title, c.AgeDays, uploaded)
+	}
+}
+
+// printRetentionResult reports what a retention run moved
+func printRetentionResult(result *retention.Result) {
+	stdout.Printf("%d recording(s) %s.\n", len(result.Moved), retentionVerb(result.Action))
+	for _, err := range result.Errors {
+		stderr.Printf("Warning: %v\n", err)
+	}
+}
+
+// runStartupRetention applies the retention policy before the TUI starts.
+// Until the user has confirmed a dry run, it only shows what the policy would
+// do and asks before moving anything.
+func runStartupRetention() {
+	cfg, err := config.Load()
+	if err != nil || !cfg.Retention.Enabled() {
+		return
+	}
+	videosDir := config.GetDefaultVideosDir()
+
+	if !cfg.Retention.Reviewed {
+		plan, err := retention.Run(videosDir, cfg.Retention, true)
+		if err != nil || len(plan.Candidates) == 0 {
+			return
+		}
+
+		stdout.Println("Retention policy (first run, dry run):")
+		printRetentionPlan(plan)
+		if !confirmPrompt("Apply the retention policy now? [y/N] ") {
+			stdout.Println("Nothing was moved. Run 'kartoza-screencaster retention --apply' to apply it later.")
+			return
+		}
+
+		cfg.Retention.Reviewed = true
+		if err := config.Save(cfg); err != nil {
+			stderr.Printf("Warning: failed to save config: %v\n", err)
+			return
+		}
+	}
+
+	result, err := retention.Run(videosDir, cfg.Retention, false)
+	if err != nil {
+		stderr.Printf("Warning: retention policy failed: %v\n", err)
+		return
+	}
+	if len(result.Moved) > 0 || len(result.Errors) > 0 {
+		printRetentionResult(result)
+		_ = notify.Info("Retention policy",
+			fmt.Sprintf("%d old recording(s) %s", len(result.Moved), retentionVerb(result.Action)))
+	}
+}
+
+// confirmPrompt asks a yes/no question on the terminal. It returns false
+// without prompting when stdin is not interactive.
+func confirmPrompt(prompt string) bool {
+	stat, err := os.Stdin.Stat()
+	if err != nil || stat.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+
+	stdout.Print(prompt)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
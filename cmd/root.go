@@ -1,9 +1,9 @@
 package cmd
 
 import (
-	"fmt"
 	"os"
 
+	"github.com/kartoza/kartoza-screencaster/internal/config"
 	"github.com/spf13/cobra"
 )
 
@@ -11,6 +11,7 @@ var (
 	version           = "0.7.5-dev"
 	debugMode         bool
 	dataDir           string
+	configDir         string
 	noSplash          bool
 	presetsMode       bool
 	editRecordingMode bool
@@ -33,11 +34,33 @@ It supports:
   - Audio normalization using EBU R128 loudness standards
   - Hardware and software video encoding
 
-The tool integrates with Hyprland and other wlroots-based compositors.`,
+The tool integrates with Hyprland and other wlroots-based compositors.
+
+The configuration directory can be overridden with --config-dir or the
+KARTOZA_SCREENCASTER_CONFIG_DIR environment variable, which is useful for
+running isolated instances (testing, multiple identities). Otherwise the
+XDG base directories (XDG_CONFIG_HOME, XDG_DATA_HOME, XDG_CACHE_HOME and
+XDG_VIDEOS_DIR) are respected, and an existing ~/.config/kartoza-screencaster
+is migrated to $XDG_CONFIG_HOME on first run. Application state such as the
+new recording draft is kept in the data directory (--data-dir), and upload
+thumbnails in the cache directory.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := config.SetConfigDirOverride(configDir); err != nil {
+			return err
+		}
+		if err := config.SetDataDirOverride(dataDir); err != nil {
+			return err
+		}
+		if err := config.MigrateLegacyConfigDir(); err != nil {
+			stderr.Printf("Warning: %v\n", err)
+		}
+		return nil
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		// Default action: start TUI or toggle recording
+		runStartupRetention()
 		if err := runTUI(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			stderr.Printf("Error: %v\n", err)
 			os.Exit(1)
 		}
 	},
@@ -45,14 +68,16 @@ The tool integrates with Hyprland and other wlroots-based compositors.`,
 
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		stderr.Printf("Error: %v\n", err)
 		os.Exit(1)
 	}
 }
 
 func init() {
 	rootCmd.PersistentFlags().BoolVar(&debugMode, "debug", false, "Enable debug mode")
-	rootCmd.PersistentFlags().StringVar(&dataDir, "data-dir", "", "Data directory (default: ~/.config/kartoza-screencaster)")
+	rootCmd.PersistentFlags().StringVar(&dataDir, "data-dir", "", "Data directory for application state (default: $XDG_DATA_HOME/kartoza-screencaster)")
+	rootCmd.PersistentFlags().StringVar(&configDir, "config-dir", "", "Configuration directory (overrides $"+config.ConfigDirEnvVar+")")
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colored output for CLI commands (also honours $"+NoColorEnvVar+")")
 	rootCmd.PersistentFlags().BoolVar(&noSplash, "nosplash", false, "Skip splash screens on startup and exit")
 	rootCmd.PersistentFlags().BoolVar(&presetsMode, "presets", false, "Open directly to recording presets configuration")
 	rootCmd.PersistentFlags().BoolVar(&editRecordingMode, "edit-recording", false, "Open to edit the latest recording that needs metadata")
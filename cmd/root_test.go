@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/spf13/pflag"
+)
+
+// runCommand runs the CLI with args in isolated config, data and videos
+// directories and returns what it printed to stdout and stderr
+func runCommand(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+	t.Setenv(NoColorEnvVar, "1")
+
+	var out, errOut bytes.Buffer
+	origOut, origErr := stdout, stderr
+	stdout, stderr = &cliOutput{w: &out}, &cliOutput{w: &errOut}
+	rootCmd.SetOut(&out)
+	rootCmd.SetErr(&errOut)
+	rootCmd.SetArgs(args)
+	t.Cleanup(func() {
+		stdout, stderr = origOut, origErr
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+		rootCmd.SetArgs(nil)
+		resetFlags()
+	})
+
+	err := rootCmd.Execute()
+	return out.String(), errOut.String(), err
+}
+
+// isolateDirs points the config, data and videos directories at temporary
+// directories and returns the videos directory
+func isolateDirs(t *testing.T) string {
+	t.Helper()
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("XDG_VIDEOS_DIR", t.TempDir())
+	return config.GetDefaultVideosDir()
+}
+
+// resetFlags restores every flag to its default, as cobra keeps the values
+// of earlier runs
+func resetFlags() {
+	reset := func(f *pflag.Flag) {
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	}
+	rootCmd.PersistentFlags().VisitAll(reset)
+	for _, c := range rootCmd.Commands() {
+		c.Flags().VisitAll(reset)
+	}
+}
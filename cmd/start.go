@@ -116,13 +116,13 @@ Use 'kartoza-screencaster stop' to stop recording and process files.`,
 			RecordingInfo: recordingInfo,
 		}
 
-		fmt.Printf("Starting recording #%d...\n", seqNum)
-		fmt.Printf("Output: %s\n", recordingDir)
+		stdout.Printf("Starting recording #%d...\n", seqNum)
+		stdout.Printf("Output: %s\n", recordingDir)
 		if err := rec.StartWithOptions(opts); err != nil {
 			return err
 		}
 
-		fmt.Println("Recording started. Use 'kartoza-screencaster stop' to stop.")
+		stdout.Println("Recording started. Use 'kartoza-screencaster stop' to stop.")
 		return nil
 	},
 }
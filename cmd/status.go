@@ -58,7 +58,7 @@ Example waybar configuration:
 			if err != nil {
 				return err
 			}
-			fmt.Println(string(data))
+			stdout.Println(string(data))
 			return nil
 		}
 
@@ -67,30 +67,30 @@ Example waybar configuration:
 			if err != nil {
 				return err
 			}
-			fmt.Println(string(data))
+			stdout.Println(string(data))
 			return nil
 		}
 
 		if status.IsRecording {
 			duration := time.Since(status.StartTime).Round(time.Second)
-			fmt.Printf("Recording: ACTIVE\n")
-			fmt.Printf("Duration:  %s\n", duration)
-			fmt.Printf("Part:      %d\n", status.CurrentPart)
-			fmt.Printf("Monitor:   %s\n", status.Monitor)
-			fmt.Printf("Video:     %s\n", status.VideoFile)
-			fmt.Printf("Audio:     %s\n", status.AudioFile)
+			stdout.Printf("Recording: ACTIVE\n")
+			stdout.Printf("Duration:  %s\n", duration)
+			stdout.Printf("Part:      %d\n", status.CurrentPart)
+			stdout.Printf("Monitor:   %s\n", status.Monitor)
+			stdout.Printf("Video:     %s\n", status.VideoFile)
+			stdout.Printf("Audio:     %s\n", status.AudioFile)
 			if status.WebcamFile != "" {
-				fmt.Printf("Webcam:    %s\n", status.WebcamFile)
+				stdout.Printf("Webcam:    %s\n", status.WebcamFile)
 			}
 		} else if status.IsPaused {
 			duration := time.Since(status.StartTime).Round(time.Second)
-			fmt.Printf("Recording: PAUSED\n")
-			fmt.Printf("Duration:  %s (before pause)\n", duration)
-			fmt.Printf("Parts:     %d recorded\n", status.CurrentPart)
-			fmt.Println("\nUse 'kartoza-screencaster resume' to continue recording.")
-			fmt.Println("Use 'kartoza-screencaster stop' to finish and process.")
+			stdout.Printf("Recording: PAUSED\n")
+			stdout.Printf("Duration:  %s (before pause)\n", duration)
+			stdout.Printf("Parts:     %d recorded\n", status.CurrentPart)
+			stdout.Println("\nUse 'kartoza-screencaster resume' to continue recording.")
+			stdout.Println("Use 'kartoza-screencaster stop' to finish and process.")
 		} else {
-			fmt.Println("Recording: INACTIVE")
+			stdout.Println("Recording: INACTIVE")
 		}
 
 		return nil
@@ -8,6 +8,7 @@ import (
 )
 
 var noProcess bool
+var stopProgressFormat string
 
 var stopCmd = &cobra.Command{
 	Use:   "stop",
@@ -15,23 +16,36 @@ var stopCmd = &cobra.Command{
 	Long: `Stop the current screen recording session and process the captured files.
 
 By default, this command waits for post-processing to complete (merging audio,
-creating vertical video, etc.). Use --no-process to skip post-processing.`,
+creating vertical video, etc.). Use --no-process to skip post-processing.
+
+Use --progress=json to print one JSON object per processing progress update
+(step, step_name, status, percent, error) instead of human-readable text,
+for scripts that display their own progress UI.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := validateProgressFormat(stopProgressFormat); err != nil {
+			return err
+		}
+
 		rec := recorder.New()
 
 		if !rec.IsRecording() {
 			return fmt.Errorf("no recording in progress")
 		}
 
-		fmt.Println("Stopping recording...")
+		if stopProgressFormat == progressFormatJSON {
+			rec.SetProgressHandler(jsonProgressHandler(stdout.w))
+			return rec.StopAndProcess(!noProcess)
+		}
+
+		stdout.Println("Stopping recording...")
 		if err := rec.StopAndProcess(!noProcess); err != nil {
 			return err
 		}
 
 		if noProcess {
-			fmt.Println("Recording stopped. Post-processing skipped.")
+			stdout.Println("Recording stopped. Post-processing skipped.")
 		} else {
-			fmt.Println("Recording stopped and processed.")
+			stdout.Println("Recording stopped and processed.")
 		}
 
 		return nil
@@ -40,4 +54,5 @@ creating vertical video, etc.). Use --no-process to skip post-processing.`,
 
 func init() {
 	stopCmd.Flags().BoolVar(&noProcess, "no-process", false, "Skip post-processing (merging, vertical video, etc.)")
+	stopCmd.Flags().StringVar(&stopProgressFormat, "progress", progressFormatText, "Progress output format: text or json (one JSON object per line)")
 }
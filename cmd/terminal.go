@@ -61,20 +61,20 @@ func runTerminalRecording(cmd *cobra.Command, args []string) {
 
 	// Check dependencies
 	if !terminal.IsAsciinemaAvailable() {
-		fmt.Fprintln(os.Stderr, "Error: asciinema is not installed")
-		fmt.Fprintln(os.Stderr, "Install it with: nix-env -iA nixpkgs.asciinema")
+		stderr.Println("Error: asciinema is not installed")
+		stderr.Println("Install it with: nix-env -iA nixpkgs.asciinema")
 		os.Exit(1)
 	}
 
 	if !terminal.IsAggAvailable() {
-		fmt.Fprintln(os.Stderr, "Warning: agg is not installed - video conversion will be limited")
-		fmt.Fprintln(os.Stderr, "Install it with: nix-env -iA nixpkgs.agg")
+		stderr.Println("Warning: agg is not installed - video conversion will be limited")
+		stderr.Println("Install it with: nix-env -iA nixpkgs.agg")
 	}
 
 	// Load config
 	cfg, err := config.Load()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: Could not load config: %v\n", err)
+		stderr.Printf("Warning: Could not load config: %v\n", err)
 		cfg = &config.Config{}
 	}
 
@@ -94,7 +94,7 @@ func runTerminalRecording(cmd *cobra.Command, args []string) {
 	outputDir := filepath.Join(baseDir, folderName)
 
 	if err := os.MkdirAll(outputDir, 0755); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: Could not create output directory: %v\n", err)
+		stderr.Printf("Error: Could not create output directory: %v\n", err)
 		os.Exit(1)
 	}
 
@@ -116,7 +116,7 @@ func runTerminalRecording(cmd *cobra.Command, args []string) {
 	recordingInfo.SetStatus(models.StatusRecording)
 
 	if err := recordingInfo.Save(); err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: Could not save recording info: %v\n", err)
+		stderr.Printf("Warning: Could not save recording info: %v\n", err)
 	}
 
 	// Create recorder
@@ -128,19 +128,19 @@ func runTerminalRecording(cmd *cobra.Command, args []string) {
 
 	go func() {
 		<-sigChan
-		fmt.Println("\nStopping recording...")
+		stdout.Println("\nStopping recording...")
 		recorder.Stop()
 	}()
 
 	// Print instructions
-	fmt.Println("╭─────────────────────────────────────────────────────────╮")
-	fmt.Println("│           Terminal Recording Started                     │")
-	fmt.Println("├─────────────────────────────────────────────────────────┤")
-	fmt.Printf("│  Output: %-47s │\n", outputDir)
-	fmt.Println("│                                                          │")
-	fmt.Println("│  Press Ctrl+D or type 'exit' to stop recording          │")
-	fmt.Println("╰─────────────────────────────────────────────────────────╯")
-	fmt.Println()
+	stdout.Println("╭─────────────────────────────────────────────────────────╮")
+	stdout.Println("│           Terminal Recording Started                     │")
+	stdout.Println("├─────────────────────────────────────────────────────────┤")
+	stdout.Printf("│  Output: %-47s │\n", outputDir)
+	stdout.Println("│                                                          │")
+	stdout.Println("│  Press Ctrl+D or type 'exit' to stop recording          │")
+	stdout.Println("╰─────────────────────────────────────────────────────────╯")
+	stdout.Println()
 
 	// Start recording
 	opts := terminal.RecorderOptions{
@@ -150,15 +150,15 @@ func runTerminalRecording(cmd *cobra.Command, args []string) {
 	}
 
 	if err := recorder.Start(opts); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: Failed to start recording: %v\n", err)
+		stderr.Printf("Error: Failed to start recording: %v\n", err)
 		os.Exit(1)
 	}
 
 	// Wait for asciinema to finish (it runs interactively)
 	// The process will exit when user types 'exit' or presses Ctrl+D
 
-	fmt.Println()
-	fmt.Println("Recording stopped.")
+	stdout.Println()
+	stdout.Println("Recording stopped.")
 
 	// Get the cast file path
 	castFile := recorder.GetCastFile()
@@ -168,7 +168,7 @@ func runTerminalRecording(cmd *cobra.Command, args []string) {
 
 	// Check if cast file exists
 	if _, err := os.Stat(castFile); os.IsNotExist(err) {
-		fmt.Fprintln(os.Stderr, "Error: No recording file created")
+		stderr.Println("Error: No recording file created")
 		os.Exit(1)
 	}
 
@@ -178,7 +178,7 @@ func runTerminalRecording(cmd *cobra.Command, args []string) {
 	recordingInfo.Save()
 
 	// Convert to video
-	fmt.Println("Converting to video...")
+	stdout.Println("Converting to video...")
 	convertCastFileInDir(castFile, outputDir, cfg)
 
 	// Update final status
@@ -222,29 +222,29 @@ func convertCastFileInDir(castFile, outputDir string, cfg *config.Config) {
 	mp4File := filepath.Join(outputDir, "terminal.mp4")
 
 	// Convert to GIF
-	fmt.Print("  Creating GIF... ")
+	stdout.Print("  Creating GIF... ")
 	if err := terminal.ConvertToGif(castFile, gifFile, gifOpts); err != nil {
-		fmt.Printf("failed: %v\n", err)
+		stdout.Printf("failed: %v\n", err)
 	} else {
-		fmt.Println("done")
+		stdout.Println("done")
 	}
 
 	// Convert to MP4
-	fmt.Print("  Creating MP4... ")
+	stdout.Print("  Creating MP4... ")
 	if err := terminal.ConvertGifToMp4(gifFile, mp4File); err != nil {
-		fmt.Printf("failed: %v\n", err)
+		stdout.Printf("failed: %v\n", err)
 	} else {
-		fmt.Println("done")
+		stdout.Println("done")
 	}
 
-	fmt.Println()
-	fmt.Println("Output files:")
-	fmt.Printf("  Cast: %s\n", castFile)
+	stdout.Println()
+	stdout.Println("Output files:")
+	stdout.Printf("  Cast: %s\n", castFile)
 	if _, err := os.Stat(gifFile); err == nil {
-		fmt.Printf("  GIF:  %s\n", gifFile)
+		stdout.Printf("  GIF:  %s\n", gifFile)
 	}
 	if _, err := os.Stat(mp4File); err == nil {
-		fmt.Printf("  MP4:  %s\n", mp4File)
+		stdout.Printf("  MP4:  %s\n", mp4File)
 	}
 }
 
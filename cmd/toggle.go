@@ -1,8 +1,6 @@
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/kartoza/kartoza-screencaster/internal/recorder"
 	"github.com/spf13/cobra"
 )
@@ -15,11 +13,11 @@ var toggleCmd = &cobra.Command{
 		rec := recorder.New()
 
 		if rec.IsRecording() {
-			fmt.Println("Stopping recording...")
+			stdout.Println("Stopping recording...")
 			return rec.Stop()
 		}
 
-		fmt.Println("Starting recording...")
+		stdout.Println("Starting recording...")
 		return rec.Start()
 	},
 }
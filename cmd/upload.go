@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/spellcheck"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
+	"github.com/spf13/cobra"
+)
+
+var (
+	uploadPrivacy  string
+	uploadPlaylist string
+	uploadAccount  string
+	uploadVertical bool
+	uploadForKids  bool
+	uploadCategory string
+	uploadLanguage string
+	uploadAudio    string
+	uploadLicense  string
+)
+
+var uploadCmd = &cobra.Command{
+	Use:   "upload <recording-folder>",
+	Short: "Upload a processed recording to YouTube",
+	Long: `Upload a processed recording to YouTube without the TUI, using the title,
+description and topic stored in its recording.json.
+
+By default the account marked as default is used, or else the last used
+account; it must already be connected in Options > YouTube. For unattended
+uploads (servers, CI) credentials can be provided through the environment
+instead, which bypasses the interactive browser flow:
+
+  ` + youtube.EnvClientID + `
+  ` + youtube.EnvClientSecret + `
+  ` + youtube.EnvRefreshToken + `
+
+or
+
+  ` + youtube.EnvCredentialsFile + `=/path/to/credentials.json
+
+where the file is an "authorized_user" file (client_id, client_secret and
+refresh_token) or a "service_account" key.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		info, err := models.LoadRecordingInfo(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to load recording: %w", err)
+		}
+
+		videoPath := info.Files.MergedFile
+		if uploadVertical {
+			videoPath = info.Files.VerticalFile
+		}
+		if videoPath == "" {
+			return fmt.Errorf("recording has no processed video to upload")
+		}
+		if err := youtube.ValidateVideoFile(videoPath); err != nil {
+			return err
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+
+		account, err := uploadTargetAccount(cfg)
+		if err != nil {
+			return err
+		}
+
+		privacy := youtube.PrivacyStatus(uploadPrivacy)
+		if privacy == "" {
+			privacy = cfg.YouTube.DefaultPrivacy
+			// Sensitive topics are never public or unlisted by default
+			if cfg.IsSensitiveTopic(info.Metadata.Topic) {
+				privacy = youtube.PrivacyPrivate
+				stdout.Printf("%s is a sensitive topic, uploading as private (use --privacy to change it)\n", info.Metadata.Topic)
+			}
+		}
+		switch privacy {
+		case youtube.PrivacyPublic, youtube.PrivacyUnlisted, youtube.PrivacyPrivate:
+		case "":
+			privacy = youtube.PrivacyUnlisted
+		default:
+			return fmt.Errorf("invalid privacy %q (use public, unlisted or private)", privacy)
+		}
+
+		ctx := context.Background()
+		auth := youtube.NewAuthForAccount(account.ClientID, account.ClientSecret, config.GetConfigDir(), account.ID)
+		uploader, err := youtube.NewUploader(ctx, auth)
+		if err != nil {
+			return err
+		}
+
+		thumbnailPath := youtube.GetThumbnailPath(config.GetCacheDir(), videoPath)
+		if err := youtube.ExtractThumbnailForYouTube(videoPath, thumbnailPath); err != nil {
+			thumbnailPath = ""
+		}
+
+		madeForKids := cfg.YouTube.DefaultMadeForKids
+		if cmd.Flags().Changed("made-for-kids") {
+			madeForKids = uploadForKids
+		}
+
+		categoryID := youtube.DefaultCategoryFor(info.Metadata.Topic, cfg.YouTube.LastCategory, cfg.YouTube.DefaultCategory)
+		if uploadCategory != "" {
+			id, ok := youtube.ParseCategory(uploadCategory)
+			if !ok {
+				return fmt.Errorf("unknown category %q (use a YouTube category ID or name, e.g. 27 or Education)", uploadCategory)
+			}
+			categoryID = id
+		}
+
+		language := youtube.DefaultLanguageFor(cfg.YouTube.DefaultLanguage, spellcheck.Language)
+		if uploadLanguage != "" {
+			code, ok := youtube.ParseLanguage(uploadLanguage)
+			if !ok {
+				return fmt.Errorf("unknown language %q (use a language code or name, e.g. en-GB or French)", uploadLanguage)
+			}
+			language = code
+		}
+		audioLanguage := youtube.DefaultLanguageFor(cfg.YouTube.DefaultAudioLanguage, language)
+		if uploadAudio != "" {
+			code, ok := youtube.ParseLanguage(uploadAudio)
+			if !ok {
+				return fmt.Errorf("unknown audio language %q (use a language code or name, e.g. en-GB or French)", uploadAudio)
+			}
+			audioLanguage = code
+		}
+
+		license := cfg.YouTube.DefaultLicense
+		if uploadLicense != "" {
+			l, ok := youtube.ParseLicense(uploadLicense)
+			if !ok {
+				return fmt.Errorf("unknown license %q (use standard or cc)", uploadLicense)
+			}
+			license = l
+		}
+
+		opts := youtube.BuildUploadOptions(
+			videoPath,
+			info.Metadata.Title,
+			info.Metadata.Description,
+			info.Metadata.Topic,
+			nil,
+			privacy,
+			madeForKids,
+			categoryID,
+		)
+		opts.PlaylistID = uploadPlaylist
+		opts.ThumbnailPath = thumbnailPath
+		opts.Language = language
+		opts.AudioLanguage = audioLanguage
+		opts.License = license
+
+		uploader.SetRetryNotify(func(retry youtube.RetryInfo) {
+			stdout.Printf("\n  [WAIT] %s\n", retry)
+		})
+
+		stdout.Printf("Uploading %s (%s)...\n", info.Metadata.Title, privacy)
+		result, err := uploader.Upload(ctx, opts, func(read, total int64) {
+			if total > 0 {
+				stdout.Printf("  [....] Uploading: %.0f%%\r", float64(read)/float64(total)*100)
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("upload failed: %s", youtube.FriendlyError(err))
+		}
+
+		info.Metadata.AddYouTubeUpload(models.YouTubeUpload{
+			VideoID:     result.VideoID,
+			VideoURL:    result.VideoURL,
+			PlaylistID:  uploadPlaylist,
+			Privacy:     string(privacy),
+			MadeForKids: madeForKids,
+			CategoryID:  categoryID,
+			UploadedAt:  time.Now().Format(time.RFC3339),
+			ChannelName: account.ChannelName,
+			ChannelID:   account.ChannelID,
+			AccountID:   account.ID,
+
+			Language:      language,
+			AudioLanguage: audioLanguage,
+			License:       string(license),
+		})
+		if err := info.Save(); err != nil {
+			return fmt.Errorf("uploaded to %s but failed to save recording.json: %w", result.VideoURL, err)
+		}
+
+		stdout.Printf("  [DONE] Uploaded: %s\n", result.VideoURL)
+		return nil
+	},
+}
+
+func init() {
+	uploadCmd.Flags().StringVar(&uploadPrivacy, "privacy", "", "Privacy: public, unlisted or private (default: configured default privacy, private for sensitive topics)")
+	uploadCmd.Flags().StringVar(&uploadPlaylist, "playlist", "", "Playlist ID to add the video to")
+	uploadCmd.Flags().StringVar(&uploadAccount, "account", "", "YouTube account ID to upload with (default: the default or last used account)")
+	uploadCmd.Flags().BoolVar(&uploadVertical, "vertical", false, "Upload the vertical video instead of the merged video")
+	uploadCmd.Flags().StringVar(&uploadCategory, "category", "", "YouTube category ID or name, e.g. 27 or Education (default: from the topic or configured default)")
+	uploadCmd.Flags().StringVar(&uploadLanguage, "language", "", "Language of the title and description, e.g. en-GB or French (default: configured default, else en-GB)")
+	uploadCmd.Flags().StringVar(&uploadAudio, "audio-language", "", "Language spoken in the video (default: configured default, else the --language value)")
+	uploadCmd.Flags().StringVar(&uploadLicense, "license", "", "License: standard (YouTube) or cc (Creative Commons Attribution) (default: configured default license)")
+	uploadCmd.Flags().BoolVar(&uploadForKids, "made-for-kids", false, "Declare the video as made for kids (default: configured default audience)")
+	rootCmd.AddCommand(uploadCmd)
+}
+
+// uploadTargetAccount returns the account to upload with. Environment
+// credentials need no configured account, and cannot be combined with
+// --account since the video would go to the credentials' channel.
+func uploadTargetAccount(cfg *config.Config) (*youtube.Account, error) {
+	if youtube.HasEnvCredentials() {
+		if uploadAccount != "" {
+			return nil, fmt.Errorf("--account cannot be used with credentials from the environment: unset $%s (or the client ID, secret and refresh token variables) to upload with a configured account", youtube.EnvCredentialsFile)
+		}
+		return youtube.EnvAccount(), nil
+	}
+
+	var account *youtube.Account
+	if uploadAccount != "" {
+		account = cfg.YouTube.GetAccount(uploadAccount)
+		if account == nil {
+			return nil, fmt.Errorf("unknown YouTube account %q", uploadAccount)
+		}
+	} else {
+		account = cfg.YouTube.GetDefaultAccount()
+		if account == nil {
+			return nil, fmt.Errorf("no YouTube account configured: set one up in Options > YouTube or provide credentials via $%s", youtube.EnvCredentialsFile)
+		}
+	}
+
+	if !youtube.IsAccountAuthenticated(&cfg.YouTube, config.GetConfigDir(), account.ID) {
+		return nil, fmt.Errorf("YouTube account %q is not connected: authenticate it in Options > YouTube", account.Name)
+	}
+	return account, nil
+}
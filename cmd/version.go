@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"fmt"
 	"runtime"
 
 	"github.com/spf13/cobra"
@@ -11,8 +10,8 @@ var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print version information",
 	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Printf("kartoza-screencaster version %s\n", version)
-		fmt.Printf("Go version: %s\n", runtime.Version())
-		fmt.Printf("OS/Arch: %s/%s\n", runtime.GOOS, runtime.GOARCH)
+		stdout.Printf("kartoza-screencaster version %s\n", version)
+		stdout.Printf("Go version: %s\n", runtime.Version())
+		stdout.Printf("OS/Arch: %s/%s\n", runtime.GOOS, runtime.GOARCH)
 	},
 }
@@ -0,0 +1,154 @@
+// Package attention lists the recordings that need something done about
+// them, grouped into buckets for the needs attention dashboard. The buckets
+// are computed from recordings already loaded, so the dashboard opens fast.
+package attention
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/retention"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
+)
+
+// Bucket is a kind of problem a recording can have
+type Bucket int
+
+const (
+	NeedsMetadata    Bucket = iota // Stopped from the tray, waiting for a title
+	FailedProcessing               // Processing failed
+	FailedUpload                   // An upload stopped before it finished
+	StaleUpload                    // An uploaded video is gone from YouTube
+	OverBudget                     // Suggested for archiving to get back under the storage budget
+)
+
+// Buckets lists the buckets in the order they are shown
+var Buckets = []Bucket{NeedsMetadata, FailedProcessing, FailedUpload, StaleUpload, OverBudget}
+
+// bucketLabels provides human-readable labels for the buckets
+var bucketLabels = map[Bucket]string{
+	NeedsMetadata:    "Needs metadata",
+	FailedProcessing: "Processing failed",
+	FailedUpload:     "Upload not finished",
+	StaleUpload:      "Gone from YouTube",
+	OverBudget:       "Over the storage budget",
+}
+
+// Label returns the heading of the bucket
+func (b Bucket) Label() string {
+	return bucketLabels[b]
+}
+
+// Item is a recording in a bucket, with what is wrong with it
+type Item struct {
+	Bucket     Bucket
+	FolderPath string
+	Title      string
+	Reason     string
+}
+
+// Report is every recording that needs attention
+type Report struct {
+	Items  []Item               // Grouped by bucket, in the order of Buckets
+	Budget retention.BudgetPlan // Usage of the videos directory
+}
+
+// Count returns the number of items in bucket b
+func (r Report) Count(b Bucket) int {
+	n := 0
+	for _, item := range r.Items {
+		if item.Bucket == b {
+			n++
+		}
+	}
+	return n
+}
+
+// UploadCheck reports whether an upload of a recording stopped before it
+// finished
+type UploadCheck func(rec *models.RecordingInfo) bool
+
+// Collect returns the recordings that need attention. Within a bucket they
+// keep the order of recordings, except for the storage budget suggestions,
+// which keep the order of the plan. unfinished may be nil to skip the check
+// for uploads that did not finish.
+func Collect(recordings []models.RecordingInfo, budget retention.BudgetPlan, unfinished UploadCheck) Report {
+	report := Report{Budget: budget}
+	add := func(b Bucket, rec *models.RecordingInfo, reason string) {
+		report.Items = append(report.Items, Item{Bucket: b, FolderPath: rec.Files.FolderPath, Title: title(rec), Reason: reason})
+	}
+
+	for i := range recordings {
+		if rec := &recordings[i]; rec.Status == models.StatusNeedsMetadata {
+			add(NeedsMetadata, rec, "Add a title and description to process it")
+		}
+	}
+	for i := range recordings {
+		if rec := &recordings[i]; rec.Status == models.StatusFailed {
+			add(FailedProcessing, rec, failureReason(rec))
+		}
+	}
+	if unfinished != nil {
+		for i := range recordings {
+			if rec := &recordings[i]; unfinished(rec) {
+				add(FailedUpload, rec, "Upload again to resume where it stopped")
+			}
+		}
+	}
+	for i := range recordings {
+		if rec := &recordings[i]; rec.Metadata.HasMissingYouTubeVideo() {
+			add(StaleUpload, rec, missingReason(rec))
+		}
+	}
+	for _, c := range budget.Suggestions {
+		report.Items = append(report.Items, Item{
+			Bucket:     OverBudget,
+			FolderPath: c.FolderPath,
+			Title:      c.Title,
+			Reason:     fmt.Sprintf("Uploaded, archiving it frees %s", models.FormatFileSize(c.Size)),
+		})
+	}
+	return report
+}
+
+// UnfinishedUpload returns true if an upload of the merged, vertical or
+// webcam-only video of the recording stopped before it finished and can be
+// resumed
+func UnfinishedUpload(rec *models.RecordingInfo) bool {
+	for _, path := range []string{rec.Files.MergedFile, rec.Files.VerticalFile, rec.Files.WebcamOnlyFile} {
+		if path != "" && youtube.HasAnyUploadSession(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// title returns the title of the recording, or its folder name if untitled
+func title(rec *models.RecordingInfo) string {
+	if rec.Metadata.Title != "" {
+		return rec.Metadata.Title
+	}
+	return filepath.Base(rec.Files.FolderPath)
+}
+
+// failureReason returns the first line of the first processing error
+func failureReason(rec *models.RecordingInfo) string {
+	if len(rec.Processing.Errors) == 0 {
+		return "Processing failed"
+	}
+	reason, _, _ := strings.Cut(rec.Processing.Errors[0], "\n")
+	return reason
+}
+
+// missingReason says since when an uploaded video is gone from YouTube
+func missingReason(rec *models.RecordingInfo) string {
+	for _, upload := range rec.Metadata.AllYouTubeUploads() {
+		if since, err := time.Parse(time.RFC3339, upload.MissingSince); err == nil {
+			return fmt.Sprintf("Not found on YouTube since %s", since.Format("2006-01-02"))
+		}
+	}
+	return "Not found on YouTube"
+}
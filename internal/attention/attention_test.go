@@ -0,0 +1,68 @@
+package attention
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/retention"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
+)
+
+func TestCollect(t *testing.T) {
+	recordings := make([]models.RecordingInfo, 5)
+	for i, name := range []string{"fine", "untitled", "failed", "interrupted", "removed"} {
+		recordings[i].Files.FolderPath = "/videos/" + name
+		recordings[i].Metadata.Title = name
+		recordings[i].Status = models.StatusCompleted
+	}
+	recordings[1].Status = models.StatusNeedsMetadata
+	recordings[1].Metadata.Title = ""
+	recordings[2].Status = models.StatusFailed
+	recordings[2].Processing.Errors = []string{"failed to merge recordings: exit status 1\nOutput: ..."}
+	recordings[4].Metadata.AddYouTubeUpload(models.YouTubeUpload{VideoID: "abc", MissingSince: "2026-03-04T10:00:00Z"})
+
+	budget := retention.BudgetPlan{Budget: 100, Used: 150, Suggestions: []retention.Candidate{
+		{FolderPath: "/videos/old", Title: "Old", Size: 2048},
+	}}
+	unfinished := func(rec *models.RecordingInfo) bool { return rec.Metadata.Title == "interrupted" }
+
+	report := Collect(recordings, budget, unfinished)
+	want := []Item{
+		{NeedsMetadata, "/videos/untitled", "untitled", "Add a title and description to process it"},
+		{FailedProcessing, "/videos/failed", "failed", "failed to merge recordings: exit status 1"},
+		{FailedUpload, "/videos/interrupted", "interrupted", "Upload again to resume where it stopped"},
+		{StaleUpload, "/videos/removed", "removed", "Not found on YouTube since 2026-03-04"},
+		{OverBudget, "/videos/old", "Old", "Uploaded, archiving it frees 2.0 KB"},
+	}
+	if !reflect.DeepEqual(report.Items, want) {
+		t.Errorf("Collect() = %+v\nwant %+v", report.Items, want)
+	}
+	for _, b := range Buckets {
+		if report.Count(b) != 1 {
+			t.Errorf("Count(%s) = %d, want 1", b.Label(), report.Count(b))
+		}
+	}
+
+	if report := Collect(recordings[:1], retention.BudgetPlan{}, nil); len(report.Items) != 0 {
+		t.Errorf("Collect() = %+v, want nothing for a completed recording", report.Items)
+	}
+}
+
+func TestUnfinishedUpload(t *testing.T) {
+	rec := &models.RecordingInfo{}
+	rec.Files.FolderPath = t.TempDir()
+	rec.Files.MergedFile = filepath.Join(rec.Files.FolderPath, "screen-merged.mp4")
+	rec.Files.VerticalFile = filepath.Join(rec.Files.FolderPath, "screen-vertical.mp4")
+	if UnfinishedUpload(rec) {
+		t.Error("expected no unfinished upload without a session")
+	}
+	if err := os.WriteFile(youtube.SessionPath(rec.Files.VerticalFile, "work"), []byte("{}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if !UnfinishedUpload(rec) {
+		t.Error("expected the session of the vertical video to be found")
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"os/exec"
 	"regexp"
+	"strings"
 
 	"github.com/kartoza/kartoza-screencaster/internal/models"
 	"github.com/kartoza/kartoza-screencaster/internal/notify"
+	"github.com/kartoza/kartoza-screencaster/internal/proclog"
 )
 
 // Note: Recorder is defined in platform-specific files:
@@ -18,6 +20,7 @@ import (
 // Processor handles audio post-processing
 type Processor struct {
 	options models.AudioProcessingOptions
+	log     *proclog.Logger
 }
 
 // NewProcessor creates a new audio processor
@@ -25,6 +28,12 @@ func NewProcessor(opts models.AudioProcessingOptions) *Processor {
 	return &Processor{options: opts}
 }
 
+// SetLogger sets the processing log that receives ffmpeg commands and output.
+// With a dry run log the commands are logged but not run.
+func (p *Processor) SetLogger(log *proclog.Logger) {
+	p.log = log
+}
+
 // AnalyzeLoudness performs first-pass loudnorm analysis
 func (p *Processor) AnalyzeLoudness(inputFile string) (*models.LoudnormStats, error) {
 	_ = notify.ProcessingStep("Analyzing audio levels...")
@@ -42,7 +51,12 @@ func (p *Processor) AnalyzeLoudness(inputFile string) (*models.LoudnormStats, er
 		"-",
 	)
 
+	p.log.Command(cmd)
+	if p.log.DryRun() {
+		return dryRunLoudnormStats(), nil
+	}
 	output, err := cmd.CombinedOutput()
+	p.log.Output(output, err)
 	if err != nil {
 		return nil, fmt.Errorf("loudness analysis failed: %w", err)
 	}
@@ -79,7 +93,12 @@ func (p *Processor) Normalize(inputFile, outputFile string, stats *models.Loudno
 		outputFile,
 	)
 
+	p.log.Command(cmd)
+	if p.log.DryRun() {
+		return nil
+	}
 	output, err := cmd.CombinedOutput()
+	p.log.Output(output, err)
 	if err != nil {
 		return fmt.Errorf("normalization failed: %w, output: %s", err, output)
 	}
@@ -87,6 +106,44 @@ func (p *Processor) Normalize(inputFile, outputFile string, stats *models.Loudno
 	return nil
 }
 
+// ApplyFilters runs the audio through an ffmpeg filter chain, such as the
+// chain built from a recording's audio filter presets
+func (p *Processor) ApplyFilters(inputFile, outputFile, filter string) error {
+	_ = notify.ProcessingStep("Applying audio filter presets...")
+
+	cmd := exec.Command("ffmpeg",
+		"-y",
+		"-i", inputFile,
+		"-af", filter,
+		"-c:a", "pcm_s16le",
+		outputFile,
+	)
+
+	p.log.Command(cmd)
+	if p.log.DryRun() {
+		return nil
+	}
+	output, err := cmd.CombinedOutput()
+	p.log.Output(output, err)
+	if err != nil {
+		return fmt.Errorf("audio filters failed: %w, output: %s", err, output)
+	}
+
+	return nil
+}
+
+// dryRunLoudnormStats stands in for the analysis in a dry run. The measured
+// values are placeholders, to be filled in from the output of the analysis
+// command when the normalization command is run by hand.
+func dryRunLoudnormStats() *models.LoudnormStats {
+	return &models.LoudnormStats{
+		InputI:      "<input_i>",
+		InputTP:     "<input_tp>",
+		InputLRA:    "<input_lra>",
+		InputThresh: "<input_thresh>",
+	}
+}
+
 // Process performs full audio processing pipeline
 func (p *Processor) Process(inputFile, outputFile string) error {
 	if p.options.NormalizeEnabled {
@@ -105,6 +162,45 @@ func (p *Processor) Process(inputFile, outputFile string) error {
 	return nil
 }
 
+// parseSources reads the input devices from the output of
+// "pactl list sources". Monitors of output devices are left out.
+func parseSources(output string) []models.AudioDevice {
+	var devices []models.AudioDevice
+	var current *models.AudioDevice
+	monitor := false
+	flush := func() {
+		if current != nil && current.Name != "" && !monitor && !strings.HasSuffix(current.Name, ".monitor") {
+			devices = append(devices, *current)
+		}
+		current, monitor = nil, false
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasPrefix(line, "Source #") {
+			flush()
+			current = &models.AudioDevice{}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		key, value, ok := strings.Cut(strings.TrimSpace(line), ": ")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "Name":
+			current.Name = value
+		case "Description":
+			current.Description = value
+		case "Monitor of Sink":
+			monitor = value != "n/a"
+		}
+	}
+	flush()
+	return devices
+}
+
 // parseLoudnormOutput extracts loudnorm stats from ffmpeg output
 func parseLoudnormOutput(output string) (*models.LoudnormStats, error) {
 	// Find JSON block in output
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os/exec"
 	"syscall"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
 )
 
 // Recorder handles audio recording via ffmpeg avfoundation (macOS)
@@ -71,6 +73,12 @@ func (r *Recorder) PID() int {
 	return r.pid
 }
 
+// ListSources returns the input devices that can be recorded from. Listing
+// them is not supported on macOS yet, so the default device is used.
+func ListSources() ([]models.AudioDevice, error) {
+	return nil, nil
+}
+
 // ListAudioDevices returns a list of available audio input devices on macOS
 func ListAudioDevices() ([]string, error) {
 	cmd := exec.Command("ffmpeg", "-f", "avfoundation", "-list_devices", "true", "-i", "")
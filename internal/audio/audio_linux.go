@@ -4,8 +4,11 @@ package audio
 
 import (
 	"fmt"
+	"os"
 	"os/exec"
 	"syscall"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
 )
 
 // Recorder handles audio recording via PipeWire (Linux)
@@ -61,6 +64,18 @@ func (r *Recorder) PID() int {
 	return r.pid
 }
 
+// ListSources returns the PulseAudio sources, or the PipeWire sources through
+// pipewire-pulse, that can be recorded from
+func ListSources() ([]models.AudioDevice, error) {
+	cmd := exec.Command("pactl", "list", "sources")
+	cmd.Env = append(os.Environ(), "LC_ALL=C") // Field names are translated
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run pactl: %w", err)
+	}
+	return parseSources(string(output)), nil
+}
+
 // ListAudioDevices returns a list of available audio input devices on Linux
 func ListAudioDevices() ([]string, error) {
 	// Use pw-record --list-targets to list available PipeWire sources
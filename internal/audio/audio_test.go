@@ -0,0 +1,39 @@
+package audio
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestParseSources(t *testing.T) {
+	output := `Source #52
+	State: SUSPENDED
+	Name: alsa_output.pci-0000_00_1f.3.analog-stereo.monitor
+	Description: Monitor of Built-in Audio Analog Stereo
+	Monitor of Sink: alsa_output.pci-0000_00_1f.3.analog-stereo
+	Properties:
+		device.description = "Monitor of Built-in Audio"
+
+Source #53
+	State: RUNNING
+	Name: alsa_input.pci-0000_00_1f.3.analog-stereo
+	Description: Built-in Audio Analog Stereo
+	Monitor of Sink: n/a
+
+Source #61
+	Name: alsa_input.usb-RODE_NT-USB-00.analog-stereo
+	Monitor of Sink: n/a
+`
+	want := []models.AudioDevice{
+		{Name: "alsa_input.pci-0000_00_1f.3.analog-stereo", Description: "Built-in Audio Analog Stereo"},
+		{Name: "alsa_input.usb-RODE_NT-USB-00.analog-stereo"},
+	}
+	if got := parseSources(output); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseSources() = %+v, want %+v", got, want)
+	}
+	if got := parseSources(""); len(got) != 0 {
+		t.Errorf("parseSources(\"\") = %+v, want none", got)
+	}
+}
@@ -5,6 +5,8 @@ package audio
 import (
 	"fmt"
 	"os/exec"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
 )
 
 // Recorder handles audio recording via ffmpeg dshow (Windows)
@@ -66,6 +68,12 @@ func (r *Recorder) PID() int {
 	return r.pid
 }
 
+// ListSources returns the input devices that can be recorded from. Listing
+// them is not supported on Windows yet, so the default device is used.
+func ListSources() ([]models.AudioDevice, error) {
+	return nil, nil
+}
+
 // ListAudioDevices returns a list of available audio input devices on Windows
 func ListAudioDevices() ([]string, error) {
 	cmd := exec.Command("ffmpeg", "-f", "dshow", "-list_devices", "true", "-i", "dummy")
@@ -0,0 +1,149 @@
+// Package batchedit applies one metadata change to several recordings, such
+// as correcting the presenter of every recording of a workshop.
+package batchedit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// Privacies are the upload privacy settings an edit can set
+var Privacies = []string{"public", "unlisted", "private"}
+
+// Edit is a change to the metadata of a recording. Empty fields leave the
+// metadata as it is.
+type Edit struct {
+	Presenter string
+	Topic     string // Topic name
+	AddTag    string // Added to the upload tags unless already there
+	RemoveTag string // Removed from the upload tags, ignoring case
+	Privacy   string // Upload privacy: public, unlisted or private
+}
+
+// IsEmpty returns true if the edit changes nothing
+func (e Edit) IsEmpty() bool {
+	return strings.TrimSpace(e.Presenter) == "" && strings.TrimSpace(e.Topic) == "" &&
+		strings.TrimSpace(e.AddTag) == "" && strings.TrimSpace(e.RemoveTag) == "" && e.Privacy == ""
+}
+
+// Validate returns an error if the edit cannot be applied
+func (e Edit) Validate() error {
+	if e.IsEmpty() {
+		return errors.New("nothing to change")
+	}
+	if e.Privacy != "" && !contains(Privacies, e.Privacy) {
+		return fmt.Errorf("unknown privacy %q", e.Privacy)
+	}
+	if tag := strings.TrimSpace(e.AddTag); tag != "" && strings.EqualFold(tag, strings.TrimSpace(e.RemoveTag)) {
+		return fmt.Errorf("tag %q is both added and removed", tag)
+	}
+	return nil
+}
+
+// Changes describes each change of the edit, e.g. "presenter: Tim Sutton"
+func (e Edit) Changes() []string {
+	var changes []string
+	if v := strings.TrimSpace(e.Presenter); v != "" {
+		changes = append(changes, "presenter: "+v)
+	}
+	if v := strings.TrimSpace(e.Topic); v != "" {
+		changes = append(changes, "topic: "+v)
+	}
+	if v := strings.TrimSpace(e.AddTag); v != "" {
+		changes = append(changes, "add tag: "+v)
+	}
+	if v := strings.TrimSpace(e.RemoveTag); v != "" {
+		changes = append(changes, "remove tag: "+v)
+	}
+	if e.Privacy != "" {
+		changes = append(changes, "privacy: "+e.Privacy)
+	}
+	return changes
+}
+
+// Apply changes the metadata and returns true if anything changed. Tags
+// and privacy are the upload defaults, used the next time the recording is
+// uploaded; videos already on YouTube keep their privacy.
+func (e Edit) Apply(meta *models.RecordingMetadata) bool {
+	changed := false
+	set := func(field *string, value string) {
+		if value = strings.TrimSpace(value); value != "" && *field != value {
+			*field = value
+			changed = true
+		}
+	}
+	set(&meta.Presenter, e.Presenter)
+	set(&meta.Topic, e.Topic)
+
+	if tag := strings.TrimSpace(e.RemoveTag); tag != "" && meta.UploadDefaults != nil {
+		kept := meta.UploadDefaults.Tags[:0]
+		for _, t := range meta.UploadDefaults.Tags {
+			if strings.EqualFold(t, tag) {
+				changed = true
+				continue
+			}
+			kept = append(kept, t)
+		}
+		if len(kept) == 0 {
+			kept = nil
+		}
+		meta.UploadDefaults.Tags = kept
+	}
+	if tag := strings.TrimSpace(e.AddTag); tag != "" {
+		if meta.UploadDefaults == nil {
+			meta.UploadDefaults = &models.UploadDefaults{}
+		}
+		if !containsFold(meta.UploadDefaults.Tags, tag) {
+			meta.UploadDefaults.Tags = append(meta.UploadDefaults.Tags, tag)
+			changed = true
+		}
+	}
+	if e.Privacy != "" {
+		if meta.UploadDefaults == nil {
+			meta.UploadDefaults = &models.UploadDefaults{}
+		}
+		set(&meta.UploadDefaults.Privacy, e.Privacy)
+	}
+	return changed
+}
+
+// ApplyTo applies the edit to the recording in folder and saves it. The
+// recording is read again first so changes made since it was listed are
+// kept; it is only written if the edit changed it.
+func ApplyTo(folder string, e Edit) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+	rec, err := models.LoadRecordingInfo(folder)
+	if err != nil {
+		return fmt.Errorf("failed to read recording: %w", err)
+	}
+	if !e.Apply(&rec.Metadata) {
+		return nil
+	}
+	if err := rec.Save(); err != nil {
+		return fmt.Errorf("failed to save recording: %w", err)
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
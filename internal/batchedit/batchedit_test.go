@@ -0,0 +1,91 @@
+package batchedit
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestApply(t *testing.T) {
+	meta := models.RecordingMetadata{
+		Title:          "QGIS intro",
+		Presenter:      "Tim",
+		UploadDefaults: &models.UploadDefaults{Tags: []string{"QGIS", "draft"}, Privacy: "public"},
+	}
+
+	edit := Edit{Presenter: " Tim Sutton ", AddTag: "qgis", RemoveTag: "Draft", Privacy: "private"}
+	if !edit.Apply(&meta) {
+		t.Fatal("expected the metadata to change")
+	}
+	if meta.Presenter != "Tim Sutton" || meta.Title != "QGIS intro" {
+		t.Errorf("presenter %q, title %q; want only the presenter changed", meta.Presenter, meta.Title)
+	}
+	if !reflect.DeepEqual(meta.UploadDefaults.Tags, []string{"QGIS"}) || meta.UploadDefaults.Privacy != "private" {
+		t.Errorf("upload defaults = %+v, want the draft tag removed, no duplicate QGIS tag and private", meta.UploadDefaults)
+	}
+	if edit.Apply(&meta) {
+		t.Error("expected applying the same edit again to change nothing")
+	}
+
+	// Tags and privacy create the upload defaults of older recordings
+	var old models.RecordingMetadata
+	Edit{AddTag: "workshop"}.Apply(&old)
+	if old.UploadDefaults == nil || !reflect.DeepEqual(old.UploadDefaults.Tags, []string{"workshop"}) {
+		t.Errorf("upload defaults = %+v, want the tag added", old.UploadDefaults)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    Edit
+		wantErr bool
+	}{
+		{"empty", Edit{Presenter: "  "}, true},
+		{"unknown privacy", Edit{Privacy: "secret"}, true},
+		{"same tag added and removed", Edit{AddTag: "QGIS", RemoveTag: "qgis"}, true},
+		{"presenter", Edit{Presenter: "Tim"}, false},
+		{"privacy", Edit{Privacy: "unlisted"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.edit.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestApplyTo(t *testing.T) {
+	folder := t.TempDir()
+	rec := models.RecordingInfo{}
+	rec.Files.FolderPath = folder
+	rec.Metadata.Title = "GeoServer"
+	rec.Metadata.Presenter = "Tim"
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ApplyTo(folder, Edit{Presenter: "Tim Sutton", Topic: "GeoServer"}); err != nil {
+		t.Fatalf("ApplyTo() error: %v", err)
+	}
+	saved, err := models.LoadRecordingInfo(folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Metadata.Presenter != "Tim Sutton" || saved.Metadata.Topic != "GeoServer" || saved.Metadata.Title != "GeoServer" {
+		t.Errorf("saved metadata = %+v, want the presenter and topic changed", saved.Metadata)
+	}
+
+	if err := ApplyTo(filepath.Join(folder, "missing"), Edit{Presenter: "Tim"}); err == nil {
+		t.Error("expected an error for a folder without recording.json")
+	}
+	if err := ApplyTo(folder, Edit{Presenter: "Tim Sutton"}); err != nil {
+		t.Fatalf("ApplyTo() error: %v", err)
+	}
+	if again, _ := models.LoadRecordingInfo(folder); again == nil || !again.UpdatedAt.Equal(saved.UpdatedAt) {
+		t.Error("expected a recording the edit does not change not to be written")
+	}
+}
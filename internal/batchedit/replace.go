@@ -0,0 +1,125 @@
+package batchedit
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// ErrDescriptionChanged is returned when a description was edited after the
+// change to it was previewed
+var ErrDescriptionChanged = errors.New("description changed since the preview")
+
+// Replacement replaces text in the descriptions of recordings, such as a
+// link that no longer works. The search is literal and case sensitive.
+type Replacement struct {
+	Search  string
+	Replace string
+}
+
+// Validate returns an error if the replacement cannot be applied
+func (r Replacement) Validate() error {
+	if r.Search == "" {
+		return errors.New("nothing to search for")
+	}
+	if r.Search == r.Replace {
+		return errors.New("the replacement is the same as the search")
+	}
+	return nil
+}
+
+// DescriptionChange is the description of a recording before and after a
+// replacement
+type DescriptionChange struct {
+	Folder string
+	Title  string // Title, or the folder name of an untitled recording
+	Before string
+	After  string
+	Count  int // Number of occurrences replaced
+}
+
+// Preview returns the change the replacement makes to each recording whose
+// description contains the search text, in the order of recs. Nothing is
+// saved.
+func (r Replacement) Preview(recs []models.RecordingInfo) []DescriptionChange {
+	if r.Validate() != nil {
+		return nil
+	}
+	var changes []DescriptionChange
+	for _, rec := range recs {
+		desc := rec.Metadata.Description
+		count := strings.Count(desc, r.Search)
+		if count == 0 {
+			continue
+		}
+		title := rec.Metadata.Title
+		if title == "" {
+			title = filepath.Base(rec.Files.FolderPath)
+		}
+		changes = append(changes, DescriptionChange{
+			Folder: rec.Files.FolderPath,
+			Title:  title,
+			Before: desc,
+			After:  strings.ReplaceAll(desc, r.Search, r.Replace),
+			Count:  count,
+		})
+	}
+	return changes
+}
+
+// Reverse returns the change that undoes c
+func (c DescriptionChange) Reverse() DescriptionChange {
+	c.Before, c.After = c.After, c.Before
+	return c
+}
+
+// Excerpt returns the part of the description that changes, with context
+// characters either side, before and after the change. Line breaks are
+// shown as spaces so the excerpt fits on one line.
+func (c DescriptionChange) Excerpt(context int) (before, after string) {
+	b, a := []rune(c.Before), []rune(c.After)
+	prefix := 0
+	for prefix < len(b) && prefix < len(a) && b[prefix] == a[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(b)-prefix && suffix < len(a)-prefix && b[len(b)-1-suffix] == a[len(a)-1-suffix] {
+		suffix++
+	}
+
+	excerpt := func(text []rune) string {
+		start := max(prefix-context, 0)
+		end := min(len(text)-suffix+context, len(text))
+		s := strings.Join(strings.Fields(string(text[start:end])), " ")
+		if start > 0 {
+			s = "…" + s
+		}
+		if end < len(text) {
+			s += "…"
+		}
+		return s
+	}
+	return excerpt(b), excerpt(a)
+}
+
+// ApplyDescription saves the new description to the recording in the
+// change's folder. The recording is read again first; if its description is
+// no longer the one previewed, nothing is saved and ErrDescriptionChanged is
+// returned, so a change is never applied twice or over a later edit.
+func ApplyDescription(c DescriptionChange) error {
+	rec, err := models.LoadRecordingInfo(c.Folder)
+	if err != nil {
+		return fmt.Errorf("failed to read recording: %w", err)
+	}
+	if rec.Metadata.Description != c.Before {
+		return ErrDescriptionChanged
+	}
+	rec.Metadata.Description = c.After
+	if err := rec.Save(); err != nil {
+		return fmt.Errorf("failed to save recording: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package batchedit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestReplacementPreview(t *testing.T) {
+	recs := make([]models.RecordingInfo, 3)
+	recs[0].Metadata.Title = "QGIS intro"
+	recs[0].Metadata.Description = "Slides: https://old.example.org/qgis\nData: https://old.example.org/data"
+	recs[1].Metadata.Title = "GeoServer"
+	recs[1].Metadata.Description = "No links here"
+	recs[2].Files.FolderPath = "/videos/untitled"
+	recs[2].Metadata.Description = "See https://old.example.org"
+
+	r := Replacement{Search: "https://old.example.org", Replace: "https://kartoza.com"}
+	changes := r.Preview(recs)
+	if len(changes) != 2 {
+		t.Fatalf("Preview() returned %d changes, want the 2 descriptions with the link", len(changes))
+	}
+	if changes[0].Count != 2 || changes[0].After != "Slides: https://kartoza.com/qgis\nData: https://kartoza.com/data" {
+		t.Errorf("change = %+v, want both links replaced", changes[0])
+	}
+	if changes[1].Title != "untitled" {
+		t.Errorf("title = %q, want the folder name of an untitled recording", changes[1].Title)
+	}
+	if recs[0].Metadata.Description == changes[0].After {
+		t.Error("expected the preview not to change the recordings")
+	}
+
+	if got := (Replacement{Search: "same", Replace: "same"}).Preview(recs); got != nil {
+		t.Errorf("Preview() = %+v, want nothing for a replacement that changes nothing", got)
+	}
+}
+
+func TestDescriptionChangeExcerpt(t *testing.T) {
+	c := DescriptionChange{
+		Before: "Watch the whole series at\nhttps://old.example.org/qgis today",
+		After:  "Watch the whole series at\nhttps://kartoza.com/qgis today",
+	}
+	before, after := c.Excerpt(6)
+	if before != "…tps://old.example.org/qgis…" || after != "…tps://kartoza.com/qgis…" {
+		t.Errorf("Excerpt() = %q, %q", before, after)
+	}
+
+	before, after = c.Reverse().Excerpt(100)
+	if before != "Watch the whole series at https://kartoza.com/qgis today" || after != "Watch the whole series at https://old.example.org/qgis today" {
+		t.Errorf("reversed Excerpt() = %q, %q", before, after)
+	}
+}
+
+func TestApplyDescription(t *testing.T) {
+	folder := t.TempDir()
+	rec := models.RecordingInfo{}
+	rec.Files.FolderPath = folder
+	rec.Metadata.Title = "QGIS intro"
+	rec.Metadata.Description = "Slides: https://old.example.org"
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	changes := Replacement{Search: "old.example.org", Replace: "kartoza.com"}.Preview([]models.RecordingInfo{rec})
+	if len(changes) != 1 {
+		t.Fatalf("Preview() returned %d changes, want 1", len(changes))
+	}
+	if err := ApplyDescription(changes[0]); err != nil {
+		t.Fatalf("ApplyDescription() error: %v", err)
+	}
+	saved, err := models.LoadRecordingInfo(folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Metadata.Description != "Slides: https://kartoza.com" || saved.Metadata.Title != "QGIS intro" {
+		t.Errorf("saved metadata = %+v, want only the description changed", saved.Metadata)
+	}
+
+	if err := ApplyDescription(changes[0]); !errors.Is(err, ErrDescriptionChanged) {
+		t.Errorf("applying twice: error = %v, want ErrDescriptionChanged", err)
+	}
+	if err := ApplyDescription(changes[0].Reverse()); err != nil {
+		t.Fatalf("undo: ApplyDescription() error: %v", err)
+	}
+	if undone, _ := models.LoadRecordingInfo(folder); undone == nil || undone.Metadata.Description != "Slides: https://old.example.org" {
+		t.Error("expected the reversed change to restore the description")
+	}
+}
@@ -16,9 +16,10 @@ var Frequencies = map[int]int{
 	1: 554,
 }
 
-// Play plays a beep at the specified frequency for the countdown number
+// Play plays a beep at the specified frequency for the countdown number.
+// Counts above 5, in longer countdowns, beep at the frequency of 5.
 func Play(count int) {
-	freq, ok := Frequencies[count]
+	freq, ok := Frequencies[min(count, len(Frequencies))]
 	if !ok {
 		return
 	}
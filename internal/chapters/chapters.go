@@ -0,0 +1,180 @@
+// Package chapters lists the chapters of a recording, one for each part
+// recorded between pauses, in the format YouTube reads from a video
+// description ("00:00 Intro"). The chapters are kept in chapters.txt in the
+// recording folder so they can be named by editing the file.
+package chapters
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FileName is the name of the chapters file in a recording folder
+const FileName = "chapters.txt"
+
+// YouTube only shows chapters when there are at least MinCount of them and
+// each is at least MinLength long
+const (
+	MinCount  = 3
+	MinLength = 10 * time.Second
+)
+
+// Chapter is a named position in a video
+type Chapter struct {
+	Start time.Duration
+	Title string
+}
+
+// FromPauses returns a chapter for each part of a recording: "Intro" at the
+// start and "Part N" where the recording continued after each pause. offsets
+// are the positions in the video where the parts after the first start.
+// A part shorter than MinLength is joined to the chapter before it.
+func FromPauses(offsets []time.Duration) []Chapter {
+	chapters := []Chapter{{Start: 0, Title: "Intro"}}
+	for i, offset := range offsets {
+		if offset-chapters[len(chapters)-1].Start < MinLength {
+			continue
+		}
+		chapters = append(chapters, Chapter{Start: offset, Title: fmt.Sprintf("Part %d", i+2)})
+	}
+	return chapters
+}
+
+// Validate returns an error if YouTube would not show the chapters: the
+// first must start at 00:00, there must be at least MinCount, and each must
+// be at least MinLength long.
+func Validate(chapters []Chapter) error {
+	if len(chapters) < MinCount {
+		return fmt.Errorf("YouTube needs at least %d chapters", MinCount)
+	}
+	if chapters[0].Start != 0 {
+		return errors.New("the first chapter must start at 00:00")
+	}
+	for i := 1; i < len(chapters); i++ {
+		if chapters[i].Start-chapters[i-1].Start < MinLength {
+			return fmt.Errorf("chapter %q is shorter than %s", chapters[i-1].Title, MinLength)
+		}
+	}
+	return nil
+}
+
+// Format returns the chapters one per line, e.g. "01:30 Installing QGIS".
+// Hours are only shown when the last chapter starts after an hour.
+func Format(chapters []Chapter) string {
+	hours := len(chapters) > 0 && chapters[len(chapters)-1].Start >= time.Hour
+	var sb strings.Builder
+	for _, c := range chapters {
+		sb.WriteString(formatTimestamp(c.Start, hours))
+		sb.WriteString(" ")
+		sb.WriteString(c.Title)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func formatTimestamp(d time.Duration, hours bool) string {
+	total := int(d / time.Second)
+	h, m, s := total/3600, total/60%60, total%60
+	if hours {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%02d:%02d", total/60, s)
+}
+
+// Parse reads chapters in the format written by Format. Blank lines and
+// lines starting with # are skipped; a chapter without a title keeps its
+// timestamp as the title.
+func Parse(text string) ([]Chapter, error) {
+	var chapters []Chapter
+	for n, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		stamp, title, _ := strings.Cut(line, " ")
+		start, err := parseTimestamp(stamp)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+1, err)
+		}
+		if title = strings.TrimSpace(title); title == "" {
+			title = stamp
+		}
+		if len(chapters) > 0 && start <= chapters[len(chapters)-1].Start {
+			return nil, fmt.Errorf("line %d: chapters must be in order", n+1)
+		}
+		chapters = append(chapters, Chapter{Start: start, Title: title})
+	}
+	return chapters, nil
+}
+
+// parseTimestamp parses MM:SS or H:MM:SS
+func parseTimestamp(stamp string) (time.Duration, error) {
+	parts := strings.Split(stamp, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid timestamp %q, want MM:SS or H:MM:SS", stamp)
+	}
+	var d time.Duration
+	for i, part := range parts {
+		v, err := strconv.Atoi(part)
+		if err != nil || v < 0 || (i > 0 && v >= 60) {
+			return 0, fmt.Errorf("invalid timestamp %q, want MM:SS or H:MM:SS", stamp)
+		}
+		d = d*60 + time.Duration(v)
+	}
+	return d * time.Second, nil
+}
+
+// Load reads the chapters file of the recording in folder. It returns an
+// error satisfying errors.Is(err, os.ErrNotExist) if there is none.
+func Load(folder string) ([]Chapter, error) {
+	data, err := os.ReadFile(filepath.Join(folder, FileName))
+	if err != nil {
+		return nil, err
+	}
+	return Parse(string(data))
+}
+
+// Save writes the chapters file of the recording in folder. Titles already
+// given to the chapters in an existing file are kept, matched by position,
+// so naming the chapters survives processing the recording again. A file
+// that cannot be read is left alone rather than losing its titles.
+func Save(folder string, chapters []Chapter) error {
+	existing, err := Load(folder)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("keeping %s: %w", FileName, err)
+	}
+	chapters = append([]Chapter(nil), chapters...)
+	for i := range chapters {
+		if i < len(existing) {
+			chapters[i].Title = existing[i].Title
+		}
+	}
+	header := "# Chapters of this recording, one per line: timestamp and title.\n" +
+		"# Rename them here; the upload form can add them to the description.\n"
+	return os.WriteFile(filepath.Join(folder, FileName), []byte(header+Format(chapters)), 0644)
+}
+
+// AppendToDescription adds the chapters to the end of a video description.
+// A description that already starts a line with 00:00 or 0:00 is returned
+// unchanged, so chapters are never added twice.
+func AppendToDescription(description string, chapters []Chapter) string {
+	if len(chapters) == 0 {
+		return description
+	}
+	for _, line := range strings.Split(description, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "00:00") || strings.HasPrefix(line, "0:00") {
+			return description
+		}
+	}
+	block := "Chapters:\n" + strings.TrimSuffix(Format(chapters), "\n")
+	if strings.TrimSpace(description) == "" {
+		return block
+	}
+	return strings.TrimRight(description, "\n") + "\n\n" + block
+}
@@ -0,0 +1,122 @@
+package chapters
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFromPauses(t *testing.T) {
+	got := FromPauses([]time.Duration{90 * time.Second, 95 * time.Second, 4 * time.Minute})
+	want := []Chapter{
+		{Start: 0, Title: "Intro"},
+		{Start: 90 * time.Second, Title: "Part 2"},
+		{Start: 4 * time.Minute, Title: "Part 4"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FromPauses() = %+v, want the 5 second part joined to the one before", got)
+	}
+	if got := FromPauses(nil); len(got) != 1 {
+		t.Errorf("FromPauses(nil) = %+v, want only the intro", got)
+	}
+}
+
+func TestFormatAndParse(t *testing.T) {
+	chapters := []Chapter{
+		{Start: 0, Title: "Intro"},
+		{Start: 95 * time.Second, Title: "Installing QGIS"},
+		{Start: 12 * time.Minute, Title: "Plugins"},
+	}
+	text := Format(chapters)
+	if text != "00:00 Intro\n01:35 Installing QGIS\n12:00 Plugins\n" {
+		t.Errorf("Format() = %q", text)
+	}
+	parsed, err := Parse("# header\n\n" + text)
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, chapters) {
+		t.Errorf("Parse() = %+v, want %+v", parsed, chapters)
+	}
+
+	long := append(chapters, Chapter{Start: time.Hour + 2*time.Minute + 3*time.Second, Title: "Q&A"})
+	if text := Format(long); !strings.HasPrefix(text, "0:00:00 Intro\n") || !strings.HasSuffix(text, "1:02:03 Q&A\n") {
+		t.Errorf("Format() = %q, want hours once a chapter starts after an hour", text)
+	}
+
+	for _, bad := range []string{"1:75 Too many seconds", "intro", "01:00 B\n00:30 A"} {
+		if _, err := Parse(bad); err == nil {
+			t.Errorf("Parse(%q) succeeded, want an error", bad)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := []Chapter{{0, "Intro"}, {time.Minute, "Middle"}, {2 * time.Minute, "End"}}
+	if err := Validate(valid); err != nil {
+		t.Errorf("Validate() error: %v", err)
+	}
+	tooFew := valid[:2]
+	tooShort := []Chapter{{0, "Intro"}, {5 * time.Second, "Middle"}, {time.Minute, "End"}}
+	late := []Chapter{{time.Second, "Intro"}, {time.Minute, "Middle"}, {2 * time.Minute, "End"}}
+	for _, chapters := range [][]Chapter{tooFew, tooShort, late} {
+		if err := Validate(chapters); err == nil {
+			t.Errorf("Validate(%+v) succeeded, want an error", chapters)
+		}
+	}
+}
+
+func TestSaveKeepsTitles(t *testing.T) {
+	folder := t.TempDir()
+	if _, err := Load(folder); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("Load() error = %v, want not exist", err)
+	}
+
+	if err := Save(folder, FromPauses([]time.Duration{time.Minute})); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(folder, FileName)
+	data, _ := os.ReadFile(path)
+	renamed := strings.Replace(string(data), "Part 2", "Installing QGIS", 1)
+	if err := os.WriteFile(path, []byte(renamed), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Processing again keeps the title and adds the new chapter
+	if err := Save(folder, FromPauses([]time.Duration{time.Minute, 2 * time.Minute})); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Load(folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Chapter{{0, "Intro"}, {time.Minute, "Installing QGIS"}, {2 * time.Minute, "Part 3"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Load() = %+v, want %+v", got, want)
+	}
+
+	if err := os.WriteFile(path, []byte("not a chapter"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Save(folder, want); err == nil {
+		t.Error("expected a file that cannot be read to be left alone")
+	}
+}
+
+func TestAppendToDescription(t *testing.T) {
+	chapters := []Chapter{{0, "Intro"}, {time.Minute, "Demo"}}
+	got := AppendToDescription("Learn QGIS.\n", chapters)
+	if got != "Learn QGIS.\n\nChapters:\n00:00 Intro\n01:00 Demo" {
+		t.Errorf("AppendToDescription() = %q", got)
+	}
+	if again := AppendToDescription(got, chapters); again != got {
+		t.Errorf("AppendToDescription() = %q, want chapters not added twice", again)
+	}
+	if got := AppendToDescription("", chapters); !strings.HasPrefix(got, "Chapters:") {
+		t.Errorf("AppendToDescription() = %q, want only the chapters", got)
+	}
+}
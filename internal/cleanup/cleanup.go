@@ -0,0 +1,211 @@
+// Package cleanup finds files in recording folders that recording.json does
+// not reference, such as intermediate files left behind by failed
+// processing runs, so the space they use can be reclaimed.
+package cleanup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/proclog"
+)
+
+// Kind classifies an unreferenced file
+type Kind int
+
+const (
+	// KindIntermediate is a file processing creates and no longer needs,
+	// e.g. filtered audio or a concat list. It is safe to remove.
+	KindIntermediate Kind = iota
+	// KindMedia is an audio or video file that is not referenced. It may be
+	// raw footage of an interrupted recording, so it is only removed when
+	// explicitly asked for.
+	KindMedia
+)
+
+// String returns a short label for the kind
+func (k Kind) String() string {
+	if k == KindMedia {
+		return "unreferenced media"
+	}
+	return "intermediate"
+}
+
+// File is an unreferenced file in a recording folder
+type File struct {
+	Path string
+	Size int64
+	Kind Kind
+}
+
+// Report lists the unreferenced files of one recording
+type Report struct {
+	FolderPath string
+	Title      string
+	Files      []File
+}
+
+// Size returns the total size of the files of a kind
+func (r Report) Size(kind Kind) int64 {
+	var total int64
+	for _, f := range r.Files {
+		if f.Kind == kind {
+			total += f.Size
+		}
+	}
+	return total
+}
+
+// mediaExtensions are the extensions of audio and video files
+var mediaExtensions = map[string]bool{
+	".mp4": true, ".mkv": true, ".webm": true, ".mov": true,
+	".wav": true, ".mp3": true, ".m4a": true, ".ogg": true, ".flac": true,
+}
+
+// intermediateSuffixes end the names of files processing leaves behind
+var intermediateSuffixes = []string{
+	"-filtered.wav", // Audio with filter presets applied
+	"-filtered.mp4", // Video with filter presets applied
+	".mp4.txt",      // ffmpeg concat list of video parts
+	".wav.txt",      // ffmpeg concat list of audio parts
+	".tmp",
+	".part",
+}
+
+// referenced returns the names of the files recording.json refers to,
+// including the normalized audio kept for playback
+func referenced(rec models.RecordingInfo) map[string]bool {
+	names := map[string]bool{
+		"recording.json": true,
+		proclog.FileName: true,
+	}
+	add := func(path string) {
+		if path != "" {
+			names[filepath.Base(path)] = true
+		}
+	}
+
+	f := rec.Files
+	for _, path := range []string{f.VideoFile, f.AudioFile, f.WebcamFile, f.MergedFile, f.VerticalFile, f.WebcamOnlyFile} {
+		add(path)
+	}
+	for _, parts := range [][]string{f.VideoParts, f.AudioParts, f.WebcamParts} {
+		for _, path := range parts {
+			add(path)
+		}
+	}
+	if f.AudioFile != "" {
+		add(strings.TrimSuffix(f.AudioFile, ".wav") + "-normalized.wav")
+	}
+	return names
+}
+
+// classify returns the kind of an unreferenced file, or false if it should
+// be left alone (e.g. notes or logo images)
+func classify(rec models.RecordingInfo, name string) (Kind, bool) {
+	lower := strings.ToLower(name)
+	for _, suffix := range intermediateSuffixes {
+		if strings.HasSuffix(lower, suffix) {
+			return KindIntermediate, true
+		}
+	}
+
+	// Files the parts were concatenated into before merging
+	switch {
+	case lower == "screen.mp4" && len(rec.Files.VideoParts) > 1,
+		lower == "audio.wav" && len(rec.Files.AudioParts) > 1,
+		lower == "webcam.mp4" && len(rec.Files.WebcamParts) > 1:
+		return KindIntermediate, true
+	}
+
+	if mediaExtensions[filepath.Ext(lower)] {
+		return KindMedia, true
+	}
+	return 0, false
+}
+
+// Scan returns the unreferenced intermediate and media files directly
+// inside the folder of a recording. Other files, hidden files and
+// subfolders are never reported.
+func Scan(rec models.RecordingInfo) (Report, error) {
+	report := Report{FolderPath: rec.Files.FolderPath, Title: rec.Metadata.Title}
+
+	entries, err := os.ReadDir(rec.Files.FolderPath)
+	if err != nil {
+		return report, err
+	}
+
+	names := referenced(rec)
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.Type().IsRegular() || strings.HasPrefix(name, ".") || names[name] {
+			continue
+		}
+		kind, ok := classify(rec, name)
+		if !ok {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		report.Files = append(report.Files, File{
+			Path: filepath.Join(rec.Files.FolderPath, name),
+			Size: info.Size(),
+			Kind: kind,
+		})
+	}
+	return report, nil
+}
+
+// ScanAll scans every recording that is not being recorded or processed and
+// returns the reports that found files
+func ScanAll(recordings []models.RecordingInfo) ([]Report, []error) {
+	var reports []Report
+	var errs []error
+	for _, rec := range recordings {
+		switch rec.Status {
+		case models.StatusRecording, models.StatusPaused, models.StatusProcessing:
+			continue
+		}
+		report, err := Scan(rec)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", filepath.Base(rec.Files.FolderPath), err))
+			continue
+		}
+		if len(report.Files) > 0 {
+			reports = append(reports, report)
+		}
+	}
+	return reports, errs
+}
+
+// Result summarizes a cleanup
+type Result struct {
+	Removed   int
+	Reclaimed int64 // Bytes freed
+	Errors    []error
+}
+
+// Remove deletes the intermediate files of the reports, and also the
+// unreferenced media files if includeMedia is true
+func Remove(reports []Report, includeMedia bool) Result {
+	var result Result
+	for _, r := range reports {
+		for _, f := range r.Files {
+			if f.Kind == KindMedia && !includeMedia {
+				continue
+			}
+			if err := os.Remove(f.Path); err != nil {
+				result.Errors = append(result.Errors, err)
+				continue
+			}
+			result.Removed++
+			result.Reclaimed += f.Size
+		}
+	}
+	return result
+}
@@ -0,0 +1,126 @@
+package cleanup
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// recordingFolder creates a recording folder containing files of the given
+// sizes
+func recordingFolder(t *testing.T, files map[string]int) models.RecordingInfo {
+	t.Helper()
+	dir := t.TempDir()
+	for name, size := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), make([]byte, size), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var rec models.RecordingInfo
+	rec.Status = models.StatusCompleted
+	rec.Files.FolderPath = dir
+	return rec
+}
+
+func TestScan(t *testing.T) {
+	rec := recordingFolder(t, map[string]int{
+		"recording.json":               10,
+		"processing.log":               10,
+		"screen_part000.mp4":           100,
+		"screen_part001.mp4":           100,
+		"screen.mp4":                   200,
+		"audio_part000.wav":            50,
+		"audio_part000-normalized.wav": 50,
+		"audio_part000-filtered.wav":   50,
+		"screen-merged.mp4":            300,
+		"webcam-only.mp4":              90,
+		"screen.mp4.txt":               1,
+		"webcam_part000.mp4":           80, // Not referenced: raw footage
+		"logo_left.png":                5,
+		"notes.md":                     5,
+		".hidden.tmp":                  5,
+	})
+	dir := rec.Files.FolderPath
+	rec.Files.VideoParts = []string{filepath.Join(dir, "screen_part000.mp4"), filepath.Join(dir, "screen_part001.mp4")}
+	rec.Files.AudioParts = []string{filepath.Join(dir, "audio_part000.wav")}
+	// Paths from another machine only match by name
+	rec.Files.AudioFile = "/elsewhere/audio_part000.wav"
+	rec.Files.MergedFile = filepath.Join(dir, "screen-merged.mp4")
+	rec.Files.WebcamOnlyFile = filepath.Join(dir, "webcam-only.mp4")
+	if err := os.Mkdir(filepath.Join(dir, "thumbs.tmp"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := Scan(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[string]Kind{}
+	for _, f := range report.Files {
+		got[filepath.Base(f.Path)] = f.Kind
+	}
+	want := map[string]Kind{
+		"screen.mp4":                 KindIntermediate,
+		"audio_part000-filtered.wav": KindIntermediate,
+		"screen.mp4.txt":             KindIntermediate,
+		"webcam_part000.mp4":         KindMedia,
+	}
+	if len(got) != len(want) {
+		names := make([]string, 0, len(got))
+		for name := range got {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		t.Fatalf("Scan() found %v, want %d files", names, len(want))
+	}
+	for name, kind := range want {
+		if got[name] != kind {
+			t.Errorf("%s: kind %v, want %v", name, got[name], kind)
+		}
+	}
+	if report.Size(KindIntermediate) != 251 || report.Size(KindMedia) != 80 {
+		t.Errorf("sizes = %d intermediate, %d media; want 251, 80", report.Size(KindIntermediate), report.Size(KindMedia))
+	}
+}
+
+func TestRemove(t *testing.T) {
+	rec := recordingFolder(t, map[string]int{
+		"recording.json":      10,
+		"screen-filtered.mp4": 100,
+		"webcam_part000.mp4":  40,
+	})
+	reports, errs := ScanAll([]models.RecordingInfo{rec})
+	if len(errs) != 0 || len(reports) != 1 {
+		t.Fatalf("ScanAll() = %d reports, errors %v", len(reports), errs)
+	}
+
+	// Media is kept unless asked for
+	result := Remove(reports, false)
+	if result.Removed != 1 || result.Reclaimed != 100 || len(result.Errors) != 0 {
+		t.Errorf("Remove() = %+v, want 1 file and 100 bytes", result)
+	}
+	if _, err := os.Stat(filepath.Join(rec.Files.FolderPath, "webcam_part000.mp4")); err != nil {
+		t.Errorf("unreferenced media was removed without asking: %v", err)
+	}
+
+	reports, _ = ScanAll([]models.RecordingInfo{rec})
+	result = Remove(reports, true)
+	if result.Removed != 1 || result.Reclaimed != 40 {
+		t.Errorf("Remove(includeMedia) = %+v, want 1 file and 40 bytes", result)
+	}
+	if _, err := os.Stat(filepath.Join(rec.Files.FolderPath, "recording.json")); err != nil {
+		t.Errorf("recording.json was removed: %v", err)
+	}
+}
+
+func TestScanAllSkipsActiveRecordings(t *testing.T) {
+	rec := recordingFolder(t, map[string]int{"audio-filtered.wav": 10})
+	rec.Status = models.StatusProcessing
+	if reports, _ := ScanAll([]models.RecordingInfo{rec}); len(reports) != 0 {
+		t.Errorf("expected a recording being processed to be skipped, got %d reports", len(reports))
+	}
+}
@@ -0,0 +1,24 @@
+// Package clipboard copies text to the system clipboard.
+package clipboard
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/atotto/clipboard"
+)
+
+// Copy puts text on the system clipboard. On Linux this needs wl-copy
+// (Wayland), xclip or xsel to be installed.
+func Copy(text string) error {
+	if clipboard.Unsupported {
+		if runtime.GOOS == "linux" {
+			return fmt.Errorf("no clipboard tool found: install wl-clipboard, xclip or xsel")
+		}
+		return fmt.Errorf("clipboard is not supported on %s", runtime.GOOS)
+	}
+	if err := clipboard.WriteAll(text); err != nil {
+		return fmt.Errorf("failed to copy to clipboard: %w", err)
+	}
+	return nil
+}
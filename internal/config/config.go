@@ -2,23 +2,49 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/kartoza/kartoza-screencaster/internal/models"
 	"github.com/kartoza/kartoza-screencaster/internal/syndication"
+	"github.com/kartoza/kartoza-screencaster/internal/templates"
 	"github.com/kartoza/kartoza-screencaster/internal/youtube"
 )
 
 const (
+	// AppDirName is the per-application directory name inside XDG base directories
+	AppDirName = "kartoza-screencaster"
 	// DefaultConfigDir is the default configuration directory
 	DefaultConfigDir = ".config/kartoza-screencaster"
+	// DefaultDataDir is the default data directory (application state such as
+	// the new recording draft)
+	DefaultDataDir = ".local/share/kartoza-screencaster"
+	// DefaultCacheDir is the default cache directory (YouTube upload thumbnails)
+	DefaultCacheDir = ".cache/kartoza-screencaster"
 	// DefaultVideosDir is the default output directory for recordings
 	DefaultVideosDir = "Videos/Screencasts"
+	// ScreencastsDirName is the recordings folder inside the user's videos directory
+	ScreencastsDirName = "Screencasts"
+	// ArchiveDirName is the folder inside the videos directory that archived recordings are moved to
+	ArchiveDirName = ".archive"
+	// TrashDirName is the folder inside the videos directory that soft-deleted recordings are moved to
+	TrashDirName = ".trash"
 	// ConfigFileName is the name of the configuration file
 	ConfigFileName = "config.json"
+	// ConfigDirEnvVar overrides the configuration directory when set
+	ConfigDirEnvVar = "KARTOZA_SCREENCASTER_CONFIG_DIR"
 )
 
+// configDirOverride is set from the --config-dir flag and takes precedence
+// over the environment variable
+var configDirOverride string
+
+// dataDirOverride is set from the --data-dir flag
+var dataDirOverride string
+
 // Paths for PID and state files
 const (
 	VideoPIDFile   = "/tmp/kartoza-video.pid"
@@ -32,6 +58,7 @@ const (
 	OutputDirFile  = "/tmp/kartoza-output.path" // Stores current recording output directory
 	PartNumberFile = "/tmp/kartoza-part.num"    // Stores current part number for pause/resume
 	PausedFile     = "/tmp/kartoza-paused"      // Indicates recording is paused
+	RecordedFile   = "/tmp/kartoza-recorded"    // Stores the recorded time of the parts before the current one
 )
 
 // GifLoopMode controls how animated GIFs are played
@@ -53,6 +80,523 @@ var GifLoopModeLabels = map[GifLoopMode]string{
 	GifLoopNone:       "First frame only",
 }
 
+// LogoFit controls how a logo is fitted into its overlay slot when the aspect
+// ratios differ. Logos are never stretched.
+type LogoFit string
+
+const (
+	LogoFitContain LogoFit = "contain" // Fit inside the slot, pad with transparency
+	LogoFitCover   LogoFit = "cover"   // Fill the slot, crop the overflow
+)
+
+// LogoFits is the list of available logo fit modes
+var LogoFits = []LogoFit{LogoFitContain, LogoFitCover}
+
+// LogoFitLabels provides human-readable labels for logo fit modes
+var LogoFitLabels = map[LogoFit]string{
+	LogoFitContain: "Contain",
+	LogoFitCover:   "Cover",
+}
+
+// LogoFitIndex returns the index of f in LogoFits (0 if not found)
+func LogoFitIndex(f LogoFit) int {
+	for i, fit := range LogoFits {
+		if fit == f {
+			return i
+		}
+	}
+	return 0
+}
+
+// FormFocus is the field focused when the recording form opens
+type FormFocus string
+
+const (
+	FormFocusTitle   FormFocus = "title"   // Start on the title input
+	FormFocusTopic   FormFocus = "topic"   // Start on the topic selector
+	FormFocusConfirm FormFocus = "confirm" // Start on the confirm button (new recordings only)
+)
+
+// FormFocuses is the list of available initial form fields
+var FormFocuses = []FormFocus{FormFocusTitle, FormFocusTopic, FormFocusConfirm}
+
+// FormFocusLabels provides human-readable labels for initial form fields
+var FormFocusLabels = map[FormFocus]string{
+	FormFocusTitle:   "Title",
+	FormFocusTopic:   "Topic",
+	FormFocusConfirm: "Confirm",
+}
+
+// FormFocusIndex returns the index of f in FormFocuses (0 if not found)
+func FormFocusIndex(f FormFocus) int {
+	for i, focus := range FormFocuses {
+		if focus == f {
+			return i
+		}
+	}
+	return 0
+}
+
+// OutputResolution controls the resolution of processed output videos
+type OutputResolution string
+
+const (
+	OutputResolutionNative OutputResolution = "native" // Keep source resolution
+	OutputResolution1080p  OutputResolution = "1080p"  // Downscale to 1080 lines
+	OutputResolution720p   OutputResolution = "720p"   // Downscale to 720 lines
+)
+
+// OutputResolutions is the list of available output resolutions
+var OutputResolutions = []OutputResolution{OutputResolutionNative, OutputResolution1080p, OutputResolution720p}
+
+// OutputResolutionLabels provides human-readable labels for output resolutions
+var OutputResolutionLabels = map[OutputResolution]string{
+	OutputResolutionNative: "Native",
+	OutputResolution1080p:  "1080p",
+	OutputResolution720p:   "720p",
+}
+
+// Height returns the target output height in pixels, or 0 for native resolution
+func (r OutputResolution) Height() int {
+	switch r {
+	case OutputResolution1080p:
+		return 1080
+	case OutputResolution720p:
+		return 720
+	default:
+		return 0
+	}
+}
+
+// OutputResolutionIndex returns the index of r in OutputResolutions (0 if not found)
+func OutputResolutionIndex(r OutputResolution) int {
+	for i, res := range OutputResolutions {
+		if res == r {
+			return i
+		}
+	}
+	return 0
+}
+
+// DefaultFrameRate is the output frame rate of recordings without one chosen
+const DefaultFrameRate = 30
+
+// FrameRates is the list of available output frame rates
+var FrameRates = []int{24, 30, 60}
+
+// FrameRateIndex returns the index of fps in FrameRates (the default if not found)
+func FrameRateIndex(fps int) int {
+	for i, rate := range FrameRates {
+		if rate == fps {
+			return i
+		}
+	}
+	for i, rate := range FrameRates {
+		if rate == DefaultFrameRate {
+			return i
+		}
+	}
+	return 0
+}
+
+// PiPCorner is the corner of the landscape video the webcam overlay is placed in
+type PiPCorner string
+
+const (
+	PiPCornerBottomRight PiPCorner = "bottom-right" // Default placement
+	PiPCornerBottomLeft  PiPCorner = "bottom-left"
+	PiPCornerTopRight    PiPCorner = "top-right"
+	PiPCornerTopLeft     PiPCorner = "top-left"
+)
+
+// PiPCorners is the list of available webcam overlay corners
+var PiPCorners = []PiPCorner{PiPCornerBottomRight, PiPCornerBottomLeft, PiPCornerTopRight, PiPCornerTopLeft}
+
+// PiPCornerLabels provides human-readable labels for webcam overlay corners
+var PiPCornerLabels = map[PiPCorner]string{
+	PiPCornerBottomRight: "Bottom Right",
+	PiPCornerBottomLeft:  "Bottom Left",
+	PiPCornerTopRight:    "Top Right",
+	PiPCornerTopLeft:     "Top Left",
+}
+
+// PiPCornerIndex returns the index of c in PiPCorners (0 if not found)
+func PiPCornerIndex(c PiPCorner) int {
+	for i, corner := range PiPCorners {
+		if corner == c {
+			return i
+		}
+	}
+	return 0
+}
+
+// PiPSize is the size of the webcam overlay on the landscape video
+type PiPSize string
+
+const (
+	PiPSizeSmall  PiPSize = "small"
+	PiPSizeMedium PiPSize = "medium" // Default size
+	PiPSizeLarge  PiPSize = "large"
+)
+
+// PiPSizes is the list of available webcam overlay sizes
+var PiPSizes = []PiPSize{PiPSizeSmall, PiPSizeMedium, PiPSizeLarge}
+
+// PiPSizeLabels provides human-readable labels for webcam overlay sizes
+var PiPSizeLabels = map[PiPSize]string{
+	PiPSizeSmall:  "Small",
+	PiPSizeMedium: "Medium",
+	PiPSizeLarge:  "Large",
+}
+
+// Diameter returns the webcam overlay diameter in pixels for a video of the
+// given height. Sizes are defined for 1080p and scaled proportionally; the
+// result is always even so it stays valid for yuv420p.
+func (s PiPSize) Diameter(videoHeight int) int {
+	base := 250
+	switch s {
+	case PiPSizeSmall:
+		base = 180
+	case PiPSizeLarge:
+		base = 360
+	}
+	if videoHeight <= 0 {
+		return base
+	}
+	return (base * videoHeight / 1080) &^ 1
+}
+
+// PiPSizeIndex returns the index of s in PiPSizes (the medium size if not found)
+func PiPSizeIndex(s PiPSize) int {
+	for i, size := range PiPSizes {
+		if size == s {
+			return i
+		}
+	}
+	return 1
+}
+
+// VerticalWebcamPosition is where the webcam is placed on the vertical
+// video: above or below the screen, and to the left, center or right
+type VerticalWebcamPosition string
+
+const (
+	VerticalWebcamBottomCenter VerticalWebcamPosition = "bottom-center" // Default placement
+	VerticalWebcamBottomLeft   VerticalWebcamPosition = "bottom-left"
+	VerticalWebcamBottomRight  VerticalWebcamPosition = "bottom-right"
+	VerticalWebcamTopCenter    VerticalWebcamPosition = "top-center"
+	VerticalWebcamTopLeft      VerticalWebcamPosition = "top-left"
+	VerticalWebcamTopRight     VerticalWebcamPosition = "top-right"
+)
+
+// VerticalWebcamPositions is the list of available vertical webcam positions
+var VerticalWebcamPositions = []VerticalWebcamPosition{
+	VerticalWebcamBottomCenter, VerticalWebcamBottomLeft, VerticalWebcamBottomRight,
+	VerticalWebcamTopCenter, VerticalWebcamTopLeft, VerticalWebcamTopRight,
+}
+
+// VerticalWebcamPositionLabels provides human-readable labels for vertical
+// webcam positions
+var VerticalWebcamPositionLabels = map[VerticalWebcamPosition]string{
+	VerticalWebcamBottomCenter: "Bottom Center",
+	VerticalWebcamBottomLeft:   "Bottom Left",
+	VerticalWebcamBottomRight:  "Bottom Right",
+	VerticalWebcamTopCenter:    "Top Center",
+	VerticalWebcamTopLeft:      "Top Left",
+	VerticalWebcamTopRight:     "Top Right",
+}
+
+// IsTop returns true if the webcam is placed above the screen
+func (p VerticalWebcamPosition) IsTop() bool {
+	return strings.HasPrefix(string(p), "top-")
+}
+
+// VerticalWebcamPositionIndex returns the index of p in
+// VerticalWebcamPositions (0 if not found)
+func VerticalWebcamPositionIndex(p VerticalWebcamPosition) int {
+	for i, pos := range VerticalWebcamPositions {
+		if pos == p {
+			return i
+		}
+	}
+	return 0
+}
+
+// VerticalWebcamSize is how much of the space next to the screen the webcam
+// takes on the vertical video
+type VerticalWebcamSize string
+
+const (
+	VerticalWebcamSmall  VerticalWebcamSize = "small"
+	VerticalWebcamMedium VerticalWebcamSize = "medium"
+	VerticalWebcamLarge  VerticalWebcamSize = "large" // Default size, fills the space
+)
+
+// VerticalWebcamSizes is the list of available vertical webcam sizes
+var VerticalWebcamSizes = []VerticalWebcamSize{VerticalWebcamSmall, VerticalWebcamMedium, VerticalWebcamLarge}
+
+// VerticalWebcamSizeLabels provides human-readable labels for vertical
+// webcam sizes
+var VerticalWebcamSizeLabels = map[VerticalWebcamSize]string{
+	VerticalWebcamSmall:  "Small",
+	VerticalWebcamMedium: "Medium",
+	VerticalWebcamLarge:  "Large",
+}
+
+// Percent returns the share of the largest webcam that fits next to the
+// screen, in percent
+func (s VerticalWebcamSize) Percent() int {
+	switch s {
+	case VerticalWebcamSmall:
+		return 50
+	case VerticalWebcamMedium:
+		return 75
+	}
+	return 100
+}
+
+// VerticalWebcamSizeIndex returns the index of s in VerticalWebcamSizes (the
+// large size if not found)
+func VerticalWebcamSizeIndex(s VerticalWebcamSize) int {
+	for i, size := range VerticalWebcamSizes {
+		if size == s {
+			return i
+		}
+	}
+	return len(VerticalWebcamSizes) - 1
+}
+
+// AutoPlayOutput selects which processed video is played when processing
+// finishes
+type AutoPlayOutput string
+
+const (
+	AutoPlayOutputMerged   AutoPlayOutput = "merged"   // Landscape merged video (default)
+	AutoPlayOutputVertical AutoPlayOutput = "vertical" // Vertical video, falling back to the merged video
+)
+
+// AutoPlayOutputs is the list of available auto-play outputs
+var AutoPlayOutputs = []AutoPlayOutput{AutoPlayOutputMerged, AutoPlayOutputVertical}
+
+// AutoPlayOutputLabels provides human-readable labels for auto-play outputs
+var AutoPlayOutputLabels = map[AutoPlayOutput]string{
+	AutoPlayOutputMerged:   "Landscape",
+	AutoPlayOutputVertical: "Vertical",
+}
+
+// AutoPlayOutputIndex returns the index of o in AutoPlayOutputs (0 if not found)
+func AutoPlayOutputIndex(o AutoPlayOutput) int {
+	for i, output := range AutoPlayOutputs {
+		if output == o {
+			return i
+		}
+	}
+	return 0
+}
+
+// File returns the video of files to play for o, or "" if there is none
+func (o AutoPlayOutput) File(files models.FileInfo) string {
+	if o == AutoPlayOutputVertical && files.VerticalFile != "" {
+		return files.VerticalFile
+	}
+	return files.MergedFile
+}
+
+// UITheme selects the TUI color theme
+type UITheme string
+
+const (
+	UIThemeAuto  UITheme = "auto"  // Detect from the terminal background
+	UIThemeDark  UITheme = "dark"  // Palette tuned for dark terminals
+	UIThemeLight UITheme = "light" // Palette tuned for light terminals
+)
+
+// UIThemes is the list of available TUI themes
+var UIThemes = []UITheme{UIThemeAuto, UIThemeDark, UIThemeLight}
+
+// UIThemeLabels provides human-readable labels for TUI themes
+var UIThemeLabels = map[UITheme]string{
+	UIThemeAuto:  "Auto",
+	UIThemeDark:  "Dark",
+	UIThemeLight: "Light",
+}
+
+// SavedFilter is a named combination of history filters. Empty fields do
+// not restrict the recordings.
+type SavedFilter struct {
+	Name   string `json:"name"`
+	Text   string `json:"text,omitempty"`   // Words matched like the "/" filter
+	Status string `json:"status,omitempty"` // Recording status, e.g. "failed"
+	Topic  string `json:"topic,omitempty"`  // Topic name
+	Period string `json:"period,omitempty"` // today, week (last 7 days), month or year
+}
+
+// DefaultSavedFilters returns the saved filters offered before the user
+// changed them
+func DefaultSavedFilters() []SavedFilter {
+	return []SavedFilter{
+		{Name: "Failed recordings", Status: models.StatusFailed},
+		{Name: "This month's tutorials", Topic: "Tutorial", Period: "month"},
+	}
+}
+
+// GetSavedFilters returns the saved filters, or the defaults if the user
+// never changed them
+func (c *Config) GetSavedFilters() []SavedFilter {
+	if !c.SavedFiltersConfigured {
+		return DefaultSavedFilters()
+	}
+	return c.SavedFilters
+}
+
+// MaxRecentRecordings is the number of recently opened recordings remembered
+const MaxRecentRecordings = 10
+
+// AddRecentRecording moves a recording folder to the front of the recently
+// opened recordings, forgetting the oldest beyond MaxRecentRecordings
+func (c *Config) AddRecentRecording(folderPath string) {
+	recent := []string{folderPath}
+	for _, p := range c.RecentRecordings {
+		if p != folderPath && len(recent) < MaxRecentRecordings {
+			recent = append(recent, p)
+		}
+	}
+	c.RecentRecordings = recent
+}
+
+// RenameRecentRecording replaces a renamed recording folder in the recently
+// opened recordings, keeping its place
+func (c *Config) RenameRecentRecording(oldPath, newPath string) {
+	for i, p := range c.RecentRecordings {
+		if p == oldPath {
+			c.RecentRecordings[i] = newPath
+		}
+	}
+}
+
+// RetentionAction is what the retention policy does with expired recordings
+type RetentionAction string
+
+const (
+	RetentionActionOff     RetentionAction = "off"     // Keep all recordings
+	RetentionActionArchive RetentionAction = "archive" // Move to the archive folder
+	RetentionActionTrash   RetentionAction = "trash"   // Move to the trash folder (soft delete)
+)
+
+// RetentionActions is the list of available retention actions
+var RetentionActions = []RetentionAction{RetentionActionOff, RetentionActionArchive, RetentionActionTrash}
+
+// RetentionActionLabels provides human-readable labels for retention actions
+var RetentionActionLabels = map[RetentionAction]string{
+	RetentionActionOff:     "Off",
+	RetentionActionArchive: "Archive",
+	RetentionActionTrash:   "Trash",
+}
+
+// RetentionAgeDays is the list of selectable age thresholds in days
+var RetentionAgeDays = []int{30, 60, 90, 180, 365}
+
+// DefaultRetentionAgeDays is the age threshold used when none is configured
+const DefaultRetentionAgeDays = 90
+
+// RetentionPolicy controls automatic archiving of old recordings at startup
+type RetentionPolicy struct {
+	Action         RetentionAction `json:"action,omitempty"`           // What to do with expired recordings (empty = off)
+	MaxAgeDays     int             `json:"max_age_days,omitempty"`     // Age threshold in days
+	OnlyIfUploaded bool            `json:"only_if_uploaded,omitempty"` // Only act on recordings published to YouTube
+	Reviewed       bool            `json:"reviewed,omitempty"`         // Set once the user has confirmed the first dry run
+}
+
+// Enabled returns true if the policy archives or trashes recordings
+func (p RetentionPolicy) Enabled() bool {
+	return p.Action == RetentionActionArchive || p.Action == RetentionActionTrash
+}
+
+// AgeDays returns the configured age threshold, or the default if unset
+func (p RetentionPolicy) AgeDays() int {
+	if p.MaxAgeDays <= 0 {
+		return DefaultRetentionAgeDays
+	}
+	return p.MaxAgeDays
+}
+
+// StorageBudgetsGB is the list of selectable storage budgets for the videos
+// directory in gigabytes (0 = no budget)
+var StorageBudgetsGB = []int{0, 50, 100, 250, 500, 1000}
+
+// StorageBudgetBytes returns the configured storage budget in bytes, or 0
+// if no budget is set
+func (c *Config) StorageBudgetBytes() int64 {
+	if c.StorageBudgetGB <= 0 {
+		return 0
+	}
+	return int64(c.StorageBudgetGB) << 30
+}
+
+// DefaultCountdownSeconds is the length of the countdown before a recording
+// starts, and MaxCountdownSeconds the longest selectable one
+const (
+	DefaultCountdownSeconds = 5
+	MaxCountdownSeconds     = 10
+)
+
+// Countdown returns the configured countdown length in seconds (0 = start
+// recording immediately), or the default if unset
+func (c *Config) Countdown() int {
+	if !c.CountdownConfigured {
+		return DefaultCountdownSeconds
+	}
+	return min(max(c.CountdownSeconds, 0), MaxCountdownSeconds)
+}
+
+// LoudnessTargetLUFS returns the integrated loudness audio is normalized to,
+// within the range that can be chosen, or the default if unset
+func (c *Config) LoudnessTargetLUFS() float64 {
+	if c.AudioProcessing.TargetLoudness == 0 {
+		return models.DefaultLoudnessTargetLUFS
+	}
+	return min(max(c.AudioProcessing.TargetLoudness, models.MinLoudnessTargetLUFS), models.MaxLoudnessTargetLUFS)
+}
+
+// AudioOptions returns the audio processing options, with the loudness
+// target kept within its range
+func (c *Config) AudioOptions() models.AudioProcessingOptions {
+	opts := c.AudioProcessing
+	opts.TargetLoudness = c.LoudnessTargetLUFS()
+	return opts
+}
+
+// IsSensitiveTopic returns true if the topic with the given name is marked
+// sensitive
+func (c *Config) IsSensitiveTopic(name string) bool {
+	for _, t := range c.Topics {
+		if t.Sensitive && strings.EqualFold(t.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// BreakReminderIntervals is the list of selectable break reminder intervals
+// in minutes (0 = off)
+var BreakReminderIntervals = []int{0, 20, 30, 45, 60, 90}
+
+// BreakReminder configures reminders to take a break during long recordings
+type BreakReminder struct {
+	IntervalMinutes int  `json:"interval_minutes,omitempty"` // Continuous recording time before a reminder (0 = off)
+	AutoSplit       bool `json:"auto_split,omitempty"`       // Start a new part when a reminder fires
+}
+
+// Enabled returns true if break reminders are on
+func (b BreakReminder) Enabled() bool {
+	return b.IntervalMinutes > 0
+}
+
+// Interval returns the continuous recording time before a reminder
+func (b BreakReminder) Interval() time.Duration {
+	return time.Duration(b.IntervalMinutes) * time.Minute
+}
+
 // LogoSelection holds the selected logos for a recording
 type LogoSelection struct {
 	LeftLogo    string      `json:"left_logo,omitempty"`    // Top-left logo
@@ -84,12 +628,34 @@ func DefaultTerminalRecordingSettings() TerminalRecordingSettings {
 // RecordingPresets holds the user's preferred recording settings
 // These are saved and restored between sessions (excludes title, description, number)
 type RecordingPresets struct {
-	RecordAudio   bool   `json:"record_audio"`
-	RecordWebcam  bool   `json:"record_webcam"`
-	RecordScreen  bool   `json:"record_screen"`
-	VerticalVideo bool   `json:"vertical_video"`
-	AddLogos      bool   `json:"add_logos"`
-	Topic         string `json:"topic,omitempty"` // Last selected topic name
+	RecordAudio     bool   `json:"record_audio"`
+	RecordWebcam    bool   `json:"record_webcam"`
+	RecordScreen    bool   `json:"record_screen"`
+	VerticalVideo   bool   `json:"vertical_video"`
+	WebcamOnlyVideo bool   `json:"webcam_only_video,omitempty"` // Square video of the webcam alone
+	AddLogos        bool   `json:"add_logos"`
+	Topic           string `json:"topic,omitempty"`         // Last selected topic name
+	AudioDevice     string `json:"audio_device,omitempty"`  // Last selected microphone (empty = default)
+	WebcamDevice    string `json:"webcam_device,omitempty"` // Last selected webcam (empty = detected)
+}
+
+// VerticalVideoSupported returns true if a vertical video can be created from
+// a recording with these sources. The vertical layout stacks the screen above
+// the webcam, so it needs both.
+func VerticalVideoSupported(webcam, screen bool) bool {
+	return webcam && screen
+}
+
+// CreatesVerticalVideo returns true if recordings made with the presets get a
+// vertical video
+func (p RecordingPresets) CreatesVerticalVideo() bool {
+	return p.VerticalVideo && VerticalVideoSupported(p.RecordWebcam, p.RecordScreen)
+}
+
+// CreatesWebcamOnlyVideo returns true if recordings made with the presets get
+// a webcam-only video, which needs the webcam
+func (p RecordingPresets) CreatesWebcamOnlyVideo() bool {
+	return p.WebcamOnlyVideo && p.RecordWebcam
 }
 
 // DefaultRecordingPresets returns sensible defaults for recording presets
@@ -122,17 +688,68 @@ type Config struct {
 	DefaultOptions   models.RecordingOptions       `json:"default_options"`
 	AudioProcessing  models.AudioProcessingOptions `json:"audio_processing"`
 	Topics           []models.Topic                `json:"topics,omitempty"`
+	FilterPresets    []models.FilterPreset         `json:"filter_presets,omitempty"` // Library of reusable ffmpeg filter presets
 	DefaultPresenter string                        `json:"default_presenter,omitempty"`
 
 	// Logo settings
 	LogoDirectory  string        `json:"logo_directory,omitempty"`   // Directory to browse for logos
 	LastUsedLogos  LogoSelection `json:"last_used_logos,omitempty"`  // Last used logo selection
 	BgColor        string        `json:"bg_color,omitempty"`         // Background color for vertical video lower third
+	LogoFit        LogoFit       `json:"logo_fit,omitempty"`         // How logos are fitted into their slots (empty = contain)
+
+	// Processing settings
+	OutputResolution OutputResolution `json:"output_resolution,omitempty"` // Default output resolution for new recordings
+	PiPCorner        PiPCorner        `json:"pip_corner,omitempty"`        // Default webcam overlay corner on landscape video
+	PiPSize          PiPSize          `json:"pip_size,omitempty"`          // Default webcam overlay size on landscape video
+	HardwareEncoding bool             `json:"hardware_encoding,omitempty"` // Encode with VAAPI/NVENC when available
+
+	// Review settings: what to open once processing has finished successfully
+	// (ignored when the YouTube upload prompt is shown instead)
+	AutoOpenOutputOnComplete bool           `json:"auto_open_output_on_complete,omitempty"` // Open the recording folder
+	AutoPlayOnComplete       bool           `json:"auto_play_on_complete,omitempty"`        // Play the processed video
+	AutoPlayOutput           AutoPlayOutput `json:"auto_play_output,omitempty"`             // Which video to play (empty = merged)
+
+	// Appearance settings
+	UITheme UITheme `json:"ui_theme,omitempty"` // TUI color theme (empty = auto)
+
+	// History filter: match typed words as fuzzy subsequences ranked by
+	// match quality instead of exact substrings
+	FuzzySearch bool `json:"fuzzy_search,omitempty"`
+
+	// Named history filters ("smart lists") picked with f in the history
+	SavedFilters           []SavedFilter `json:"saved_filters,omitempty"`
+	SavedFiltersConfigured bool          `json:"saved_filters_configured,omitempty"` // Whether the user changed the defaults
+
+	// Recording metadata templates, imported in Options and applied in the
+	// recording form
+	RecordingTemplates []templates.Template `json:"recording_templates,omitempty"`
+
+	// Recordings recently opened in the history, by folder path, newest first
+	RecentRecordings []string `json:"recent_recordings,omitempty"`
+
+	// Retention policy for old recordings
+	Retention RetentionPolicy `json:"retention,omitempty"`
+
+	// Maximum size of the videos directory in GB before the app suggests
+	// recordings to archive or delete (0 = no budget)
+	StorageBudgetGB int `json:"storage_budget_gb,omitempty"`
+
+	// Break reminders during long recording sessions (off by default)
+	BreakReminder BreakReminder `json:"break_reminder,omitempty"`
+
+	// Length of the countdown before recording starts, see Countdown
+	CountdownSeconds    int  `json:"countdown_seconds,omitempty"`
+	CountdownConfigured bool `json:"countdown_configured,omitempty"` // Whether the user changed the default
 
 	// Recording presets (saved between sessions)
 	RecordingPresets  RecordingPresets `json:"recording_presets,omitempty"`
 	PresetsConfigured bool             `json:"presets_configured,omitempty"` // Whether user has explicitly configured presets
 
+	// Recording form navigation: the field focused when the form opens and
+	// whether tab skips toggles that still match the recording presets
+	FormFocus         FormFocus `json:"form_focus,omitempty"`
+	SkipPresetToggles bool      `json:"skip_preset_toggles,omitempty"`
+
 	// YouTube integration settings
 	YouTube youtube.Config `json:"youtube,omitempty"`
 
@@ -149,6 +766,7 @@ func DefaultConfig() Config {
 		OutputDir:       GetDefaultVideosDir(),
 		DefaultOptions:  models.DefaultRecordingOptions(),
 		AudioProcessing: models.DefaultAudioProcessingOptions(),
+		FilterPresets:   models.DefaultFilterPresets(),
 		YouTube:         youtube.DefaultConfig(),
 		Syndication:     syndication.DefaultConfig(),
 	}
@@ -164,17 +782,98 @@ func (c *Config) IsYouTubeConnected() bool {
 	return c.GetYouTubeAuthStatus() == youtube.AuthStatusAuthenticated
 }
 
-// GetConfigDir returns the configuration directory path
-func GetConfigDir() string {
+// SetConfigDirOverride overrides the configuration directory for this process.
+// The override is also exported via ConfigDirEnvVar so that child processes
+// (e.g. a TUI launched from the systray) use the same isolated config.
+func SetConfigDirOverride(dir string) error {
+	if dir == "" {
+		configDirOverride = ""
+		return nil
+	}
+	abs, err := filepath.Abs(expandHome(dir))
+	if err != nil {
+		return err
+	}
+	configDirOverride = abs
+	return os.Setenv(ConfigDirEnvVar, abs)
+}
+
+// SetDataDirOverride overrides the data directory for this process
+func SetDataDirOverride(dir string) error {
+	if dir == "" {
+		dataDirOverride = ""
+		return nil
+	}
+	abs, err := filepath.Abs(expandHome(dir))
+	if err != nil {
+		return err
+	}
+	dataDirOverride = abs
+	return nil
+}
+
+// expandHome expands a leading ~ to the user's home directory
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+// xdgDir resolves an application directory inside an XDG base directory.
+// The XDG variable is only honoured when it holds an absolute path, as required
+// by the XDG Base Directory specification; otherwise defaultDir (relative to the
+// home directory) is used.
+func xdgDir(envVar, defaultDir string) string {
+	if base := os.Getenv(envVar); base != "" && filepath.IsAbs(base) {
+		return filepath.Join(base, AppDirName)
+	}
 	home, err := os.UserHomeDir()
 	if err != nil {
-		return DefaultConfigDir
+		return defaultDir
+	}
+	return filepath.Join(home, defaultDir)
+}
+
+// GetConfigDir returns the configuration directory path.
+// Resolution order: --config-dir flag, ConfigDirEnvVar, $XDG_CONFIG_HOME/kartoza-screencaster,
+// then ~/.config/kartoza-screencaster.
+func GetConfigDir() string {
+	if configDirOverride != "" {
+		return configDirOverride
+	}
+	if dir := os.Getenv(ConfigDirEnvVar); dir != "" {
+		return expandHome(dir)
+	}
+	return xdgDir("XDG_CONFIG_HOME", DefaultConfigDir)
+}
+
+// GetDataDir returns the application data directory path.
+// Resolution order: --data-dir flag, $XDG_DATA_HOME/kartoza-screencaster,
+// then ~/.local/share/kartoza-screencaster.
+func GetDataDir() string {
+	if dataDirOverride != "" {
+		return dataDirOverride
 	}
-	return filepath.Join(home, DefaultConfigDir)
+	return xdgDir("XDG_DATA_HOME", DefaultDataDir)
 }
 
-// GetDefaultVideosDir returns the default videos directory path
+// GetCacheDir returns the cache directory path for disposable files such as
+// YouTube upload thumbnails ($XDG_CACHE_HOME/kartoza-screencaster or ~/.cache/kartoza-screencaster)
+func GetCacheDir() string {
+	return xdgDir("XDG_CACHE_HOME", DefaultCacheDir)
+}
+
+// GetDefaultVideosDir returns the default videos directory path.
+// Uses $XDG_VIDEOS_DIR/Screencasts when set, otherwise ~/Videos/Screencasts.
 func GetDefaultVideosDir() string {
+	if base := os.Getenv("XDG_VIDEOS_DIR"); base != "" && filepath.IsAbs(base) {
+		return filepath.Join(base, ScreencastsDirName)
+	}
 	home, err := os.UserHomeDir()
 	if err != nil {
 		return DefaultVideosDir
@@ -182,6 +881,37 @@ func GetDefaultVideosDir() string {
 	return filepath.Join(home, DefaultVideosDir)
 }
 
+// MigrateLegacyConfigDir moves the configuration from ~/.config/kartoza-screencaster
+// to the XDG config location when XDG_CONFIG_HOME points elsewhere. It does nothing
+// if the config directory is overridden, already exists, or there is nothing to migrate.
+func MigrateLegacyConfigDir() error {
+	if configDirOverride != "" || os.Getenv(ConfigDirEnvVar) != "" {
+		return nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+	legacyDir := filepath.Join(home, DefaultConfigDir)
+	newDir := GetConfigDir()
+	if legacyDir == newDir {
+		return nil
+	}
+	if _, err := os.Stat(newDir); err == nil {
+		return nil
+	}
+	if info, err := os.Stat(legacyDir); err != nil || !info.IsDir() {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(newDir), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(newDir), err)
+	}
+	if err := os.Rename(legacyDir, newDir); err != nil {
+		return fmt.Errorf("failed to migrate config from %s to %s: %w", legacyDir, newDir, err)
+	}
+	return nil
+}
+
 // EnsureDirectories creates the necessary directories
 func EnsureDirectories() error {
 	dirs := []string{
@@ -4,6 +4,8 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -14,8 +16,8 @@ func TestDefaultConfig(t *testing.T) {
 	}
 
 	// Check audio processing defaults
-	if cfg.AudioProcessing.TargetLoudness != -14.0 {
-		t.Errorf("expected TargetLoudness to be -14.0, got %f", cfg.AudioProcessing.TargetLoudness)
+	if cfg.AudioProcessing.TargetLoudness != -16.0 {
+		t.Errorf("expected TargetLoudness to be -16.0, got %f", cfg.AudioProcessing.TargetLoudness)
 	}
 
 	if cfg.AudioProcessing.TruePeak != -1.5 {
@@ -28,6 +30,7 @@ func TestDefaultConfig(t *testing.T) {
 }
 
 func TestGetConfigDir(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "")
 	dir := GetConfigDir()
 
 	if dir == "" {
@@ -40,7 +43,72 @@ func TestGetConfigDir(t *testing.T) {
 	}
 }
 
+func TestGetConfigDir_Override(t *testing.T) {
+	envDir := t.TempDir()
+	t.Setenv(ConfigDirEnvVar, envDir)
+
+	if dir := GetConfigDir(); dir != envDir {
+		t.Errorf("expected env override %q, got %q", envDir, dir)
+	}
+
+	flagDir := t.TempDir()
+	if err := SetConfigDirOverride(flagDir); err != nil {
+		t.Fatalf("SetConfigDirOverride failed: %v", err)
+	}
+	defer SetConfigDirOverride("")
+
+	if dir := GetConfigDir(); dir != flagDir {
+		t.Errorf("expected flag override %q, got %q", flagDir, dir)
+	}
+	if env := os.Getenv(ConfigDirEnvVar); env != flagDir {
+		t.Errorf("expected flag override to be exported, got %q", env)
+	}
+}
+
+func TestGetConfigDir_XDG(t *testing.T) {
+	xdg := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+
+	if dir := GetConfigDir(); dir != filepath.Join(xdg, AppDirName) {
+		t.Errorf("expected XDG config dir, got %q", dir)
+	}
+
+	// Relative XDG paths are invalid and must be ignored
+	t.Setenv("XDG_CONFIG_HOME", "relative/path")
+	if !containsPath(GetConfigDir(), DefaultConfigDir) {
+		t.Errorf("expected relative XDG_CONFIG_HOME to be ignored, got %q", GetConfigDir())
+	}
+}
+
+func TestMigrateLegacyConfigDir(t *testing.T) {
+	home := t.TempDir()
+	xdg := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	t.Setenv(ConfigDirEnvVar, "")
+
+	legacyDir := filepath.Join(home, DefaultConfigDir)
+	if err := os.MkdirAll(legacyDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(legacyDir, ConfigFileName), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := MigrateLegacyConfigDir(); err != nil {
+		t.Fatalf("MigrateLegacyConfigDir failed: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(xdg, AppDirName, ConfigFileName)); err != nil {
+		t.Errorf("expected config to be migrated: %v", err)
+	}
+	if _, err := os.Stat(legacyDir); !os.IsNotExist(err) {
+		t.Errorf("expected legacy config dir to be moved, stat err: %v", err)
+	}
+}
+
 func TestGetDefaultVideosDir(t *testing.T) {
+	t.Setenv("XDG_VIDEOS_DIR", "")
 	dir := GetDefaultVideosDir()
 
 	if dir == "" {
@@ -143,6 +211,210 @@ func TestConstants(t *testing.T) {
 	}
 }
 
+func TestPiPSize_Diameter(t *testing.T) {
+	tests := []struct {
+		size   PiPSize
+		height int
+		want   int
+	}{
+		{PiPSizeMedium, 1080, 250},
+		{"", 1080, 250},
+		{PiPSizeSmall, 1080, 180},
+		{PiPSizeLarge, 1080, 360},
+		{PiPSizeMedium, 720, 166},
+		{PiPSizeMedium, 0, 250},
+	}
+	for _, tt := range tests {
+		if got := tt.size.Diameter(tt.height); got != tt.want {
+			t.Errorf("PiPSize(%q).Diameter(%d) = %d, want %d", tt.size, tt.height, got, tt.want)
+		}
+	}
+}
+
+func TestAutoPlayOutput_File(t *testing.T) {
+	both := models.FileInfo{MergedFile: "merged.mp4", VerticalFile: "vertical.mp4"}
+	mergedOnly := models.FileInfo{MergedFile: "merged.mp4"}
+	tests := []struct {
+		output AutoPlayOutput
+		files  models.FileInfo
+		want   string
+	}{
+		{AutoPlayOutputMerged, both, "merged.mp4"},
+		{"", both, "merged.mp4"},
+		{AutoPlayOutputVertical, both, "vertical.mp4"},
+		{AutoPlayOutputVertical, mergedOnly, "merged.mp4"},
+		{AutoPlayOutputVertical, models.FileInfo{}, ""},
+	}
+	for _, tt := range tests {
+		if got := tt.output.File(tt.files); got != tt.want {
+			t.Errorf("AutoPlayOutput(%q).File(%+v) = %q, want %q", tt.output, tt.files, got, tt.want)
+		}
+	}
+}
+
+func TestConfig_Countdown(t *testing.T) {
+	tests := []struct {
+		seconds    int
+		configured bool
+		want       int
+	}{
+		{0, false, DefaultCountdownSeconds},
+		{0, true, 0},
+		{3, true, 3},
+		{MaxCountdownSeconds + 5, true, MaxCountdownSeconds},
+		{-1, true, 0},
+	}
+	for _, tt := range tests {
+		cfg := &Config{CountdownSeconds: tt.seconds, CountdownConfigured: tt.configured}
+		if got := cfg.Countdown(); got != tt.want {
+			t.Errorf("Countdown() with %d seconds (configured %v) = %d, want %d", tt.seconds, tt.configured, got, tt.want)
+		}
+	}
+}
+
+func TestConfig_LoudnessTargetLUFS(t *testing.T) {
+	tests := []struct {
+		target float64
+		want   float64
+	}{
+		{0, models.DefaultLoudnessTargetLUFS},
+		{-18, -18},
+		{-30, models.MinLoudnessTargetLUFS},
+		{-6, models.MaxLoudnessTargetLUFS},
+	}
+	for _, tt := range tests {
+		cfg := &Config{AudioProcessing: models.AudioProcessingOptions{NormalizeEnabled: true, TargetLoudness: tt.target}}
+		if got := cfg.LoudnessTargetLUFS(); got != tt.want {
+			t.Errorf("LoudnessTargetLUFS() with %v = %v, want %v", tt.target, got, tt.want)
+		}
+		if opts := cfg.AudioOptions(); opts.TargetLoudness != tt.want || !opts.NormalizeEnabled {
+			t.Errorf("AudioOptions() with %v = %+v, want the target %v", tt.target, opts, tt.want)
+		}
+	}
+}
+
+func TestConfig_IsSensitiveTopic(t *testing.T) {
+	cfg := &Config{Topics: []models.Topic{
+		{ID: "internal", Name: "Internal", Sensitive: true},
+		{ID: "demo", Name: "Demo"},
+	}}
+	if !cfg.IsSensitiveTopic("Internal") || !cfg.IsSensitiveTopic("internal") {
+		t.Error("expected Internal to be sensitive, in any case")
+	}
+	if cfg.IsSensitiveTopic("Demo") || cfg.IsSensitiveTopic("") || cfg.IsSensitiveTopic("Unknown") {
+		t.Error("expected only topics marked sensitive to be sensitive")
+	}
+}
+
+func TestAddRecentRecording(t *testing.T) {
+	var cfg Config
+	cfg.AddRecentRecording("/videos/a")
+	cfg.AddRecentRecording("/videos/b")
+	cfg.AddRecentRecording("/videos/a")
+	if len(cfg.RecentRecordings) != 2 || cfg.RecentRecordings[0] != "/videos/a" || cfg.RecentRecordings[1] != "/videos/b" {
+		t.Fatalf("RecentRecordings = %v, want [/videos/a /videos/b]", cfg.RecentRecordings)
+	}
+
+	for i := 0; i < MaxRecentRecordings+5; i++ {
+		cfg.AddRecentRecording(filepath.Join("/videos", "n", string(rune('a'+i))))
+	}
+	if len(cfg.RecentRecordings) != MaxRecentRecordings {
+		t.Errorf("kept %d recent recordings, want %d", len(cfg.RecentRecordings), MaxRecentRecordings)
+	}
+	if cfg.RecentRecordings[0] != "/videos/n/o" {
+		t.Errorf("newest recent recording = %q, want /videos/n/o", cfg.RecentRecordings[0])
+	}
+}
+
+func TestSetupDraft(t *testing.T) {
+	t.Setenv(ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+
+	if draft, err := LoadSetupDraft(); draft != nil || err != nil {
+		t.Fatalf("LoadSetupDraft() = %v, %v; want no draft", draft, err)
+	}
+
+	draft := &SetupDraft{
+		Metadata: models.RecordingMetadata{Title: "QGIS intro", Description: "A long description"},
+		Settings: models.RecordingSettings{AudioEnabled: true, FrameRate: 60},
+	}
+	if err := SaveSetupDraft(draft); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadSetupDraft()
+	if err != nil || loaded == nil {
+		t.Fatalf("LoadSetupDraft() = %v, %v; want the saved draft", loaded, err)
+	}
+	if loaded.Metadata.Description != "A long description" || loaded.Settings.FrameRate != 60 || loaded.IsEmpty() {
+		t.Errorf("loaded %+v, want the saved draft", loaded)
+	}
+
+	if err := ClearSetupDraft(); err != nil {
+		t.Fatal(err)
+	}
+	if draft, _ := LoadSetupDraft(); draft != nil {
+		t.Error("expected the draft to be removed")
+	}
+	if err := ClearSetupDraft(); err != nil {
+		t.Errorf("ClearSetupDraft() without a draft = %v, want nil", err)
+	}
+}
+
+func TestSetupDraft_LegacyLocation(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv(ConfigDirEnvVar, configDir)
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+
+	legacy := filepath.Join(configDir, SetupDraftFileName)
+	if err := os.WriteFile(legacy, []byte(`{"metadata":{"title":"Old draft"}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	draft, err := LoadSetupDraft()
+	if err != nil || draft == nil || draft.Metadata.Title != "Old draft" {
+		t.Fatalf("LoadSetupDraft() = %+v, %v; want the draft from the config directory", draft, err)
+	}
+
+	if err := ClearSetupDraft(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(legacy); !os.IsNotExist(err) {
+		t.Errorf("expected the legacy draft to be removed, stat err: %v", err)
+	}
+}
+
+func TestGetDataDir(t *testing.T) {
+	xdg := t.TempDir()
+	t.Setenv("XDG_DATA_HOME", xdg)
+
+	if dir := GetDataDir(); dir != filepath.Join(xdg, AppDirName) {
+		t.Errorf("expected XDG data dir, got %q", dir)
+	}
+
+	flagDir := filepath.Join(t.TempDir(), "data")
+	if err := SetDataDirOverride(flagDir); err != nil {
+		t.Fatalf("SetDataDirOverride failed: %v", err)
+	}
+	defer SetDataDirOverride("")
+	if dir := GetDataDir(); dir != flagDir {
+		t.Errorf("expected flag override %q, got %q", flagDir, dir)
+	}
+
+	SetDataDirOverride("")
+	t.Setenv("XDG_DATA_HOME", "relative/path")
+	if !containsPath(GetDataDir(), DefaultDataDir) {
+		t.Errorf("expected relative XDG_DATA_HOME to be ignored, got %q", GetDataDir())
+	}
+}
+
+func TestGetCacheDir_XDG(t *testing.T) {
+	xdg := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", xdg)
+
+	if dir := GetCacheDir(); dir != filepath.Join(xdg, AppDirName) {
+		t.Errorf("expected XDG cache dir, got %q", dir)
+	}
+}
+
 // Helper functions
 
 func containsPath(fullPath, subPath string) bool {
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// SetupDraftFileName is the file in the config directory that holds the new
+// recording form while it is being filled in
+const SetupDraftFileName = "setup-draft.json"
+
+// SetupDraft is the new recording form as it was last saved, so what was
+// typed is not lost if the application is quit before the recording starts
+type SetupDraft struct {
+	SavedAt  time.Time                `json:"saved_at"`
+	Metadata models.RecordingMetadata `json:"metadata"`
+	Monitor  string                   `json:"monitor,omitempty"`
+	Settings models.RecordingSettings `json:"settings"`
+}
+
+// IsEmpty returns true if the draft has no title and no description, so
+// there is nothing worth restoring
+func (d *SetupDraft) IsEmpty() bool {
+	return strings.TrimSpace(d.Metadata.Title) == "" && strings.TrimSpace(d.Metadata.Description) == ""
+}
+
+// SetupDraftPath returns the path of the new recording form draft, which
+// is application state and so lives in the data directory
+func SetupDraftPath() string {
+	return filepath.Join(GetDataDir(), SetupDraftFileName)
+}
+
+// legacySetupDraftPath is where drafts were saved before they moved to the
+// data directory
+func legacySetupDraftPath() string {
+	return filepath.Join(GetConfigDir(), SetupDraftFileName)
+}
+
+// LoadSetupDraft loads the new recording form draft. It returns nil without
+// an error if there is none. A draft left in the config directory by an
+// older version is still restored.
+func LoadSetupDraft() (*SetupDraft, error) {
+	data, err := os.ReadFile(SetupDraftPath())
+	if os.IsNotExist(err) {
+		data, err = os.ReadFile(legacySetupDraftPath())
+	}
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var draft SetupDraft
+	if err := json.Unmarshal(data, &draft); err != nil {
+		return nil, err
+	}
+	return &draft, nil
+}
+
+// SaveSetupDraft saves the new recording form draft. The file is replaced
+// in one step, so quitting while it is written keeps the previous draft.
+func SaveSetupDraft(draft *SetupDraft) error {
+	if err := os.MkdirAll(GetDataDir(), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(draft, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := SetupDraftPath() + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, SetupDraftPath())
+}
+
+// ClearSetupDraft removes the new recording form draft, once the recording
+// started or the draft was discarded
+func ClearSetupDraft() error {
+	for _, path := range []string{SetupDraftPath(), legacySetupDraftPath()} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+// Package duplicates finds recordings that were likely made twice by
+// mistake, so the extra copies can be deleted.
+package duplicates
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// Thresholds for two recordings to count as similar
+const (
+	// Durations are similar when they differ by at most DurationTolerance
+	// or DurationToleranceRatio of the longer recording
+	DurationTolerance      = 3 * time.Second
+	DurationToleranceRatio = 0.01
+
+	// SizeToleranceRatio is the largest difference in total size relative
+	// to the larger recording
+	SizeToleranceRatio = 0.05
+
+	// TitleSimilarity is the smallest similarity (0-1) of two titles, see
+	// titleSimilarity
+	TitleSimilarity = 0.8
+)
+
+// Group is a set of recordings that are likely duplicates of each other,
+// oldest first
+type Group struct {
+	Recordings []models.RecordingInfo
+}
+
+// Find returns the groups of likely duplicates among the recordings, the
+// group with the oldest recording first. Two recordings are likely
+// duplicates when their titles are similar and so is their duration or
+// total size. Recordings without a title must match both duration and size.
+// Unknown (zero) durations and sizes never match, and recordings that are
+// still being recorded or processed are ignored.
+func Find(recordings []models.RecordingInfo) []Group {
+	var candidates []models.RecordingInfo
+	for _, rec := range recordings {
+		switch rec.Status {
+		case models.StatusRecording, models.StatusPaused, models.StatusProcessing:
+			continue
+		}
+		candidates = append(candidates, rec)
+	}
+
+	titles := make([]string, len(candidates))
+	for i, rec := range candidates {
+		titles[i] = normalizeTitle(rec.Metadata.Title)
+	}
+
+	// Union-find over the pairs of likely duplicates
+	parent := make([]int, len(candidates))
+	for i := range parent {
+		parent[i] = i
+	}
+	var root func(i int) int
+	root = func(i int) int {
+		if parent[i] != i {
+			parent[i] = root(parent[i])
+		}
+		return parent[i]
+	}
+
+	for i := range candidates {
+		for j := i + 1; j < len(candidates); j++ {
+			if root(i) == root(j) {
+				continue
+			}
+			if similar(candidates[i], candidates[j], titles[i], titles[j]) {
+				parent[root(j)] = root(i)
+			}
+		}
+	}
+
+	members := make(map[int][]models.RecordingInfo)
+	for i, rec := range candidates {
+		r := root(i)
+		members[r] = append(members[r], rec)
+	}
+
+	var groups []Group
+	for _, recs := range members {
+		if len(recs) < 2 {
+			continue
+		}
+		sort.SliceStable(recs, func(a, b int) bool {
+			return recs[a].StartTime.Before(recs[b].StartTime)
+		})
+		groups = append(groups, Group{Recordings: recs})
+	}
+	sort.Slice(groups, func(a, b int) bool {
+		ra, rb := groups[a].Recordings[0], groups[b].Recordings[0]
+		if !ra.StartTime.Equal(rb.StartTime) {
+			return ra.StartTime.Before(rb.StartTime)
+		}
+		return ra.Files.FolderPath < rb.Files.FolderPath
+	})
+	return groups
+}
+
+// similar reports whether two recordings are likely duplicates. titleA and
+// titleB are their titles as returned by normalizeTitle, computed once by
+// Find.
+func similar(a, b models.RecordingInfo, titleA, titleB string) bool {
+	duration := similarDuration(a.Duration, b.Duration)
+	size := similarSize(a.Files.TotalSize, b.Files.TotalSize)
+	if !duration && !size {
+		return false
+	}
+	if titleA == "" || titleB == "" {
+		// Recordings of the same length have similar sizes, so without
+		// titles both must match
+		return duration && size
+	}
+	return similarTitle(titleA, titleB)
+}
+
+// similarTitle reports whether two normalized titles are close enough, or
+// one extends the other (e.g. "qgis intro" and "qgis intro take 2")
+func similarTitle(a, b string) bool {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	return strings.HasPrefix(b, a+" ") || titleSimilarity(a, b) >= TitleSimilarity
+}
+
+// similarDuration reports whether two known durations are close
+func similarDuration(a, b time.Duration) bool {
+	if a <= 0 || b <= 0 {
+		return false
+	}
+	diff := a - b
+	if diff < 0 {
+		diff = -diff
+	}
+	longest := max(a, b)
+	return diff <= DurationTolerance || float64(diff) <= float64(longest)*DurationToleranceRatio
+}
+
+// similarSize reports whether two known sizes are close
+func similarSize(a, b int64) bool {
+	if a <= 0 || b <= 0 {
+		return false
+	}
+	diff := a - b
+	if diff < 0 {
+		diff = -diff
+	}
+	return float64(diff) <= float64(max(a, b))*SizeToleranceRatio
+}
+
+// normalizeTitle lowercases a title and reduces it to single spaced words,
+// so punctuation and spacing do not affect the comparison
+func normalizeTitle(title string) string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	return strings.Join(words, " ")
+}
+
+// titleSimilarity returns the Sørensen-Dice coefficient of the character
+// bigrams of two normalized titles: 1 for equal titles, 0 for titles
+// without a bigram in common. It tolerates small edits such as "take 2" or
+// a typo fixed in one of the titles.
+func titleSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) < 2 || len(rb) < 2 {
+		return 0
+	}
+
+	bigrams := make(map[string]int, len(ra))
+	for i := 0; i < len(ra)-1; i++ {
+		bigrams[string(ra[i:i+2])]++
+	}
+	common := 0
+	for i := 0; i < len(rb)-1; i++ {
+		bg := string(rb[i : i+2])
+		if bigrams[bg] > 0 {
+			bigrams[bg]--
+			common++
+		}
+	}
+	return 2 * float64(common) / float64(len(ra)-1+len(rb)-1)
+}
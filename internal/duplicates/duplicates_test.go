@@ -0,0 +1,105 @@
+package duplicates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func recording(folder, title string, duration time.Duration, size int64, started time.Time) models.RecordingInfo {
+	var rec models.RecordingInfo
+	rec.Status = models.StatusCompleted
+	rec.Files.FolderPath = folder
+	rec.Metadata.Title = title
+	rec.Duration = duration
+	rec.Files.TotalSize = size
+	rec.StartTime = started
+	return rec
+}
+
+func folders(g Group) []string {
+	var paths []string
+	for _, rec := range g.Recordings {
+		paths = append(paths, rec.Files.FolderPath)
+	}
+	return paths
+}
+
+func TestFind(t *testing.T) {
+	day := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	recordings := []models.RecordingInfo{
+		recording("/v/intro-2", "QGIS Intro (take 2)", 10*time.Minute+time.Second, 500e6, day.Add(time.Hour)),
+		recording("/v/intro", "QGIS intro", 10*time.Minute, 480e6, day),
+		recording("/v/meeting-1", "Weekly meeting", time.Hour, 3e9, day),
+		recording("/v/meeting-2", "Sprint planning", time.Hour+20*time.Second, 3e9, day.AddDate(0, 0, 7)),
+		recording("/v/untitled-1", "", 5*time.Minute, 200e6, day.AddDate(0, 0, 1)),
+		recording("/v/untitled-2", "", 5*time.Minute+time.Second, 201e6, day.AddDate(0, 0, 1).Add(time.Minute)),
+		recording("/v/untitled-3", "", 5*time.Minute, 900e6, day.AddDate(0, 0, 2)),
+		recording("/v/plugins", "QGIS intro", 25*time.Minute, 1e9, day.AddDate(0, 0, 3)),
+	}
+	active := recording("/v/active", "QGIS intro", 10*time.Minute, 480e6, day)
+	active.Status = models.StatusProcessing
+	recordings = append(recordings, active)
+
+	groups := Find(recordings)
+	want := [][]string{
+		{"/v/intro", "/v/intro-2"},
+		{"/v/untitled-1", "/v/untitled-2"},
+	}
+	if len(groups) != len(want) {
+		for _, g := range groups {
+			t.Log(folders(g))
+		}
+		t.Fatalf("Find() returned %d groups, want %d", len(groups), len(want))
+	}
+	for i, g := range groups {
+		got := folders(g)
+		if len(got) != len(want[i]) {
+			t.Errorf("group %d = %v, want %v", i, got, want[i])
+			continue
+		}
+		for j := range got {
+			if got[j] != want[i][j] {
+				t.Errorf("group %d = %v, want %v", i, got, want[i])
+				break
+			}
+		}
+	}
+}
+
+func TestFindChainsSimilarRecordings(t *testing.T) {
+	start := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	groups := Find([]models.RecordingInfo{
+		recording("/v/a", "Demo", time.Minute, 100e6, start),
+		recording("/v/b", "Demo", 0, 104e6, start.Add(time.Minute)),
+		recording("/v/c", "Demo", 0, 108e6, start.Add(2*time.Minute)),
+	})
+	// a and c differ by more than the size tolerance but both match b
+	if len(groups) != 1 || len(groups[0].Recordings) != 3 {
+		t.Fatalf("expected one group of 3, got %d groups", len(groups))
+	}
+}
+
+func TestSimilarTitle(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"qgis intro", "qgis intro", true},
+		{"qgis intro", "qgis intro take 2", true},
+		{"qgis", "qgisintro", false},
+		{"introduction to qgis", "introduction to qgis 2", true},
+		{"introduction to qgsi", "introduction to qgis", true},
+		{"weekly meeting", "sprint planning", false},
+		{"demo", "qgis demo", false},
+	}
+	for _, tt := range tests {
+		if got := similarTitle(tt.a, tt.b); got != tt.want {
+			t.Errorf("similarTitle(%q, %q) = %v (similarity %.2f), want %v", tt.a, tt.b, got, titleSimilarity(tt.a, tt.b), tt.want)
+		}
+	}
+	if got := normalizeTitle("  QGIS: Intro -- (take 2) "); got != "qgis intro take 2" {
+		t.Errorf("normalizeTitle() = %q", got)
+	}
+}
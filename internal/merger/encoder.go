@@ -0,0 +1,142 @@
+package merger
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// Encoder is the H.264 encoder the processing steps encode video with
+type Encoder string
+
+const (
+	EncoderSoftware Encoder = "x264"  // libx264 on the CPU
+	EncoderVAAPI    Encoder = "vaapi" // Intel/AMD GPUs through VA-API
+	EncoderNVENC    Encoder = "nvenc" // NVIDIA GPUs
+)
+
+// EncoderLabels provides human-readable labels for encoders
+var EncoderLabels = map[Encoder]string{
+	EncoderSoftware: "x264",
+	EncoderVAAPI:    "VAAPI",
+	EncoderNVENC:    "NVENC",
+}
+
+// Label returns the name of the encoder shown while processing
+func (e Encoder) Label() string {
+	if label, ok := EncoderLabels[e]; ok {
+		return label
+	}
+	return EncoderLabels[EncoderSoftware]
+}
+
+// vaapiDevice is the render node VA-API encodes on
+const vaapiDevice = "/dev/dri/renderD128"
+
+// softwareCodecArgs are the encoder arguments every encoding step uses for
+// libx264, replaced by hardwareArgs for a hardware encoder
+var softwareCodecArgs = []string{"-c:v", "libx264", "-preset", "medium", "-crf", "18"}
+
+var (
+	detectOnce       sync.Once
+	detectedEncoder  Encoder
+	hardwareEncoders = []Encoder{EncoderNVENC, EncoderVAAPI}
+
+	// probeEncoder reports whether an encoder works on this machine, a
+	// variable so tests can replace it
+	probeEncoder = probeWithFFmpeg
+)
+
+// DetectHardwareEncoder returns the first hardware encoder that works on
+// this machine, or EncoderSoftware if none does. The result is cached, so
+// only the first call runs ffmpeg.
+func DetectHardwareEncoder() Encoder {
+	detectOnce.Do(func() {
+		detectedEncoder = EncoderSoftware
+		for _, enc := range hardwareEncoders {
+			if probeEncoder(enc) {
+				detectedEncoder = enc
+				return
+			}
+		}
+	})
+	return detectedEncoder
+}
+
+// probeWithFFmpeg encodes a few frames of a test pattern with the encoder
+func probeWithFFmpeg(enc Encoder) bool {
+	if enc == EncoderVAAPI {
+		if _, err := os.Stat(vaapiDevice); err != nil {
+			return false
+		}
+	}
+	args := []string{"-hide_banner", "-v", "error",
+		"-f", "lavfi", "-i", "testsrc=size=256x256:duration=0.2"}
+	args = append(args, softwareCodecArgs...)
+	args = append(args, "-pix_fmt", "yuv420p", "-f", "null", "-")
+	return exec.Command("ffmpeg", hardwareArgs(enc, args)...).Run() == nil
+}
+
+// usesSoftwareEncoder returns true if args encode video with libx264
+func usesSoftwareEncoder(args []string) bool {
+	return indexOf(args, softwareCodecArgs) >= 0
+}
+
+// hardwareArgs rewrites ffmpeg arguments that encode with libx264 to use
+// the hardware encoder. VA-API needs the frames uploaded to the GPU, so an
+// upload is added at the end of the filters.
+func hardwareArgs(enc Encoder, args []string) []string {
+	i := indexOf(args, softwareCodecArgs)
+	if i < 0 || enc == EncoderSoftware {
+		return args
+	}
+
+	var codec []string
+	switch enc {
+	case EncoderNVENC:
+		codec = []string{"-c:v", "h264_nvenc", "-preset", "p5", "-rc", "vbr", "-cq", "19", "-b:v", "0"}
+	case EncoderVAAPI:
+		codec = []string{"-c:v", "h264_vaapi", "-qp", "20"}
+	default:
+		return args
+	}
+
+	out := make([]string, 0, len(args)+8)
+	out = append(out, args[:i]...)
+	out = append(out, codec...)
+	out = append(out, args[i+len(softwareCodecArgs):]...)
+	if enc != EncoderVAAPI {
+		return out
+	}
+
+	// The uploaded frames are in the GPU's own pixel format
+	if j := indexOf(out, []string{"-pix_fmt", "yuv420p"}); j >= 0 {
+		out = append(out[:j], out[j+2:]...)
+	}
+	const upload = "format=nv12,hwupload"
+	fc := indexOf(out, []string{"-filter_complex"})
+	mapped := indexOf(out, []string{"-map", "[outv]"})
+	vf := indexOf(out, []string{"-vf"})
+	switch {
+	case fc >= 0 && mapped >= 0:
+		out[fc+1] += ";[outv]" + upload + "[outhw]"
+		out[mapped+1] = "[outhw]"
+	case vf >= 0:
+		out[vf+1] += "," + upload
+	default:
+		c := indexOf(out, []string{"-c:v"})
+		out = append(out[:c], append([]string{"-vf", upload}, out[c:]...)...)
+	}
+	return append([]string{"-vaapi_device", vaapiDevice}, out...)
+}
+
+// indexOf returns the index of the first occurrence of seq in args, or -1
+func indexOf(args, seq []string) int {
+	for i := 0; i+len(seq) <= len(args); i++ {
+		if strings.Join(args[i:i+len(seq)], "\x00") == strings.Join(seq, "\x00") {
+			return i
+		}
+	}
+	return -1
+}
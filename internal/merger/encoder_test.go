@@ -0,0 +1,107 @@
+package merger
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/testmedia"
+)
+
+func TestHardwareArgs(t *testing.T) {
+	codec := strings.Join(softwareCodecArgs, " ")
+	tests := []struct {
+		name string
+		enc  Encoder
+		args string
+		want string
+	}{
+		{"software is unchanged", EncoderSoftware,
+			"-y -i in.mp4 " + codec + " -pix_fmt yuv420p out.mp4",
+			"-y -i in.mp4 " + codec + " -pix_fmt yuv420p out.mp4"},
+		{"audio only is unchanged", EncoderVAAPI,
+			"-y -i in.wav -c:a aac out.m4a",
+			"-y -i in.wav -c:a aac out.m4a"},
+		{"nvenc", EncoderNVENC,
+			"-y -i in.mp4 " + codec + " -pix_fmt yuv420p out.mp4",
+			"-y -i in.mp4 -c:v h264_nvenc -preset p5 -rc vbr -cq 19 -b:v 0 -pix_fmt yuv420p out.mp4"},
+		{"vaapi uploads after the filter graph", EncoderVAAPI,
+			"-y -i a.mp4 -i b.png -filter_complex [0:v][1:v]overlay[outv] -map [outv] " + codec + " -pix_fmt yuv420p out.mp4",
+			"-vaapi_device /dev/dri/renderD128 -y -i a.mp4 -i b.png -filter_complex [0:v][1:v]overlay[outv];[outv]format=nv12,hwupload[outhw] -map [outhw] -c:v h264_vaapi -qp 20 out.mp4"},
+		{"vaapi uploads after the video filter", EncoderVAAPI,
+			"-y -i in.mp4 -vf scale=-2:720 " + codec + " out.mp4",
+			"-vaapi_device /dev/dri/renderD128 -y -i in.mp4 -vf scale=-2:720,format=nv12,hwupload -c:v h264_vaapi -qp 20 out.mp4"},
+		{"vaapi adds an upload filter", EncoderVAAPI,
+			"-y -i in.mp4 " + codec + " out.mp4",
+			"-vaapi_device /dev/dri/renderD128 -y -i in.mp4 -vf format=nv12,hwupload -c:v h264_vaapi -qp 20 out.mp4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := strings.Fields(tt.args)
+			got := strings.Join(hardwareArgs(tt.enc, args), " ")
+			if got != tt.want {
+				t.Errorf("hardwareArgs() =\n%s\nwant\n%s", got, tt.want)
+			}
+			if strings.Join(args, " ") != tt.args {
+				t.Error("hardwareArgs() modified its input, which the software fallback reuses")
+			}
+		})
+	}
+}
+
+func TestDetectHardwareEncoder(t *testing.T) {
+	oldProbe := probeEncoder
+	t.Cleanup(func() {
+		probeEncoder = oldProbe
+		detectOnce = sync.Once{}
+	})
+
+	var probed []Encoder
+	probeEncoder = func(enc Encoder) bool {
+		probed = append(probed, enc)
+		return enc == EncoderVAAPI
+	}
+	detectOnce = sync.Once{}
+	if got := DetectHardwareEncoder(); got != EncoderVAAPI {
+		t.Errorf("DetectHardwareEncoder() = %s, want vaapi", got)
+	}
+	if got := DetectHardwareEncoder(); got != EncoderVAAPI || !reflect.DeepEqual(probed, []Encoder{EncoderNVENC, EncoderVAAPI}) {
+		t.Errorf("probed %v, want each encoder probed once and the result cached", probed)
+	}
+
+	probeEncoder = func(Encoder) bool { return false }
+	detectOnce = sync.Once{}
+	if got := DetectHardwareEncoder(); got != EncoderSoftware {
+		t.Errorf("DetectHardwareEncoder() = %s, want software without a GPU", got)
+	}
+}
+
+func TestPipeline_HardwareEncoderFallsBackToSoftware(t *testing.T) {
+	testmedia.Require(t)
+	if probeWithFFmpeg(EncoderNVENC) {
+		t.Skip("NVENC works on this machine")
+	}
+	dir := t.TempDir()
+
+	m := New(models.DefaultAudioProcessingOptions())
+	m.SetEncoder(EncoderNVENC)
+	var used []Encoder
+	m.SetEncoderCallback(func(step ProcessingStep, enc Encoder) {
+		used = append(used, enc)
+	})
+	result, err := m.Merge(MergeOptions{
+		VideoFile: testmedia.Screen(t, dir, "screen.mp4", 320, 240, 1),
+		OutputDir: dir,
+	})
+	if err != nil {
+		t.Fatalf("Merge() error: %v", err)
+	}
+	if !reflect.DeepEqual(used, []Encoder{EncoderNVENC, EncoderSoftware}) {
+		t.Errorf("encoders used = %v, want NVENC then the software fallback", used)
+	}
+	if merged := testmedia.Probe(t, result.MergedFile); merged.VideoCodec != "h264" {
+		t.Errorf("merged video codec = %q, want h264", merged.VideoCodec)
+	}
+}
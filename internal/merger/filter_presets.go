@@ -0,0 +1,82 @@
+package merger
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/notify"
+)
+
+// ValidateFilterPreset checks a filter preset and test-runs its filter on a
+// one second generated clip, so a typo is reported when the preset is
+// created rather than when a recording is processed
+func ValidateFilterPreset(preset models.FilterPreset) error {
+	if err := preset.Validate(); err != nil {
+		return err
+	}
+
+	filter := strings.TrimSpace(preset.Filter)
+	var args []string
+	switch preset.Stage {
+	case models.FilterStageAudio:
+		args = []string{"-f", "lavfi", "-i", "sine=frequency=440:duration=1", "-af", filter}
+	default:
+		args = []string{"-f", "lavfi", "-i", "testsrc2=size=320x240:rate=10:duration=1", "-vf", filter}
+	}
+	args = append([]string{"-hide_banner", "-nostdin"}, args...)
+	args = append(args, "-f", "null", "-")
+
+	output, err := exec.Command("ffmpeg", args...).CombinedOutput()
+	if err != nil {
+		if msg := lastLine(string(output)); msg != "" {
+			return fmt.Errorf("filter test failed: %s", msg)
+		}
+		return fmt.Errorf("filter test failed: %w", err)
+	}
+	return nil
+}
+
+// lastLine returns the last non-empty line of s, which is where ffmpeg puts
+// the reason it gave up
+func lastLine(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
+
+// applyVideoFilters re-encodes videoFile through the video-stage filter
+// chain and returns the path of the filtered copy
+func (m *Merger) applyVideoFilters(videoFile, filter string) (string, error) {
+	_ = notify.ProcessingStep("Applying video filter presets...")
+
+	outputFile := strings.TrimSuffix(videoFile, ".mp4") + "-filtered.mp4"
+	err := m.runFFmpegWithProgress(StepMerging, getVideoDurationUs(videoFile),
+		"-y", "-i", videoFile,
+		"-vf", filter,
+		"-c:v", "libx264",
+		"-preset", "medium",
+		"-crf", "18",
+		"-c:a", "copy",
+		outputFile,
+	)
+	if err != nil {
+		return "", err
+	}
+	return outputFile, nil
+}
+
+// outputVideoFilter returns the filter chain applied last to the merged
+// video: the output scaling followed by the merge-stage filter presets
+func outputVideoFilter(opts *MergeOptions, srcHeight int) string {
+	var filters []string
+	if scale := outputScaleFilter(opts, srcHeight); scale != "" {
+		filters = append(filters, scale)
+	}
+	if opts != nil {
+		if chain := models.FilterChain(opts.FilterPresets, models.FilterStageMerge); chain != "" {
+			filters = append(filters, chain)
+		}
+	}
+	return strings.Join(filters, ",")
+}
@@ -9,11 +9,13 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/kartoza/kartoza-screencaster/internal/audio"
 	"github.com/kartoza/kartoza-screencaster/internal/config"
 	"github.com/kartoza/kartoza-screencaster/internal/models"
 	"github.com/kartoza/kartoza-screencaster/internal/notify"
+	"github.com/kartoza/kartoza-screencaster/internal/proclog"
 	"github.com/kartoza/kartoza-screencaster/internal/webcam"
 )
 
@@ -25,6 +27,7 @@ const (
 	StepNormalizing
 	StepMerging
 	StepCreatingVertical
+	StepCreatingWebcamOnly
 )
 
 // ProgressCallback is called when a processing step starts or completes
@@ -33,11 +36,18 @@ type ProgressCallback func(step ProcessingStep, completed bool, skipped bool, er
 // PercentCallback is called to report progress percentage during a step
 type PercentCallback func(step ProcessingStep, percent float64)
 
+// EncoderCallback is called when a step starts encoding video, and again if
+// it falls back to software encoding
+type EncoderCallback func(step ProcessingStep, encoder Encoder)
+
 // Merger handles merging of video, audio, and webcam recordings
 type Merger struct {
 	audioOpts  models.AudioProcessingOptions
 	onProgress ProgressCallback
 	onPercent  PercentCallback
+	onEncoder  EncoderCallback
+	log        *proclog.Logger
+	encoder    Encoder // Video encoder (empty = software)
 }
 
 // New creates a new Merger
@@ -55,6 +65,23 @@ func (m *Merger) SetPercentCallback(cb PercentCallback) {
 	m.onPercent = cb
 }
 
+// SetEncoderCallback sets the callback told which encoder a step uses
+func (m *Merger) SetEncoderCallback(cb EncoderCallback) {
+	m.onEncoder = cb
+}
+
+// SetEncoder sets the video encoder. Steps encoding with a hardware encoder
+// are repeated once in software if it fails.
+func (m *Merger) SetEncoder(enc Encoder) {
+	m.encoder = enc
+}
+
+// SetLogger sets the processing log that receives every ffmpeg command line
+// and its output. With a dry run log the commands are logged but not run.
+func (m *Merger) SetLogger(log *proclog.Logger) {
+	m.log = log
+}
+
 // reportProgress reports progress if callback is set
 func (m *Merger) reportProgress(step ProcessingStep, completed bool, skipped bool, err error) {
 	if m.onProgress != nil {
@@ -69,9 +96,38 @@ func (m *Merger) reportPercent(step ProcessingStep, percent float64) {
 	}
 }
 
-// runFFmpegWithProgress runs an FFmpeg command and reports progress
-// durationUs is the expected duration in microseconds for calculating percentage
+// runFFmpegWithProgress runs an FFmpeg command and reports progress. Video
+// is encoded with the hardware encoder if one is set, and encoded again in
+// software if that fails.
 func (m *Merger) runFFmpegWithProgress(step ProcessingStep, durationUs int64, args ...string) error {
+	if !usesSoftwareEncoder(args) {
+		return m.runFFmpeg(step, durationUs, args...)
+	}
+	if m.encoder == "" || m.encoder == EncoderSoftware {
+		m.reportEncoder(step, EncoderSoftware)
+		return m.runFFmpeg(step, durationUs, args...)
+	}
+
+	m.reportEncoder(step, m.encoder)
+	err := m.runFFmpeg(step, durationUs, hardwareArgs(m.encoder, args)...)
+	if err == nil {
+		return nil
+	}
+	m.log.Printf("%s encoding failed, encoding again in software: %v", m.encoder.Label(), err)
+	m.reportEncoder(step, EncoderSoftware)
+	return m.runFFmpeg(step, durationUs, args...)
+}
+
+// reportEncoder reports the encoder of a step if callback is set
+func (m *Merger) reportEncoder(step ProcessingStep, enc Encoder) {
+	if m.onEncoder != nil {
+		m.onEncoder(step, enc)
+	}
+}
+
+// runFFmpeg runs an FFmpeg command and reports progress
+// durationUs is the expected duration in microseconds for calculating percentage
+func (m *Merger) runFFmpeg(step ProcessingStep, durationUs int64, args ...string) error {
 	// Add progress pipe and stats period to args for frequent updates
 	// -stats_period 0.5 outputs progress every 0.5 seconds
 	progressArgs := append([]string{"-progress", "pipe:1", "-stats_period", "0.5", "-nostats"}, args...)
@@ -84,11 +140,16 @@ func (m *Merger) runFFmpegWithProgress(step ProcessingStep, durationUs int64, ar
 		return fmt.Errorf("failed to create stdout pipe: %w", err)
 	}
 
-	// Capture stderr for errors
+	// Capture stderr for errors, streaming it into the processing log
 	var stderrBuf strings.Builder
-	cmd.Stderr = &stderrBuf
+	cmd.Stderr = m.log.Tee(&stderrBuf)
 
+	m.log.Command(cmd)
+	if m.log.DryRun() {
+		return nil
+	}
 	if err := cmd.Start(); err != nil {
+		m.log.Result(err)
 		return fmt.Errorf("failed to start ffmpeg: %w", err)
 	}
 
@@ -120,7 +181,9 @@ func (m *Merger) runFFmpegWithProgress(step ProcessingStep, durationUs int64, ar
 		}
 	}
 
-	if err := cmd.Wait(); err != nil {
+	err = cmd.Wait()
+	m.log.Result(err)
+	if err != nil {
 		return fmt.Errorf("ffmpeg failed: %w, stderr: %s", err, stderrBuf.String())
 	}
 
@@ -150,12 +213,42 @@ type MergeOptions struct {
 	TitleColor     string             // Color for title text (e.g., "white", "black", "yellow")
 	BgColor        string             // Background color for vertical video lower third
 	GifLoopMode    config.GifLoopMode // How to loop animated GIFs
+	LogoFit        config.LogoFit     // How logos are fitted into their slots (empty = contain)
 	OutputDir      string             // Directory for output files
 
+	// OutputResolution downscales the merged and vertical outputs (empty = native)
+	OutputResolution config.OutputResolution
+
+	// FrameRate of the merged and vertical outputs (0 = 30 fps)
+	FrameRate int
+
+	// Webcam overlay placement on the merged (landscape) video; the vertical
+	// layout is not affected
+	PiPCorner config.PiPCorner // Corner for the circular webcam overlay (empty = bottom-right)
+	PiPSize   config.PiPSize   // Size of the circular webcam overlay (empty = medium)
+
+	// Webcam placement on the vertical video
+	VerticalWebcamPosition config.VerticalWebcamPosition // Above or below the screen (empty = bottom-center)
+	VerticalWebcamSize     config.VerticalWebcamSize     // Share of the space next to the screen (empty = large)
+
+	// CreateWebcamOnly adds a square crop of the webcam alone, named
+	// webcam-only.mp4 in OutputDir
+	CreateWebcamOnly bool
+
+	// FilterPresets are applied at their stage: audio after normalization,
+	// video to the source video before merging, merge to the merged video
+	FilterPresets []models.FilterPreset
+
 	// Part files for pause/resume support (if set, these override single file options)
 	VideoParts  []string
 	AudioParts  []string
 	WebcamParts []string
+
+	// Part of the recording kept in the outputs, as positions in the
+	// recorded sources (0 = from the start, to the end). The sources are
+	// only read from TrimStart to TrimEnd, never changed.
+	TrimStart time.Duration
+	TrimEnd   time.Duration
 }
 
 // MergeResult contains the paths to merged files and processing info
@@ -163,19 +256,23 @@ type MergeResult struct {
 	MergedFile       string
 	VerticalFile     string
 	NormalizeApplied bool
-	VerticalError    error // Non-nil if vertical video creation was attempted but failed
+	NormalizedLUFS   float64 // Loudness target the audio was normalized to
+	VerticalError    error   // Non-nil if vertical video creation was attempted but failed
+	WebcamOnlyFile   string
+	WebcamOnlyError  error         // Non-nil if webcam-only video creation was attempted but failed
+	SourceDuration   time.Duration // Length of the main video source before trimming
 }
 
 // concatenateParts concatenates multiple video or audio parts into a single file
 // Uses FFmpeg's concat demuxer for lossless concatenation
-func concatenateParts(parts []string, outputFile string) error {
+func (m *Merger) concatenateParts(parts []string, outputFile string) error {
 	if len(parts) == 0 {
 		return fmt.Errorf("no parts to concatenate")
 	}
 
 	if len(parts) == 1 {
 		// Only one part, just copy/rename it
-		return copyFile(parts[0], outputFile)
+		return m.copyPart(parts[0], outputFile)
 	}
 
 	// Filter to only existing parts
@@ -191,11 +288,25 @@ func concatenateParts(parts []string, outputFile string) error {
 	}
 
 	if len(existingParts) == 1 {
-		return copyFile(existingParts[0], outputFile)
+		return m.copyPart(existingParts[0], outputFile)
 	}
 
-	// Create a temporary file list for FFmpeg concat demuxer
+	// FFmpeg concatenates the parts named in a temporary file list
 	listFile := outputFile + ".txt"
+	cmd := exec.Command("ffmpeg",
+		"-y",
+		"-f", "concat",
+		"-safe", "0",
+		"-i", listFile,
+		"-c", "copy",
+		outputFile,
+	)
+	m.log.Command(cmd)
+	if m.log.DryRun() {
+		return nil
+	}
+
+	// Create a temporary file list for FFmpeg concat demuxer
 	f, err := os.Create(listFile)
 	if err != nil {
 		return fmt.Errorf("failed to create concat list: %w", err)
@@ -211,16 +322,8 @@ func concatenateParts(parts []string, outputFile string) error {
 	defer func() { _ = os.Remove(listFile) }()
 
 	// Run FFmpeg to concatenate
-	cmd := exec.Command("ffmpeg",
-		"-y",
-		"-f", "concat",
-		"-safe", "0",
-		"-i", listFile,
-		"-c", "copy",
-		outputFile,
-	)
-
 	output, err := cmd.CombinedOutput()
+	m.log.Output(output, err)
 	if err != nil {
 		return fmt.Errorf("ffmpeg concat failed: %w\nOutput: %s", err, string(output))
 	}
@@ -228,6 +331,15 @@ func concatenateParts(parts []string, outputFile string) error {
 	return nil
 }
 
+// copyPart copies the only part of a recording to outputFile, leaving the
+// files alone in a dry run
+func (m *Merger) copyPart(src, outputFile string) error {
+	if m.log.DryRun() {
+		return nil
+	}
+	return copyFile(src, outputFile)
+}
+
 // copyFile copies a file from src to dst
 func copyFile(src, dst string) error {
 	source, err := os.Open(src)
@@ -261,7 +373,7 @@ func (m *Merger) Merge(opts MergeOptions) (*MergeResult, error) {
 	// If we have multiple parts, concatenate them first
 	if len(opts.VideoParts) > 1 {
 		concatVideo := filepath.Join(opts.OutputDir, "screen.mp4")
-		if err := concatenateParts(opts.VideoParts, concatVideo); err != nil {
+		if err := m.concatenateParts(opts.VideoParts, concatVideo); err != nil {
 			return result, fmt.Errorf("failed to concatenate video parts: %w", err)
 		}
 		opts.VideoFile = concatVideo
@@ -271,7 +383,7 @@ func (m *Merger) Merge(opts MergeOptions) (*MergeResult, error) {
 
 	if len(opts.AudioParts) > 1 {
 		concatAudio := filepath.Join(opts.OutputDir, "audio.wav")
-		if err := concatenateParts(opts.AudioParts, concatAudio); err != nil {
+		if err := m.concatenateParts(opts.AudioParts, concatAudio); err != nil {
 			return result, fmt.Errorf("failed to concatenate audio parts: %w", err)
 		}
 		opts.AudioFile = concatAudio
@@ -281,7 +393,7 @@ func (m *Merger) Merge(opts MergeOptions) (*MergeResult, error) {
 
 	if len(opts.WebcamParts) > 1 {
 		concatWebcam := filepath.Join(opts.OutputDir, "webcam.mp4")
-		if err := concatenateParts(opts.WebcamParts, concatWebcam); err != nil {
+		if err := m.concatenateParts(opts.WebcamParts, concatWebcam); err != nil {
 			return result, fmt.Errorf("failed to concatenate webcam parts: %w", err)
 		}
 		opts.WebcamFile = concatWebcam
@@ -290,9 +402,9 @@ func (m *Merger) Merge(opts MergeOptions) (*MergeResult, error) {
 	}
 
 	// Check what inputs we have
-	hasVideo := opts.VideoFile != "" && fileExists(opts.VideoFile)
-	hasAudio := opts.AudioFile != "" && fileExists(opts.AudioFile)
-	hasWebcam := opts.WebcamFile != "" && fileExists(opts.WebcamFile)
+	hasVideo := m.inputExists(opts.VideoFile, opts.VideoParts)
+	hasAudio := m.inputExists(opts.AudioFile, opts.AudioParts)
+	hasWebcam := m.inputExists(opts.WebcamFile, opts.WebcamParts)
 
 	// If we have no inputs at all, return early
 	if !hasVideo && !hasAudio && !hasWebcam {
@@ -302,6 +414,7 @@ func (m *Merger) Merge(opts MergeOptions) (*MergeResult, error) {
 	// Process audio if available
 	var normalizedAudio string
 	processor := audio.NewProcessor(m.audioOpts)
+	processor.SetLogger(m.log)
 
 	// Step 1: Analyze audio levels (skip if no audio)
 	m.reportProgress(StepAnalyzingAudio, false, false, nil)
@@ -330,6 +443,7 @@ func (m *Merger) Merge(opts MergeOptions) (*MergeResult, error) {
 				normalizedAudio = opts.AudioFile
 			} else {
 				result.NormalizeApplied = true
+				result.NormalizedLUFS = m.audioOpts.TargetLoudness
 				m.reportProgress(StepNormalizing, true, false, nil)
 			}
 		} else {
@@ -340,6 +454,16 @@ func (m *Merger) Merge(opts MergeOptions) (*MergeResult, error) {
 		m.reportProgress(StepNormalizing, true, true, nil)
 	}
 
+	// Apply audio filter presets; on failure the unfiltered audio is used
+	if chain := models.FilterChain(opts.FilterPresets, models.FilterStageAudio); hasAudio && chain != "" {
+		filteredAudio := strings.TrimSuffix(opts.AudioFile, ".wav") + "-filtered.wav"
+		if err := processor.ApplyFilters(normalizedAudio, filteredAudio, chain); err != nil {
+			_ = notify.Warning("Audio Filter Warning", "Using unfiltered audio")
+		} else {
+			normalizedAudio = filteredAudio
+		}
+	}
+
 	// Step 3: Create merged output
 	m.reportProgress(StepMerging, false, false, nil)
 
@@ -352,11 +476,31 @@ func (m *Merger) Merge(opts MergeOptions) (*MergeResult, error) {
 		// Audio only - skip video merge
 		m.reportProgress(StepMerging, true, true, nil)
 		m.reportProgress(StepCreatingVertical, true, true, nil)
+		m.reportProgress(StepCreatingWebcamOnly, true, true, nil)
 		return result, nil
 	}
 
 	outputFile := strings.TrimSuffix(baseFile, ".mp4") + "-merged.mp4"
 
+	// Refuse a trim that would leave nothing of the recording
+	result.SourceDuration = time.Duration(getVideoDurationUs(baseFile)) * time.Microsecond
+	if err := models.ValidateTrim(opts.TrimStart, opts.TrimEnd, result.SourceDuration); err != nil {
+		m.reportProgress(StepMerging, true, false, err)
+		return result, err
+	}
+
+	// Apply video filter presets to the main video source; output names
+	// stay based on the original file. On failure the unfiltered video is used.
+	if chain := models.FilterChain(opts.FilterPresets, models.FilterStageVideo); chain != "" {
+		if filtered, err := m.applyVideoFilters(baseFile, chain); err != nil {
+			_ = notify.Warning("Video Filter Warning", "Using unfiltered video")
+		} else if hasVideo {
+			opts.VideoFile = filtered
+		} else {
+			opts.WebcamFile = filtered
+		}
+	}
+
 	// Handle different input combinations
 	var mergeErr error
 	switch {
@@ -390,7 +534,7 @@ func (m *Merger) Merge(opts MergeOptions) (*MergeResult, error) {
 	// Step 4: Create vertical video with webcam if available
 	m.reportProgress(StepCreatingVertical, false, false, nil)
 	if opts.CreateVertical && hasVideo && hasWebcam {
-		verticalFile := strings.TrimSuffix(opts.VideoFile, ".mp4") + "-vertical.mp4"
+		verticalFile := strings.TrimSuffix(baseFile, ".mp4") + "-vertical.mp4"
 
 		var verticalErr error
 		if hasAudio {
@@ -412,9 +556,42 @@ func (m *Merger) Merge(opts MergeOptions) (*MergeResult, error) {
 		m.reportProgress(StepCreatingVertical, true, true, nil)
 	}
 
+	// Step 5: Create the webcam-only video if requested
+	m.reportProgress(StepCreatingWebcamOnly, false, false, nil)
+	if opts.CreateWebcamOnly && hasWebcam {
+		webcamOnlyFile := filepath.Join(filepath.Dir(baseFile), WebcamOnlyFileName)
+		if opts.OutputDir != "" {
+			webcamOnlyFile = filepath.Join(opts.OutputDir, WebcamOnlyFileName)
+		}
+
+		audioFile := ""
+		if hasAudio {
+			audioFile = normalizedAudio
+		}
+		if err := m.createWebcamOnlyVideo(opts.WebcamFile, audioFile, webcamOnlyFile, &opts); err != nil {
+			result.WebcamOnlyError = err
+			m.reportProgress(StepCreatingWebcamOnly, true, true, err)
+			_ = notify.Warning("Webcam-Only Video Warning", "Failed to create webcam-only video")
+		} else {
+			result.WebcamOnlyFile = webcamOnlyFile
+			m.reportProgress(StepCreatingWebcamOnly, true, false, nil)
+		}
+	} else {
+		m.reportProgress(StepCreatingWebcamOnly, true, true, nil)
+	}
+
 	return result, nil
 }
 
+// inputExists returns true if the input file exists. In a dry run a file
+// joined from several parts counts too, as joining them was only planned.
+func (m *Merger) inputExists(file string, parts []string) bool {
+	if file == "" {
+		return false
+	}
+	return fileExists(file) || (m.log.DryRun() && len(parts) > 1)
+}
+
 // fileExists checks if a file exists and is not a directory
 func fileExists(path string) bool {
 	info, err := os.Stat(path)
@@ -426,16 +603,18 @@ func fileExists(path string) bool {
 
 // processVideoOnly re-encodes a video file without audio, optionally with logo and webcam overlays
 func (m *Merger) processVideoOnly(videoFile, outputFile string, opts *MergeOptions) error {
-	durationUs := getVideoDurationUs(videoFile)
+	durationUs := trimmedDurationUs(opts, getVideoDurationUs(videoFile))
+
+	videoWidth, videoHeight, _ := webcam.GetVideoInfo(videoFile)
+	outputFilter := outputVideoFilter(opts, videoHeight)
 
 	// Check if we need overlays (logos or circular webcam)
 	hasLogos := opts != nil && opts.AddLogos && opts.OutputDir != ""
 	hasWebcamOverlay := opts != nil && opts.WebcamFile != "" && opts.WebcamFile != videoFile && fileExists(opts.WebcamFile)
 
 	if hasLogos || hasWebcamOverlay {
-		videoWidth, _, _ := webcam.GetVideoInfo(videoFile)
 		if videoWidth > 0 {
-			inputs := []string{"-y", "-i", videoFile}
+			inputs := append([]string{"-y"}, trimInput(opts, videoFile)...)
 			nextIdx := 1 // next FFmpeg input index
 
 			// Add logo inputs
@@ -457,22 +636,22 @@ func (m *Merger) processVideoOnly(videoFile, outputFile string, opts *MergeOptio
 			}
 
 			// Add webcam input for circular overlay
-			webcam := webcamOverlayOpts{inputIdx: -1, size: webcamOverlaySize, margin: webcamOverlayMargin}
+			webcam := newWebcamOverlayOpts(opts, videoHeight)
 			if hasWebcamOverlay {
-				inputs = append(inputs, "-i", opts.WebcamFile)
+				inputs = append(inputs, trimInput(opts, opts.WebcamFile)...)
 				webcam.inputIdx = nextIdx
 			}
 
 			hasAnyLogos := setup.logo1Path != "" || setup.logo2Path != "" || setup.bannerPath != ""
 			if hasAnyLogos || webcam.inputIdx >= 0 {
-				filter := buildMergedOverlayFilter(setup, videoWidth, webcam)
+				filter := buildMergedOverlayFilter(setup, videoWidth, webcam, outputFilter)
 				args := append(inputs,
 					"-filter_complex", filter,
 					"-map", "[outv]",
 					"-c:v", "libx264",
 					"-preset", "medium",
 					"-crf", "18",
-					"-r", "30",
+					"-r", outputFrameRate(opts),
 					"-pix_fmt", "yuv420p",
 					"-an",
 					outputFile,
@@ -483,32 +662,37 @@ func (m *Merger) processVideoOnly(videoFile, outputFile string, opts *MergeOptio
 	}
 
 	// Simple re-encode without overlays
-	args := []string{
-		"-y",
-		"-i", videoFile,
+	args := append([]string{"-y"}, trimInput(opts, videoFile)...)
+	if outputFilter != "" {
+		args = append(args, "-vf", outputFilter)
+	}
+	args = append(args,
 		"-c:v", "libx264",
 		"-preset", "medium",
 		"-crf", "18",
-		"-r", "30",
+		"-r", outputFrameRate(opts),
 		"-an", // No audio
 		outputFile,
-	}
+	)
 
 	return m.runFFmpegWithProgress(StepMerging, durationUs, args...)
 }
 
 // mergeVideoAudio merges video and audio using ffmpeg, optionally with logo and webcam overlays
 func (m *Merger) mergeVideoAudio(videoFile, audioFile, outputFile string, opts *MergeOptions) error {
-	durationUs := getVideoDurationUs(videoFile)
+	durationUs := trimmedDurationUs(opts, getVideoDurationUs(videoFile))
+
+	videoWidth, videoHeight, _ := webcam.GetVideoInfo(videoFile)
+	outputFilter := outputVideoFilter(opts, videoHeight)
 
 	// Check if we need overlays (logos or circular webcam)
 	hasLogos := opts != nil && opts.AddLogos && opts.OutputDir != ""
 	hasWebcamOverlay := opts != nil && opts.WebcamFile != "" && opts.WebcamFile != videoFile && fileExists(opts.WebcamFile)
 
 	if hasLogos || hasWebcamOverlay {
-		videoWidth, _, _ := webcam.GetVideoInfo(videoFile)
 		if videoWidth > 0 {
-			inputs := []string{"-y", "-i", videoFile, "-i", audioFile}
+			inputs := append([]string{"-y"}, trimInput(opts, videoFile)...)
+			inputs = append(inputs, trimInput(opts, audioFile)...)
 			nextIdx := 2 // next FFmpeg input index
 
 			// Add logo inputs
@@ -530,15 +714,15 @@ func (m *Merger) mergeVideoAudio(videoFile, audioFile, outputFile string, opts *
 			}
 
 			// Add webcam input for circular overlay
-			webcam := webcamOverlayOpts{inputIdx: -1, size: webcamOverlaySize, margin: webcamOverlayMargin}
+			webcam := newWebcamOverlayOpts(opts, videoHeight)
 			if hasWebcamOverlay {
-				inputs = append(inputs, "-i", opts.WebcamFile)
+				inputs = append(inputs, trimInput(opts, opts.WebcamFile)...)
 				webcam.inputIdx = nextIdx
 			}
 
 			hasAnyLogos := setup.logo1Path != "" || setup.logo2Path != "" || setup.bannerPath != ""
 			if hasAnyLogos || webcam.inputIdx >= 0 {
-				filter := buildMergedOverlayFilter(setup, videoWidth, webcam)
+				filter := buildMergedOverlayFilter(setup, videoWidth, webcam, outputFilter)
 				args := append(inputs,
 					"-filter_complex", filter,
 					"-map", "[outv]",
@@ -546,7 +730,7 @@ func (m *Merger) mergeVideoAudio(videoFile, audioFile, outputFile string, opts *
 					"-c:v", "libx264",
 					"-preset", "medium",
 					"-crf", "18",
-					"-r", "30",
+					"-r", outputFrameRate(opts),
 					"-pix_fmt", "yuv420p",
 					"-c:a", "aac",
 					"-b:a", "320k",
@@ -559,34 +743,101 @@ func (m *Merger) mergeVideoAudio(videoFile, audioFile, outputFile string, opts *
 	}
 
 	// Simple merge without overlays
-	args := []string{
-		"-y",
-		"-i", videoFile,
-		"-i", audioFile,
+	args := append([]string{"-y"}, trimInput(opts, videoFile)...)
+	args = append(args, trimInput(opts, audioFile)...)
+	if outputFilter != "" {
+		args = append(args, "-vf", outputFilter)
+	}
+	args = append(args,
 		"-c:v", "libx264",
 		"-preset", "medium",
 		"-crf", "18",
-		"-r", "30",
+		"-r", outputFrameRate(opts),
 		"-c:a", "aac",
 		"-b:a", "320k",
 		"-shortest",
 		outputFile,
-	}
+	)
 
 	return m.runFFmpegWithProgress(StepMerging, durationUs, args...)
 }
 
+// trimInput returns the FFmpeg arguments that open a recorded source, reading
+// only the part kept by the trim of the recording
+func trimInput(opts *MergeOptions, file string) []string {
+	var args []string
+	if opts != nil && opts.TrimStart > 0 {
+		args = append(args, "-ss", formatSeconds(opts.TrimStart))
+	}
+	if opts != nil && opts.TrimEnd > 0 {
+		args = append(args, "-to", formatSeconds(opts.TrimEnd))
+	}
+	return append(args, "-i", file)
+}
+
+// trimmedDurationUs returns the length of a source of durationUs once
+// trimmed, for the progress of the outputs
+func trimmedDurationUs(opts *MergeOptions, durationUs int64) int64 {
+	if opts == nil {
+		return durationUs
+	}
+	if end := opts.TrimEnd.Microseconds(); end > 0 && end < durationUs {
+		durationUs = end
+	}
+	if durationUs -= opts.TrimStart.Microseconds(); durationUs < 0 {
+		return 0
+	}
+	return durationUs
+}
+
+// formatSeconds formats d as seconds for FFmpeg, e.g. "90.5"
+func formatSeconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64)
+}
+
 // YouTube Shorts recommended dimensions
 const (
 	YouTubeShortsWidth  = 1080
 	YouTubeShortsHeight = 1920
 )
 
+// outputScaleFilter returns an FFmpeg scale filter that downscales a video of the
+// given source height to the requested output resolution, or "" if no scaling is needed.
+// Videos are never upscaled.
+func outputScaleFilter(opts *MergeOptions, srcHeight int) string {
+	if opts == nil {
+		return ""
+	}
+	target := opts.OutputResolution.Height()
+	if target == 0 || srcHeight <= target {
+		return ""
+	}
+	return fmt.Sprintf("scale=-2:%d:flags=lanczos", target)
+}
+
+// outputFrameRate returns the frame rate of the outputs as an FFmpeg argument
+func outputFrameRate(opts *MergeOptions) string {
+	if opts == nil || opts.FrameRate <= 0 {
+		return strconv.Itoa(config.DefaultFrameRate)
+	}
+	return strconv.Itoa(opts.FrameRate)
+}
+
+// verticalDimensions returns the vertical video canvas size for the output resolution.
+// 720p output uses a 720x1280 canvas; native and 1080p use the full 1080x1920 Shorts size.
+func verticalDimensions(opts *MergeOptions) (int, int) {
+	if opts != nil && opts.OutputResolution == config.OutputResolution720p {
+		return 720, 1280
+	}
+	return YouTubeShortsWidth, YouTubeShortsHeight
+}
+
 // createVerticalVideo creates a vertical video with webcam and branding
 // Layout: screen (top) | webcam (middle) | white branding area (bottom third)
-// Output is always 1080x1920 (9:16) for YouTube Shorts compatibility
+// Output is 9:16 (1080x1920, or 720x1280 for 720p output) for YouTube Shorts compatibility
 func (m *Merger) createVerticalVideo(videoFile, webcamFile, audioFile, outputFile string, opts *MergeOptions) error {
-	_ = notify.ProcessingStep("Creating vertical video (1080x1920) with webcam...")
+	outW, outH := verticalDimensions(opts)
+	_ = notify.ProcessingStep(fmt.Sprintf("Creating vertical video (%dx%d) with webcam...", outW, outH))
 
 	filterComplex, inputs, err := m.buildVerticalFilterComplex(videoFile, webcamFile, opts, 3)
 	if err != nil {
@@ -594,11 +845,13 @@ func (m *Merger) createVerticalVideo(videoFile, webcamFile, audioFile, outputFil
 	}
 
 	// Build inputs list
-	allInputs := []string{"-y", "-i", videoFile, "-i", webcamFile, "-i", audioFile}
+	allInputs := append([]string{"-y"}, trimInput(opts, videoFile)...)
+	allInputs = append(allInputs, trimInput(opts, webcamFile)...)
+	allInputs = append(allInputs, trimInput(opts, audioFile)...)
 	allInputs = append(allInputs, inputs...)
 
 	// Get video duration for progress calculation and to set output duration
-	durationUs := getVideoDurationUs(videoFile)
+	durationUs := trimmedDurationUs(opts, getVideoDurationUs(videoFile))
 	durationSecs := float64(durationUs) / 1000000.0
 
 	args := append(allInputs,
@@ -608,7 +861,7 @@ func (m *Merger) createVerticalVideo(videoFile, webcamFile, audioFile, outputFil
 		"-c:v", "libx264",
 		"-preset", "medium",
 		"-crf", "18",
-		"-r", "30",
+		"-r", outputFrameRate(opts),
 		"-pix_fmt", "yuv420p",
 		"-c:a", "aac",
 		"-b:a", "320k",
@@ -621,9 +874,10 @@ func (m *Merger) createVerticalVideo(videoFile, webcamFile, audioFile, outputFil
 
 // createVerticalVideoNoAudio creates a vertical video with webcam but without audio
 // Layout: screen (top) | webcam (middle) | white branding area (bottom third)
-// Output is always 1080x1920 (9:16) for YouTube Shorts compatibility
+// Output is 9:16 (1080x1920, or 720x1280 for 720p output) for YouTube Shorts compatibility
 func (m *Merger) createVerticalVideoNoAudio(videoFile, webcamFile, outputFile string, opts *MergeOptions) error {
-	_ = notify.ProcessingStep("Creating vertical video (1080x1920) with webcam (no audio)...")
+	outW, outH := verticalDimensions(opts)
+	_ = notify.ProcessingStep(fmt.Sprintf("Creating vertical video (%dx%d) with webcam (no audio)...", outW, outH))
 
 	filterComplex, inputs, err := m.buildVerticalFilterComplex(videoFile, webcamFile, opts, 2)
 	if err != nil {
@@ -631,10 +885,11 @@ func (m *Merger) createVerticalVideoNoAudio(videoFile, webcamFile, outputFile st
 	}
 
 	// Build inputs list (no audio input)
-	allInputs := []string{"-y", "-i", videoFile, "-i", webcamFile}
+	allInputs := append([]string{"-y"}, trimInput(opts, videoFile)...)
+	allInputs = append(allInputs, trimInput(opts, webcamFile)...)
 	allInputs = append(allInputs, inputs...)
 
-	durationUs := getVideoDurationUs(videoFile)
+	durationUs := trimmedDurationUs(opts, getVideoDurationUs(videoFile))
 	durationSecs := float64(durationUs) / 1000000.0
 
 	args := append(allInputs,
@@ -643,7 +898,7 @@ func (m *Merger) createVerticalVideoNoAudio(videoFile, webcamFile, outputFile st
 		"-c:v", "libx264",
 		"-preset", "medium",
 		"-crf", "18",
-		"-r", "30",
+		"-r", outputFrameRate(opts),
 		"-pix_fmt", "yuv420p",
 		"-an",
 		"-t", fmt.Sprintf("%.3f", durationSecs),
@@ -653,11 +908,59 @@ func (m *Merger) createVerticalVideoNoAudio(videoFile, webcamFile, outputFile st
 	return m.runFFmpegWithProgress(StepCreatingVertical, durationUs, args...)
 }
 
-// lowerThirdY is the Y coordinate where the bottom third starts in the vertical video
-const lowerThirdY = YouTubeShortsHeight * 2 / 3 // 1280
+// WebcamOnlyFileName is the name of the webcam-only video in the recording folder
+const WebcamOnlyFileName = "webcam-only.mp4"
+
+// webcamOnlySize returns the side of the square webcam-only video for the
+// output resolution: 720 for 720p output, else 1080
+func webcamOnlySize(opts *MergeOptions) int {
+	if opts != nil && opts.OutputResolution == config.OutputResolution720p {
+		return 720
+	}
+	return 1080
+}
+
+// webcamOnlyFilter crops the centre square of the webcam and scales it to
+// size, never upscaling beyond the webcam's own height
+func webcamOnlyFilter(size int) string {
+	return fmt.Sprintf("crop='min(iw,ih)':'min(iw,ih)',scale='min(%d,ih)':'min(%d,ih)',setsar=1", size, size)
+}
+
+// createWebcamOnlyVideo creates a square video of the webcam alone, the
+// "talking head" for social clips. audioFile is "" for a video without audio.
+func (m *Merger) createWebcamOnlyVideo(webcamFile, audioFile, outputFile string, opts *MergeOptions) error {
+	size := webcamOnlySize(opts)
+	_ = notify.ProcessingStep(fmt.Sprintf("Creating webcam-only video (%dx%d)...", size, size))
+
+	args := append([]string{"-y"}, trimInput(opts, webcamFile)...)
+	if audioFile != "" {
+		args = append(args, trimInput(opts, audioFile)...)
+	}
+
+	durationUs := trimmedDurationUs(opts, getVideoDurationUs(webcamFile))
+	durationSecs := float64(durationUs) / 1000000.0
+
+	args = append(args,
+		"-vf", webcamOnlyFilter(size),
+		"-map", "0:v",
+		"-c:v", "libx264",
+		"-preset", "medium",
+		"-crf", "18",
+		"-r", outputFrameRate(opts),
+		"-pix_fmt", "yuv420p",
+	)
+	if audioFile != "" {
+		args = append(args, "-map", "1:a", "-c:a", "aac", "-b:a", "320k")
+	} else {
+		args = append(args, "-an")
+	}
+	args = append(args, "-t", fmt.Sprintf("%.3f", durationSecs), outputFile)
+
+	return m.runFFmpegWithProgress(StepCreatingWebcamOnly, durationUs, args...)
+}
 
 // buildVerticalFilterComplex builds the shared FFmpeg filter_complex for vertical video.
-// Layout: screen (top third) | webcam (middle third) | white branding area (bottom third)
+// Layout: screen and webcam (top two thirds, see newVerticalLayout) | white branding area (bottom third)
 // logoStartIndex is the FFmpeg input index where logo inputs begin (3 with audio, 2 without).
 // Returns: (filterComplex string, additional FFmpeg inputs for logos, error)
 func (m *Merger) buildVerticalFilterComplex(videoFile, webcamFile string, opts *MergeOptions, logoStartIndex int) (string, []string, error) {
@@ -673,27 +976,15 @@ func (m *Merger) buildVerticalFilterComplex(videoFile, webcamFile string, opts *
 		return "", nil, fmt.Errorf("failed to get webcam dimensions: %w", err)
 	}
 
-	// Calculate layout for 1080x1920 output
-	// Screen takes top portion, webcam fills the middle area (between screen and lower third)
-	scaledScreenWidth := YouTubeShortsWidth
-	scaledScreenHeight := screenHeight * YouTubeShortsWidth / screenWidth
-
-	// Webcam fills the space between screen and the lower third branding area
-	webcamAreaHeight := lowerThirdY - scaledScreenHeight
-	if webcamAreaHeight < 0 {
-		webcamAreaHeight = 0
-	}
-
-	// Scale webcam to fit the webcam area while maintaining aspect ratio
-	scaledWebcamWidth := YouTubeShortsWidth
-	scaledWebcamHeight := webcamHeight * YouTubeShortsWidth / webcamWidth
+	// Calculate layout for the vertical canvas (1080x1920, or 720x1280 for 720p output)
+	// Screen and webcam share the space above the lower third
+	outW, outH := verticalDimensions(opts)
+	lowerThirdY := outH * 2 / 3 // 1280 on a 1920 canvas
+	scale := func(px int) int { return px * outW / YouTubeShortsWidth }
 
-	if scaledWebcamHeight > webcamAreaHeight {
-		scaledWebcamHeight = webcamAreaHeight
-		scaledWebcamWidth = webcamWidth * webcamAreaHeight / webcamHeight
-	}
-
-	webcamPadX := (YouTubeShortsWidth - scaledWebcamWidth) / 2
+	scaledScreenWidth := outW
+	scaledScreenHeight := screenHeight * outW / screenWidth
+	layout := newVerticalLayout(opts, outW, lowerThirdY, scaledScreenHeight, webcamWidth, webcamHeight)
 
 	// Prepare logo inputs
 	var logoInputs []string
@@ -705,26 +996,27 @@ func (m *Merger) buildVerticalFilterComplex(videoFile, webcamFile string, opts *
 		bgColor = opts.BgColor
 	}
 
-	// Build filter complex for 1080x1920 output
-	// 1. Scale screen to fit width (1080)
-	// 2. Scale webcam to fit middle area
+	// Build filter complex for the vertical canvas
+	// 1. Scale screen to fit width
+	// 2. Scale webcam to fit the space next to the screen
 	// 3. Create black canvas, then draw colored lower third
-	// 4. Overlay screen at top, webcam in middle
+	// 4. Overlay screen and webcam above the lower third
 	filterComplex := fmt.Sprintf(
 		"[0:v]scale=%d:%d:flags=lanczos[screen];"+
 			"[1:v]scale=%d:%d:flags=lanczos[webcam];"+
 			"color=black:size=%dx%d:duration=99999[bg];"+
 			// Draw background for the bottom third
 			"[bg]drawbox=y=%d:w=%d:h=%d:c=%s:t=fill[canvas];"+
-			// Overlay screen at top center
-			"[canvas][screen]overlay=(W-w)/2:0[with_screen];"+
-			// Overlay webcam in middle area (centered)
+			// Overlay screen, at the top unless the webcam is above it
+			"[canvas][screen]overlay=(W-w)/2:%d[with_screen];"+
+			// Overlay webcam next to the screen
 			"[with_screen][webcam]overlay=%d:%d[stacked]",
 		scaledScreenWidth, scaledScreenHeight,
-		scaledWebcamWidth, scaledWebcamHeight,
-		YouTubeShortsWidth, YouTubeShortsHeight,
-		lowerThirdY, YouTubeShortsWidth, YouTubeShortsHeight-lowerThirdY, bgColor,
-		webcamPadX, scaledScreenHeight,
+		layout.webcamW, layout.webcamH,
+		outW, outH,
+		lowerThirdY, outW, outH-lowerThirdY, bgColor,
+		layout.screenY,
+		layout.webcamX, layout.webcamY,
 	)
 
 	currentOutput := "[stacked]"
@@ -737,46 +1029,48 @@ func (m *Merger) buildVerticalFilterComplex(videoFile, webcamFile string, opts *
 	}
 
 	// Add logo overlays in the bottom third (white branding area)
-	// Left logo: 1/3 of output width (360px), top-left of bottom third
+	// Left logo: 1/3 of output width in a 3:2 slot (360x240 at 1080), top-left of bottom third
+	logoSlotSize := logoSlot{w: outW / 3, h: outW * 2 / 9}
 	if setup.logo1Path != "" {
-		fragment, out := buildLogoOverlay(inputIdx, "logo1", "360:-1", "0", fmt.Sprintf("%d", lowerThirdY), currentOutput, setup.logo1Path, "")
+		fragment, out := buildLogoOverlay(inputIdx, "logo1", logoSlotSize, setup.fit, "0", fmt.Sprintf("%d", lowerThirdY), currentOutput, setup.logo1Path, "")
 		filterComplex += ";" + fragment
 		currentOutput = out
 		inputIdx++
 	}
 
-	// Right logo: 1/3 of output width (360px), top-right of bottom third
+	// Right logo: same slot as the left logo, top-right of bottom third
 	if setup.logo2Path != "" {
-		fragment, out := buildLogoOverlay(inputIdx, "logo2", "360:-1", "W-w", fmt.Sprintf("%d", lowerThirdY), currentOutput, setup.logo2Path, "")
+		fragment, out := buildLogoOverlay(inputIdx, "logo2", logoSlotSize, setup.fit, "W-w", fmt.Sprintf("%d", lowerThirdY), currentOutput, setup.logo2Path, "")
 		filterComplex += ";" + fragment
 		currentOutput = out
 		inputIdx++
 	}
 
-	// Banner: full width (1080px), positioned above title text in the lower third
+	// Banner: full output width in an 8:1 slot, positioned above title text in the lower third
 	if setup.bannerPath != "" {
 		// Place banner in the lower portion of the bottom third, above the title
 		// Banner is at the middle of the lower third area, title text below it
-		bannerY := lowerThirdY + (YouTubeShortsHeight-lowerThirdY)/2 - 60 // Centered vertically with room for title below
-		fragment, out := buildLogoOverlay(inputIdx, "banner", fmt.Sprintf("%d:-1", YouTubeShortsWidth), "(W-w)/2", fmt.Sprintf("%d", bannerY), currentOutput, setup.bannerPath, "")
+		bannerY := lowerThirdY + (outH-lowerThirdY)/2 - scale(60) // Centered vertically with room for title below
+		bannerSlot := logoSlot{w: outW, h: outW / 8}
+		fragment, out := buildLogoOverlay(inputIdx, "banner", bannerSlot, setup.fit, "(W-w)/2", fmt.Sprintf("%d", bannerY), currentOutput, setup.bannerPath, "")
 		filterComplex += ";" + fragment
 		currentOutput = out
 
 		// Add title text below the banner
 		if opts != nil && opts.VideoTitle != "" {
-			titleY := bannerY + 80 // Position below the banner
+			titleY := bannerY + bannerSlot.h + scale(10) // Position below the banner slot
 			filterComplex += fmt.Sprintf(
-				";%sdrawtext=text='%s':fontcolor=%s:fontsize=36:x=(w-text_w)/2:y=%d[outv]",
-				currentOutput, escapeFFmpegText(opts.VideoTitle), titleColor, titleY,
+				";%sdrawtext=text='%s':fontcolor=%s:fontsize=%d:x=(w-text_w)/2:y=%d[outv]",
+				currentOutput, escapeFFmpegText(opts.VideoTitle), titleColor, scale(36), titleY,
 			)
 			return filterComplex, logoInputs, nil
 		}
 	} else if opts != nil && opts.VideoTitle != "" {
 		// Title text without banner, centered in lower third
-		titleY := lowerThirdY + (YouTubeShortsHeight-lowerThirdY)/2
+		titleY := lowerThirdY + (outH-lowerThirdY)/2
 		filterComplex += fmt.Sprintf(
-			";%sdrawtext=text='%s':fontcolor=%s:fontsize=36:x=(w-text_w)/2:y=%d[outv]",
-			currentOutput, escapeFFmpegText(opts.VideoTitle), titleColor, titleY,
+			";%sdrawtext=text='%s':fontcolor=%s:fontsize=%d:x=(w-text_w)/2:y=%d[outv]",
+			currentOutput, escapeFFmpegText(opts.VideoTitle), titleColor, scale(36), titleY,
 		)
 		return filterComplex, logoInputs, nil
 	}
@@ -787,7 +1081,8 @@ func (m *Merger) buildVerticalFilterComplex(videoFile, webcamFile string, opts *
 	return filterComplex, logoInputs, nil
 }
 
-// copyLogoToOutputDir copies a logo file to the output directory
+// copyLogoToOutputDir copies a logo file to the output directory. A dry run
+// returns the path it would be copied to.
 func (m *Merger) copyLogoToOutputDir(srcPath, outputDir, baseName string) string {
 	if srcPath == "" {
 		return ""
@@ -801,6 +1096,9 @@ func (m *Merger) copyLogoToOutputDir(srcPath, outputDir, baseName string) string
 	// Get the extension
 	ext := filepath.Ext(srcPath)
 	destPath := filepath.Join(outputDir, baseName+ext)
+	if m.log.DryRun() {
+		return destPath
+	}
 
 	// Copy the file
 	src, err := os.Open(srcPath)
@@ -862,12 +1160,34 @@ func appendLogoInput(inputs []string, logoPath string, gifLoopMode config.GifLoo
 	return append(inputs, "-i", logoPath)
 }
 
+// logoSlot is the box a logo is fitted into, in pixels
+type logoSlot struct {
+	w int
+	h int
+}
+
+// logoFitFilters returns the filters that fit a logo into slot without
+// distorting it. Contain scales the logo to fit inside the slot and pads it
+// to the slot size with transparency; cover scales it to fill the slot and
+// crops the overflow, so padFilter is empty.
+func logoFitFilters(slot logoSlot, fit config.LogoFit) (scaleFilter, padFilter string) {
+	if fit == config.LogoFitCover {
+		scaleFilter = fmt.Sprintf("scale=%d:%d:force_original_aspect_ratio=increase,crop=%d:%d",
+			slot.w, slot.h, slot.w, slot.h)
+		return scaleFilter, ""
+	}
+	scaleFilter = fmt.Sprintf("scale=%d:%d:force_original_aspect_ratio=decrease", slot.w, slot.h)
+	padFilter = fmt.Sprintf("format=rgba,pad=%d:%d:(ow-iw)/2:(oh-ih)/2:color=black@0", slot.w, slot.h)
+	return scaleFilter, padFilter
+}
+
 // buildLogoOverlay builds an FFmpeg filter fragment for a single logo overlay.
 // It handles both static images and GIFs (with white background for transparency).
 // Parameters:
 //   - inputIdx: FFmpeg input index for the logo
 //   - label: unique label suffix (e.g., "logo1", "logo2", "banner")
-//   - scaleExpr: scale expression (e.g., "360:-1")
+//   - slot: box the logo is fitted into (e.g., 360x240)
+//   - fit: contain (pad) or cover (crop) when the aspect ratios differ
 //   - xExpr: x position expression (e.g., "0", "W-w")
 //   - yExpr: y position expression (e.g., "0", "1280")
 //   - currentOutput: current filter chain output label (e.g., "[stacked]")
@@ -875,33 +1195,40 @@ func appendLogoInput(inputs []string, logoPath string, gifLoopMode config.GifLoo
 //   - enableExpr: optional enable expression (e.g., "between(t,0,15)"), empty for always visible
 //
 // Returns: (filterFragment, newOutputLabel)
-func buildLogoOverlay(inputIdx int, label, scaleExpr, xExpr, yExpr, currentOutput, logoPath, enableExpr string) (string, string) {
+func buildLogoOverlay(inputIdx int, label string, slot logoSlot, fit config.LogoFit, xExpr, yExpr, currentOutput, logoPath, enableExpr string) (string, string) {
 	outLabel := fmt.Sprintf("[out_%s]", label)
 	enableClause := ""
 	if enableExpr != "" {
 		enableClause = fmt.Sprintf(":enable='%s'", enableExpr)
 	}
 
+	scaleFilter, padFilter := logoFitFilters(slot, fit)
+	padClause := ""
+	if padFilter != "" {
+		padClause = "," + padFilter
+	}
+
 	if isGif(logoPath) {
-		// For GIFs: create white background, then overlay the GIF on it
+		// For GIFs: create white background, then overlay the GIF on it.
+		// Padding is added afterwards so it stays transparent.
 		fragment := fmt.Sprintf(
-			"[%d:v]scale=%s[%s_raw];"+
+			"[%d:v]%s[%s_raw];"+
 				"[%s_raw]split[%s_a][%s_b];"+
 				"[%s_a]drawbox=c=white:t=fill[%s_bg];"+
-				"[%s_bg][%s_b]overlay=0:0:format=auto[%s_final];"+
+				"[%s_bg][%s_b]overlay=0:0:format=auto%s[%s_final];"+
 				"%s[%s_final]overlay=%s:%s:format=auto:eof_action=repeat%s%s",
-			inputIdx, scaleExpr, label,
+			inputIdx, scaleFilter, label,
 			label, label, label,
 			label, label,
-			label, label, label,
+			label, label, padClause, label,
 			currentOutput, label, xExpr, yExpr, enableClause, outLabel,
 		)
 		return fragment, outLabel
 	}
 
 	fragment := fmt.Sprintf(
-		"[%d:v]scale=%s[%s];%s[%s]overlay=%s:%s:format=auto:eof_action=repeat%s%s",
-		inputIdx, scaleExpr, label, currentOutput, label, xExpr, yExpr, enableClause, outLabel,
+		"[%d:v]%s%s[%s];%s[%s]overlay=%s:%s:format=auto:eof_action=repeat%s%s",
+		inputIdx, scaleFilter, padClause, label, currentOutput, label, xExpr, yExpr, enableClause, outLabel,
 	)
 	return fragment, outLabel
 }
@@ -912,6 +1239,7 @@ type logoSetup struct {
 	logo2Path       string
 	bannerPath      string
 	gifLoopMode     config.GifLoopMode
+	fit             config.LogoFit
 	startInputIndex int // FFmpeg input index where logos start
 }
 
@@ -927,6 +1255,10 @@ func (m *Merger) prepareMergedLogos(opts *MergeOptions, inputs []string, startIn
 	if opts.GifLoopMode != "" {
 		setup.gifLoopMode = opts.GifLoopMode
 	}
+	setup.fit = config.LogoFitContain
+	if opts.LogoFit != "" {
+		setup.fit = opts.LogoFit
+	}
 
 	if opts.ProductLogo1 != "" {
 		setup.logo1Path = m.copyLogoToOutputDir(opts.ProductLogo1, opts.OutputDir, "product_logo_1")
@@ -955,16 +1287,18 @@ func (m *Merger) prepareMergedLogos(opts *MergeOptions, inputs []string, startIn
 // All logo overlays are timed to show for the first 15 seconds only.
 // The webcam circle overlay is shown for the full duration.
 // videoWidth is the width of the input video in pixels.
-func buildMergedOverlayFilter(setup logoSetup, videoWidth int, webcam webcamOverlayOpts) string {
+// outputFilter, if non-empty, is applied last to the composited output (scaling
+// and merge-stage filter presets).
+func buildMergedOverlayFilter(setup logoSetup, videoWidth int, webcam webcamOverlayOpts, outputFilter string) string {
 	filter := ""
 	currentOutput := "[0:v]"
 	inputIdx := setup.startInputIndex
 	enableExpr := "between(t,0,15)"
 
-	// Left logo: 1/8 of video width, top-left corner
+	// Left logo: 1/8 of video width in a 3:2 slot, top-left corner
+	logoSlotSize := logoSlot{w: videoWidth / 8, h: videoWidth / 12}
 	if setup.logo1Path != "" {
-		scaleW := videoWidth / 8
-		fragment, out := buildLogoOverlay(inputIdx, "logo1", fmt.Sprintf("%d:-1", scaleW), "0", "0", currentOutput, setup.logo1Path, enableExpr)
+		fragment, out := buildLogoOverlay(inputIdx, "logo1", logoSlotSize, setup.fit, "0", "0", currentOutput, setup.logo1Path, enableExpr)
 		if filter != "" {
 			filter += ";"
 		}
@@ -973,10 +1307,9 @@ func buildMergedOverlayFilter(setup logoSetup, videoWidth int, webcam webcamOver
 		inputIdx++
 	}
 
-	// Right logo: 1/8 of video width, top-right corner
+	// Right logo: same slot as the left logo, top-right corner
 	if setup.logo2Path != "" {
-		scaleW := videoWidth / 8
-		fragment, out := buildLogoOverlay(inputIdx, "logo2", fmt.Sprintf("%d:-1", scaleW), "W-w", "0", currentOutput, setup.logo2Path, enableExpr)
+		fragment, out := buildLogoOverlay(inputIdx, "logo2", logoSlotSize, setup.fit, "W-w", "0", currentOutput, setup.logo2Path, enableExpr)
 		if filter != "" {
 			filter += ";"
 		}
@@ -985,10 +1318,10 @@ func buildMergedOverlayFilter(setup logoSetup, videoWidth int, webcam webcamOver
 		inputIdx++
 	}
 
-	// Banner: half video width, bottom-left corner
+	// Banner: half video width in a 4:1 slot, bottom-left corner
 	if setup.bannerPath != "" {
-		scaleW := videoWidth / 2
-		fragment, out := buildLogoOverlay(inputIdx, "banner", fmt.Sprintf("%d:-1", scaleW), "0", "H-h", currentOutput, setup.bannerPath, enableExpr)
+		bannerSlot := logoSlot{w: videoWidth / 2, h: videoWidth / 8}
+		fragment, out := buildLogoOverlay(inputIdx, "banner", bannerSlot, setup.fit, "0", "H-h", currentOutput, setup.bannerPath, enableExpr)
 		if filter != "" {
 			filter += ";"
 		}
@@ -996,9 +1329,9 @@ func buildMergedOverlayFilter(setup logoSetup, videoWidth int, webcam webcamOver
 		currentOutput = out
 	}
 
-	// Circular webcam overlay: bottom-right corner, full duration
+	// Circular webcam overlay: configured corner, full duration
 	if webcam.inputIdx >= 0 {
-		fragment, out := buildWebcamCircleOverlay(webcam.inputIdx, webcam.size, webcam.margin, currentOutput)
+		fragment, out := buildWebcamCircleOverlay(webcam, currentOutput)
 		if filter != "" {
 			filter += ";"
 		}
@@ -1006,9 +1339,13 @@ func buildMergedOverlayFilter(setup logoSetup, videoWidth int, webcam webcamOver
 		currentOutput = out
 	}
 
-	// Rename final output to [outv]
+	// Rename final output to [outv], downscaling if requested
 	if filter != "" {
-		filter += fmt.Sprintf(";%snull[outv]", currentOutput)
+		finalFilter := "null"
+		if outputFilter != "" {
+			finalFilter = outputFilter
+		}
+		filter += fmt.Sprintf(";%s%s[outv]", currentOutput, finalFilter)
 	}
 
 	return filter
@@ -1016,32 +1353,137 @@ func buildMergedOverlayFilter(setup logoSetup, videoWidth int, webcam webcamOver
 
 // Circular webcam overlay constants
 const (
-	webcamOverlaySize   = 250 // Circular webcam overlay diameter in pixels
-	webcamOverlayMargin = 20  // Margin from bottom-right corner
+	webcamOverlayMargin = 20 // Margin from the video edges in pixels
 )
 
+// verticalLayout is where the screen and webcam are placed above the lower
+// third of the vertical video
+type verticalLayout struct {
+	screenY int // top of the screen
+	webcamX int // left of the webcam
+	webcamY int // top of the webcam
+	webcamW int
+	webcamH int
+}
+
+// newVerticalLayout places the webcam next to the screen, in the space the
+// screen leaves above the lower third. By default the screen is at the top
+// and the webcam fills the space below it, centered. A webcam above the
+// screen moves the screen down onto the lower third; a smaller webcam stays
+// against the screen and is aligned to the left, center or right.
+func newVerticalLayout(opts *MergeOptions, outW, lowerThirdY, screenH, webcamWidth, webcamHeight int) verticalLayout {
+	position := config.VerticalWebcamBottomCenter
+	size := config.VerticalWebcamLarge
+	if opts != nil && opts.VerticalWebcamPosition != "" {
+		position = opts.VerticalWebcamPosition
+	}
+	if opts != nil && opts.VerticalWebcamSize != "" {
+		size = opts.VerticalWebcamSize
+	}
+
+	// Webcam fills the space between screen and the lower third branding area
+	areaHeight := lowerThirdY - screenH
+	if areaHeight < 0 {
+		areaHeight = 0
+	}
+
+	// Scale webcam to fit the area while maintaining aspect ratio
+	layout := verticalLayout{webcamW: outW, webcamH: webcamHeight * outW / webcamWidth}
+	if layout.webcamH > areaHeight {
+		layout.webcamH = areaHeight
+		layout.webcamW = webcamWidth * areaHeight / webcamHeight
+	}
+	if percent := size.Percent(); percent < 100 {
+		// Keep the size even so it stays valid for yuv420p
+		layout.webcamW = (layout.webcamW * percent / 100) &^ 1
+		layout.webcamH = (layout.webcamH * percent / 100) &^ 1
+	}
+
+	switch {
+	case strings.HasSuffix(string(position), "-left"):
+		layout.webcamX = 0
+	case strings.HasSuffix(string(position), "-right"):
+		layout.webcamX = outW - layout.webcamW
+	default:
+		layout.webcamX = (outW - layout.webcamW) / 2
+	}
+
+	if position.IsTop() {
+		layout.screenY = max(lowerThirdY-screenH, 0)
+		layout.webcamY = layout.screenY - layout.webcamH
+	} else {
+		layout.webcamY = screenH
+	}
+	return layout
+}
+
 // webcamOverlayOpts holds parameters for the circular webcam overlay on merged video
 type webcamOverlayOpts struct {
-	inputIdx int // FFmpeg input index for webcam file; -1 means no webcam overlay
-	size     int // diameter in pixels
-	margin   int // margin from bottom-right corner in pixels
+	inputIdx int              // FFmpeg input index for webcam file; -1 means no webcam overlay
+	size     int              // diameter in pixels
+	margin   int              // margin from the video edges in pixels
+	corner   config.PiPCorner // corner the overlay is placed in
+}
+
+// newWebcamOverlayOpts returns the webcam overlay parameters for the merge
+// options, sized for a video of the given height. The input index is left
+// unset (-1) until the webcam input is added.
+func newWebcamOverlayOpts(opts *MergeOptions, videoHeight int) webcamOverlayOpts {
+	webcam := webcamOverlayOpts{
+		inputIdx: -1,
+		size:     config.PiPSizeMedium.Diameter(videoHeight),
+		margin:   webcamOverlayMargin,
+		corner:   config.PiPCornerBottomRight,
+	}
+	if opts == nil {
+		return webcam
+	}
+	if opts.PiPSize != "" {
+		webcam.size = opts.PiPSize.Diameter(videoHeight)
+	}
+	if opts.PiPCorner != "" {
+		webcam.corner = opts.PiPCorner
+	}
+	return webcam
+}
+
+// overlayPosition returns the FFmpeg overlay x and y expressions for the
+// webcam corner
+func (w webcamOverlayOpts) overlayPosition() (string, string) {
+	left := fmt.Sprintf("%d", w.margin)
+	right := fmt.Sprintf("W-w-%d", w.margin)
+	top := fmt.Sprintf("%d", w.margin)
+	bottom := fmt.Sprintf("H-h-%d", w.margin)
+
+	switch w.corner {
+	case config.PiPCornerBottomLeft:
+		return left, bottom
+	case config.PiPCornerTopRight:
+		return right, top
+	case config.PiPCornerTopLeft:
+		return left, top
+	default:
+		return right, bottom
+	}
 }
 
 // buildWebcamCircleOverlay builds an FFmpeg filter fragment for a circular webcam overlay.
 // It scales the webcam to the given size, applies a circular alpha mask using the geq filter,
-// and overlays it at the bottom-right of the video with the specified margin.
+// and overlays it in the configured corner of the video with the specified margin.
 // Returns: (filterFragment, newOutputLabel)
-func buildWebcamCircleOverlay(inputIdx, size, margin int, currentOutput string) (string, string) {
+func buildWebcamCircleOverlay(webcam webcamOverlayOpts, currentOutput string) (string, string) {
+	size := webcam.size
 	radius := size / 2
+	x, y := webcam.overlayPosition()
 	outLabel := "[out_webcam]"
 	fragment := fmt.Sprintf(
 		"[%d:v]scale=%d:%d,format=yuva420p,"+
 			"geq=lum='p(X,Y)':cb='p(X,Y)':cr='p(X,Y)':"+
 			"a='if(gt((X-%d)*(X-%d)+(Y-%d)*(Y-%d),%d*%d),0,255)'[webcam_circle];"+
-			"%s[webcam_circle]overlay=W-w-%d:H-h-%d%s",
-		inputIdx, size, size,
+			"%s[webcam_circle]overlay=%s:%s%s",
+		webcam.inputIdx, size, size,
 		radius, radius, radius, radius, radius, radius,
-		currentOutput, margin, margin, outLabel,
+		currentOutput, x, y, outLabel,
 	)
 	return fragment, outLabel
 }
@@ -0,0 +1,446 @@
+package merger
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/notify"
+	"github.com/kartoza/kartoza-screencaster/internal/proclog"
+	"github.com/kartoza/kartoza-screencaster/internal/testmedia"
+)
+
+func TestMain(m *testing.M) {
+	// Processing reports its steps as desktop notifications
+	notify.Disabled = true
+	os.Exit(m.Run())
+}
+
+// assertDuration fails the test unless the file lasts about want seconds
+func assertDuration(t *testing.T, name string, info testmedia.Info, want float64) {
+	t.Helper()
+	if math.Abs(info.Duration-want) > 0.5 {
+		t.Errorf("%s lasts %.2fs, want about %.0fs", name, info.Duration, want)
+	}
+}
+
+// assertExists fails the test unless path exists
+func assertExists(t *testing.T, path string) {
+	t.Helper()
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("expected %s: %v", filepath.Base(path), err)
+	}
+}
+
+func TestPipeline_ScreenAndAudio(t *testing.T) {
+	testmedia.Require(t)
+	dir := t.TempDir()
+	video := testmedia.Screen(t, dir, "screen.mp4", 320, 240, 3)
+	audio := testmedia.Tone(t, dir, "audio.wav", 3)
+
+	m := New(models.DefaultAudioProcessingOptions())
+	completed := map[ProcessingStep]bool{}
+	m.SetProgressCallback(func(step ProcessingStep, done, skipped bool, err error) {
+		if done {
+			completed[step] = true
+		}
+	})
+
+	result, err := m.Merge(MergeOptions{
+		VideoFile: video,
+		AudioFile: audio,
+		OutputDir: dir,
+		FilterPresets: []models.FilterPreset{
+			{Name: "Rumble", Stage: models.FilterStageAudio, Filter: "highpass=f=80"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Merge() error: %v", err)
+	}
+
+	for _, step := range []ProcessingStep{StepAnalyzingAudio, StepNormalizing, StepMerging, StepCreatingVertical} {
+		if !completed[step] {
+			t.Errorf("step %d was not reported as completed", step)
+		}
+	}
+	if !result.NormalizeApplied {
+		t.Error("expected the audio to be normalized")
+	}
+	assertExists(t, filepath.Join(dir, "audio-normalized.wav"))
+	assertExists(t, filepath.Join(dir, "audio-filtered.wav"))
+
+	if result.MergedFile != filepath.Join(dir, "screen-merged.mp4") {
+		t.Fatalf("MergedFile = %q", result.MergedFile)
+	}
+	merged := testmedia.Probe(t, result.MergedFile)
+	if merged.VideoCodec != "h264" || merged.AudioCodec != "aac" || merged.Width != 320 || merged.Height != 240 {
+		t.Errorf("merged = %+v, want 320x240 h264 with aac audio", merged)
+	}
+	assertDuration(t, "merged video", merged, 3)
+	if result.VerticalFile != "" {
+		t.Errorf("expected no vertical video without a webcam, got %q", result.VerticalFile)
+	}
+}
+
+func TestPipeline_PartsAreConcatenated(t *testing.T) {
+	testmedia.Require(t)
+	dir := t.TempDir()
+	opts := MergeOptions{OutputDir: dir}
+	for _, name := range []string{"part000", "part001"} {
+		opts.VideoParts = append(opts.VideoParts, testmedia.Screen(t, dir, "screen_"+name+".mp4", 320, 240, 2))
+		opts.AudioParts = append(opts.AudioParts, testmedia.Tone(t, dir, "audio_"+name+".wav", 2))
+	}
+
+	result, err := New(models.AudioProcessingOptions{}).Merge(opts)
+	if err != nil {
+		t.Fatalf("Merge() error: %v", err)
+	}
+	if result.NormalizeApplied {
+		t.Error("expected normalization to be skipped when disabled")
+	}
+
+	merged := testmedia.Probe(t, result.MergedFile)
+	if !merged.HasVideo() || !merged.HasAudio() {
+		t.Errorf("merged = %+v, want video and audio", merged)
+	}
+	assertDuration(t, "merged video", merged, 4)
+}
+
+func TestPipeline_VerticalWithWebcam(t *testing.T) {
+	testmedia.Require(t)
+	dir := t.TempDir()
+
+	result, err := New(models.AudioProcessingOptions{}).Merge(MergeOptions{
+		VideoFile:        testmedia.Screen(t, dir, "screen.mp4", 640, 360, 2),
+		WebcamFile:       testmedia.Webcam(t, dir, "webcam.mp4", 320, 240, 2),
+		AudioFile:        testmedia.Tone(t, dir, "audio.wav", 2),
+		CreateVertical:   true,
+		VideoTitle:       "Sample recording",
+		OutputResolution: config.OutputResolution720p,
+		OutputDir:        dir,
+	})
+	if err != nil {
+		t.Fatalf("Merge() error: %v", err)
+	}
+	if result.VerticalError != nil {
+		t.Fatalf("vertical video failed: %v", result.VerticalError)
+	}
+
+	// The webcam is overlaid on the merged video, which is not upscaled
+	merged := testmedia.Probe(t, result.MergedFile)
+	if merged.Width != 640 || merged.Height != 360 || !merged.HasAudio() {
+		t.Errorf("merged = %+v, want 640x360 with audio", merged)
+	}
+	assertDuration(t, "merged video", merged, 2)
+
+	vertical := testmedia.Probe(t, result.VerticalFile)
+	if vertical.Width != 720 || vertical.Height != 1280 || vertical.AudioCodec != "aac" {
+		t.Errorf("vertical = %+v, want 720x1280 with aac audio", vertical)
+	}
+	assertDuration(t, "vertical video", vertical, 2)
+}
+
+func TestPipeline_WebcamOnly(t *testing.T) {
+	testmedia.Require(t)
+	dir := t.TempDir()
+
+	result, err := New(models.AudioProcessingOptions{}).Merge(MergeOptions{
+		VideoFile:        testmedia.Screen(t, dir, "screen.mp4", 640, 360, 2),
+		WebcamFile:       testmedia.Webcam(t, dir, "webcam.mp4", 320, 240, 2),
+		AudioFile:        testmedia.Tone(t, dir, "audio.wav", 2),
+		CreateWebcamOnly: true,
+		OutputDir:        dir,
+	})
+	if err != nil {
+		t.Fatalf("Merge() error: %v", err)
+	}
+	if result.WebcamOnlyError != nil {
+		t.Fatalf("webcam-only video failed: %v", result.WebcamOnlyError)
+	}
+	if result.WebcamOnlyFile != filepath.Join(dir, WebcamOnlyFileName) {
+		t.Fatalf("WebcamOnlyFile = %q", result.WebcamOnlyFile)
+	}
+
+	// The centre square of the webcam, not upscaled
+	webcamOnly := testmedia.Probe(t, result.WebcamOnlyFile)
+	if webcamOnly.Width != 240 || webcamOnly.Height != 240 || webcamOnly.AudioCodec != "aac" {
+		t.Errorf("webcam-only = %+v, want 240x240 with aac audio", webcamOnly)
+	}
+	assertDuration(t, "webcam-only video", webcamOnly, 2)
+}
+
+func TestPipeline_VideoOnlyWithFiltersAndScaling(t *testing.T) {
+	testmedia.Require(t)
+	dir := t.TempDir()
+
+	result, err := New(models.DefaultAudioProcessingOptions()).Merge(MergeOptions{
+		VideoFile:        testmedia.Screen(t, dir, "screen.mp4", 1280, 960, 2),
+		OutputResolution: config.OutputResolution720p,
+		OutputDir:        dir,
+		FilterPresets: []models.FilterPreset{
+			{Name: "Brighten", Stage: models.FilterStageVideo, Filter: "eq=brightness=0.05"},
+			{Name: "Sharpen", Stage: models.FilterStageMerge, Filter: "unsharp=5:5:0.8:5:5:0.0"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Merge() error: %v", err)
+	}
+	assertExists(t, filepath.Join(dir, "screen-filtered.mp4"))
+
+	merged := testmedia.Probe(t, result.MergedFile)
+	if merged.Width != 960 || merged.Height != 720 || merged.HasAudio() {
+		t.Errorf("merged = %+v, want 960x720 without audio", merged)
+	}
+	assertDuration(t, "merged video", merged, 2)
+}
+
+func TestPipeline_TrimKeepsSources(t *testing.T) {
+	testmedia.Require(t)
+	dir := t.TempDir()
+	video := testmedia.Screen(t, dir, "screen.mp4", 320, 240, 4)
+	audio := testmedia.Tone(t, dir, "audio.wav", 4)
+
+	opts := MergeOptions{VideoFile: video, AudioFile: audio, OutputDir: dir, TrimStart: time.Second, TrimEnd: 3 * time.Second}
+	result, err := New(models.AudioProcessingOptions{}).Merge(opts)
+	if err != nil {
+		t.Fatalf("Merge() error: %v", err)
+	}
+	assertDuration(t, "merged video", testmedia.Probe(t, result.MergedFile), 2)
+	assertDuration(t, "recorded video", testmedia.Probe(t, video), 4)
+	if math.Abs(result.SourceDuration.Seconds()-4) > 0.5 {
+		t.Errorf("SourceDuration = %v, want the length before trimming", result.SourceDuration)
+	}
+
+	// A trim past the end of the recording is refused
+	opts.TrimStart, opts.TrimEnd = 5*time.Second, 0
+	if _, err := New(models.AudioProcessingOptions{}).Merge(opts); err == nil {
+		t.Error("expected a trim after the end of the recording to fail")
+	}
+}
+
+func TestPipeline_DryRunPlansWithoutRunning(t *testing.T) {
+	dir := t.TempDir()
+	var parts []string
+	for _, name := range []string{"screen-part1.mp4", "screen-part2.mp4", "audio.wav"} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte("recorded"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		parts = append(parts, path)
+	}
+
+	plog := proclog.NewDryRun()
+	m := New(models.DefaultAudioProcessingOptions())
+	m.SetLogger(plog)
+	result, err := m.Merge(MergeOptions{
+		VideoParts: parts[:2],
+		AudioFile:  parts[2],
+		OutputDir:  dir,
+	})
+	if err != nil {
+		t.Fatalf("Merge: %v", err)
+	}
+
+	commands := plog.Commands()
+	wants := []string{"-f concat", "print_format=json", "measured_I=<input_i>", "screen-merged.mp4"}
+	if len(commands) != len(wants) {
+		t.Fatalf("planned %d commands, want %d:\n%s", len(commands), len(wants), strings.Join(commands, "\n"))
+	}
+	for i, want := range wants {
+		if !strings.Contains(commands[i], want) {
+			t.Errorf("command %d = %s, want it to contain %q", i+1, commands[i], want)
+		}
+	}
+	if result.MergedFile == "" {
+		t.Error("expected the merged file to be planned")
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != len(parts) {
+		t.Errorf("a dry run wrote %d files, want none", len(entries)-len(parts))
+	}
+}
+
+func TestTrimInput(t *testing.T) {
+	opts := &MergeOptions{TrimStart: 1500 * time.Millisecond, TrimEnd: time.Minute}
+	if got := strings.Join(trimInput(opts, "screen.mp4"), " "); got != "-ss 1.5 -to 60 -i screen.mp4" {
+		t.Errorf("trimInput() = %q", got)
+	}
+	if got := strings.Join(trimInput(&MergeOptions{}, "screen.mp4"), " "); got != "-i screen.mp4" {
+		t.Errorf("trimInput() = %q, want only the input without a trim", got)
+	}
+	if got := trimmedDurationUs(opts, 90_000_000); got != 58_500_000 {
+		t.Errorf("trimmedDurationUs() = %d, want 58.5s", got)
+	}
+}
+
+func TestOutputScaleFilter(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      *MergeOptions
+		srcHeight int
+		want      string
+	}{
+		{"no options", nil, 1440, ""},
+		{"native", &MergeOptions{OutputResolution: config.OutputResolutionNative}, 1440, ""},
+		{"1080p from 1440", &MergeOptions{OutputResolution: config.OutputResolution1080p}, 1440, "scale=-2:1080:flags=lanczos"},
+		{"1080p from 1080", &MergeOptions{OutputResolution: config.OutputResolution1080p}, 1080, ""},
+		{"720p from 1440", &MergeOptions{OutputResolution: config.OutputResolution720p}, 1440, "scale=-2:720:flags=lanczos"},
+		{"1080p from 720 is not upscaled", &MergeOptions{OutputResolution: config.OutputResolution1080p}, 720, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := outputScaleFilter(tt.opts, tt.srcHeight); got != tt.want {
+				t.Errorf("outputScaleFilter() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerticalDimensions(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         *MergeOptions
+		wantW, wantH int
+	}{
+		{"no options", nil, 1080, 1920},
+		{"native", &MergeOptions{OutputResolution: config.OutputResolutionNative}, 1080, 1920},
+		{"1080p", &MergeOptions{OutputResolution: config.OutputResolution1080p}, 1080, 1920},
+		{"720p", &MergeOptions{OutputResolution: config.OutputResolution720p}, 720, 1280},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w, h := verticalDimensions(tt.opts); w != tt.wantW || h != tt.wantH {
+				t.Errorf("verticalDimensions() = %dx%d, want %dx%d", w, h, tt.wantW, tt.wantH)
+			}
+		})
+	}
+}
+
+func TestNewVerticalLayout(t *testing.T) {
+	// A 1920x1080 screen and 1280x720 webcam on the 1080x1920 canvas: both
+	// are 607 pixels high, the lower third starts at 1280
+	tests := []struct {
+		name string
+		opts *MergeOptions
+		want verticalLayout
+	}{
+		{"default fills the space below the screen", nil,
+			verticalLayout{screenY: 0, webcamX: 0, webcamY: 607, webcamW: 1080, webcamH: 607}},
+		{"bottom left medium", &MergeOptions{VerticalWebcamPosition: config.VerticalWebcamBottomLeft, VerticalWebcamSize: config.VerticalWebcamMedium},
+			verticalLayout{screenY: 0, webcamX: 0, webcamY: 607, webcamW: 810, webcamH: 454}},
+		{"top right small", &MergeOptions{VerticalWebcamPosition: config.VerticalWebcamTopRight, VerticalWebcamSize: config.VerticalWebcamSmall},
+			verticalLayout{screenY: 673, webcamX: 540, webcamY: 371, webcamW: 540, webcamH: 302}},
+		{"top center", &MergeOptions{VerticalWebcamPosition: config.VerticalWebcamTopCenter},
+			verticalLayout{screenY: 673, webcamX: 0, webcamY: 66, webcamW: 1080, webcamH: 607}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newVerticalLayout(tt.opts, 1080, 1280, 607, 1280, 720); got != tt.want {
+				t.Errorf("newVerticalLayout() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogoFitFilters(t *testing.T) {
+	slot := logoSlot{w: 360, h: 240}
+	tests := []struct {
+		name      string
+		fit       config.LogoFit
+		wantScale string
+		wantPad   string
+	}{
+		{"contain pads", config.LogoFitContain,
+			"scale=360:240:force_original_aspect_ratio=decrease",
+			"format=rgba,pad=360:240:(ow-iw)/2:(oh-ih)/2:color=black@0"},
+		{"empty is contain", "",
+			"scale=360:240:force_original_aspect_ratio=decrease",
+			"format=rgba,pad=360:240:(ow-iw)/2:(oh-ih)/2:color=black@0"},
+		{"cover crops", config.LogoFitCover,
+			"scale=360:240:force_original_aspect_ratio=increase,crop=360:240", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scale, pad := logoFitFilters(slot, tt.fit)
+			if scale != tt.wantScale {
+				t.Errorf("scale filter = %q, want %q", scale, tt.wantScale)
+			}
+			if pad != tt.wantPad {
+				t.Errorf("pad filter = %q, want %q", pad, tt.wantPad)
+			}
+		})
+	}
+}
+
+func TestBuildLogoOverlay_Fit(t *testing.T) {
+	slot := logoSlot{w: 360, h: 240}
+	tests := []struct {
+		name string
+		fit  config.LogoFit
+		path string
+		want string
+	}{
+		{"contain", config.LogoFitContain, "logo.png",
+			"[3:v]scale=360:240:force_original_aspect_ratio=decrease,format=rgba,pad=360:240:(ow-iw)/2:(oh-ih)/2:color=black@0[logo1];" +
+				"[stacked][logo1]overlay=0:0:format=auto:eof_action=repeat[out_logo1]"},
+		{"cover", config.LogoFitCover, "logo.png",
+			"[3:v]scale=360:240:force_original_aspect_ratio=increase,crop=360:240[logo1];" +
+				"[stacked][logo1]overlay=0:0:format=auto:eof_action=repeat[out_logo1]"},
+		// The white GIF background is drawn before padding, so the padding
+		// stays transparent
+		{"contain gif", config.LogoFitContain, "logo.gif",
+			"[3:v]scale=360:240:force_original_aspect_ratio=decrease[logo1_raw];" +
+				"[logo1_raw]split[logo1_a][logo1_b];" +
+				"[logo1_a]drawbox=c=white:t=fill[logo1_bg];" +
+				"[logo1_bg][logo1_b]overlay=0:0:format=auto,format=rgba,pad=360:240:(ow-iw)/2:(oh-ih)/2:color=black@0[logo1_final];" +
+				"[stacked][logo1_final]overlay=0:0:format=auto:eof_action=repeat[out_logo1]"},
+		{"cover gif", config.LogoFitCover, "logo.gif",
+			"[3:v]scale=360:240:force_original_aspect_ratio=increase,crop=360:240[logo1_raw];" +
+				"[logo1_raw]split[logo1_a][logo1_b];" +
+				"[logo1_a]drawbox=c=white:t=fill[logo1_bg];" +
+				"[logo1_bg][logo1_b]overlay=0:0:format=auto[logo1_final];" +
+				"[stacked][logo1_final]overlay=0:0:format=auto:eof_action=repeat[out_logo1]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, out := buildLogoOverlay(3, "logo1", slot, tt.fit, "0", "0", "[stacked]", tt.path, "")
+			if got != tt.want {
+				t.Errorf("buildLogoOverlay() =\n%s\nwant\n%s", got, tt.want)
+			}
+			if out != "[out_logo1]" {
+				t.Errorf("output label = %q, want [out_logo1]", out)
+			}
+		})
+	}
+}
+
+func TestPrepareMergedLogos_Fit(t *testing.T) {
+	m := &Merger{}
+	setup, _ := m.prepareMergedLogos(&MergeOptions{AddLogos: true, OutputDir: t.TempDir()}, nil, 2)
+	if setup.fit != config.LogoFitContain {
+		t.Errorf("fit = %q, want contain by default", setup.fit)
+	}
+	setup, _ = m.prepareMergedLogos(&MergeOptions{AddLogos: true, OutputDir: t.TempDir(), LogoFit: config.LogoFitCover}, nil, 2)
+	if setup.fit != config.LogoFitCover {
+		t.Errorf("fit = %q, want the chosen cover", setup.fit)
+	}
+}
+
+func TestValidateFilterPreset(t *testing.T) {
+	testmedia.Require(t)
+
+	valid := models.FilterPreset{Name: "Denoise", Stage: models.FilterStageVideo, Filter: "hqdn3d=4:3:6:4.5"}
+	if err := ValidateFilterPreset(valid); err != nil {
+		t.Errorf("ValidateFilterPreset(%q) error: %v", valid.Filter, err)
+	}
+
+	typo := models.FilterPreset{Name: "Voice", Stage: models.FilterStageAudio, Filter: "highpas=f=80"}
+	if err := ValidateFilterPreset(typo); err == nil {
+		t.Errorf("expected ValidateFilterPreset(%q) to fail", typo.Filter)
+	}
+}
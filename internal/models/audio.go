@@ -13,6 +13,20 @@ type LoudnormStats struct {
 	TargetOffset string `json:"target_offset"`
 }
 
+// AudioDevice is an audio input device that can be recorded from
+type AudioDevice struct {
+	Name        string `json:"name"`                  // Source name passed to the recorder
+	Description string `json:"description,omitempty"` // Human-readable name
+}
+
+// Label returns the description of the device, or its name
+func (d AudioDevice) Label() string {
+	if d.Description != "" {
+		return d.Description
+	}
+	return d.Name
+}
+
 // AudioProcessingOptions contains options for audio post-processing
 type AudioProcessingOptions struct {
 	// NormalizeEnabled enables EBU R128 loudness normalization
@@ -25,12 +39,20 @@ type AudioProcessingOptions struct {
 	LoudnessRange float64
 }
 
+// DefaultLoudnessTargetLUFS is the integrated loudness audio is normalized
+// to, and Min/MaxLoudnessTargetLUFS the range that can be chosen
+const (
+	DefaultLoudnessTargetLUFS = -16.0 // Common target for online video and podcasts
+	MinLoudnessTargetLUFS     = -24.0
+	MaxLoudnessTargetLUFS     = -12.0
+)
+
 // DefaultAudioProcessingOptions returns sensible defaults for audio processing
 func DefaultAudioProcessingOptions() AudioProcessingOptions {
 	return AudioProcessingOptions{
 		NormalizeEnabled: true,
-		TargetLoudness:   -14.0, // Louder than broadcast, good for screen recordings
-		TruePeak:         -1.5,  // Prevents clipping
-		LoudnessRange:    11.0,  // Preserves dynamic range
+		TargetLoudness:   DefaultLoudnessTargetLUFS,
+		TruePeak:         -1.5, // Prevents clipping
+		LoudnessRange:    11.0, // Preserves dynamic range
 	}
 }
@@ -0,0 +1,88 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FilterStage is the processing stage a filter preset is applied at
+type FilterStage string
+
+const (
+	FilterStageAudio FilterStage = "audio" // Audio track, after loudness normalization
+	FilterStageVideo FilterStage = "video" // Source video, before merging (affects all outputs)
+	FilterStageMerge FilterStage = "merge" // Merged landscape video only, after overlays and scaling
+)
+
+// FilterStages is the list of available filter stages
+var FilterStages = []FilterStage{FilterStageAudio, FilterStageVideo, FilterStageMerge}
+
+// FilterStageLabels provides human-readable labels for filter stages
+var FilterStageLabels = map[FilterStage]string{
+	FilterStageAudio: "Audio",
+	FilterStageVideo: "Video",
+	FilterStageMerge: "Merge",
+}
+
+// FilterStageIndex returns the index of s in FilterStages (0 if not found)
+func FilterStageIndex(s FilterStage) int {
+	for i, stage := range FilterStages {
+		if stage == s {
+			return i
+		}
+	}
+	return 0
+}
+
+// FilterPreset is a named, reusable ffmpeg filter chain for one processing
+// stage, e.g. "hqdn3d=4:3:6:4.5" as "Heavy denoise" at the video stage
+type FilterPreset struct {
+	Name   string      `json:"name"`
+	Stage  FilterStage `json:"stage"`
+	Filter string      `json:"filter"`
+}
+
+// Validate checks that the preset is complete and that its filter is a plain
+// filter chain that can be appended to the chains built during processing.
+// It does not run ffmpeg; see merger.ValidateFilterPreset for that.
+func (p FilterPreset) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("preset name is required")
+	}
+	if FilterStageLabels[p.Stage] == "" {
+		return fmt.Errorf("unknown filter stage %q", p.Stage)
+	}
+	filter := strings.TrimSpace(p.Filter)
+	if filter == "" {
+		return fmt.Errorf("filter is required")
+	}
+	if strings.ContainsAny(filter, ";[]") {
+		return fmt.Errorf("filter must be a single filter chain without ';' or [labels]")
+	}
+	if strings.HasPrefix(filter, ",") || strings.HasSuffix(filter, ",") {
+		return fmt.Errorf("filter must not start or end with ','")
+	}
+	return nil
+}
+
+// FilterChain joins the filters of the presets for stage into one ffmpeg
+// filter chain, in preset order. It returns "" if no preset applies.
+func FilterChain(presets []FilterPreset, stage FilterStage) string {
+	var filters []string
+	for _, p := range presets {
+		if p.Stage == stage && strings.TrimSpace(p.Filter) != "" {
+			filters = append(filters, strings.TrimSpace(p.Filter))
+		}
+	}
+	return strings.Join(filters, ",")
+}
+
+// DefaultFilterPresets returns example presets for new configurations
+func DefaultFilterPresets() []FilterPreset {
+	return []FilterPreset{
+		{Name: "Dark room brightness boost", Stage: FilterStageVideo, Filter: "eq=brightness=0.06:contrast=1.1:gamma=1.15"},
+		{Name: "Heavy denoise", Stage: FilterStageVideo, Filter: "hqdn3d=4:3:6:4.5"},
+		{Name: "Sharpen", Stage: FilterStageMerge, Filter: "unsharp=5:5:0.8:5:5:0.0"},
+		{Name: "Voice clarity", Stage: FilterStageAudio, Filter: "highpass=f=80,afftdn=nf=-25"},
+	}
+}
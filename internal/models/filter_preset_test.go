@@ -0,0 +1,44 @@
+package models
+
+import "testing"
+
+func TestFilterPreset_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		preset  FilterPreset
+		wantErr bool
+	}{
+		{"valid", FilterPreset{Name: "Sharpen", Stage: FilterStageMerge, Filter: "unsharp=5:5:0.8"}, false},
+		{"chain", FilterPreset{Name: "Voice", Stage: FilterStageAudio, Filter: "highpass=f=80,lowpass=f=12000"}, false},
+		{"missing name", FilterPreset{Stage: FilterStageVideo, Filter: "eq=gamma=1.2"}, true},
+		{"unknown stage", FilterPreset{Name: "x", Stage: "vertical", Filter: "eq=gamma=1.2"}, true},
+		{"missing filter", FilterPreset{Name: "x", Stage: FilterStageVideo, Filter: "  "}, true},
+		{"filtergraph", FilterPreset{Name: "x", Stage: FilterStageVideo, Filter: "split[a][b];[a][b]overlay"}, true},
+		{"dangling comma", FilterPreset{Name: "x", Stage: FilterStageVideo, Filter: "eq=gamma=1.2,"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.preset.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFilterChain(t *testing.T) {
+	presets := []FilterPreset{
+		{Name: "Boost", Stage: FilterStageVideo, Filter: "eq=brightness=0.06"},
+		{Name: "Voice", Stage: FilterStageAudio, Filter: "highpass=f=80"},
+		{Name: "Denoise", Stage: FilterStageVideo, Filter: " hqdn3d "},
+	}
+	if got, want := FilterChain(presets, FilterStageVideo), "eq=brightness=0.06,hqdn3d"; got != want {
+		t.Errorf("video chain = %q, want %q", got, want)
+	}
+	if got, want := FilterChain(presets, FilterStageAudio), "highpass=f=80"; got != want {
+		t.Errorf("audio chain = %q, want %q", got, want)
+	}
+	if got := FilterChain(presets, FilterStageMerge); got != "" {
+		t.Errorf("merge chain = %q, want empty", got)
+	}
+}
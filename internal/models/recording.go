@@ -68,7 +68,7 @@ type RecordingStatus struct {
 	IsRecording bool      `json:"is_recording"`
 	IsPaused    bool      `json:"is_paused"`
 	CurrentPart int       `json:"current_part,omitempty"`
-	StartTime   time.Time `json:"start_time,omitempty"`
+	StartTime   time.Time `json:"start_time,omitempty"` // Start of the current part
 	Monitor     string    `json:"monitor,omitempty"`
 	VideoFile   string    `json:"video_file,omitempty"`
 	AudioFile   string    `json:"audio_file,omitempty"`
@@ -76,4 +76,16 @@ type RecordingStatus struct {
 	VideoPID    int       `json:"video_pid,omitempty"`
 	AudioPID    int       `json:"audio_pid,omitempty"`
 	WebcamPID   int       `json:"webcam_pid,omitempty"`
+
+	// Recorded time of the parts before the current one
+	RecordedBefore time.Duration `json:"recorded_before,omitempty"`
+}
+
+// Elapsed returns the time recorded so far. Paused time does not count.
+func (s RecordingStatus) Elapsed() time.Duration {
+	elapsed := s.RecordedBefore
+	if s.IsRecording && !s.StartTime.IsZero() {
+		elapsed += time.Since(s.StartTime)
+	}
+	return elapsed
 }
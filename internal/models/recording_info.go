@@ -21,6 +21,16 @@ const (
 	StatusNeedsMetadata   = "needs_metadata" // Recording stopped via systray, needs title/description
 )
 
+// Statuses lists every recording status
+var Statuses = []string{
+	StatusRecording,
+	StatusPaused,
+	StatusProcessing,
+	StatusCompleted,
+	StatusFailed,
+	StatusNeedsMetadata,
+}
+
 // RecordingInfo contains all information about a recording
 type RecordingInfo struct {
 	// Current status of the recording
@@ -34,6 +44,9 @@ type RecordingInfo struct {
 	EndTime   time.Time     `json:"end_time"`
 	Duration  time.Duration `json:"duration"`
 
+	// Pauses of the recording, in order, each starting a new part
+	Pauses []PausePoint `json:"pauses,omitempty"`
+
 	// Recording environment
 	Environment EnvironmentInfo `json:"environment"`
 
@@ -83,24 +96,29 @@ type FileInfo struct {
 	MergedFile   string `json:"merged_file,omitempty"`
 	VerticalFile string `json:"vertical_file,omitempty"`
 
+	// Square crop of the webcam alone, for social clips
+	WebcamOnlyFile string `json:"webcam_only_file,omitempty"`
+
 	// Part files for pause/resume support
 	VideoParts  []string `json:"video_parts,omitempty"`
 	AudioParts  []string `json:"audio_parts,omitempty"`
 	WebcamParts []string `json:"webcam_parts,omitempty"`
 	CurrentPart int      `json:"current_part"` // Current part number (0-indexed)
 
-	VideoSize    int64 `json:"video_size,omitempty"`
-	AudioSize    int64 `json:"audio_size,omitempty"`
-	WebcamSize   int64 `json:"webcam_size,omitempty"`
-	MergedSize   int64 `json:"merged_size,omitempty"`
-	VerticalSize int64 `json:"vertical_size,omitempty"`
-	TotalSize    int64 `json:"total_size"`
+	VideoSize      int64 `json:"video_size,omitempty"`
+	AudioSize      int64 `json:"audio_size,omitempty"`
+	WebcamSize     int64 `json:"webcam_size,omitempty"`
+	MergedSize     int64 `json:"merged_size,omitempty"`
+	VerticalSize   int64 `json:"vertical_size,omitempty"`
+	WebcamOnlySize int64 `json:"webcam_only_size,omitempty"`
+	TotalSize      int64 `json:"total_size"`
 
 	// Video metadata for each file
-	VideoMeta  *VideoFileMetadata `json:"video_meta,omitempty"`
-	WebcamMeta *VideoFileMetadata `json:"webcam_meta,omitempty"`
-	MergedMeta   *VideoFileMetadata `json:"merged_meta,omitempty"`
-	VerticalMeta *VideoFileMetadata `json:"vertical_meta,omitempty"`
+	VideoMeta      *VideoFileMetadata `json:"video_meta,omitempty"`
+	WebcamMeta     *VideoFileMetadata `json:"webcam_meta,omitempty"`
+	MergedMeta     *VideoFileMetadata `json:"merged_meta,omitempty"`
+	VerticalMeta   *VideoFileMetadata `json:"vertical_meta,omitempty"`
+	WebcamOnlyMeta *VideoFileMetadata `json:"webcam_only_meta,omitempty"`
 }
 
 // RecordingSettings contains the settings used for recording
@@ -111,8 +129,12 @@ type RecordingSettings struct {
 	WebcamEnabled  bool `json:"webcam_enabled"`
 
 	// Output options
-	VerticalEnabled bool `json:"vertical_enabled"` // Whether vertical video will be created
-	LogosEnabled    bool `json:"logos_enabled"`    // Whether logos will be added
+	VerticalEnabled   bool `json:"vertical_enabled"`              // Whether vertical video will be created
+	WebcamOnlyEnabled bool `json:"webcam_only_enabled,omitempty"` // Whether the webcam-only video will be created
+	LogosEnabled      bool `json:"logos_enabled"`                 // Whether logos will be added
+
+	// Minutes of recording after which it stops by itself (0 = unlimited)
+	MaxDuration int `json:"max_duration,omitempty"`
 
 	// Hardware/device settings
 	HardwareAccel bool   `json:"hardware_accel"`
@@ -121,7 +143,26 @@ type RecordingSettings struct {
 	WebcamFPS     int    `json:"webcam_fps,omitempty"`
 
 	// Processing options
-	NormalizeEnabled bool `json:"normalize_enabled"`
+	NormalizeEnabled bool   `json:"normalize_enabled"`
+	OutputResolution string `json:"output_resolution,omitempty"` // native, 1080p or 720p (empty = native)
+	FrameRate        int    `json:"frame_rate,omitempty"`        // Output frames per second: 24, 30 or 60 (0 = 30)
+	PiPCorner        string `json:"pip_corner,omitempty"`        // Landscape webcam overlay corner (empty = bottom-right)
+	PiPSize          string `json:"pip_size,omitempty"`          // Landscape webcam overlay size: small, medium or large
+
+	// Webcam placement on the vertical video (empty = below the screen,
+	// centered, filling the space)
+	VerticalWebcamPosition string `json:"vertical_webcam_position,omitempty"` // e.g. bottom-center or top-left
+	VerticalWebcamSize     string `json:"vertical_webcam_size,omitempty"`     // small, medium or large
+
+	// Part of the recording kept when processing, in seconds of the recorded
+	// video (0 = from the start, to the end). The recorded files are kept
+	// whole, so the trim can be changed and the recording processed again.
+	TrimStart float64 `json:"trim_start_seconds,omitempty"`
+	TrimEnd   float64 `json:"trim_end_seconds,omitempty"`
+
+	// Filter presets attached to the recording, copied from the preset
+	// library so reprocessing gives the same result if the library changes
+	FilterPresets []FilterPreset `json:"filter_presets,omitempty"`
 
 	// Logo settings (if logos enabled)
 	LeftLogo    string `json:"left_logo,omitempty"`
@@ -130,19 +171,39 @@ type RecordingSettings struct {
 	TitleColor  string `json:"title_color,omitempty"`
 	GifLoopMode string `json:"gif_loop_mode,omitempty"`
 	BgColor     string `json:"bg_color,omitempty"` // Background color for vertical video lower third
+	LogoFit     string `json:"logo_fit,omitempty"` // How logos are fitted into their slots: contain or cover
+}
+
+// PausePoint is a pause of the recording. Offset is the position in the
+// processed video where the recording continues after it.
+type PausePoint struct {
+	PausedAt  time.Time `json:"paused_at"`
+	ResumedAt time.Time `json:"resumed_at,omitempty"`
+	Offset    float64   `json:"offset_seconds"`
 }
 
 // ProcessingInfo contains information about post-processing
 type ProcessingInfo struct {
-	ProcessedAt      time.Time     `json:"processed_at,omitempty"`
-	ProcessingTime   time.Duration `json:"processing_time,omitempty"`
-	NormalizeApplied bool          `json:"normalize_applied"`
-	VerticalCreated  bool          `json:"vertical_created"`
-	Errors           []string      `json:"errors,omitempty"`
+	ProcessedAt       time.Time     `json:"processed_at,omitempty"`
+	ProcessingTime    time.Duration `json:"processing_time,omitempty"`
+	NormalizeApplied  bool          `json:"normalize_applied"`
+	NormalizedLUFS    float64       `json:"normalized_lufs,omitempty"` // Loudness target the audio was normalized to
+	VerticalCreated   bool          `json:"vertical_created"`
+	WebcamOnlyCreated bool          `json:"webcam_only_created,omitempty"`
+	Errors            []string      `json:"errors,omitempty"`
 	// ErrorDetail provides a detailed, user-friendly explanation of what went wrong
 	ErrorDetail string `json:"error_detail,omitempty"`
 	// Traceback contains the full stack trace or error chain for debugging
 	Traceback string `json:"traceback,omitempty"`
+	// LogFile is the processing log with every ffmpeg command and its output,
+	// written for every run whether it succeeded or not
+	LogFile string `json:"log_file,omitempty"`
+	// Commands are the ffmpeg command lines of the last run, in the order
+	// they ran, so a failing step can be inspected or run by hand
+	Commands []string `json:"commands,omitempty"`
+	// SourceDuration is the length in seconds of the recorded video before
+	// trimming, measured when processing
+	SourceDuration float64 `json:"source_duration_seconds,omitempty"`
 }
 
 // NewRecordingInfo creates a new RecordingInfo with system information populated
@@ -181,6 +242,21 @@ func (r *RecordingInfo) SetEndTime(t time.Time) {
 	r.UpdatedAt = time.Now()
 }
 
+// ResetProcessing marks the recording as processing and clears the results
+// and errors of any previous run, before it is reprocessed
+func (r *RecordingInfo) ResetProcessing() {
+	r.SetStatus(StatusProcessing)
+	r.Processing.Errors = nil
+	r.Processing.ErrorDetail = ""
+	r.Processing.Traceback = ""
+	r.Processing.Commands = nil
+	r.Processing.ProcessedAt = time.Time{}
+	r.Processing.NormalizeApplied = false
+	r.Processing.NormalizedLUFS = 0
+	r.Processing.VerticalCreated = false
+	r.Processing.WebcamOnlyCreated = false
+}
+
 // Save saves the recording info to a JSON file in the recording folder
 func (r *RecordingInfo) Save() error {
 	if r.Files.FolderPath == "" {
@@ -215,6 +291,10 @@ func LoadRecordingInfo(folderPath string) (*RecordingInfo, error) {
 	// Fix file paths to use the actual folder path (in case folder was moved or user changed)
 	info.fixFilePaths(folderPath)
 
+	// Move the single upload of older files into the list of uploads, so the
+	// next save only writes the new shape
+	info.Metadata.MigrateLegacyYouTube()
+
 	return &info, nil
 }
 
@@ -238,6 +318,7 @@ func (r *RecordingInfo) fixFilePaths(folderPath string) {
 	r.Files.WebcamFile = fixPath(r.Files.WebcamFile)
 	r.Files.MergedFile = fixPath(r.Files.MergedFile)
 	r.Files.VerticalFile = fixPath(r.Files.VerticalFile)
+	r.Files.WebcamOnlyFile = fixPath(r.Files.WebcamOnlyFile)
 
 	// Fix part file paths
 	for i, part := range r.Files.VideoParts {
@@ -251,6 +332,59 @@ func (r *RecordingInfo) fixFilePaths(folderPath string) {
 	}
 }
 
+// ValidateFolderName returns an error if name cannot be used as the name of
+// a recording folder
+func ValidateFolderName(name string) error {
+	switch {
+	case strings.TrimSpace(name) == "":
+		return fmt.Errorf("folder name is empty")
+	case strings.TrimSpace(name) != name:
+		return fmt.Errorf("folder name cannot start or end with a space")
+	case strings.HasPrefix(name, "."):
+		return fmt.Errorf("folder name cannot start with a dot")
+	case strings.ContainsAny(name, "/\\\x00"):
+		return fmt.Errorf("folder name cannot contain / or \\")
+	}
+	return nil
+}
+
+// RenameFolder renames the recording folder to newName in the same parent
+// directory, updates the paths of its files and saves recording.json.
+// Recordings that are being recorded or processed cannot be renamed.
+func (r *RecordingInfo) RenameFolder(newName string) error {
+	switch r.Status {
+	case StatusRecording, StatusPaused, StatusProcessing:
+		return fmt.Errorf("cannot rename a recording while it is %s", r.Status)
+	}
+	if err := ValidateFolderName(newName); err != nil {
+		return err
+	}
+
+	oldPath := r.Files.FolderPath
+	newPath := filepath.Join(filepath.Dir(oldPath), newName)
+	if newPath == oldPath {
+		return nil
+	}
+	if _, err := os.Lstat(newPath); err == nil {
+		return fmt.Errorf("a folder named %s already exists", newName)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Rename(oldPath, newPath); err != nil {
+		return fmt.Errorf("failed to rename folder: %w", err)
+	}
+
+	r.fixFilePaths(newPath)
+	if r.Processing.LogFile != "" {
+		r.Processing.LogFile = filepath.Join(newPath, filepath.Base(r.Processing.LogFile))
+	}
+	r.Metadata.FolderName = newName
+	if err := r.Save(); err != nil {
+		return fmt.Errorf("folder renamed but recording.json could not be saved: %w", err)
+	}
+	return nil
+}
+
 // UpdateFileSizes updates the file size information
 func (r *RecordingInfo) UpdateFileSizes() {
 	r.Files.TotalSize = 0
@@ -290,6 +424,13 @@ func (r *RecordingInfo) UpdateFileSizes() {
 		}
 	}
 
+	if r.Files.WebcamOnlyFile != "" {
+		if stat, err := os.Stat(r.Files.WebcamOnlyFile); err == nil {
+			r.Files.WebcamOnlySize = stat.Size()
+			r.Files.TotalSize += stat.Size()
+		}
+	}
+
 	r.UpdatedAt = time.Now()
 }
 
@@ -327,6 +468,12 @@ func (r *RecordingInfo) UpdateVideoMetadata(getInfo VideoInfoFunc) {
 		}
 	}
 
+	if r.Files.WebcamOnlyFile != "" {
+		if meta, err := getInfo(r.Files.WebcamOnlyFile); err == nil {
+			r.Files.WebcamOnlyMeta = meta
+		}
+	}
+
 	r.UpdatedAt = time.Now()
 }
 
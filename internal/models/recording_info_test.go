@@ -0,0 +1,125 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateFolderName(t *testing.T) {
+	for _, name := range []string{"", "  ", " 001-demo", ".trash", "..", "a/b", `a\b`, "a\x00b"} {
+		if ValidateFolderName(name) == nil {
+			t.Errorf("ValidateFolderName(%q) = nil, want an error", name)
+		}
+	}
+	for _, name := range []string{"001-demo", "002 QGIS intro", "demo.v2"} {
+		if err := ValidateFolderName(name); err != nil {
+			t.Errorf("ValidateFolderName(%q) = %v", name, err)
+		}
+	}
+}
+
+func TestRenameFolder(t *testing.T) {
+	videosDir := t.TempDir()
+	folder := filepath.Join(videosDir, "001-demo")
+	if err := os.Mkdir(folder, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(videosDir, "003-taken"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	var rec RecordingInfo
+	rec.Status = StatusCompleted
+	rec.Metadata.FolderName = "001-demo"
+	rec.fixFilePaths(folder)
+	rec.Files.VideoFile = filepath.Join(folder, "screen.mp4")
+	rec.Files.MergedFile = filepath.Join(folder, "screen-merged.mp4")
+	rec.Files.AudioParts = []string{filepath.Join(folder, "audio_part000.wav")}
+	rec.Processing.LogFile = filepath.Join(folder, "processing.log")
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rec.RenameFolder("003-taken"); err == nil {
+		t.Error("expected renaming onto an existing folder to fail")
+	}
+	rec.Status = StatusProcessing
+	if err := rec.RenameFolder("002-demo"); err == nil {
+		t.Error("expected renaming a recording being processed to fail")
+	}
+	rec.Status = StatusCompleted
+
+	if err := rec.RenameFolder("002-demo"); err != nil {
+		t.Fatalf("RenameFolder() = %v", err)
+	}
+	renamed := filepath.Join(videosDir, "002-demo")
+	if _, err := os.Stat(folder); !os.IsNotExist(err) {
+		t.Errorf("expected the old folder to be gone, stat error %v", err)
+	}
+
+	loaded, err := LoadRecordingInfo(renamed)
+	if err != nil {
+		t.Fatalf("recording.json not found in the renamed folder: %v", err)
+	}
+	for name, got := range map[string]string{
+		"folder":     rec.Files.FolderPath,
+		"video":      filepath.Dir(rec.Files.VideoFile),
+		"merged":     filepath.Dir(rec.Files.MergedFile),
+		"audio part": filepath.Dir(rec.Files.AudioParts[0]),
+		"log":        filepath.Dir(rec.Processing.LogFile),
+		"saved":      loaded.Files.FolderPath,
+	} {
+		if got != renamed {
+			t.Errorf("%s path is in %s, want %s", name, got, renamed)
+		}
+	}
+	if loaded.Metadata.FolderName != "002-demo" {
+		t.Errorf("saved folder name = %q", loaded.Metadata.FolderName)
+	}
+}
+
+func TestLoadRecordingInfo_MigratesSingleYouTubeUpload(t *testing.T) {
+	folder := t.TempDir()
+	infoPath := filepath.Join(folder, "recording.json")
+	preSeries := `{
+  "metadata": {
+    "title": "QGIS intro",
+    "youtube": {
+      "video_id": "abc123",
+      "video_url": "https://youtu.be/abc123",
+      "privacy": "unlisted"
+    }
+  }
+}`
+	if err := os.WriteFile(infoPath, []byte(preSeries), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err := LoadRecordingInfo(folder)
+	if err != nil {
+		t.Fatalf("LoadRecordingInfo() = %v", err)
+	}
+	if rec.Metadata.LegacyYouTube != nil {
+		t.Error("expected the single upload to be moved into the list")
+	}
+	uploads := rec.Metadata.YouTubeUploads
+	if len(uploads) != 1 || uploads[0].VideoID != "abc123" || uploads[0].Privacy != "unlisted" {
+		t.Fatalf("uploads = %+v, want the single upload", uploads)
+	}
+	if yt := rec.Metadata.PrimaryYouTube(); yt == nil || yt.VideoURL != "https://youtu.be/abc123" {
+		t.Errorf("primary = %+v, want the migrated upload", yt)
+	}
+
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(infoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), `"youtube":`) || !strings.Contains(string(data), `"youtube_uploads":`) {
+		t.Errorf("saved recording.json still uses the old shape:\n%s", data)
+	}
+}
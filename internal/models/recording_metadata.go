@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 )
 
 // RecordingMetadata holds user-provided metadata for a recording
@@ -15,29 +16,257 @@ type RecordingMetadata struct {
 	Presenter   string `json:"presenter"`
 	FolderName  string `json:"folder_name,omitempty"`
 
-	// YouTube upload information
-	YouTube *YouTubeMetadata `json:"youtube,omitempty"`
+	// YouTubeUploads lists every channel the recording was uploaded to. The
+	// first one is the primary destination, see PrimaryYouTube.
+	YouTubeUploads []YouTubeUpload `json:"youtube_uploads,omitempty"`
+
+	// LegacyYouTube is the single upload of recording.json files written
+	// before multi-account uploads. It is only read on load and moved into
+	// YouTubeUploads by MigrateLegacyYouTube.
+	LegacyYouTube *YouTubeUpload `json:"youtube,omitempty"`
+
+	// History of videos deleted from YouTube (most recent last)
+	YouTubeDeletions []YouTubeDeletion `json:"youtube_deletions,omitempty"`
 
 	// Syndication information (posts to other platforms)
 	Syndication *SyndicationMetadata `json:"syndication,omitempty"`
+
+	// Upload settings from the recording template, used to fill in the
+	// YouTube upload form
+	UploadDefaults *UploadDefaults `json:"upload_defaults,omitempty"`
 }
 
-// YouTubeMetadata holds information about a video uploaded to YouTube
-type YouTubeMetadata struct {
-	VideoID      string `json:"video_id"`
-	VideoURL     string `json:"video_url"`
-	PlaylistID   string `json:"playlist_id,omitempty"`
-	PlaylistName string `json:"playlist_name,omitempty"`
-	Privacy      string `json:"privacy"` // public, unlisted, private
-	UploadedAt   string `json:"uploaded_at"`
-	ThumbnailURL string `json:"thumbnail_url,omitempty"`
-	ChannelID    string `json:"channel_id,omitempty"`
-	ChannelName  string `json:"channel_name,omitempty"`
+// UploadDefaults are YouTube upload settings chosen before recording
+type UploadDefaults struct {
+	Template string   `json:"template,omitempty"` // Name of the recording template
+	Tags     []string `json:"tags,omitempty"`
+	Privacy  string   `json:"privacy,omitempty"`  // public, unlisted or private
+	Playlist string   `json:"playlist,omitempty"` // Playlist title
+}
+
+// YouTubeUpload holds information about a video uploaded to YouTube
+type YouTubeUpload struct {
+	VideoID       string `json:"video_id"`
+	VideoURL      string `json:"video_url"`
+	PlaylistID    string `json:"playlist_id,omitempty"`
+	PlaylistName  string `json:"playlist_name,omitempty"`
+	Privacy       string `json:"privacy"` // public, unlisted, private
+	UploadedAt    string `json:"uploaded_at"`
+	ThumbnailURL  string `json:"thumbnail_url,omitempty"`
+	ChannelID     string `json:"channel_id,omitempty"`
+	ChannelName   string `json:"channel_name,omitempty"`
+	AccountID     string `json:"account_id,omitempty"`
+	MadeForKids   bool   `json:"made_for_kids"`            // Audience declared at upload
+	CategoryID    string `json:"category_id,omitempty"`    // YouTube category ID
+	Language      string `json:"language,omitempty"`       // BCP-47 language of the title and description
+	AudioLanguage string `json:"audio_language,omitempty"` // BCP-47 language spoken in the video
+	License       string `json:"license,omitempty"`        // youtube or creativeCommon (empty = youtube)
+	MissingSince  string `json:"missing_since,omitempty"`  // Set when verification found the video gone from YouTube
+	PublishAt     string `json:"publish_at,omitempty"`     // RFC3339 time YouTube makes the private video public
+
+	// Tags of the video, as uploaded or last changed from here
+	Tags []string `json:"tags,omitempty"`
+
+	// Translated titles and descriptions set after upload, by BCP-47 language
+	Localizations map[string]Localization `json:"localizations,omitempty"`
+}
+
+// Localization is a translated title and description of a YouTube video
+type Localization struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
+
+// PrivacyLabel describes the privacy of the video, e.g. "Scheduled for Jan 5
+// 09:00" for a private video YouTube has yet to publish
+func (y *YouTubeUpload) PrivacyLabel(now time.Time) string {
+	if y.PublishAt == "" || y.Privacy != "private" {
+		return y.Privacy
+	}
+	publishAt, err := time.Parse(time.RFC3339, y.PublishAt)
+	if err != nil {
+		return y.Privacy
+	}
+	if publishAt.After(now) {
+		return "Scheduled for " + publishAt.Local().Format("Jan 2 15:04")
+	}
+	return "public (scheduled " + publishAt.Local().Format("Jan 2 15:04") + ")"
 }
 
 // IsPublishedToYouTube returns true if the recording has been uploaded to YouTube
 func (m *RecordingMetadata) IsPublishedToYouTube() bool {
-	return m.YouTube != nil && m.YouTube.VideoID != ""
+	primary := m.PrimaryYouTube()
+	return primary != nil && primary.VideoID != ""
+}
+
+// PrimaryYouTube returns the primary YouTube destination, used by
+// single-destination actions such as changing the privacy or deleting the
+// video, or nil if the recording was not uploaded
+func (m *RecordingMetadata) PrimaryYouTube() *YouTubeUpload {
+	if len(m.YouTubeUploads) > 0 {
+		return &m.YouTubeUploads[0]
+	}
+	return m.LegacyYouTube
+}
+
+// AllYouTubeUploads returns every YouTube destination of the recording,
+// including a legacy single upload that was not migrated yet
+func (m *RecordingMetadata) AllYouTubeUploads() []YouTubeUpload {
+	if len(m.YouTubeUploads) == 0 && m.LegacyYouTube != nil && m.LegacyYouTube.VideoID != "" {
+		return []YouTubeUpload{*m.LegacyYouTube}
+	}
+	return m.YouTubeUploads
+}
+
+// MigrateLegacyYouTube moves the single upload of an older recording.json
+// into YouTubeUploads, so only the list is saved
+func (m *RecordingMetadata) MigrateLegacyYouTube() {
+	legacy := m.LegacyYouTube
+	m.LegacyYouTube = nil
+	if legacy == nil || legacy.VideoID == "" {
+		return
+	}
+	for _, upload := range m.YouTubeUploads {
+		if upload.VideoID == legacy.VideoID {
+			return
+		}
+	}
+	m.YouTubeUploads = append([]YouTubeUpload{*legacy}, m.YouTubeUploads...)
+}
+
+// AddYouTubeUpload records an upload to a YouTube channel. The first upload
+// becomes the primary destination.
+func (m *RecordingMetadata) AddYouTubeUpload(upload YouTubeUpload) {
+	m.MigrateLegacyYouTube()
+	m.YouTubeUploads = append(m.YouTubeUploads, upload)
+}
+
+// uploadOf returns the upload of the given video, or nil
+func (m *RecordingMetadata) uploadOf(videoID string) *YouTubeUpload {
+	m.MigrateLegacyYouTube()
+	for i := range m.YouTubeUploads {
+		if m.YouTubeUploads[i].VideoID == videoID {
+			return &m.YouTubeUploads[i]
+		}
+	}
+	return nil
+}
+
+// SetYouTubePrivacy updates the recorded privacy status of the given video.
+// Changing it by hand cancels the scheduled publish time.
+func (m *RecordingMetadata) SetYouTubePrivacy(videoID, privacy string) {
+	if upload := m.uploadOf(videoID); upload != nil {
+		upload.Privacy = privacy
+		upload.PublishAt = ""
+	}
+}
+
+// SetYouTubeTags updates the recorded tags of the given video
+func (m *RecordingMetadata) SetYouTubeTags(videoID string, tags []string) {
+	if upload := m.uploadOf(videoID); upload != nil {
+		upload.Tags = tags
+	}
+}
+
+// SetYouTubePlaylist updates the recorded playlist of the given video (empty
+// when it is in no playlist)
+func (m *RecordingMetadata) SetYouTubePlaylist(videoID, playlistID, playlistName string) {
+	if upload := m.uploadOf(videoID); upload != nil {
+		upload.PlaylistID = playlistID
+		upload.PlaylistName = playlistName
+	}
+}
+
+// SetYouTubeLocalizations updates the stored translations of a YouTube video
+func (m *RecordingMetadata) SetYouTubeLocalizations(videoID string, localizations map[string]Localization) {
+	if upload := m.uploadOf(videoID); upload != nil {
+		upload.Localizations = localizations
+	}
+}
+
+// SetYouTubeMissing flags (or unflags) a video that verification found is no
+// longer on YouTube. Returns true if the metadata changed.
+func (m *RecordingMetadata) SetYouTubeMissing(videoID string, missing bool) bool {
+	upload := m.uploadOf(videoID)
+	if upload == nil || (upload.MissingSince != "") == missing {
+		return false
+	}
+	upload.MissingSince = ""
+	if missing {
+		upload.MissingSince = time.Now().Format(time.RFC3339)
+	}
+	return true
+}
+
+// HasMissingYouTubeVideo returns true if any uploaded video was found to be
+// gone from YouTube
+func (m *RecordingMetadata) HasMissingYouTubeVideo() bool {
+	for _, upload := range m.AllYouTubeUploads() {
+		if upload.MissingSince != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ClearMissingYouTubeUploads moves the uploads that are gone from YouTube into
+// the deletion history. The next remaining upload, if any, becomes the
+// primary destination.
+func (m *RecordingMetadata) ClearMissingYouTubeUploads() {
+	m.MigrateLegacyYouTube()
+	var remaining []YouTubeUpload
+	for _, upload := range m.YouTubeUploads {
+		if upload.MissingSince == "" {
+			remaining = append(remaining, upload)
+			continue
+		}
+		m.YouTubeDeletions = append(m.YouTubeDeletions, YouTubeDeletion{
+			VideoID:     upload.VideoID,
+			VideoURL:    upload.VideoURL,
+			Title:       m.Title,
+			Privacy:     upload.Privacy,
+			ChannelName: upload.ChannelName,
+			DeletedAt:   upload.MissingSince,
+		})
+	}
+	m.YouTubeUploads = remaining
+}
+
+// YouTubeDeletion records a video that was deleted from YouTube
+type YouTubeDeletion struct {
+	VideoID     string `json:"video_id"`
+	VideoURL    string `json:"video_url"`
+	Title       string `json:"title"`   // Local recording title, not the title on YouTube
+	Privacy     string `json:"privacy"` // Privacy status at the time of deletion
+	ChannelName string `json:"channel_name,omitempty"`
+	DeletedAt   string `json:"deleted_at"`
+}
+
+// RecordYouTubeDeletion adds the primary YouTube video to the deletion history
+// and removes it from the uploads. The next remaining upload, if any, becomes
+// the primary destination. The history keeps the local recording title, as
+// the title the video had on YouTube is not stored.
+func (m *RecordingMetadata) RecordYouTubeDeletion() {
+	m.MigrateLegacyYouTube()
+	if len(m.YouTubeUploads) == 0 {
+		return
+	}
+	primary := m.YouTubeUploads[0]
+	m.YouTubeDeletions = append(m.YouTubeDeletions, YouTubeDeletion{
+		VideoID:     primary.VideoID,
+		VideoURL:    primary.VideoURL,
+		Title:       m.Title,
+		Privacy:     primary.Privacy,
+		ChannelName: primary.ChannelName,
+		DeletedAt:   time.Now().Format(time.RFC3339),
+	})
+	var remaining []YouTubeUpload
+	for _, upload := range m.YouTubeUploads {
+		if upload.VideoID != primary.VideoID {
+			remaining = append(remaining, upload)
+		}
+	}
+	m.YouTubeUploads = remaining
 }
 
 // SyndicationPost represents a single syndication post to a platform
@@ -142,6 +371,10 @@ func sanitizeForFilename(s string) string {
 type Topic struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
+
+	// Sensitive topics, e.g. internal ones, are uploaded as private by
+	// default and show a reminder when recording or uploading
+	Sensitive bool `json:"sensitive,omitempty"`
 }
 
 // DefaultTopics returns a list of default topics
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+// videoIDs returns the video IDs of uploads
+func videoIDs(uploads []YouTubeUpload) []string {
+	var ids []string
+	for _, upload := range uploads {
+		ids = append(ids, upload.VideoID)
+	}
+	return ids
+}
+
+func TestAddYouTubeUpload(t *testing.T) {
+	var m RecordingMetadata
+	if m.IsPublishedToYouTube() || m.PrimaryYouTube() != nil || m.AllYouTubeUploads() != nil {
+		t.Fatal("expected a new recording not to be on YouTube")
+	}
+
+	m.AddYouTubeUpload(YouTubeUpload{VideoID: "a1", ChannelID: "UC1"})
+	m.AddYouTubeUpload(YouTubeUpload{VideoID: "b1", ChannelID: "UC2"})
+	if got := videoIDs(m.AllYouTubeUploads()); !reflect.DeepEqual(got, []string{"a1", "b1"}) {
+		t.Errorf("uploads = %v, want both in upload order", got)
+	}
+	if !m.IsPublishedToYouTube() || m.PrimaryYouTube().VideoID != "a1" {
+		t.Errorf("primary = %+v, want the first upload", m.PrimaryYouTube())
+	}
+}
+
+func TestAddYouTubeUpload_Legacy(t *testing.T) {
+	m := RecordingMetadata{LegacyYouTube: &YouTubeUpload{VideoID: "old", Privacy: "unlisted"}}
+	if got := videoIDs(m.AllYouTubeUploads()); !reflect.DeepEqual(got, []string{"old"}) {
+		t.Errorf("uploads = %v, want the legacy upload", got)
+	}
+	if !m.IsPublishedToYouTube() || m.PrimaryYouTube().VideoID != "old" {
+		t.Errorf("primary = %+v, want the legacy upload", m.PrimaryYouTube())
+	}
+
+	m.AddYouTubeUpload(YouTubeUpload{VideoID: "new"})
+	if m.LegacyYouTube != nil {
+		t.Error("expected the legacy upload to be moved into the list")
+	}
+	if got := videoIDs(m.YouTubeUploads); !reflect.DeepEqual(got, []string{"old", "new"}) {
+		t.Errorf("uploads = %v, want the legacy upload kept first", got)
+	}
+
+	// A legacy upload without a video is dropped
+	m = RecordingMetadata{LegacyYouTube: &YouTubeUpload{}}
+	if m.AllYouTubeUploads() != nil {
+		t.Error("expected no uploads from an empty legacy value")
+	}
+	m.AddYouTubeUpload(YouTubeUpload{VideoID: "new"})
+	if got := videoIDs(m.YouTubeUploads); !reflect.DeepEqual(got, []string{"new"}) {
+		t.Errorf("uploads = %v, want only the new upload", got)
+	}
+}
+
+func TestMigrateLegacyYouTube_KeepsListedUpload(t *testing.T) {
+	m := RecordingMetadata{
+		LegacyYouTube:  &YouTubeUpload{VideoID: "a1"},
+		YouTubeUploads: []YouTubeUpload{{VideoID: "a1"}, {VideoID: "b1"}},
+	}
+	m.MigrateLegacyYouTube()
+	if m.LegacyYouTube != nil {
+		t.Error("expected the legacy field to be cleared")
+	}
+	if got := videoIDs(m.YouTubeUploads); !reflect.DeepEqual(got, []string{"a1", "b1"}) {
+		t.Errorf("uploads = %v, want the upload listed once", got)
+	}
+}
+
+func TestSetYouTubeFields(t *testing.T) {
+	m := RecordingMetadata{LegacyYouTube: &YouTubeUpload{VideoID: "a1", Privacy: "private", PublishAt: "2026-01-05T09:00:00Z"}}
+	m.AddYouTubeUpload(YouTubeUpload{VideoID: "b1", Privacy: "unlisted"})
+
+	m.SetYouTubePrivacy("a1", "public")
+	m.SetYouTubeTags("b1", []string{"qgis"})
+	m.SetYouTubePlaylist("a1", "PL1", "Tutorials")
+	m.SetYouTubeLocalizations("b1", map[string]Localization{"de": {Title: "Einführung"}})
+
+	a1, b1 := m.YouTubeUploads[0], m.YouTubeUploads[1]
+	if a1.Privacy != "public" || a1.PublishAt != "" || a1.PlaylistName != "Tutorials" || a1.Tags != nil {
+		t.Errorf("a1 = %+v, want public, unscheduled, in Tutorials", a1)
+	}
+	if b1.Privacy != "unlisted" || !reflect.DeepEqual(b1.Tags, []string{"qgis"}) || b1.Localizations["de"].Title != "Einführung" {
+		t.Errorf("b1 = %+v, want only its tags and translation changed", b1)
+	}
+
+	if !m.SetYouTubeMissing("b1", true) || m.SetYouTubeMissing("b1", true) {
+		t.Error("expected flagging a video as missing to change the metadata once")
+	}
+	if !m.HasMissingYouTubeVideo() || m.YouTubeUploads[0].MissingSince != "" {
+		t.Error("expected only b1 to be flagged")
+	}
+	if m.SetYouTubeMissing("unknown", true) {
+		t.Error("expected an unknown video not to change the metadata")
+	}
+}
+
+func TestClearMissingYouTubeUploads(t *testing.T) {
+	m := RecordingMetadata{Title: "QGIS intro"}
+	m.AddYouTubeUpload(YouTubeUpload{VideoID: "a1", ChannelName: "Personal"})
+	m.AddYouTubeUpload(YouTubeUpload{VideoID: "b1", ChannelName: "Brand"})
+	m.SetYouTubeMissing("a1", true)
+
+	m.ClearMissingYouTubeUploads()
+	if got := videoIDs(m.YouTubeUploads); !reflect.DeepEqual(got, []string{"b1"}) {
+		t.Errorf("uploads = %v, want only the video still on YouTube", got)
+	}
+	if m.PrimaryYouTube().VideoID != "b1" {
+		t.Errorf("primary = %s, want b1 promoted", m.PrimaryYouTube().VideoID)
+	}
+	if len(m.YouTubeDeletions) != 1 || m.YouTubeDeletions[0].VideoID != "a1" || m.YouTubeDeletions[0].ChannelName != "Personal" {
+		t.Errorf("deletions = %+v, want a1 recorded", m.YouTubeDeletions)
+	}
+}
+
+func TestRecordYouTubeDeletion(t *testing.T) {
+	m := RecordingMetadata{Title: "QGIS intro"}
+	m.AddYouTubeUpload(YouTubeUpload{VideoID: "a1", VideoURL: "https://youtu.be/a1", Privacy: "public", ChannelName: "Personal"})
+	m.AddYouTubeUpload(YouTubeUpload{VideoID: "b1", Privacy: "unlisted", ChannelName: "Brand"})
+
+	m.RecordYouTubeDeletion()
+	if got := videoIDs(m.YouTubeUploads); !reflect.DeepEqual(got, []string{"b1"}) {
+		t.Errorf("uploads = %v, want only the other channel's video", got)
+	}
+	if m.PrimaryYouTube().VideoID != "b1" {
+		t.Errorf("primary = %s, want b1 promoted", m.PrimaryYouTube().VideoID)
+	}
+	if len(m.YouTubeDeletions) != 1 {
+		t.Fatalf("deletions = %+v, want one entry", m.YouTubeDeletions)
+	}
+	d := m.YouTubeDeletions[0]
+	if d.VideoID != "a1" || d.VideoURL != "https://youtu.be/a1" || d.Title != "QGIS intro" ||
+		d.Privacy != "public" || d.ChannelName != "Personal" || d.DeletedAt == "" {
+		t.Errorf("deletion = %+v, want a1 recorded with the local title", d)
+	}
+
+	m.RecordYouTubeDeletion()
+	m.RecordYouTubeDeletion()
+	if m.IsPublishedToYouTube() || len(m.YouTubeDeletions) != 2 {
+		t.Errorf("deletions = %+v, want b1 recorded and nothing for the empty list", m.YouTubeDeletions)
+	}
+}
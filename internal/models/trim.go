@@ -0,0 +1,109 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseTrimTime parses a trim position given in seconds ("90", "90.5") or as
+// M:SS or H:MM:SS ("1:30", "1:02:03"). An empty string is 0, no trim.
+func ParseTrimTime(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("invalid time %q, want seconds or mm:ss", s)
+	}
+	var seconds float64
+	for i, part := range parts {
+		v, err := strconv.ParseFloat(part, 64)
+		last := i == len(parts)-1
+		// Only the seconds may have a fraction, and below a minute when
+		// minutes are given
+		if err != nil || v < 0 || (!last && v != float64(int(v))) || (i > 0 && v >= 60) {
+			return 0, fmt.Errorf("invalid time %q, want seconds or mm:ss", s)
+		}
+		seconds = seconds*60 + v
+	}
+	return time.Duration(seconds * float64(time.Second)).Round(time.Millisecond), nil
+}
+
+// FormatTrimTime formats a trim position as M:SS or H:MM:SS, with the
+// fraction of a second if there is one. 0 is formatted as "".
+func FormatTrimTime(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	total := int(d / time.Second)
+	h, m, s := total/3600, total/60%60, total%60
+	text := fmt.Sprintf("%d:%02d", total/60, s)
+	if h > 0 {
+		text = fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	if frac := d % time.Second; frac > 0 {
+		text += strings.TrimPrefix(strconv.FormatFloat(frac.Seconds(), 'f', -1, 64), "0")
+	}
+	return text
+}
+
+// ValidateTrim checks that a trim keeps part of a recording: start before
+// end, and both before the end of the source when its length is known.
+// end 0 keeps everything after start.
+func ValidateTrim(start, end, source time.Duration) error {
+	if start < 0 || end < 0 {
+		return fmt.Errorf("trim times cannot be negative")
+	}
+	if end > 0 && start >= end {
+		return fmt.Errorf("trim start %s must be before the end %s", FormatTrimTime(start), FormatTrimTime(end))
+	}
+	if source <= 0 {
+		return nil
+	}
+	if start >= source {
+		return fmt.Errorf("trim start %s must be before the end of the recording (%s)", FormatTrimTime(start), FormatTrimTime(source))
+	}
+	if end >= source {
+		return fmt.Errorf("trim end %s must be before the end of the recording (%s)", FormatTrimTime(end), FormatTrimTime(source))
+	}
+	return nil
+}
+
+// Trim returns the part of the recording kept when it is processed, as
+// positions in the recorded video. end 0 keeps everything after start.
+func (s RecordingSettings) Trim() (start, end time.Duration) {
+	return secondsDuration(s.TrimStart), secondsDuration(s.TrimEnd)
+}
+
+// Trimmed returns true if part of the recording is trimmed away
+func (s RecordingSettings) Trimmed() bool {
+	return s.TrimStart > 0 || s.TrimEnd > 0
+}
+
+// SourceDuration returns the length of the recorded video before trimming,
+// as measured when it was last processed, else the time it was recording for
+func (r *RecordingInfo) SourceDuration() time.Duration {
+	if r.Processing.SourceDuration > 0 {
+		return secondsDuration(r.Processing.SourceDuration)
+	}
+	return r.Duration
+}
+
+// TrimmedDuration returns the length of the video once trimmed
+func (r *RecordingInfo) TrimmedDuration() time.Duration {
+	start, end := r.Settings.Trim()
+	if end <= 0 {
+		end = r.SourceDuration()
+	}
+	if end <= start {
+		return 0
+	}
+	return end - start
+}
+
+func secondsDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Millisecond)
+}
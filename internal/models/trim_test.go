@@ -0,0 +1,84 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTrimTime(t *testing.T) {
+	valid := map[string]time.Duration{
+		"":        0,
+		"90":      90 * time.Second,
+		"90.5":    90500 * time.Millisecond,
+		"1:30":    90 * time.Second,
+		"1:02:03": time.Hour + 2*time.Minute + 3*time.Second,
+		" 0:45 ":  45 * time.Second,
+	}
+	for text, want := range valid {
+		got, err := ParseTrimTime(text)
+		if err != nil || got != want {
+			t.Errorf("ParseTrimTime(%q) = %v, %v; want %v", text, got, err, want)
+		}
+	}
+	for _, text := range []string{"abc", "1:75", "-5", "1.5:00", "1:2:3:4"} {
+		if _, err := ParseTrimTime(text); err == nil {
+			t.Errorf("ParseTrimTime(%q) succeeded, want an error", text)
+		}
+	}
+}
+
+func TestFormatTrimTime(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		0:                         "",
+		90 * time.Second:          "1:30",
+		90500 * time.Millisecond:  "1:30.5",
+		time.Hour + 3*time.Second: "1:00:03",
+	} {
+		if got := FormatTrimTime(d); got != want {
+			t.Errorf("FormatTrimTime(%v) = %q, want %q", d, got, want)
+		}
+		if back, _ := ParseTrimTime(want); back != d {
+			t.Errorf("ParseTrimTime(%q) = %v, want %v back", want, back, d)
+		}
+	}
+}
+
+func TestValidateTrim(t *testing.T) {
+	source := 10 * time.Minute
+	if err := ValidateTrim(30*time.Second, 9*time.Minute, source); err != nil {
+		t.Errorf("ValidateTrim() error: %v", err)
+	}
+	if err := ValidateTrim(30*time.Second, 0, source); err != nil {
+		t.Errorf("ValidateTrim() error = %v, want no end to keep the rest", err)
+	}
+	if err := ValidateTrim(time.Hour, 0, 0); err != nil {
+		t.Errorf("ValidateTrim() error = %v, want no check against an unknown length", err)
+	}
+	for _, trim := range [][2]time.Duration{
+		{2 * time.Minute, time.Minute},
+		{time.Minute, time.Minute},
+		{source, 0},
+		{0, source},
+		{-time.Second, 0},
+	} {
+		if err := ValidateTrim(trim[0], trim[1], source); err == nil {
+			t.Errorf("ValidateTrim(%v, %v) succeeded, want an error", trim[0], trim[1])
+		}
+	}
+}
+
+func TestTrimmedDuration(t *testing.T) {
+	rec := &RecordingInfo{Duration: 12 * time.Minute}
+	if got := rec.TrimmedDuration(); got != 12*time.Minute {
+		t.Errorf("TrimmedDuration() = %v, want the recording time until processed", got)
+	}
+	rec.Processing.SourceDuration = 600
+	rec.Settings.TrimStart = 45
+	if got := rec.TrimmedDuration(); got != 9*time.Minute+15*time.Second {
+		t.Errorf("TrimmedDuration() = %v, want the rest of the source after the start", got)
+	}
+	rec.Settings.TrimEnd = 345
+	if got := rec.TrimmedDuration(); got != 5*time.Minute {
+		t.Errorf("TrimmedDuration() = %v, want 5m", got)
+	}
+}
@@ -13,8 +13,16 @@ const (
 	UrgencyCritical Urgency = "critical"
 )
 
+// Disabled suppresses all notifications, e.g. while tests run the
+// processing pipeline
+var Disabled bool
+
 // Send sends a desktop notification using notify-send
 func Send(title, body string, urgency Urgency, icon string) error {
+	if Disabled {
+		return nil
+	}
+
 	args := []string{title, body}
 
 	if urgency != "" {
@@ -0,0 +1,168 @@
+// Package proclog writes the processing log kept in every recording folder.
+// It records each ffmpeg command line and its output, whether processing
+// succeeds or not, so quality issues can be diagnosed after the fact.
+package proclog
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileName is the name of the processing log inside a recording folder
+const FileName = "processing.log"
+
+// Logger writes command lines and their output to a log, and keeps the
+// command lines so they can be stored with the recording. A nil *Logger
+// discards everything, so callers don't need to check whether logging is on.
+type Logger struct {
+	mu       sync.Mutex
+	w        io.Writer
+	closer   io.Closer
+	path     string
+	commands []string
+	dryRun   bool
+}
+
+// New creates a logger writing to w
+func New(w io.Writer) *Logger {
+	return &Logger{w: w}
+}
+
+// NewDryRun creates a logger for a dry run: it keeps the command lines it is
+// given, discards everything else, and tells callers not to run the commands
+func NewDryRun() *Logger {
+	return &Logger{w: io.Discard, dryRun: true}
+}
+
+// Create creates (or truncates) the processing log in folderPath
+func Create(folderPath string) (*Logger, error) {
+	path := filepath.Join(folderPath, FileName)
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create processing log: %w", err)
+	}
+	return &Logger{w: f, closer: f, path: path}, nil
+}
+
+// Path returns the log file path (empty for loggers created with New)
+func (l *Logger) Path() string {
+	if l == nil {
+		return ""
+	}
+	return l.path
+}
+
+// Close closes the underlying log file
+func (l *Logger) Close() error {
+	if l == nil || l.closer == nil {
+		return nil
+	}
+	return l.closer.Close()
+}
+
+// Printf writes a timestamped line to the log
+func (l *Logger) Printf(format string, args ...interface{}) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = fmt.Fprintf(l.w, "[%s] %s\n", time.Now().Format("15:04:05"), fmt.Sprintf(format, args...))
+}
+
+// DryRun returns true if commands are only to be logged, not run
+func (l *Logger) DryRun() bool {
+	return l != nil && l.dryRun
+}
+
+// Command logs the command line of cmd before it runs
+func (l *Logger) Command(cmd *exec.Cmd) {
+	if l == nil {
+		return
+	}
+	line := QuoteCommand(cmd.Args)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.commands = append(l.commands, line)
+	_, _ = fmt.Fprintf(l.w, "\n[%s] $ %s\n", time.Now().Format("15:04:05"), line)
+}
+
+// Commands returns the command lines logged so far, in the order they ran
+func (l *Logger) Commands() []string {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.commands...)
+}
+
+// Output logs the captured output of a finished command and its result
+func (l *Logger) Output(output []byte, err error) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	_, _ = l.w.Write(output)
+	if len(output) > 0 && output[len(output)-1] != '\n' {
+		_, _ = io.WriteString(l.w, "\n")
+	}
+	l.mu.Unlock()
+	l.Result(err)
+}
+
+// Result logs how a command finished
+func (l *Logger) Result(err error) {
+	if err != nil {
+		l.Printf("command failed: %v", err)
+	} else {
+		l.Printf("command succeeded")
+	}
+}
+
+// Tee returns a writer that copies everything written to w into the log,
+// used to stream the output of long-running commands
+func (l *Logger) Tee(w io.Writer) io.Writer {
+	if l == nil {
+		return w
+	}
+	return io.MultiWriter(w, lockedWriter{l})
+}
+
+// lockedWriter serialises writes to the log
+type lockedWriter struct {
+	l *Logger
+}
+
+func (lw lockedWriter) Write(p []byte) (int, error) {
+	lw.l.mu.Lock()
+	defer lw.l.mu.Unlock()
+	return lw.l.w.Write(p)
+}
+
+// QuoteCommand formats args as a shell command line that can be copied and
+// re-run, quoting arguments that contain spaces or shell metacharacters
+func QuoteCommand(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = quoteArg(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// quoteArg single-quotes arg if the shell would otherwise split or expand it
+func quoteArg(arg string) string {
+	if arg == "" {
+		return "''"
+	}
+	if !strings.ContainsAny(arg, " \t\n'\"\\$`!*?[]{}()<>|&;#~") {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
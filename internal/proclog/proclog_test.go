@@ -0,0 +1,80 @@
+package proclog
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestQuoteCommand(t *testing.T) {
+	got := QuoteCommand([]string{
+		"ffmpeg", "-i", "/videos/my talk/screen.mp4",
+		"-filter_complex", "[0:v]scale=1920:-2[v]",
+		"-metadata", "title=It's done", "",
+	})
+	want := `ffmpeg -i '/videos/my talk/screen.mp4' -filter_complex '[0:v]scale=1920:-2[v]' -metadata 'title=It'\''s done' ''`
+	if got != want {
+		t.Errorf("QuoteCommand:\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestLogger_WritesCommandsAndOutput(t *testing.T) {
+	dir := t.TempDir()
+	l, err := Create(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("ffmpeg", "-y", "-i", "in.wav", "out.wav")
+	l.Command(cmd)
+	l.Output([]byte("size=1kB"), errors.New("exit status 1"))
+	_, _ = l.Tee(&strings.Builder{}).Write([]byte("streamed\n"))
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(l.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"$ ffmpeg -y -i in.wav out.wav", "size=1kB\n", "command failed: exit status 1", "streamed"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("log is missing %q:\n%s", want, data)
+		}
+	}
+	if got := l.Commands(); len(got) != 1 || got[0] != "ffmpeg -y -i in.wav out.wav" || l.DryRun() {
+		t.Errorf("Commands() = %q, want the command kept and no dry run", got)
+	}
+}
+
+func TestLogger_DryRunKeepsCommands(t *testing.T) {
+	l := NewDryRun()
+	l.Printf("ignored")
+	l.Command(exec.Command("ffmpeg", "-i", "my talk.mp4", "out.mp4"))
+	l.Command(exec.Command("ffmpeg", "-i", "out.mp4", "vertical.mp4"))
+	want := []string{"ffmpeg -i 'my talk.mp4' out.mp4", "ffmpeg -i out.mp4 vertical.mp4"}
+	got := l.Commands()
+	if !l.DryRun() || len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Commands() = %q, want %q in a dry run", got, want)
+	}
+}
+
+func TestLogger_NilIsNoop(t *testing.T) {
+	var l *Logger
+	l.Printf("ignored")
+	l.Command(exec.Command("ffmpeg"))
+	l.Output([]byte("ignored"), nil)
+	if l.Commands() != nil || l.DryRun() {
+		t.Error("a nil logger keeps no commands and is no dry run")
+	}
+	var b strings.Builder
+	_, _ = l.Tee(&b).Write([]byte("kept"))
+	if b.String() != "kept" {
+		t.Errorf("Tee on a nil logger must pass writes through, got %q", b.String())
+	}
+	if err := l.Close(); err != nil {
+		t.Errorf("Close on a nil logger: %v", err)
+	}
+}
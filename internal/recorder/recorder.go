@@ -1,6 +1,7 @@
 package recorder
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -13,12 +14,14 @@ import (
 	"time"
 
 	"github.com/kartoza/kartoza-screencaster/internal/audio"
+	"github.com/kartoza/kartoza-screencaster/internal/chapters"
 	"github.com/kartoza/kartoza-screencaster/internal/config"
 	"github.com/kartoza/kartoza-screencaster/internal/deps"
 	"github.com/kartoza/kartoza-screencaster/internal/merger"
 	"github.com/kartoza/kartoza-screencaster/internal/models"
 	"github.com/kartoza/kartoza-screencaster/internal/monitor"
 	"github.com/kartoza/kartoza-screencaster/internal/notify"
+	"github.com/kartoza/kartoza-screencaster/internal/proclog"
 	"github.com/kartoza/kartoza-screencaster/internal/webcam"
 )
 
@@ -64,6 +67,9 @@ type Recorder struct {
 	createVertical bool
 	logoSelection  config.LogoSelection
 
+	// Optional handler for CLI processing progress (default: human-readable output)
+	progressHandler func(ProgressUpdate)
+
 	// Synchronization
 	startBarrier chan struct{}
 	stopSignal   chan struct{}
@@ -142,6 +148,7 @@ func (r *Recorder) GetStatus() models.RecordingStatus {
 				status.StartTime = t
 			}
 		}
+		status.RecordedBefore = readRecordedTime()
 	}
 
 	return status
@@ -197,6 +204,7 @@ func (r *Recorder) StartWithOptions(opts Options) error {
 		// New recording - reset part number to 0
 		partNum = 0
 		writePartNumber(0)
+		_ = os.Remove(config.RecordedFile)
 	} else {
 		// Resume - use current part number (already incremented by Pause)
 		partNum = readPartNumber()
@@ -441,6 +449,15 @@ func (r *Recorder) startVideoRecorderWayland(hwAccel bool, ready, started chan<-
 	}
 }
 
+// captureFrameRate returns the frame rate the screen is captured at: the
+// output frame rate chosen for the recording, or 60 fps without one
+func (r *Recorder) captureFrameRate() string {
+	if r.recordingInfo != nil && r.recordingInfo.Settings.FrameRate > 0 {
+		return strconv.Itoa(r.recordingInfo.Settings.FrameRate)
+	}
+	return "60"
+}
+
 // startVideoRecorderX11 starts video recording using ffmpeg with x11grab (X11)
 func (r *Recorder) startVideoRecorderX11(ready, started chan<- string, errors chan<- error) {
 	// Get monitor info for position and size
@@ -459,7 +476,7 @@ func (r *Recorder) startVideoRecorderX11(ready, started chan<- string, errors ch
 
 	args := []string{
 		"-f", "x11grab",
-		"-framerate", "60",
+		"-framerate", r.captureFrameRate(),
 		"-video_size", fmt.Sprintf("%dx%d", mon.Width, mon.Height),
 		"-i", fmt.Sprintf("%s+%d,%d", display, mon.X, mon.Y),
 		"-c:v", "libx264",
@@ -518,7 +535,7 @@ func (r *Recorder) startVideoRecorderDarwin(ready, started chan<- string, errors
 	// Format: -i "screen_index:" (colon with no audio index means video only)
 	args := []string{
 		"-f", "avfoundation",
-		"-framerate", "60",
+		"-framerate", r.captureFrameRate(),
 		"-capture_cursor", "1",
 		"-i", screenIndex + ":",
 		"-c:v", "libx264",
@@ -574,7 +591,7 @@ func (r *Recorder) startVideoRecorderWindows(ready, started chan<- string, error
 	// Build ffmpeg gdigrab command
 	args := []string{
 		"-f", "gdigrab",
-		"-framerate", "60",
+		"-framerate", r.captureFrameRate(),
 		"-i", "desktop",
 		"-c:v", "libx264",
 		"-preset", "ultrafast",
@@ -803,6 +820,7 @@ func (r *Recorder) stopInternal(waitForProcessing bool) error {
 	// Clean up state files
 	_ = os.Remove(config.PartNumberFile)
 	_ = os.Remove(config.OutputDirFile)
+	_ = os.Remove(config.RecordedFile)
 
 	r.mu.Unlock()
 
@@ -810,7 +828,9 @@ func (r *Recorder) stopInternal(waitForProcessing bool) error {
 	// For TUI mode (waitForProcessing=false), the TUI will call ProcessWithProgress() itself
 	if waitForProcessing {
 		// Run synchronously for CLI
-		fmt.Println("Processing recordings...")
+		if r.progressHandler == nil {
+			fmt.Println("Processing recordings...")
+		}
 		r.processRecordingsWithOutput()
 	}
 	// Note: For TUI, don't start processing here - the TUI handles it via ProcessWithProgress()
@@ -818,34 +838,35 @@ func (r *Recorder) stopInternal(waitForProcessing bool) error {
 	return nil
 }
 
+// SetProgressHandler sets the function that receives processing progress
+// when StopAndProcess runs the pipeline synchronously. When unset, progress
+// is printed as human-readable text.
+func (r *Recorder) SetProgressHandler(handler func(ProgressUpdate)) {
+	r.progressHandler = handler
+}
+
+// Process runs the processing pipeline synchronously on the recording set
+// with SetRecordingInfo, reporting progress to the progress handler. Used to
+// reprocess existing recordings from the CLI.
+func (r *Recorder) Process() {
+	r.processRecordingsWithOutput()
+}
+
 // processRecordingsWithOutput processes recordings with console output for CLI use
 func (r *Recorder) processRecordingsWithOutput() {
 	progressChan := make(chan ProgressUpdate, 10)
 
+	handler := r.progressHandler
+	if handler == nil {
+		handler = printProgressUpdate
+	}
+
 	// Process updates in a goroutine
 	done := make(chan struct{})
 	go func() {
-		stepNames := []string{
-			"Stopping recorders",
-			"Analyzing audio",
-			"Normalizing audio",
-			"Merging video and audio",
-			"Creating vertical video",
-		}
 		for update := range progressChan {
-			if update.Step >= 0 && update.Step < len(stepNames) {
-				if update.Skipped {
-					fmt.Printf("  [SKIP] %s\n", stepNames[update.Step])
-				} else if update.Completed {
-					fmt.Printf("  [DONE] %s\n", stepNames[update.Step])
-				} else if update.Percent >= 0 {
-					// Progress update - could show a progress bar
-					fmt.Printf("  [....] %s: %.0f%%\r", stepNames[update.Step], update.Percent)
-				} else if update.Error != nil {
-					fmt.Printf("  [FAIL] %s: %v\n", stepNames[update.Step], update.Error)
-				} else {
-					fmt.Printf("  [....] %s\n", stepNames[update.Step])
-				}
+			if update.Step >= 0 && update.Step < len(ProcessingStepNames) {
+				handler(update)
 			}
 		}
 		close(done)
@@ -855,6 +876,25 @@ func (r *Recorder) processRecordingsWithOutput() {
 	<-done
 }
 
+// printProgressUpdate prints a progress update as a human-readable line
+func printProgressUpdate(update ProgressUpdate) {
+	name := update.StepName()
+	if update.Skipped {
+		fmt.Printf("  [SKIP] %s\n", name)
+	} else if update.Completed {
+		fmt.Printf("  [DONE] %s\n", name)
+	} else if update.Encoder != "" {
+		fmt.Printf("  [....] %s: encoding with %s\n", name, update.Encoder)
+	} else if update.Percent >= 0 {
+		// Progress update - could show a progress bar
+		fmt.Printf("  [....] %s: %.0f%%\r", name, update.Percent)
+	} else if update.Error != nil {
+		fmt.Printf("  [FAIL] %s: %v\n", name, update.Error)
+	} else {
+		fmt.Printf("  [....] %s\n", name)
+	}
+}
+
 // IsRecordingLocked checks recording status without locking (internal use)
 func (r *Recorder) IsRecordingLocked() bool {
 	return checkPID(config.VideoPIDFile) ||
@@ -869,6 +909,72 @@ type ProgressUpdate struct {
 	Skipped   bool
 	Error     error
 	Percent   float64 // Progress percentage (0-100), -1 means not a percent update
+	Encoder   string  // Video encoder the step switched to, e.g. "VAAPI" ("" = not an encoder update)
+}
+
+// ProcessingStepNames are the display names of the processing steps, indexed by ProgressUpdate.Step
+var ProcessingStepNames = []string{
+	"Stopping recorders",
+	"Analyzing audio",
+	"Normalizing audio",
+	"Merging video and audio",
+	"Creating vertical video",
+	"Creating webcam-only video",
+}
+
+// Progress event statuses used in machine-readable output
+const (
+	ProgressStatusStarted  = "started"
+	ProgressStatusProgress = "progress"
+	ProgressStatusDone     = "done"
+	ProgressStatusSkipped  = "skipped"
+	ProgressStatusFailed   = "failed"
+	ProgressStatusEncoding = "encoding"
+)
+
+// ProgressEvent is the serializable form of a ProgressUpdate, used for
+// machine-readable (JSON lines) progress output
+type ProgressEvent struct {
+	Step     int      `json:"step"`
+	StepName string   `json:"step_name"`
+	Status   string   `json:"status"`
+	Percent  *float64 `json:"percent,omitempty"`
+	Error    string   `json:"error,omitempty"`
+	Encoder  string   `json:"encoder,omitempty"`
+}
+
+// StepName returns the display name of the update's step
+func (u ProgressUpdate) StepName() string {
+	if u.Step >= 0 && u.Step < len(ProcessingStepNames) {
+		return ProcessingStepNames[u.Step]
+	}
+	return fmt.Sprintf("Step %d", u.Step)
+}
+
+// Event converts the update to its serializable form
+func (u ProgressUpdate) Event() ProgressEvent {
+	event := ProgressEvent{Step: u.Step, StepName: u.StepName()}
+	switch {
+	case u.Skipped:
+		event.Status = ProgressStatusSkipped
+	case u.Completed:
+		event.Status = ProgressStatusDone
+	case u.Encoder != "":
+		event.Status = ProgressStatusEncoding
+		event.Encoder = u.Encoder
+	case u.Percent >= 0:
+		percent := u.Percent
+		event.Status = ProgressStatusProgress
+		event.Percent = &percent
+	case u.Error != nil:
+		event.Status = ProgressStatusFailed
+	default:
+		event.Status = ProgressStatusStarted
+	}
+	if u.Error != nil {
+		event.Error = u.Error.Error()
+	}
+	return event
 }
 
 // ProcessWithProgress processes recordings and sends progress updates to the channel
@@ -890,19 +996,10 @@ func (r *Recorder) ProcessWithProgress(progressChan chan<- ProgressUpdate) {
 		r.recordingInfo.Processing.Errors = nil
 		r.recordingInfo.Processing.ErrorDetail = ""
 		r.recordingInfo.Processing.Traceback = ""
+		r.recordingInfo.Processing.Commands = nil
 	}
 
-	// Get file paths from recording info or fallback to path files
-	var videoFile, audioFile, webcamFile string
-	if r.recordingInfo != nil {
-		videoFile = r.recordingInfo.Files.VideoFile
-		audioFile = r.recordingInfo.Files.AudioFile
-		webcamFile = r.recordingInfo.Files.WebcamFile
-	} else {
-		videoFile = readPath(config.VideoPathFile)
-		audioFile = readPath(config.AudioPathFile)
-		webcamFile = readPath(config.WebcamPathFile)
-	}
+	videoFile, audioFile, webcamFile := r.inputFiles()
 
 	if videoFile == "" && audioFile == "" {
 		// No input files found - save error to recording info
@@ -918,7 +1015,20 @@ func (r *Recorder) ProcessWithProgress(progressChan chan<- ProgressUpdate) {
 		return
 	}
 
-	m := merger.New(r.config.AudioProcessing)
+	m := merger.New(r.config.AudioOptions())
+
+	// Keep a full processing log in the recording folder, replacing the log
+	// of any previous run
+	var plog *proclog.Logger
+	if r.recordingInfo != nil && r.recordingInfo.Files.FolderPath != "" {
+		if l, err := proclog.Create(r.recordingInfo.Files.FolderPath); err == nil {
+			plog = l
+			defer func() { _ = plog.Close() }()
+			r.recordingInfo.Processing.LogFile = plog.Path()
+			plog.Printf("Processing %q", r.recordingInfo.Metadata.Title)
+		}
+	}
+	m.SetLogger(plog)
 
 	// Set up progress callback
 	m.SetProgressCallback(func(step merger.ProcessingStep, completed bool, skipped bool, err error) {
@@ -942,52 +1052,43 @@ func (r *Recorder) ProcessWithProgress(progressChan chan<- ProgressUpdate) {
 		}
 	})
 
-	// Build merge options
-	mergeOpts := merger.MergeOptions{
-		VideoFile:      videoFile,
-		AudioFile:      audioFile,
-		WebcamFile:     webcamFile,
-		CreateVertical: r.createVertical && webcamFile != "",
-	}
-	// Add part files if available (for pause/resume support)
-	if r.recordingInfo != nil && len(r.recordingInfo.Files.VideoParts) > 0 {
-		mergeOpts.VideoParts = r.recordingInfo.Files.VideoParts
-		mergeOpts.AudioParts = r.recordingInfo.Files.AudioParts
-		mergeOpts.WebcamParts = r.recordingInfo.Files.WebcamParts
+	// Encode on the GPU when enabled and one is found, else in software
+	if r.config.HardwareEncoding {
+		encoder := merger.DetectHardwareEncoder()
+		m.SetEncoder(encoder)
+		plog.Printf("Hardware encoding: %s", encoder.Label())
 	}
+	m.SetEncoderCallback(func(step merger.ProcessingStep, encoder merger.Encoder) {
+		progressChan <- ProgressUpdate{
+			Step:    int(step) + 1,
+			Percent: -1,
+			Encoder: encoder.Label(),
+		}
+	})
 
-	// Add logo options from the recording's logo selection (in-memory)
-	// or from recording info settings (CLI stop case)
-	if r.logoSelection.LeftLogo != "" || r.logoSelection.RightLogo != "" || r.logoSelection.BottomLogo != "" {
-		mergeOpts.ProductLogo1 = r.logoSelection.LeftLogo
-		mergeOpts.ProductLogo2 = r.logoSelection.RightLogo
-		mergeOpts.CompanyLogo = r.logoSelection.BottomLogo
-		mergeOpts.TitleColor = r.logoSelection.TitleColor
-		mergeOpts.GifLoopMode = r.logoSelection.GifLoopMode
-	} else if r.recordingInfo != nil {
-		// Load from recording info settings (CLI stop case)
-		mergeOpts.ProductLogo1 = r.recordingInfo.Settings.LeftLogo
-		mergeOpts.ProductLogo2 = r.recordingInfo.Settings.RightLogo
-		mergeOpts.CompanyLogo = r.recordingInfo.Settings.BottomLogo
-		mergeOpts.TitleColor = r.recordingInfo.Settings.TitleColor
-		mergeOpts.GifLoopMode = config.GifLoopMode(r.recordingInfo.Settings.GifLoopMode)
-		mergeOpts.CreateVertical = r.recordingInfo.Settings.VerticalEnabled && webcamFile != ""
+	mergeOpts := r.mergeOptions(videoFile, audioFile, webcamFile)
+
+	plog.Printf("Inputs: video=%q audio=%q webcam=%q parts=%d vertical=%t webcam-only=%t resolution=%q fps=%d",
+		mergeOpts.VideoFile, mergeOpts.AudioFile, mergeOpts.WebcamFile, len(mergeOpts.VideoParts),
+		mergeOpts.CreateVertical, mergeOpts.CreateWebcamOnly, mergeOpts.OutputResolution, mergeOpts.FrameRate)
+	if mergeOpts.TrimStart > 0 || mergeOpts.TrimEnd > 0 {
+		plog.Printf("Trim: start=%s end=%s", mergeOpts.TrimStart, mergeOpts.TrimEnd)
 	}
-	// Check if any logos are configured
-	mergeOpts.AddLogos = mergeOpts.ProductLogo1 != "" || mergeOpts.ProductLogo2 != "" || mergeOpts.CompanyLogo != ""
-	// Set background color: prefer saved recording setting, fall back to config
-	if r.recordingInfo != nil && r.recordingInfo.Settings.BgColor != "" {
-		mergeOpts.BgColor = r.recordingInfo.Settings.BgColor
-	} else if r.config != nil && r.config.BgColor != "" {
-		mergeOpts.BgColor = r.config.BgColor
+	for _, preset := range mergeOpts.FilterPresets {
+		plog.Printf("Filter preset %q (%s): %s", preset.Name, preset.Stage, preset.Filter)
 	}
-	// Get video title and output directory from recording info
-	if r.recordingInfo != nil {
-		mergeOpts.VideoTitle = r.recordingInfo.Metadata.Title
-		mergeOpts.OutputDir = r.recordingInfo.Files.FolderPath
-	}
-
 	mergeResult, err := m.Merge(mergeOpts)
+	if err != nil {
+		plog.Printf("Processing failed: %v", err)
+	} else {
+		if mergeResult.VerticalError != nil {
+			plog.Printf("Vertical video failed: %v", mergeResult.VerticalError)
+		}
+		if mergeResult.WebcamOnlyError != nil {
+			plog.Printf("Webcam-only video failed: %v", mergeResult.WebcamOnlyError)
+		}
+		plog.Printf("Processing finished")
+	}
 
 	hasErrors := false
 	if err != nil {
@@ -1003,6 +1104,7 @@ func (r *Recorder) ProcessWithProgress(progressChan chan<- ProgressUpdate) {
 
 	// Update recording info with merged file paths and processing info
 	if r.recordingInfo != nil {
+		r.recordingInfo.Processing.Commands = plog.Commands()
 		if mergeResult != nil {
 			if mergeResult.MergedFile != "" {
 				r.recordingInfo.Files.MergedFile = mergeResult.MergedFile
@@ -1010,16 +1112,31 @@ func (r *Recorder) ProcessWithProgress(progressChan chan<- ProgressUpdate) {
 			if mergeResult.VerticalFile != "" {
 				r.recordingInfo.Files.VerticalFile = mergeResult.VerticalFile
 			}
+			if mergeResult.WebcamOnlyFile != "" {
+				r.recordingInfo.Files.WebcamOnlyFile = mergeResult.WebcamOnlyFile
+			}
 			r.recordingInfo.Processing.NormalizeApplied = mergeResult.NormalizeApplied
+			r.recordingInfo.Processing.NormalizedLUFS = mergeResult.NormalizedLUFS
+			if mergeResult.SourceDuration > 0 {
+				r.recordingInfo.Processing.SourceDuration = mergeResult.SourceDuration.Seconds()
+			}
 			r.recordingInfo.Processing.VerticalCreated = mergeResult.VerticalFile != ""
 			// Capture vertical video errors (these were previously lost)
 			if mergeResult.VerticalError != nil {
 				r.recordingInfo.Processing.Errors = append(r.recordingInfo.Processing.Errors,
 					"vertical video: "+mergeResult.VerticalError.Error())
 			}
+			r.recordingInfo.Processing.WebcamOnlyCreated = mergeResult.WebcamOnlyFile != ""
+			if mergeResult.WebcamOnlyError != nil {
+				r.recordingInfo.Processing.Errors = append(r.recordingInfo.Processing.Errors,
+					"webcam-only video: "+mergeResult.WebcamOnlyError.Error())
+			}
 		}
 		r.recordingInfo.Processing.ProcessedAt = time.Now()
 		r.recordingInfo.UpdateFileSizes()
+		if !hasErrors {
+			writeChapters(r.recordingInfo, plog)
+		}
 
 		// Update video metadata (resolution, fps, aspect ratio)
 		r.recordingInfo.UpdateVideoMetadata(func(filepath string) (*models.VideoFileMetadata, error) {
@@ -1055,6 +1172,205 @@ func (r *Recorder) ProcessWithProgress(progressChan chan<- ProgressUpdate) {
 	_ = os.Remove(config.OutputDirFile)
 	_ = os.Remove(config.PartNumberFile)
 	_ = os.Remove(config.PausedFile)
+	_ = os.Remove(config.RecordedFile)
+}
+
+// inputFiles returns the recorded files to process, from the recording info
+// or else from the path files of the current session
+func (r *Recorder) inputFiles() (videoFile, audioFile, webcamFile string) {
+	if r.recordingInfo != nil {
+		return r.recordingInfo.Files.VideoFile, r.recordingInfo.Files.AudioFile, r.recordingInfo.Files.WebcamFile
+	}
+	return readPath(config.VideoPathFile), readPath(config.AudioPathFile), readPath(config.WebcamPathFile)
+}
+
+// mergeOptions builds the merge options for the recorded files from the
+// recording's settings, falling back to the config
+func (r *Recorder) mergeOptions(videoFile, audioFile, webcamFile string) merger.MergeOptions {
+	mergeOpts := merger.MergeOptions{
+		VideoFile:      videoFile,
+		AudioFile:      audioFile,
+		WebcamFile:     webcamFile,
+		CreateVertical: r.createVertical && webcamFile != "",
+	}
+	// Add part files if available (for pause/resume support)
+	if r.recordingInfo != nil && len(r.recordingInfo.Files.VideoParts) > 0 {
+		mergeOpts.VideoParts = r.recordingInfo.Files.VideoParts
+		mergeOpts.AudioParts = r.recordingInfo.Files.AudioParts
+		mergeOpts.WebcamParts = r.recordingInfo.Files.WebcamParts
+	}
+
+	// Add logo options from the recording's logo selection (in-memory)
+	// or from recording info settings (CLI stop case)
+	if r.logoSelection.LeftLogo != "" || r.logoSelection.RightLogo != "" || r.logoSelection.BottomLogo != "" {
+		mergeOpts.ProductLogo1 = r.logoSelection.LeftLogo
+		mergeOpts.ProductLogo2 = r.logoSelection.RightLogo
+		mergeOpts.CompanyLogo = r.logoSelection.BottomLogo
+		mergeOpts.TitleColor = r.logoSelection.TitleColor
+		mergeOpts.GifLoopMode = r.logoSelection.GifLoopMode
+	} else if r.recordingInfo != nil {
+		// Load from recording info settings (CLI stop case)
+		mergeOpts.ProductLogo1 = r.recordingInfo.Settings.LeftLogo
+		mergeOpts.ProductLogo2 = r.recordingInfo.Settings.RightLogo
+		mergeOpts.CompanyLogo = r.recordingInfo.Settings.BottomLogo
+		mergeOpts.TitleColor = r.recordingInfo.Settings.TitleColor
+		mergeOpts.GifLoopMode = config.GifLoopMode(r.recordingInfo.Settings.GifLoopMode)
+		mergeOpts.CreateVertical = r.recordingInfo.Settings.VerticalEnabled && webcamFile != ""
+	}
+	// Webcam-only video, a setting of the recording only
+	if r.recordingInfo != nil {
+		mergeOpts.CreateWebcamOnly = r.recordingInfo.Settings.WebcamOnlyEnabled && webcamFile != ""
+	}
+	// Check if any logos are configured
+	mergeOpts.AddLogos = mergeOpts.ProductLogo1 != "" || mergeOpts.ProductLogo2 != "" || mergeOpts.CompanyLogo != ""
+	// Set background color: prefer saved recording setting, fall back to config
+	if r.recordingInfo != nil && r.recordingInfo.Settings.BgColor != "" {
+		mergeOpts.BgColor = r.recordingInfo.Settings.BgColor
+	} else if r.config != nil && r.config.BgColor != "" {
+		mergeOpts.BgColor = r.config.BgColor
+	}
+	// Set logo fit mode: prefer saved recording setting, fall back to config
+	if r.recordingInfo != nil && r.recordingInfo.Settings.LogoFit != "" {
+		mergeOpts.LogoFit = config.LogoFit(r.recordingInfo.Settings.LogoFit)
+	} else if r.config != nil {
+		mergeOpts.LogoFit = r.config.LogoFit
+	}
+	// Set output resolution: prefer saved recording setting, fall back to config
+	if r.recordingInfo != nil && r.recordingInfo.Settings.OutputResolution != "" {
+		mergeOpts.OutputResolution = config.OutputResolution(r.recordingInfo.Settings.OutputResolution)
+	} else if r.config != nil {
+		mergeOpts.OutputResolution = r.config.OutputResolution
+	}
+	// Set output frame rate from the recording (0 = default)
+	if r.recordingInfo != nil {
+		mergeOpts.FrameRate = r.recordingInfo.Settings.FrameRate
+	}
+	// Set landscape webcam overlay placement: prefer saved recording settings, fall back to config
+	if r.config != nil {
+		mergeOpts.PiPCorner = r.config.PiPCorner
+		mergeOpts.PiPSize = r.config.PiPSize
+	}
+	if r.recordingInfo != nil && r.recordingInfo.Settings.PiPCorner != "" {
+		mergeOpts.PiPCorner = config.PiPCorner(r.recordingInfo.Settings.PiPCorner)
+	}
+	if r.recordingInfo != nil && r.recordingInfo.Settings.PiPSize != "" {
+		mergeOpts.PiPSize = config.PiPSize(r.recordingInfo.Settings.PiPSize)
+	}
+	// Set vertical webcam placement from the recording (empty = default layout)
+	if r.recordingInfo != nil {
+		mergeOpts.VerticalWebcamPosition = config.VerticalWebcamPosition(r.recordingInfo.Settings.VerticalWebcamPosition)
+		mergeOpts.VerticalWebcamSize = config.VerticalWebcamSize(r.recordingInfo.Settings.VerticalWebcamSize)
+	}
+	// Filter presets attached to the recording
+	if r.recordingInfo != nil {
+		mergeOpts.FilterPresets = r.recordingInfo.Settings.FilterPresets
+	}
+	// Trim, applied while merging so the recorded files stay whole
+	if r.recordingInfo != nil {
+		mergeOpts.TrimStart, mergeOpts.TrimEnd = r.recordingInfo.Settings.Trim()
+	}
+	// Get video title and output directory from recording info
+	if r.recordingInfo != nil {
+		mergeOpts.VideoTitle = r.recordingInfo.Metadata.Title
+		mergeOpts.OutputDir = r.recordingInfo.Files.FolderPath
+	}
+	return mergeOpts
+}
+
+// PlanCommands returns the ffmpeg commands processing the recording would
+// run, without running them. Steps that probe a file made by an earlier step
+// use the file left by a previous run, if there is one.
+func (r *Recorder) PlanCommands() ([]string, error) {
+	videoFile, audioFile, webcamFile := r.inputFiles()
+	if videoFile == "" && audioFile == "" {
+		return nil, fmt.Errorf("no video or audio files found to process")
+	}
+
+	plog := proclog.NewDryRun()
+	m := merger.New(r.config.AudioOptions())
+	m.SetLogger(plog)
+	if r.config.HardwareEncoding {
+		m.SetEncoder(merger.DetectHardwareEncoder())
+	}
+	result, err := m.Merge(r.mergeOptions(videoFile, audioFile, webcamFile))
+	if err != nil {
+		return plog.Commands(), err
+	}
+	var errs []error
+	if result.VerticalError != nil {
+		errs = append(errs, fmt.Errorf("vertical video: %w", result.VerticalError))
+	}
+	if result.WebcamOnlyError != nil {
+		errs = append(errs, fmt.Errorf("webcam-only video: %w", result.WebcamOnlyError))
+	}
+	return plog.Commands(), errors.Join(errs...)
+}
+
+// writeChapters writes chapters.txt to the folder of a recording that was
+// paused, with a chapter for each part. Chapters already named in the file
+// keep their names.
+func writeChapters(info *models.RecordingInfo, plog *proclog.Logger) {
+	offsets := pauseOffsets(info, func(path string) (float64, error) {
+		meta, err := webcam.GetFullVideoInfo(path)
+		if err != nil {
+			return 0, err
+		}
+		return meta.Duration, nil
+	})
+	offsets = trimOffsets(offsets, info.Settings)
+	if len(offsets) == 0 || info.Files.FolderPath == "" {
+		return
+	}
+	if err := chapters.Save(info.Files.FolderPath, chapters.FromPauses(offsets)); err != nil {
+		plog.Printf("Chapters not written: %v", err)
+		return
+	}
+	plog.Printf("Wrote %s with %d pause(s)", chapters.FileName, len(offsets))
+}
+
+// trimOffsets moves the offsets of the parts to the trimmed video, leaving
+// out the parts that start in a trimmed away section
+func trimOffsets(offsets []time.Duration, settings models.RecordingSettings) []time.Duration {
+	start, end := settings.Trim()
+	var trimmed []time.Duration
+	for _, offset := range offsets {
+		if offset <= start || (end > 0 && offset >= end) {
+			continue
+		}
+		trimmed = append(trimmed, offset-start)
+	}
+	return trimmed
+}
+
+// pauseOffsets returns where each part after the first starts in the
+// processed video, and stores it in the recording's pauses. The parts are
+// measured with probe; a part that cannot be measured uses the recorded time
+// noted when it was paused.
+func pauseOffsets(info *models.RecordingInfo, probe func(path string) (float64, error)) []time.Duration {
+	parts := info.Files.VideoParts
+	if len(parts) == 0 {
+		parts = info.Files.WebcamParts
+	}
+	if len(parts) < 2 {
+		return nil
+	}
+
+	var offsets []time.Duration
+	elapsed := 0.0
+	for i, part := range parts[:len(parts)-1] {
+		if d, err := probe(part); err == nil && d > 0 {
+			elapsed += d
+		} else if i < len(info.Pauses) {
+			elapsed = info.Pauses[i].Offset
+		} else {
+			break
+		}
+		if i < len(info.Pauses) {
+			info.Pauses[i].Offset = elapsed
+		}
+		offsets = append(offsets, time.Duration(elapsed*float64(time.Second)))
+	}
+	return offsets
 }
 
 // Helper functions
@@ -1171,6 +1487,35 @@ func writePartNumber(num int) {
 	_ = os.WriteFile(config.PartNumberFile, []byte(strconv.Itoa(num)), 0644)
 }
 
+// readRecordedTime returns the recorded time of the parts before the current
+// one
+func readRecordedTime() time.Duration {
+	data, err := os.ReadFile(config.RecordedFile)
+	if err != nil {
+		return 0
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
+// addRecordedTime adds the time of the part that started at the time in the
+// status file to the recorded time of the earlier parts
+func addRecordedTime() {
+	data, err := os.ReadFile(config.StatusFile)
+	if err != nil {
+		return
+	}
+	start, err := time.Parse(time.RFC3339, string(data))
+	if err != nil {
+		return
+	}
+	recorded := readRecordedTime() + time.Since(start).Round(time.Second)
+	_ = os.WriteFile(config.RecordedFile, []byte(recorded.String()), 0644)
+}
+
 // IsPaused checks if recording is currently paused
 func (r *Recorder) IsPaused() bool {
 	_, err := os.Stat(config.PausedFile)
@@ -1231,19 +1576,28 @@ func (r *Recorder) Pause() error {
 	// Wait briefly for files to be written
 	time.Sleep(300 * time.Millisecond)
 
-	// Mark as paused
+	// Mark as paused, keeping the time recorded in the part that ended
+	addRecordedTime()
 	_ = os.WriteFile(config.PausedFile, []byte("paused"), 0644)
 
 	// Increment part number for next resume
 	currentPart := readPartNumber()
 	writePartNumber(currentPart + 1)
 
-	// Update recording info status
+	// Update recording info status and note where the pause is in the
+	// recorded video, for the chapters
 	outputDir := readPath(config.OutputDirFile)
 	if outputDir != "" {
 		if info, err := models.LoadRecordingInfo(outputDir); err == nil {
 			info.SetStatus(models.StatusPaused)
+			info.Pauses = append(info.Pauses, models.PausePoint{
+				PausedAt: time.Now(),
+				Offset:   readRecordedTime().Seconds(),
+			})
 			_ = info.Save()
+			if r.recordingInfo != nil {
+				r.recordingInfo.Pauses = info.Pauses
+			}
 		}
 	}
 
@@ -1286,6 +1640,9 @@ func (r *Recorder) Resume() error {
 
 	// Update status to recording
 	info.SetStatus(models.StatusRecording)
+	if n := len(info.Pauses); n > 0 && info.Pauses[n-1].ResumedAt.IsZero() {
+		info.Pauses[n-1].ResumedAt = time.Now()
+	}
 	_ = info.Save()
 
 	// Start recording with the new part number
@@ -1337,6 +1694,12 @@ func buildErrorDetail(err error, opts merger.MergeOptions) string {
 	} else {
 		sb.WriteString("no\n")
 	}
+	sb.WriteString("  - Create webcam-only: ")
+	if opts.CreateWebcamOnly {
+		sb.WriteString("yes\n")
+	} else {
+		sb.WriteString("no\n")
+	}
 	sb.WriteString("  - Add logos: ")
 	if opts.AddLogos {
 		sb.WriteString("yes\n")
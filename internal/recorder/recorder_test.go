@@ -1,11 +1,17 @@
 package recorder
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/notify"
 )
 
 func TestNew(t *testing.T) {
@@ -370,3 +376,119 @@ func TestStopProcess_NonExistentPID(t *testing.T) {
 	// Should not panic, might return an error
 	_ = err
 }
+
+func TestProgressUpdate_Event(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  ProgressUpdate
+		status  string
+		percent float64
+		hasPct  bool
+		err     string
+	}{
+		{"started", ProgressUpdate{Step: 1, Percent: -1}, ProgressStatusStarted, 0, false, ""},
+		{"progress", ProgressUpdate{Step: 3, Percent: 42}, ProgressStatusProgress, 42, true, ""},
+		{"done", ProgressUpdate{Step: 2, Completed: true, Percent: -1}, ProgressStatusDone, 0, false, ""},
+		{"skipped", ProgressUpdate{Step: 4, Skipped: true, Percent: -1}, ProgressStatusSkipped, 0, false, ""},
+		{"failed", ProgressUpdate{Step: 3, Error: errors.New("boom"), Percent: -1}, ProgressStatusFailed, 0, false, "boom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := tt.update.Event()
+			if event.Status != tt.status {
+				t.Errorf("expected status %q, got %q", tt.status, event.Status)
+			}
+			if event.StepName != ProcessingStepNames[tt.update.Step] {
+				t.Errorf("expected step name %q, got %q", ProcessingStepNames[tt.update.Step], event.StepName)
+			}
+			if (event.Percent != nil) != tt.hasPct || (tt.hasPct && *event.Percent != tt.percent) {
+				t.Errorf("unexpected percent %v", event.Percent)
+			}
+			if event.Error != tt.err {
+				t.Errorf("expected error %q, got %q", tt.err, event.Error)
+			}
+		})
+	}
+}
+
+func TestPauseOffsets(t *testing.T) {
+	info := &models.RecordingInfo{}
+	info.Files.VideoParts = []string{"part000.mp4", "part001.mp4", "part002.mp4"}
+	info.Pauses = []models.PausePoint{{Offset: 61}, {Offset: 185}}
+
+	// Measured parts give the exact offsets
+	durations := map[string]float64{"part000.mp4": 60.5, "part001.mp4": 123.25}
+	probe := func(path string) (float64, error) {
+		if d, ok := durations[path]; ok {
+			return d, nil
+		}
+		return 0, errors.New("no such file")
+	}
+	offsets := pauseOffsets(info, probe)
+	want := []time.Duration{60500 * time.Millisecond, 183750 * time.Millisecond}
+	if !reflect.DeepEqual(offsets, want) {
+		t.Errorf("pauseOffsets() = %v, want %v", offsets, want)
+	}
+	if info.Pauses[1].Offset != 183.75 {
+		t.Errorf("pause offset = %v, want the measured offset stored", info.Pauses[1].Offset)
+	}
+
+	// A part that cannot be measured uses the recorded time at the pause
+	info.Pauses = []models.PausePoint{{Offset: 61}, {Offset: 185}}
+	delete(durations, "part000.mp4")
+	if offsets := pauseOffsets(info, probe); offsets[0] != 61*time.Second || offsets[1] != 184250*time.Millisecond {
+		t.Errorf("pauseOffsets() = %v, want the recorded time for the first part", offsets)
+	}
+
+	info.Files.VideoParts = info.Files.VideoParts[:1]
+	if offsets := pauseOffsets(info, probe); offsets != nil {
+		t.Errorf("pauseOffsets() = %v, want none for a recording without pauses", offsets)
+	}
+}
+
+func TestTrimOffsets(t *testing.T) {
+	offsets := []time.Duration{20 * time.Second, time.Minute, 3 * time.Minute}
+	settings := models.RecordingSettings{TrimStart: 30, TrimEnd: 150}
+	if got := trimOffsets(offsets, settings); !reflect.DeepEqual(got, []time.Duration{30 * time.Second}) {
+		t.Errorf("trimOffsets() = %v, want only the pause inside the trim, moved by its start", got)
+	}
+	if got := trimOffsets(offsets, models.RecordingSettings{}); !reflect.DeepEqual(got, offsets) {
+		t.Errorf("trimOffsets() = %v, want the offsets unchanged without a trim", got)
+	}
+}
+
+func TestRecorder_PlanCommands(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	defer func(disabled bool) { notify.Disabled = disabled }(notify.Disabled)
+	notify.Disabled = true
+
+	dir := t.TempDir()
+	info := &models.RecordingInfo{}
+	info.Files.FolderPath = dir
+	info.Files.VideoFile = filepath.Join(dir, "screen.mp4")
+	info.Files.AudioFile = filepath.Join(dir, "audio.wav")
+	for _, path := range []string{info.Files.VideoFile, info.Files.AudioFile} {
+		if err := os.WriteFile(path, []byte("recorded"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rec := New()
+	rec.SetRecordingInfo(info)
+	commands, err := rec.PlanCommands()
+	if err != nil {
+		t.Fatalf("PlanCommands: %v", err)
+	}
+	if len(commands) == 0 || !strings.Contains(commands[len(commands)-1], "screen-merged.mp4") {
+		t.Errorf("commands = %q, want the merge planned last", commands)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 2 {
+		t.Errorf("planning left %d files in the folder, want only the recorded 2", len(entries))
+	}
+
+	rec.SetRecordingInfo(&models.RecordingInfo{})
+	if _, err := rec.PlanCommands(); err == nil {
+		t.Error("expected an error for a recording without files")
+	}
+}
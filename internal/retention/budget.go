@@ -0,0 +1,80 @@
+package retention
+
+import (
+	"sort"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// BudgetPlan lists the recordings suggested for archiving or deletion to
+// bring the videos directory back under its storage budget
+type BudgetPlan struct {
+	Budget      int64 // Bytes allowed
+	Used        int64 // Bytes used by all recordings
+	Freed       int64 // Bytes the suggestions would free
+	Suggestions []Candidate
+}
+
+// OverBudget returns true if the recordings use more than the budget
+func (p BudgetPlan) OverBudget() bool {
+	return p.Budget > 0 && p.Used > p.Budget
+}
+
+// Sufficient returns true if acting on the suggestions brings the usage back
+// under the budget. It is false when too few recordings are eligible.
+func (p BudgetPlan) Sufficient() bool {
+	return p.Used-p.Freed <= p.Budget
+}
+
+// PlanBudget returns the recordings to archive or delete to bring their total
+// size under budget bytes. Only completed recordings that are published to
+// YouTube are suggested, oldest first and the largest first among recordings
+// made on the same day. A budget of 0 or less disables the check.
+func PlanBudget(recordings []models.RecordingInfo, budget int64) BudgetPlan {
+	plan := BudgetPlan{Budget: budget}
+	if budget <= 0 {
+		return plan
+	}
+
+	var eligible []models.RecordingInfo
+	for _, rec := range recordings {
+		plan.Used += rec.Files.TotalSize
+		if rec.Status == models.StatusCompleted && rec.Metadata.IsPublishedToYouTube() && rec.Files.TotalSize > 0 {
+			eligible = append(eligible, rec)
+		}
+	}
+	if !plan.OverBudget() {
+		return plan
+	}
+
+	sort.SliceStable(eligible, func(i, j int) bool {
+		di, dj := day(recordedAt(eligible[i])), day(recordedAt(eligible[j]))
+		if !di.Equal(dj) {
+			return di.Before(dj)
+		}
+		return eligible[i].Files.TotalSize > eligible[j].Files.TotalSize
+	})
+
+	for _, rec := range eligible {
+		if plan.Used-plan.Freed <= budget {
+			break
+		}
+		when := recordedAt(rec)
+		plan.Suggestions = append(plan.Suggestions, Candidate{
+			FolderPath: rec.Files.FolderPath,
+			Title:      rec.Metadata.Title,
+			RecordedAt: when,
+			Uploaded:   true,
+			Size:       rec.Files.TotalSize,
+		})
+		plan.Freed += rec.Files.TotalSize
+	}
+	return plan
+}
+
+// day truncates t to the start of its day
+func day(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
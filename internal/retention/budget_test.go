@@ -0,0 +1,48 @@
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestPlanBudget(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	sized := func(rec models.RecordingInfo, size int64) models.RecordingInfo {
+		rec.Files.TotalSize = size
+		return rec
+	}
+	recordings := []models.RecordingInfo{
+		sized(recording("/v/new", now.AddDate(0, 0, -1), models.StatusCompleted, true), 40),
+		sized(recording("/v/old-small", now.AddDate(0, 0, -30), models.StatusCompleted, true), 10),
+		sized(recording("/v/old-large", now.AddDate(0, 0, -30).Add(time.Hour), models.StatusCompleted, true), 30),
+		sized(recording("/v/oldest-local", now.AddDate(0, 0, -90), models.StatusCompleted, false), 50),
+		sized(recording("/v/failed", now.AddDate(0, 0, -60), models.StatusFailed, true), 20),
+	}
+
+	// 150 bytes used; only uploaded, completed recordings are suggested
+	plan := PlanBudget(recordings, 115)
+	if !plan.OverBudget() || plan.Used != 150 {
+		t.Fatalf("expected 150 bytes over budget, got %+v", plan)
+	}
+	if len(plan.Suggestions) != 2 || plan.Suggestions[0].FolderPath != "/v/old-large" || plan.Suggestions[1].FolderPath != "/v/old-small" {
+		t.Fatalf("expected the largest of the oldest recordings first, got %+v", plan.Suggestions)
+	}
+	if plan.Freed != 40 || !plan.Sufficient() {
+		t.Errorf("expected 40 bytes freed and back under budget, got %+v", plan)
+	}
+
+	// Not enough eligible recordings to get under budget
+	plan = PlanBudget(recordings, 50)
+	if len(plan.Suggestions) != 3 || plan.Sufficient() {
+		t.Errorf("expected all 3 eligible recordings and an insufficient plan, got %+v", plan)
+	}
+
+	if plan := PlanBudget(recordings, 200); plan.OverBudget() || len(plan.Suggestions) != 0 {
+		t.Errorf("expected no suggestions under budget, got %+v", plan)
+	}
+	if plan := PlanBudget(recordings, 0); plan.OverBudget() || len(plan.Suggestions) != 0 {
+		t.Errorf("expected no suggestions without a budget, got %+v", plan)
+	}
+}
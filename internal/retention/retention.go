@@ -0,0 +1,184 @@
+// Package retention implements the retention policy that archives or
+// soft-deletes old recordings so the videos directory stays manageable.
+package retention
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// Candidate is a recording the retention policy would act on
+type Candidate struct {
+	FolderPath string
+	Title      string
+	RecordedAt time.Time
+	AgeDays    int
+	Uploaded   bool
+	Size       int64 // Total size of the recording files in bytes
+}
+
+// Result summarizes a retention run
+type Result struct {
+	Action     config.RetentionAction
+	Candidates []Candidate
+	Moved      []string // Destination paths of recordings that were moved
+	Errors     []error
+}
+
+// LoadRecordings loads every recording folder directly inside videosDir.
+// The archive and trash folders and folders without a valid recording.json
+// are skipped.
+func LoadRecordings(videosDir string) ([]models.RecordingInfo, error) {
+	return LoadRecordingsProgress(videosDir, nil)
+}
+
+// LoadRecordingsProgress is LoadRecordings, calling progress (if not nil)
+// with the number of folders scanned so far and the total after each folder
+func LoadRecordingsProgress(videosDir string, progress func(scanned, total int)) ([]models.RecordingInfo, error) {
+	entries, err := os.ReadDir(videosDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var folders []string
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == config.ArchiveDirName || entry.Name() == config.TrashDirName {
+			continue
+		}
+		folders = append(folders, filepath.Join(videosDir, entry.Name()))
+	}
+
+	var recordings []models.RecordingInfo
+	for i, folder := range folders {
+		if info, err := models.LoadRecordingInfo(folder); err == nil {
+			recordings = append(recordings, *info)
+		}
+		if progress != nil {
+			progress(i+1, len(folders))
+		}
+	}
+	return recordings, nil
+}
+
+// recordedAt returns the best known time the recording was made
+func recordedAt(rec models.RecordingInfo) time.Time {
+	if !rec.StartTime.IsZero() {
+		return rec.StartTime
+	}
+	return rec.CreatedAt
+}
+
+// isActive returns true for recordings that are still being recorded or processed
+func isActive(rec models.RecordingInfo) bool {
+	switch rec.Status {
+	case models.StatusRecording, models.StatusPaused, models.StatusProcessing:
+		return true
+	}
+	return false
+}
+
+// Plan returns the recordings the policy would act on at time now, oldest first.
+// Recordings that are still in progress or have no known date are never selected.
+func Plan(recordings []models.RecordingInfo, policy config.RetentionPolicy, now time.Time) []Candidate {
+	if !policy.Enabled() {
+		return nil
+	}
+
+	cutoff := now.AddDate(0, 0, -policy.AgeDays())
+	var candidates []Candidate
+	for _, rec := range recordings {
+		when := recordedAt(rec)
+		if when.IsZero() || isActive(rec) || !when.Before(cutoff) {
+			continue
+		}
+		uploaded := rec.Metadata.IsPublishedToYouTube()
+		if policy.OnlyIfUploaded && !uploaded {
+			continue
+		}
+		candidates = append(candidates, Candidate{
+			FolderPath: rec.Files.FolderPath,
+			Title:      rec.Metadata.Title,
+			RecordedAt: when,
+			AgeDays:    int(now.Sub(when).Hours() / 24),
+			Uploaded:   uploaded,
+			Size:       rec.Files.TotalSize,
+		})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].RecordedAt.Before(candidates[j].RecordedAt)
+	})
+	return candidates
+}
+
+// DestinationDir returns the folder recordings are moved to for action
+func DestinationDir(videosDir string, action config.RetentionAction) string {
+	if action == config.RetentionActionTrash {
+		return filepath.Join(videosDir, config.TrashDirName)
+	}
+	return filepath.Join(videosDir, config.ArchiveDirName)
+}
+
+// Move moves a recording folder into destDir, adding a numeric suffix if a
+// folder with the same name already exists there. Returns the new path.
+func Move(folderPath, destDir string) (string, error) {
+	return moveAs(folderPath, destDir, filepath.Base(folderPath))
+}
+
+// moveAs moves a recording folder into destDir under name, adding a numeric
+// suffix if that name is taken. Returns the new path.
+func moveAs(folderPath, destDir, name string) (string, error) {
+	if err := os.MkdirAll(destDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", destDir, err)
+	}
+
+	dest := filepath.Join(destDir, name)
+	for i := 2; ; i++ {
+		if _, err := os.Stat(dest); os.IsNotExist(err) {
+			break
+		}
+		dest = filepath.Join(destDir, fmt.Sprintf("%s-%d", name, i))
+	}
+
+	if err := os.Rename(folderPath, dest); err != nil {
+		return "", fmt.Errorf("failed to move %s: %w", filepath.Base(folderPath), err)
+	}
+	return dest, nil
+}
+
+// Run evaluates the policy against the recordings in videosDir. When dryRun
+// is true nothing is moved and the result only lists the candidates.
+func Run(videosDir string, policy config.RetentionPolicy, dryRun bool) (*Result, error) {
+	recordings, err := LoadRecordings(videosDir)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Action:     policy.Action,
+		Candidates: Plan(recordings, policy, time.Now()),
+	}
+	if dryRun {
+		return result, nil
+	}
+
+	destDir := DestinationDir(videosDir, policy.Action)
+	for _, c := range result.Candidates {
+		dest, err := Move(c.FolderPath, destDir)
+		if err != nil {
+			result.Errors = append(result.Errors, err)
+			continue
+		}
+		result.Moved = append(result.Moved, dest)
+	}
+	return result, nil
+}
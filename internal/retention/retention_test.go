@@ -0,0 +1,103 @@
+package retention
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func recording(folder string, start time.Time, status string, uploaded bool) models.RecordingInfo {
+	rec := models.RecordingInfo{Status: status, StartTime: start}
+	rec.Files.FolderPath = folder
+	rec.Metadata.Title = filepath.Base(folder)
+	if uploaded {
+		rec.Metadata.YouTubeUploads = []models.YouTubeUpload{{VideoID: "abc123"}}
+	}
+	return rec
+}
+
+func TestPlan(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	recordings := []models.RecordingInfo{
+		recording("/v/new", now.AddDate(0, 0, -5), models.StatusCompleted, true),
+		recording("/v/old-uploaded", now.AddDate(0, 0, -100), models.StatusCompleted, true),
+		recording("/v/older", now.AddDate(0, 0, -200), models.StatusCompleted, false),
+		recording("/v/old-processing", now.AddDate(0, 0, -100), models.StatusProcessing, false),
+		recording("/v/undated", time.Time{}, models.StatusCompleted, false),
+	}
+
+	policy := config.RetentionPolicy{Action: config.RetentionActionArchive, MaxAgeDays: 90}
+	got := Plan(recordings, policy, now)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 candidates, got %d: %+v", len(got), got)
+	}
+	if got[0].FolderPath != "/v/older" || got[1].FolderPath != "/v/old-uploaded" {
+		t.Errorf("expected oldest first, got %s then %s", got[0].FolderPath, got[1].FolderPath)
+	}
+	if got[1].AgeDays != 100 {
+		t.Errorf("expected age 100 days, got %d", got[1].AgeDays)
+	}
+
+	policy.OnlyIfUploaded = true
+	got = Plan(recordings, policy, now)
+	if len(got) != 1 || got[0].FolderPath != "/v/old-uploaded" {
+		t.Errorf("expected only the uploaded recording, got %+v", got)
+	}
+
+	policy.Action = config.RetentionActionOff
+	if got := Plan(recordings, policy, now); len(got) != 0 {
+		t.Errorf("expected no candidates when disabled, got %d", len(got))
+	}
+}
+
+func TestMove(t *testing.T) {
+	videosDir := t.TempDir()
+	destDir := DestinationDir(videosDir, config.RetentionActionTrash)
+
+	for i := 0; i < 2; i++ {
+		folder := filepath.Join(videosDir, "001-demo")
+		if err := os.MkdirAll(folder, 0755); err != nil {
+			t.Fatal(err)
+		}
+		dest, err := Move(folder, destDir)
+		if err != nil {
+			t.Fatalf("Move failed: %v", err)
+		}
+		want := filepath.Join(videosDir, config.TrashDirName, "001-demo")
+		if i == 1 {
+			want += "-2"
+		}
+		if dest != want {
+			t.Errorf("expected %q, got %q", want, dest)
+		}
+		if _, err := os.Stat(folder); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be moved away", folder)
+		}
+	}
+}
+
+func TestLoadRecordingsProgress(t *testing.T) {
+	videosDir := t.TempDir()
+	recordingDir(t, videosDir, "001-demo")
+	recordingDir(t, videosDir, "002-demo")
+	// Scanned but skipped: no recording.json
+	if err := os.Mkdir(filepath.Join(videosDir, "notes"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	recordingDir(t, filepath.Join(videosDir, config.TrashDirName), "003-demo")
+
+	var calls [][2]int
+	recordings, err := LoadRecordingsProgress(videosDir, func(scanned, total int) {
+		calls = append(calls, [2]int{scanned, total})
+	})
+	if err != nil || len(recordings) != 2 {
+		t.Fatalf("LoadRecordingsProgress() = %d recordings, %v; want 2", len(recordings), err)
+	}
+	if len(calls) != 3 || calls[0] != [2]int{1, 3} || calls[2] != [2]int{3, 3} {
+		t.Errorf("progress calls = %v, want 1/3 to 3/3", calls)
+	}
+}
@@ -0,0 +1,105 @@
+package retention
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// trashTimeFormat is the layout of the time appended to the names of
+// folders moved to the trash
+const trashTimeFormat = "20060102-150405"
+
+// trashSuffix matches the time appended by MoveToTrash, and the numeric
+// suffix Move adds on a name collision
+var trashSuffix = regexp.MustCompile(`-(\d{8}-\d{6})(-\d+)?$`)
+
+// TrashedRecording is a recording folder in the trash
+type TrashedRecording struct {
+	Path         string
+	OriginalName string    // Folder name to restore it as
+	TrashedAt    time.Time // Zero if the folder name has no time
+	Info         *models.RecordingInfo
+}
+
+// TrashDir returns the trash folder of videosDir
+func TrashDir(videosDir string) string {
+	return DestinationDir(videosDir, config.RetentionActionTrash)
+}
+
+// MoveToTrash moves a recording folder into the trash of videosDir, adding
+// the time to its name so deleting a recording with the same name again
+// does not collide. Returns the path in the trash.
+func MoveToTrash(videosDir, folderPath string, now time.Time) (string, error) {
+	name := filepath.Base(folderPath) + "-" + now.Format(trashTimeFormat)
+	return moveAs(folderPath, TrashDir(videosDir), name)
+}
+
+// LoadTrash lists the folders in the trash of videosDir, most recently
+// trashed first. Folders without a valid recording.json are listed with a
+// nil Info so they can still be restored or removed.
+func LoadTrash(videosDir string) ([]TrashedRecording, error) {
+	trashDir := TrashDir(videosDir)
+	entries, err := os.ReadDir(trashDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var trashed []TrashedRecording
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		t := TrashedRecording{
+			Path:         filepath.Join(trashDir, entry.Name()),
+			OriginalName: entry.Name(),
+		}
+		if m := trashSuffix.FindStringSubmatchIndex(entry.Name()); m != nil {
+			t.OriginalName = entry.Name()[:m[0]]
+			t.TrashedAt, _ = time.ParseInLocation(trashTimeFormat, entry.Name()[m[2]:m[3]], time.Local)
+		}
+		t.Info, _ = models.LoadRecordingInfo(t.Path)
+		trashed = append(trashed, t)
+	}
+
+	sort.SliceStable(trashed, func(i, j int) bool {
+		return trashed[i].TrashedAt.After(trashed[j].TrashedAt)
+	})
+	return trashed, nil
+}
+
+// Restore moves a trashed recording back into videosDir under its original
+// name, adding a numeric suffix if that name is taken. Returns the new path.
+func Restore(videosDir string, t TrashedRecording) (string, error) {
+	return moveAs(t.Path, videosDir, t.OriginalName)
+}
+
+// EmptyTrash permanently removes every folder in the trash of videosDir and
+// returns how many were removed and the bytes freed. A folder that cannot be
+// removed is reported and the others are still removed.
+func EmptyTrash(videosDir string) (removed int, freed int64, errs []error) {
+	trashed, err := LoadTrash(videosDir)
+	if err != nil {
+		return 0, 0, []error{err}
+	}
+	for _, t := range trashed {
+		if err := os.RemoveAll(t.Path); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove %s: %w", filepath.Base(t.Path), err))
+			continue
+		}
+		removed++
+		if t.Info != nil {
+			freed += t.Info.Files.TotalSize
+		}
+	}
+	return removed, freed, errs
+}
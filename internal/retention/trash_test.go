@@ -0,0 +1,81 @@
+package retention
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// recordingDir creates a recording folder with a recording.json
+func recordingDir(t *testing.T, videosDir, name string) string {
+	t.Helper()
+	dir := filepath.Join(videosDir, name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := []byte(`{"status":"completed","metadata":{"title":"` + name + `"},"files":{"total_size":100}}`)
+	if err := os.WriteFile(filepath.Join(dir, "recording.json"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestMoveToTrashAndRestore(t *testing.T) {
+	videosDir := t.TempDir()
+	now := time.Date(2025, 6, 1, 12, 30, 0, 0, time.Local)
+
+	// The same name deleted twice is kept twice
+	first, err := MoveToTrash(videosDir, recordingDir(t, videosDir, "intro"), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.Base(first) != "intro-20250601-123000" {
+		t.Errorf("trashed as %s", filepath.Base(first))
+	}
+	if _, err := MoveToTrash(videosDir, recordingDir(t, videosDir, "intro"), now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	recordings, err := LoadRecordings(videosDir)
+	if err != nil || len(recordings) != 0 {
+		t.Fatalf("LoadRecordings() = %d recordings, %v; want the trash to be skipped", len(recordings), err)
+	}
+
+	trashed, err := LoadTrash(videosDir)
+	if err != nil || len(trashed) != 2 {
+		t.Fatalf("LoadTrash() = %d, %v; want 2", len(trashed), err)
+	}
+	if !trashed[0].TrashedAt.Equal(now.Add(time.Hour)) || trashed[0].OriginalName != "intro" || trashed[0].Info == nil {
+		t.Errorf("expected the most recently trashed first, got %+v", trashed[0])
+	}
+
+	// Restoring both keeps them apart
+	if dest, err := Restore(videosDir, trashed[0]); err != nil || dest != filepath.Join(videosDir, "intro") {
+		t.Fatalf("Restore() = %s, %v", dest, err)
+	}
+	if dest, err := Restore(videosDir, trashed[1]); err != nil || dest != filepath.Join(videosDir, "intro-2") {
+		t.Fatalf("Restore() = %s, %v; want intro-2", dest, err)
+	}
+	if recordings, _ := LoadRecordings(videosDir); len(recordings) != 2 {
+		t.Errorf("expected 2 restored recordings, got %d", len(recordings))
+	}
+}
+
+func TestEmptyTrash(t *testing.T) {
+	videosDir := t.TempDir()
+	now := time.Now()
+	for _, name := range []string{"a", "b"} {
+		if _, err := MoveToTrash(videosDir, recordingDir(t, videosDir, name), now); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	removed, freed, errs := EmptyTrash(videosDir)
+	if removed != 2 || freed != 200 || len(errs) != 0 {
+		t.Errorf("EmptyTrash() = %d, %d, %v; want 2 and 200 bytes", removed, freed, errs)
+	}
+	if trashed, _ := LoadTrash(videosDir); len(trashed) != 0 {
+		t.Errorf("expected an empty trash, got %d", len(trashed))
+	}
+}
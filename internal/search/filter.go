@@ -0,0 +1,89 @@
+package search
+
+import (
+	"strings"
+	"time"
+)
+
+// Period is a date range relative to the current time
+type Period string
+
+const (
+	PeriodAny   Period = ""      // No date restriction
+	PeriodToday Period = "today" // Started today
+	PeriodWeek  Period = "week"  // Started in the last 7 days
+	PeriodMonth Period = "month" // Started this calendar month
+	PeriodYear  Period = "year"  // Started this calendar year
+)
+
+// Periods is the list of selectable periods, in display order
+var Periods = []Period{PeriodAny, PeriodToday, PeriodWeek, PeriodMonth, PeriodYear}
+
+// Label returns the display name of the period
+func (p Period) Label() string {
+	switch p {
+	case PeriodToday:
+		return "Today"
+	case PeriodWeek:
+		return "Last 7 days"
+	case PeriodMonth:
+		return "This month"
+	case PeriodYear:
+		return "This year"
+	}
+	return "Any time"
+}
+
+// Since returns the start of the period relative to now, or the zero time
+// for PeriodAny and unknown periods
+func (p Period) Since(now time.Time) time.Time {
+	y, m, d := now.Date()
+	switch p {
+	case PeriodToday:
+		return time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	case PeriodWeek:
+		return time.Date(y, m, d-6, 0, 0, 0, 0, now.Location())
+	case PeriodMonth:
+		return time.Date(y, m, 1, 0, 0, 0, 0, now.Location())
+	case PeriodYear:
+		return time.Date(y, 1, 1, 0, 0, 0, 0, now.Location())
+	}
+	return time.Time{}
+}
+
+// Filter narrows recordings down by status, topic and start date. Empty
+// fields do not restrict the recordings.
+type Filter struct {
+	Status string // Recording status, e.g. models.StatusFailed
+	Topic  string // Topic name, compared case-insensitively
+	Period Period
+}
+
+// IsZero reports whether the filter matches every recording
+func (f Filter) IsZero() bool {
+	return f.Status == "" && f.Topic == "" && f.Period == PeriodAny
+}
+
+// Narrow returns the positions (as returned by Search or SearchFuzzy) of the
+// recordings that also match the filter, keeping their order. now is the
+// time the period is relative to.
+func (ix *Index) Narrow(positions []int, f Filter, now time.Time) []int {
+	topic := strings.ToLower(f.Topic)
+	since := f.Period.Since(now)
+
+	matches := make([]int, 0, len(positions))
+	for _, pos := range positions {
+		if f.Status != "" && ix.statuses[pos] != f.Status {
+			continue
+		}
+		// fields[1] is the lowercased topic
+		if topic != "" && ix.fields[pos][1] != topic {
+			continue
+		}
+		if !since.IsZero() && ix.started[pos].Before(since) {
+			continue
+		}
+		matches = append(matches, pos)
+	}
+	return matches
+}
@@ -0,0 +1,71 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestPeriodSince(t *testing.T) {
+	now := time.Date(2026, time.March, 4, 15, 30, 0, 0, time.UTC)
+	tests := []struct {
+		period Period
+		want   time.Time
+	}{
+		{PeriodAny, time.Time{}},
+		{PeriodToday, time.Date(2026, time.March, 4, 0, 0, 0, 0, time.UTC)},
+		{PeriodWeek, time.Date(2026, time.February, 26, 0, 0, 0, 0, time.UTC)},
+		{PeriodMonth, time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{PeriodYear, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{Period("fortnight"), time.Time{}},
+	}
+	for _, tt := range tests {
+		if got := tt.period.Since(now); !got.Equal(tt.want) {
+			t.Errorf("%q.Since() = %v, want %v", tt.period, got, tt.want)
+		}
+	}
+}
+
+func TestNarrow(t *testing.T) {
+	now := time.Date(2026, time.March, 20, 12, 0, 0, 0, time.UTC)
+	rec := func(title, topic, status string, started time.Time) models.RecordingInfo {
+		r := recording(title, topic, "Tim Sutton")
+		r.Status = status
+		r.StartTime = started
+		return r
+	}
+	ix := NewIndex([]models.RecordingInfo{
+		rec("QGIS basics", "Tutorial", models.StatusCompleted, now.AddDate(0, 0, -2)),
+		rec("Broken capture", "Demo", models.StatusFailed, now.AddDate(0, 0, -1)),
+		rec("Old QGIS tutorial", "Tutorial", models.StatusCompleted, now.AddDate(0, -2, 0)),
+		rec("Failed tutorial", "tutorial", models.StatusFailed, now.AddDate(0, 0, -30)),
+	})
+
+	tests := []struct {
+		name   string
+		query  string
+		filter Filter
+		want   []int
+	}{
+		{"no filter", "", Filter{}, []int{0, 1, 2, 3}},
+		{"failed recordings", "", Filter{Status: models.StatusFailed}, []int{1, 3}},
+		{"this month's tutorials", "", Filter{Topic: "Tutorial", Period: PeriodMonth}, []int{0}},
+		{"topic is case-insensitive", "", Filter{Topic: "TUTORIAL"}, []int{0, 2, 3}},
+		{"combined with text", "qgis", Filter{Topic: "Tutorial"}, []int{0, 2}},
+		{"last 7 days", "", Filter{Period: PeriodWeek}, []int{0, 1}},
+		{"nothing matches", "", Filter{Status: models.StatusRecording}, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ix.Narrow(ix.Search(tt.query), tt.filter, now); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Narrow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !(Filter{}).IsZero() || (Filter{Status: models.StatusFailed}).IsZero() {
+		t.Error("IsZero() should only be true for an empty filter")
+	}
+}
@@ -0,0 +1,65 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Scores of a fuzzy match; higher is better
+const (
+	scoreMatch       = 16 // Each matched character
+	scoreConsecutive = 12 // Matched right after the previous match
+	scoreWordStart   = 10 // Matched at the start of a word
+	scoreFirstChar   = 8  // Matched at the start of the text
+	penaltyGap       = 1  // Each skipped character between matches
+	maxGapPenalty    = 12 // Cap on the penalty of a single gap
+)
+
+// FuzzyScore matches pattern against text as a subsequence, ignoring case:
+// every character of pattern must occur in text in order, but not
+// necessarily next to each other ("qgpl" matches "QGIS plugins"). It reports
+// whether pattern matches and a score ranking the match: consecutive
+// characters and characters at word starts score higher, gaps lower.
+func FuzzyScore(pattern, text string) (int, bool) {
+	pattern = strings.ToLower(pattern)
+	text = strings.ToLower(text)
+	if pattern == "" {
+		return 0, true
+	}
+
+	score := 0
+	prevEnd := -1    // Byte index in text after the previous match
+	prev := rune(-1) // Previous rune of text
+	p, _ := utf8.DecodeRuneInString(pattern)
+	pi := 0
+	for ti, r := range text {
+		if r == p {
+			score += scoreMatch
+			switch {
+			case ti == 0:
+				score += scoreFirstChar + scoreWordStart
+			case !unicode.IsLetter(prev) && !unicode.IsDigit(prev):
+				score += scoreWordStart
+			}
+			if prevEnd >= 0 {
+				if gap := ti - prevEnd; gap == 0 {
+					score += scoreConsecutive
+				} else if gap > maxGapPenalty {
+					score -= maxGapPenalty * penaltyGap
+				} else {
+					score -= gap * penaltyGap
+				}
+			}
+			prevEnd = ti + utf8.RuneLen(r)
+
+			pi += utf8.RuneLen(p)
+			if pi >= len(pattern) {
+				return score, true
+			}
+			p, _ = utf8.DecodeRuneInString(pattern[pi:])
+		}
+		prev = r
+	}
+	return 0, false
+}
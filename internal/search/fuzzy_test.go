@@ -0,0 +1,86 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestFuzzyScore(t *testing.T) {
+	tests := []struct {
+		pattern string
+		text    string
+		wantOK  bool
+	}{
+		{"qgis", "QGIS plugins", true},
+		{"qgpl", "QGIS plugins", true},
+		{"plgn", "QGIS plugins", true},
+		{"", "anything", true},
+		{"sqig", "QGIS plugins", false}, // Out of order
+		{"qgisx", "QGIS", false},
+		{"über", "Über Demo", true},
+	}
+	for _, tt := range tests {
+		if _, ok := FuzzyScore(tt.pattern, tt.text); ok != tt.wantOK {
+			t.Errorf("FuzzyScore(%q, %q) ok = %v, want %v", tt.pattern, tt.text, ok, tt.wantOK)
+		}
+	}
+}
+
+func TestFuzzyScoreRanking(t *testing.T) {
+	score := func(pattern, text string) int {
+		t.Helper()
+		s, ok := FuzzyScore(pattern, text)
+		if !ok {
+			t.Fatalf("FuzzyScore(%q, %q) did not match", pattern, text)
+		}
+		return s
+	}
+
+	// Consecutive characters beat scattered ones
+	if a, b := score("plug", "plugins"), score("plug", "p l u g"); a <= b {
+		t.Errorf("consecutive score %d <= scattered score %d", a, b)
+	}
+	// Word starts beat characters inside words
+	if a, b := score("qp", "qgis plugins"), score("qp", "aqaap"); a <= b {
+		t.Errorf("word start score %d <= mid-word score %d", a, b)
+	}
+	// Short gaps beat long gaps
+	if a, b := score("ab", "a-b"), score("ab", "a---------b"); a <= b {
+		t.Errorf("short gap score %d <= long gap score %d", a, b)
+	}
+}
+
+func TestSearchFuzzy(t *testing.T) {
+	ix := NewIndex([]models.RecordingInfo{
+		recording("Sprint review", "Meeting", "Jeremy Prior"),
+		recording("Geoserver styling", "Tutorial", "Tim Sutton"),
+		recording("QGIS plugins", "Demo", "Tim Sutton"),
+	})
+
+	tests := []struct {
+		query string
+		want  []int
+	}{
+		{"", []int{0, 1, 2}},
+		{"qgpl", []int{2}},
+		{"tutrial", []int{1}}, // Typo, missing letter
+		{"tim", []int{1, 2}},  // Equal scores keep list order
+		{"sp", []int{0, 2}},   // Best match first
+		{"zzz", []int{}},
+	}
+	for _, tt := range tests {
+		if got := ix.SearchFuzzy(tt.query); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SearchFuzzy(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkSearchFuzzy(b *testing.B) {
+	ix := NewIndex(syntheticRecordings(10000))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ix.SearchFuzzy("lyr 42 tim")
+	}
+}
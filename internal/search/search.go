@@ -0,0 +1,129 @@
+// Package search implements the in-memory index the recording history
+// queries while filtering, so typing stays fast with thousands of
+// recordings.
+package search
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// fieldSeparator separates the fields of a document so a query cannot match
+// across two fields
+const fieldSeparator = "\x00"
+
+// Index holds the lowercased searchable text of a list of recordings. It is
+// built once when the recordings are loaded and must be rebuilt when the
+// list changes.
+type Index struct {
+	docs   []string   // Searchable text per recording, in list order
+	fields [][]string // Lowercased fields per recording, for fuzzy matching
+
+	// Filter attributes per recording, see Narrow
+	statuses []string
+	started  []time.Time
+}
+
+// NewIndex builds an index over the title, topic, presenter and folder name
+// of the recordings
+func NewIndex(recordings []models.RecordingInfo) *Index {
+	ix := &Index{
+		docs:   make([]string, len(recordings)),
+		fields: make([][]string, len(recordings)),
+
+		statuses: make([]string, len(recordings)),
+		started:  make([]time.Time, len(recordings)),
+	}
+	for i, rec := range recordings {
+		folder := ""
+		if rec.Files.FolderPath != "" {
+			folder = filepath.Base(rec.Files.FolderPath)
+		}
+		ix.fields[i] = []string{
+			strings.ToLower(rec.Metadata.Title),
+			strings.ToLower(rec.Metadata.Topic),
+			strings.ToLower(rec.Metadata.Presenter),
+			strings.ToLower(folder),
+		}
+		ix.docs[i] = strings.Join(ix.fields[i], fieldSeparator)
+		ix.statuses[i] = rec.Status
+		ix.started[i] = rec.StartTime
+	}
+	return ix
+}
+
+// Len returns the number of indexed recordings
+func (ix *Index) Len() int {
+	return len(ix.docs)
+}
+
+// Search returns the positions of the recordings matching the query, in list
+// order. Every word of the query must occur (case-insensitively) in the
+// recording; an empty query matches all recordings.
+func (ix *Index) Search(query string) []int {
+	words := strings.Fields(strings.ToLower(query))
+
+	matches := make([]int, 0, len(ix.docs))
+	for i, doc := range ix.docs {
+		if containsAll(doc, words) {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+// SearchFuzzy returns the positions of the recordings fuzzy matching the
+// query, best match first (ties in list order). Every word of the query must
+// match a field of the recording as a subsequence (see FuzzyScore); an empty
+// query matches all recordings in list order.
+func (ix *Index) SearchFuzzy(query string) []int {
+	words := strings.Fields(strings.ToLower(query))
+
+	type match struct {
+		pos   int
+		score int
+	}
+	matches := make([]match, 0, len(ix.docs))
+	for i, fields := range ix.fields {
+		total, ok := 0, true
+		for _, w := range words {
+			best, found := 0, false
+			for _, f := range fields {
+				if score, ok := FuzzyScore(w, f); ok && (!found || score > best) {
+					best, found = score, true
+				}
+			}
+			if !found {
+				ok = false
+				break
+			}
+			total += best
+		}
+		if ok {
+			matches = append(matches, match{pos: i, score: total})
+		}
+	}
+
+	sort.SliceStable(matches, func(a, b int) bool {
+		return matches[a].score > matches[b].score
+	})
+	positions := make([]int, len(matches))
+	for i, m := range matches {
+		positions[i] = m.pos
+	}
+	return positions
+}
+
+// containsAll reports whether doc contains every word
+func containsAll(doc string, words []string) bool {
+	for _, w := range words {
+		if !strings.Contains(doc, w) {
+			return false
+		}
+	}
+	return true
+}
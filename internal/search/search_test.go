@@ -0,0 +1,137 @@
+package search
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func recording(title, topic, presenter string) models.RecordingInfo {
+	var rec models.RecordingInfo
+	rec.Metadata.Title = title
+	rec.Metadata.Topic = topic
+	rec.Metadata.Presenter = presenter
+	return rec
+}
+
+func TestSearch(t *testing.T) {
+	ix := NewIndex([]models.RecordingInfo{
+		recording("Introduction to QGIS", "Tutorial", "Tim Sutton"),
+		recording("Sprint review", "Meeting", "Jeremy Prior"),
+		recording("QGIS plugin demo", "Demo", "Tim Sutton"),
+	})
+
+	tests := []struct {
+		query string
+		want  []int
+	}{
+		{"", []int{0, 1, 2}},
+		{"qgis", []int{0, 2}},
+		{"QGIS demo", []int{2}},
+		{"tim", []int{0, 2}},
+		{"meeting", []int{1}},
+		{"  review  ", []int{1}},
+		{"tutorialtim", []int{}}, // No match across fields
+		{"kubernetes", []int{}},
+	}
+	for _, tt := range tests {
+		if got := ix.Search(tt.query); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+	if ix.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", ix.Len())
+	}
+}
+
+func TestSearchFolderName(t *testing.T) {
+	renamed := recording("Introduction to QGIS", "Tutorial", "Tim Sutton")
+	renamed.Files.FolderPath = "/videos/Screencasts/014-qgis-intro-take-2"
+	ix := NewIndex([]models.RecordingInfo{
+		renamed,
+		recording("Sprint review", "Meeting", "Jeremy Prior"),
+	})
+
+	if got := ix.Search("TAKE-2"); !reflect.DeepEqual(got, []int{0}) {
+		t.Errorf("Search(%q) = %v, want [0]", "TAKE-2", got)
+	}
+	// Only the folder name is searched, not its parents
+	if got := ix.Search("screencasts"); len(got) != 0 {
+		t.Errorf("Search(%q) = %v, want no matches", "screencasts", got)
+	}
+}
+
+// syntheticRecordings returns n recordings with varied titles, topics and
+// presenters
+func syntheticRecordings(n int) []models.RecordingInfo {
+	topics := []string{"Tutorial", "Demo", "Meeting", "Training", "Presentation"}
+	presenters := []string{"Tim Sutton", "Jeremy Prior", "Amy Ratcliffe", "Dimas Ciputra"}
+	recordings := make([]models.RecordingInfo, n)
+	for i := range recordings {
+		recordings[i] = recording(
+			fmt.Sprintf("Episode %d: working with layer %d in QGIS", i, i%97),
+			topics[i%len(topics)],
+			presenters[i%len(presenters)],
+		)
+	}
+	return recordings
+}
+
+// filterWithoutIndex is the filter the index replaces: lowercasing every
+// field of every recording on each keystroke
+func filterWithoutIndex(recordings []models.RecordingInfo, query string) []int {
+	words := strings.Fields(strings.ToLower(query))
+	var matches []int
+	for i, rec := range recordings {
+		fields := []string{
+			strings.ToLower(rec.Metadata.Title),
+			strings.ToLower(rec.Metadata.Topic),
+			strings.ToLower(rec.Metadata.Presenter),
+		}
+		all := true
+		for _, w := range words {
+			found := false
+			for _, f := range fields {
+				if strings.Contains(f, w) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				all = false
+				break
+			}
+		}
+		if all {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+func BenchmarkSearch(b *testing.B) {
+	ix := NewIndex(syntheticRecordings(10000))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ix.Search("layer 42 tim")
+	}
+}
+
+func BenchmarkSearchWithoutIndex(b *testing.B) {
+	recordings := syntheticRecordings(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		filterWithoutIndex(recordings, "layer 42 tim")
+	}
+}
+
+func BenchmarkNewIndex(b *testing.B) {
+	recordings := syntheticRecordings(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NewIndex(recordings)
+	}
+}
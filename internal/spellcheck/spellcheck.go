@@ -8,6 +8,18 @@ import (
 	"github.com/sajari/fuzzy"
 )
 
+// Language is the BCP-47 code of the language the spell checker checks.
+// Upload metadata in this language is spell checked by default.
+const Language = "en-GB"
+
+// SupportsLanguage reports whether text in the given language (BCP-47 code)
+// can be spell checked. The English dictionary is used for all English
+// variants; an empty code is treated as English.
+func SupportsLanguage(code string) bool {
+	code = strings.ToLower(code)
+	return code == "" || code == "en" || strings.HasPrefix(code, "en-")
+}
+
 // SpellChecker provides spell checking functionality with UK English
 type SpellChecker struct {
 	model *fuzzy.Model
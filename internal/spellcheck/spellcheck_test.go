@@ -136,3 +136,24 @@ func TestIsLikelyAcronym(t *testing.T) {
 		}
 	}
 }
+
+func TestSupportsLanguage(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected bool
+	}{
+		{Language, true},
+		{"en", true},
+		{"en-US", true},
+		{"", true},
+		{"fr", false},
+		{"eng", false},
+	}
+
+	for _, tt := range tests {
+		result := SupportsLanguage(tt.code)
+		if result != tt.expected {
+			t.Errorf("SupportsLanguage(%q) = %v, expected %v", tt.code, result, tt.expected)
+		}
+	}
+}
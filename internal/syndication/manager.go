@@ -211,9 +211,9 @@ func CreateContentFromMetadata(metadata *models.RecordingMetadata, customMessage
 		CustomMessage: customMessage,
 	}
 
-	if metadata.YouTube != nil {
-		content.VideoURL = metadata.YouTube.VideoURL
-		content.ThumbnailPath = metadata.YouTube.ThumbnailURL
+	if yt := metadata.PrimaryYouTube(); yt != nil {
+		content.VideoURL = yt.VideoURL
+		content.ThumbnailPath = yt.ThumbnailURL
 	}
 
 	// Convert topic to tag
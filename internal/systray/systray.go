@@ -897,7 +897,8 @@ func (m *Manager) StartRecording() error {
 	recordingInfo.Settings.ScreenEnabled = presets.RecordScreen
 	recordingInfo.Settings.AudioEnabled = presets.RecordAudio
 	recordingInfo.Settings.WebcamEnabled = presets.RecordWebcam
-	recordingInfo.Settings.VerticalEnabled = presets.VerticalVideo
+	recordingInfo.Settings.VerticalEnabled = presets.CreatesVerticalVideo()
+	recordingInfo.Settings.WebcamOnlyEnabled = presets.CreatesWebcamOnlyVideo()
 	recordingInfo.Settings.LogosEnabled = presets.AddLogos
 
 	// Save initial recording.json
@@ -911,7 +912,7 @@ func (m *Manager) StartRecording() error {
 		NoAudio:        !presets.RecordAudio,
 		NoWebcam:       !presets.RecordWebcam,
 		NoScreen:       !presets.RecordScreen,
-		CreateVertical: presets.VerticalVideo,
+		CreateVertical: presets.CreatesVerticalVideo(),
 		RecordingInfo:  recordingInfo,
 	}
 
@@ -0,0 +1,152 @@
+// Package templates reads recording metadata templates: predefined series
+// with a title pattern, topic, description and YouTube upload settings that
+// seed the recording form. Templates are imported from YAML or JSON files.
+package templates
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Privacy settings a template may set for the YouTube upload
+var Privacies = []string{"public", "unlisted", "private"}
+
+// Template predefines the metadata of the recordings of a series
+type Template struct {
+	Name         string   `json:"name" yaml:"name"`
+	TitlePattern string   `json:"title_pattern,omitempty" yaml:"title_pattern"` // See Title for the placeholders
+	Topic        string   `json:"topic,omitempty" yaml:"topic"`                 // Topic name
+	Description  string   `json:"description,omitempty" yaml:"description"`
+	Tags         []string `json:"tags,omitempty" yaml:"tags"`
+	Privacy      string   `json:"privacy,omitempty" yaml:"privacy"`   // public, unlisted or private (empty = default)
+	Playlist     string   `json:"playlist,omitempty" yaml:"playlist"` // Playlist title
+}
+
+// Validate returns an error if the template has no name or an unknown
+// privacy setting
+func (t Template) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return fmt.Errorf("template has no name")
+	}
+	if t.Privacy == "" {
+		return nil
+	}
+	for _, p := range Privacies {
+		if t.Privacy == p {
+			return nil
+		}
+	}
+	return fmt.Errorf("template %q: privacy must be one of %s, not %q", t.Name, strings.Join(Privacies, ", "), t.Privacy)
+}
+
+// Title expands the title pattern for a recording: {number} is replaced by
+// the recording number, {topic} by the topic and {date} by the date as
+// YYYY-MM-DD
+func (t Template) Title(number int, date time.Time) string {
+	return strings.NewReplacer(
+		"{number}", strconv.Itoa(number),
+		"{topic}", t.Topic,
+		"{date}", date.Format("2006-01-02"),
+	).Replace(t.TitlePattern)
+}
+
+// LoadFile reads the templates in a .yaml, .yml or .json file
+func LoadFile(path string) ([]Template, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var list []Template
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		list, err = ParseJSON(data)
+	case ".yaml", ".yml":
+		list, err = ParseYAML(data)
+	default:
+		return nil, fmt.Errorf("unsupported template file %s: use .yaml, .yml or .json", filepath.Base(path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	return list, nil
+}
+
+// ParseJSON reads one template object or an array of them
+func ParseJSON(data []byte) ([]Template, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, fmt.Errorf("no templates")
+	}
+
+	var list []Template
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if data[0] == '[' {
+		if err := dec.Decode(&list); err != nil {
+			return nil, err
+		}
+	} else {
+		var t Template
+		if err := dec.Decode(&t); err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+	return checked(list)
+}
+
+// Merge adds the imported templates to a list, replacing the templates
+// with the same name in place
+func Merge(list, imported []Template) []Template {
+	merged := append([]Template(nil), list...)
+	for _, t := range imported {
+		replaced := false
+		for i := range merged {
+			if merged[i].Name == t.Name {
+				merged[i] = t
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, t)
+		}
+	}
+	return merged
+}
+
+// checked normalizes and validates parsed templates, which must have unique
+// names
+func checked(list []Template) ([]Template, error) {
+	if len(list) == 0 {
+		return nil, fmt.Errorf("no templates")
+	}
+	seen := make(map[string]bool, len(list))
+	for i := range list {
+		t := &list[i]
+		t.Name = strings.TrimSpace(t.Name)
+		t.Privacy = strings.ToLower(strings.TrimSpace(t.Privacy))
+		var tags []string
+		for _, tag := range t.Tags {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+		t.Tags = tags
+
+		if err := t.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[t.Name] {
+			return nil, fmt.Errorf("template %q is defined twice", t.Name)
+		}
+		seen[t.Name] = true
+	}
+	return list, nil
+}
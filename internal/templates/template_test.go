@@ -0,0 +1,102 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTemplate_Title(t *testing.T) {
+	tmpl := Template{Name: "QGIS Tips", Topic: "QGIS", TitlePattern: "{topic} Tips #{number} ({date})"}
+	got := tmpl.Title(12, time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC))
+	if want := "QGIS Tips #12 (2026-03-04)"; got != want {
+		t.Errorf("Title() = %q, want %q", got, want)
+	}
+}
+
+func TestTemplate_Validate(t *testing.T) {
+	tests := []struct {
+		tmpl    Template
+		wantErr bool
+	}{
+		{Template{Name: "Series"}, false},
+		{Template{Name: "Series", Privacy: "unlisted"}, false},
+		{Template{Name: " "}, true},
+		{Template{Name: "Series", Privacy: "friends"}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.tmpl.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%+v) error = %v, wantErr %v", tt.tmpl, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	list, err := ParseJSON([]byte(`[
+		{"name": "QGIS Tips", "topic": "QGIS", "tags": ["qgis", " gis "], "privacy": "Public"},
+		{"name": "Sprint review", "playlist": "Sprints"}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list[0].Privacy != "public" || !reflect.DeepEqual(list[0].Tags, []string{"qgis", "gis"}) {
+		t.Errorf("ParseJSON() = %+v", list)
+	}
+
+	single, err := ParseJSON([]byte(`{"name": "Single"}`))
+	if err != nil || len(single) != 1 || single[0].Name != "Single" {
+		t.Errorf("ParseJSON(object) = %+v, %v", single, err)
+	}
+
+	for _, bad := range []string{``, `[]`, `{"name": "A", "colour": "red"}`, `[{"name": "A"}, {"name": "A"}]`, `{"privacy": "public"}`} {
+		if _, err := ParseJSON([]byte(bad)); err == nil {
+			t.Errorf("ParseJSON(%s) succeeded, want an error", bad)
+		}
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	yamlPath := filepath.Join(dir, "series.yml")
+	if err := os.WriteFile(yamlPath, []byte("name: QGIS Tips\ntopic: QGIS\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	list, err := LoadFile(yamlPath)
+	if err != nil || len(list) != 1 || list[0].Topic != "QGIS" {
+		t.Errorf("LoadFile(yml) = %+v, %v", list, err)
+	}
+
+	txtPath := filepath.Join(dir, "series.txt")
+	if err := os.WriteFile(txtPath, []byte("name: QGIS Tips\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadFile(txtPath); err == nil {
+		t.Error("expected an error for an unsupported file type")
+	}
+
+	badPath := filepath.Join(dir, "bad.yaml")
+	if err := os.WriteFile(badPath, []byte("name: A\ncolour: red\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadFile(badPath); err == nil || !strings.Contains(err.Error(), "bad.yaml") || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("LoadFile(bad) error = %v, want the file name and line", err)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	list := []Template{{Name: "A", Topic: "old"}, {Name: "B"}}
+	merged := Merge(list, []Template{{Name: "C"}, {Name: "A", Topic: "new"}})
+	var names []string
+	for _, tmpl := range merged {
+		names = append(names, tmpl.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"A", "B", "C"}) || merged[0].Topic != "new" {
+		t.Errorf("Merge() = %+v", merged)
+	}
+	if list[0].Topic != "old" {
+		t.Error("expected Merge to leave the original list unchanged")
+	}
+}
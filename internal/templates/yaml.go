@@ -0,0 +1,83 @@
+package templates
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ParseYAML reads templates from YAML: a mapping per document (documents
+// are separated by ---) or a list of mappings. Unknown keys are rejected,
+// like in JSON files.
+func ParseYAML(data []byte) ([]Template, error) {
+	var list []Template
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if len(doc.Content) == 0 {
+			continue
+		}
+
+		switch doc.Content[0].Kind {
+		case yaml.SequenceNode:
+			for _, item := range doc.Content[0].Content {
+				if err := checkKeys(item); err != nil {
+					return nil, err
+				}
+			}
+			var items []Template
+			if err := doc.Decode(&items); err != nil {
+				return nil, err
+			}
+			list = append(list, items...)
+		case yaml.MappingNode:
+			if err := checkKeys(doc.Content[0]); err != nil {
+				return nil, err
+			}
+			var t Template
+			if err := doc.Decode(&t); err != nil {
+				return nil, err
+			}
+			list = append(list, t)
+		default:
+			return nil, fmt.Errorf("line %d: expected a template or a list of templates", doc.Content[0].Line)
+		}
+	}
+	return checked(list)
+}
+
+// templateKeys are the keys a template mapping may have
+var templateKeys = func() map[string]bool {
+	keys := make(map[string]bool)
+	typ := reflect.TypeOf(Template{})
+	for i := 0; i < typ.NumField(); i++ {
+		keys[strings.Split(typ.Field(i).Tag.Get("yaml"), ",")[0]] = true
+	}
+	return keys
+}()
+
+// checkKeys returns an error for a key that is not a template key, which
+// decoding a node would silently ignore. Merge keys (<<) are allowed.
+func checkKeys(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i]
+		if key.Value != "<<" && !templateKeys[key.Value] {
+			return fmt.Errorf("line %d: unknown key %q", key.Line, key.Value)
+		}
+	}
+	return nil
+}
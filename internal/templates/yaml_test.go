@@ -0,0 +1,130 @@
+package templates
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseYAML_Mapping(t *testing.T) {
+	list, err := ParseYAML([]byte(`# QGIS tips series
+name: QGIS Tips
+title_pattern: "QGIS Tips #{number}"
+topic: QGIS   # must match a topic in Options
+tags:
+  - qgis
+  - 'open source'
+privacy: unlisted
+playlist: QGIS Tips
+description: |
+  Short tips for QGIS users.
+
+  Subscribe for more!
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Template{
+		Name:         "QGIS Tips",
+		TitlePattern: "QGIS Tips #{number}",
+		Topic:        "QGIS",
+		Tags:         []string{"qgis", "open source"},
+		Privacy:      "unlisted",
+		Playlist:     "QGIS Tips",
+		Description:  "Short tips for QGIS users.\n\nSubscribe for more!\n",
+	}
+	if len(list) != 1 || !reflect.DeepEqual(list[0], want) {
+		t.Errorf("ParseYAML() = %+v, want %+v", list, want)
+	}
+}
+
+func TestParseYAML_Documents(t *testing.T) {
+	list, err := ParseYAML([]byte(`---
+name: First
+tags: [a, "b, c", 'd']
+---
+name: Second
+tags: [
+  x,
+  y,
+]
+...
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("got %d templates, want 2", len(list))
+	}
+	if !reflect.DeepEqual(list[0].Tags, []string{"a", "b, c", "d"}) {
+		t.Errorf("flow list tags = %q", list[0].Tags)
+	}
+	if !reflect.DeepEqual(list[1].Tags, []string{"x", "y"}) {
+		t.Errorf("multi-line flow list tags = %q", list[1].Tags)
+	}
+}
+
+func TestParseYAML_List(t *testing.T) {
+	list, err := ParseYAML([]byte(`- name: First
+  description: >-
+    Folded
+    onto one line
+  tags:
+  - a
+- name: Second
+  privacy: Private
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list[0].Description != "Folded onto one line" || list[1].Privacy != "private" {
+		t.Errorf("ParseYAML() = %+v", list)
+	}
+	if !reflect.DeepEqual(list[0].Tags, []string{"a"}) {
+		t.Errorf("tags = %q, want [a]", list[0].Tags)
+	}
+}
+
+func TestParseYAML_Anchors(t *testing.T) {
+	list, err := ParseYAML([]byte(`- &base
+  name: QGIS Tips
+  topic: QGIS
+  tags: &tags [qgis, "open source"]
+  privacy: unlisted
+- <<: *base
+  name: QGIS Deep Dives
+  tags: *tags
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list[1].Topic != "QGIS" || list[1].Privacy != "unlisted" || list[1].Name != "QGIS Deep Dives" {
+		t.Fatalf("ParseYAML() = %+v, want the second template to inherit from the first", list)
+	}
+	if !reflect.DeepEqual(list[1].Tags, []string{"qgis", "open source"}) {
+		t.Errorf("aliased tags = %q", list[1].Tags)
+	}
+}
+
+func TestParseYAML_Errors(t *testing.T) {
+	tests := []struct {
+		yaml, want string
+	}{
+		{"name: A\ncolour: red\n", `line 2: unknown key "colour"`},
+		{"name: A\nname: B\n", `line 2: mapping key "name" already defined`},
+		{"name: A\ntopic:\n  - a\n", "line 3: cannot unmarshal !!seq into string"},
+		{"name: A\ntags: [a, b\n", "did not find expected ',' or ']'"},
+		{"name: \"A\n", "found unexpected end of stream"},
+		{"name: A\nprivacy: friends\n", "privacy must be one of"},
+		{"topic: QGIS\n", "no name"},
+		{"# nothing\n", "no templates"},
+		{"just a string\n", "line 1: expected a template or a list of templates"},
+		{"name: A\ntags:\n  sub: value\n", "cannot unmarshal !!map into []string"},
+	}
+	for _, tt := range tests {
+		_, err := ParseYAML([]byte(tt.yaml))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseYAML(%q) error = %v, want %q", tt.yaml, err, tt.want)
+		}
+	}
+}
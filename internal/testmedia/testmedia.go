@@ -0,0 +1,137 @@
+// Package testmedia generates tiny sample recordings with ffmpeg so tests
+// can run the processing pipeline end to end: colour bars for the screen,
+// a moving test pattern for the webcam and a sine tone for the microphone.
+//
+// The samples are generated into a test's temporary directory rather than
+// bundled, so they always match the installed ffmpeg. Tests that use them
+// call Require first and are skipped when ffmpeg is not installed or when
+// running with -short.
+package testmedia
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// Require skips the test when ffmpeg or ffprobe is not installed, or when
+// tests run with -short
+func Require(t testing.TB) {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("skipping processing pipeline test in short mode")
+	}
+	for _, tool := range []string{"ffmpeg", "ffprobe"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			t.Skipf("skipping processing pipeline test: %s not found", tool)
+		}
+	}
+}
+
+// Screen writes colour bars of the given size and length to dir/name as an
+// H.264 MP4, like a screen recording, and returns the path
+func Screen(t testing.TB, dir, name string, width, height int, seconds float64) string {
+	t.Helper()
+	return video(t, filepath.Join(dir, name), fmt.Sprintf("smptebars=size=%dx%d:rate=30:duration=%g", width, height, seconds))
+}
+
+// Webcam writes a moving test pattern of the given size and length to
+// dir/name as an H.264 MP4, like a webcam recording, and returns the path
+func Webcam(t testing.TB, dir, name string, width, height int, seconds float64) string {
+	t.Helper()
+	return video(t, filepath.Join(dir, name), fmt.Sprintf("testsrc2=size=%dx%d:rate=30:duration=%g", width, height, seconds))
+}
+
+// Tone writes a 440 Hz sine tone of the given length to dir/name as 48 kHz
+// stereo 16-bit WAV, the format the audio recorder produces, and returns
+// the path
+func Tone(t testing.TB, dir, name string, seconds float64) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	run(t, "-f", "lavfi", "-i", fmt.Sprintf("sine=frequency=440:sample_rate=48000:duration=%g", seconds),
+		"-ac", "2", "-c:a", "pcm_s16le", path)
+	return path
+}
+
+// video encodes a lavfi source to an H.264 MP4
+func video(t testing.TB, path, source string) string {
+	t.Helper()
+	run(t, "-f", "lavfi", "-i", source,
+		"-c:v", "libx264", "-preset", "ultrafast", "-pix_fmt", "yuv420p", path)
+	return path
+}
+
+// run runs ffmpeg, failing the test with its output on error
+func run(t testing.TB, args ...string) {
+	t.Helper()
+	cmd := exec.Command("ffmpeg", append([]string{"-y", "-v", "error"}, args...)...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("ffmpeg %v failed: %v\n%s", args, err, output)
+	}
+}
+
+// Info describes the streams of a media file
+type Info struct {
+	Duration   float64 // Seconds
+	Width      int     // Of the first video stream, 0 without video
+	Height     int
+	VideoCodec string
+	AudioCodec string // Of the first audio stream, "" without audio
+	SampleRate int
+	Channels   int
+}
+
+// HasVideo returns true if the file has a video stream
+func (i Info) HasVideo() bool {
+	return i.VideoCodec != ""
+}
+
+// HasAudio returns true if the file has an audio stream
+func (i Info) HasAudio() bool {
+	return i.AudioCodec != ""
+}
+
+// Probe returns the streams of a media file, failing the test if ffprobe
+// cannot read it
+func Probe(t testing.TB, path string) Info {
+	t.Helper()
+	output, err := exec.Command("ffprobe", "-v", "error",
+		"-show_entries", "stream=codec_type,codec_name,width,height,sample_rate,channels:format=duration",
+		"-of", "json", path).Output()
+	if err != nil {
+		t.Fatalf("ffprobe %s failed: %v", path, err)
+	}
+
+	var probe struct {
+		Streams []struct {
+			CodecType  string `json:"codec_type"`
+			CodecName  string `json:"codec_name"`
+			Width      int    `json:"width"`
+			Height     int    `json:"height"`
+			SampleRate string `json:"sample_rate"`
+			Channels   int    `json:"channels"`
+		} `json:"streams"`
+		Format struct {
+			Duration string `json:"duration"`
+		} `json:"format"`
+	}
+	if err := json.Unmarshal(output, &probe); err != nil {
+		t.Fatalf("failed to parse ffprobe output for %s: %v", path, err)
+	}
+
+	var info Info
+	info.Duration, _ = strconv.ParseFloat(probe.Format.Duration, 64)
+	for _, s := range probe.Streams {
+		switch {
+		case s.CodecType == "video" && !info.HasVideo():
+			info.VideoCodec, info.Width, info.Height = s.CodecName, s.Width, s.Height
+		case s.CodecType == "audio" && !info.HasAudio():
+			info.AudioCodec, info.Channels = s.CodecName, s.Channels
+			info.SampleRate, _ = strconv.Atoi(s.SampleRate)
+		}
+	}
+	return info
+}
@@ -0,0 +1,24 @@
+package testmedia
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSamples(t *testing.T) {
+	Require(t)
+	dir := t.TempDir()
+
+	screen := Probe(t, Screen(t, dir, "screen.mp4", 320, 240, 2))
+	if screen.Width != 320 || screen.Height != 240 || screen.VideoCodec != "h264" || screen.HasAudio() {
+		t.Errorf("screen sample = %+v, want 320x240 h264 without audio", screen)
+	}
+	if math.Abs(screen.Duration-2) > 0.2 {
+		t.Errorf("screen sample lasts %.2fs, want 2s", screen.Duration)
+	}
+
+	tone := Probe(t, Tone(t, dir, "audio.wav", 2))
+	if tone.HasVideo() || tone.AudioCodec != "pcm_s16le" || tone.SampleRate != 48000 || tone.Channels != 2 {
+		t.Errorf("tone sample = %+v, want 48 kHz stereo pcm_s16le", tone)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/kartoza/kartoza-screencaster/internal/models"
 	"github.com/kartoza/kartoza-screencaster/internal/monitor"
 	"github.com/kartoza/kartoza-screencaster/internal/recorder"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
 )
 
 // Screen represents the current screen being displayed
@@ -77,6 +78,15 @@ type AppModel struct {
 	isPausing        bool
 	isResuming       bool
 	selectedButton   RecordingButton
+	quitConfirm      bool // Asking what to do with the recording before quitting
+
+	// Quit once the recording stopped from the quit confirmation is processed
+	quitAfterProcessing bool
+
+	// Break reminders for long sessions
+	breakReminder   config.BreakReminder // Loaded when the recording starts
+	continuousSince time.Time            // Start of the current unpaused stretch
+	breakRemindedAt time.Time            // When the last reminder fired
 
 	// Progress channel for processing updates
 	progressChan chan recorder.ProgressUpdate
@@ -90,6 +100,14 @@ type AppModel struct {
 
 	// Edit recording mode - opens directly to history with latest needs_metadata recording
 	editRecordingMode bool
+
+	// Videos uploaded one after another in the background, whatever screen
+	// is shown. Created when the first video is queued.
+	uploadQueue        *youtube.UploadQueue
+	uploadQueueWaiting int          // Queued uploads whose completion was not handled yet
+	uploadQueuePct     float64      // Progress of the queued upload in progress
+	uploadQueueFailed  int          // Queued uploads that failed
+	uploadBatch        *uploadBatch // Uploads of all pending recordings, while in progress
 }
 
 // countRecordings counts the number of valid recordings in the screencasts folder
@@ -211,7 +229,7 @@ func NewAppModel() AppModel {
 		blinkOn:                 true,
 		state:                   initialState,
 		status:                  status,
-		countdownNum:            5,
+		countdownNum:            countdownSeconds(),
 		processing:              NewProcessingState(),
 		processingFrame:         0,
 		externalRecordingActive: externalActive,
@@ -250,6 +268,8 @@ func (m AppModel) Init() tea.Cmd {
 		blinkCmd(),
 		updateStatus(m.recorder),
 		updateMonitors(),
+		checkStorageBudget(),
+		checkSetupDraft(),
 	}
 
 	// Initialize the active screen's sub-model if needed
@@ -268,23 +288,58 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// Handle recording setup completion messages first (from any screen)
 	switch msg.(type) {
 	case recordingSetupCompleteMsg:
-		// Recording setup is complete, save presets for next time and start countdown
+		// Recording setup is complete, save presets for next time and start countdown.
+		// The draft is kept until the recording starts.
+		m.recordingSetup.saveDraft()
 		_ = m.recordingSetup.SaveAllPresets()
 		m.metadata = m.recordingSetup.GetMetadata()
 		m.screen = ScreenRecording
-		m.state = stateCountdown
-		m.countdownNum = 5
-		go beep.Play(5)
-		return m, tea.Tick(time.Second, func(t time.Time) tea.Msg {
-			return countdownTickMsg{}
-		})
+		return m.startCountdown()
 	case backToMenuMsg:
 		m.screen = ScreenMenu
 		// Don't recreate recordingSetup — preserve form state so logos/toggles
 		// persist when the user returns to the recording form
 		// Refresh YouTube status when returning to menu
 		updateGlobalAppState(m.status.IsRecording, m.blinkOn, GlobalAppState.Status)
+		// Recordings or the budget may have changed
+		return m, checkStorageBudget()
+	case setupDraftSaveMsg:
+		// Saved whatever screen is shown, the form may have been left since
+		if m.recordingSetup != nil {
+			m.recordingSetup.saveDraft()
+		}
 		return m, nil
+	case setupDraftLoadedMsg:
+		m.menu.SetSetupDraft(msg.(setupDraftLoadedMsg).draft)
+		return m, nil
+	case restoreSetupDraftMsg:
+		// Continue the setup the previous run was quit during
+		if m.recordingSetup != nil {
+			m.recordingSetup.StopPreview()
+		}
+		m.recordingSetup = NewRecordingSetupModel()
+		m.recordingSetup.width = m.width
+		m.recordingSetup.height = m.height
+		m.recordingSetup.restoreDraft(msg.(restoreSetupDraftMsg).draft)
+		m.menu.SetSetupDraft(nil)
+		m.screen = ScreenRecordingSetup
+		return m, m.recordingSetup.Init()
+	case openStorageBudgetMsg:
+		m.screen = ScreenHistory
+		m.history = NewHistoryModel()
+		m.history.width = m.width
+		m.history.height = m.height
+		m.history.showStorageBudgetOnLoad = true
+		return m, m.history.Init()
+	case openLatestRecordingMsg:
+		m.screen = ScreenHistory
+		m.history = NewHistoryModel()
+		m.history.width = m.width
+		m.history.height = m.height
+		needsMetadata := msg.(openLatestRecordingMsg).needsMetadata
+		m.history.editRecordingOnLoad = needsMetadata
+		m.history.openLatestOnLoad = !needsMetadata
+		return m, m.history.Init()
 	case goToYouTubeSetupMsg:
 		m.screen = ScreenYouTubeSetup
 		m.youtubeSetup = NewYouTubeSetupModel()
@@ -330,7 +385,16 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, cmd
 		}
 		return m, nil
-	case uploadProgressMsg, uploadCompleteMsg, playlistsLoadedMsg:
+	case uploadProgressMsg, uploadCompleteMsg:
+		// Forward upload progress even when another screen is shown, so the
+		// status footer keeps following the upload
+		if m.youtubeUpload != nil {
+			newUpload, cmd := m.youtubeUpload.Update(msg)
+			m.youtubeUpload = newUpload
+			return m, cmd
+		}
+		return m, nil
+	case playlistsLoadedMsg:
 		// Forward upload messages to the YouTube upload model
 		if m.screen == ScreenYouTubeUpload && m.youtubeUpload != nil {
 			newUpload, cmd := m.youtubeUpload.Update(msg)
@@ -357,56 +421,71 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if m.screen == ScreenRecordingSetup {
 		// Handle escape to go back (before passing to form)
 		if keyMsg, ok := msg.(tea.KeyMsg); ok {
-			if key.Matches(keyMsg, key.NewBinding(key.WithKeys("esc"))) {
+			if key.Matches(keyMsg, key.NewBinding(key.WithKeys("esc"))) && !m.recordingSetup.IsCloning() {
+				m.recordingSetup.StopPreview()
+				m.recordingSetup.saveDraft()
 				m.screen = ScreenMenu
 				return m, nil
 			}
 			if key.Matches(keyMsg, key.NewBinding(key.WithKeys("ctrl+c"))) {
+				m.recordingSetup.StopPreview()
+				m.recordingSetup.saveDraft()
 				return m, tea.Quit
 			}
 		}
 
+		// The form renders above the status footer
+		if size, ok := msg.(tea.WindowSizeMsg); ok {
+			size.Height = max(size.Height-StatusFooterHeight, 0)
+			msg = size
+		}
+
 		// Pass message to the form
 		newSetup, cmd := m.recordingSetup.Update(msg)
 		m.recordingSetup = newSetup
 
+		// Keys change the form, which is saved as a draft shortly after
+		if _, ok := msg.(tea.KeyMsg); ok {
+			return m, tea.Batch(cmd, m.recordingSetup.scheduleDraftSave())
+		}
 		return m, cmd
 	}
 
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
-		m.height = msg.Height
+		// Screens render above the status footer
+		m.height = max(msg.Height-StatusFooterHeight, 0)
 		m.menu.width = msg.Width
-		m.menu.height = msg.Height
+		m.menu.height = m.height
 		// Also update sub-model dimensions
 		if m.recordingSetup != nil {
 			m.recordingSetup.width = msg.Width
-			m.recordingSetup.height = msg.Height
+			m.recordingSetup.height = m.height
 		}
 		if m.history != nil {
 			m.history.width = msg.Width
-			m.history.height = msg.Height
+			m.history.height = m.height
 		}
 		if m.options != nil {
 			m.options.width = msg.Width
-			m.options.height = msg.Height
+			m.options.height = m.height
 		}
 		if m.youtubeSetup != nil {
 			m.youtubeSetup.width = msg.Width
-			m.youtubeSetup.height = msg.Height
+			m.youtubeSetup.height = m.height
 		}
 		if m.youtubeUpload != nil {
 			m.youtubeUpload.width = msg.Width
-			m.youtubeUpload.height = msg.Height
+			m.youtubeUpload.height = m.height
 		}
 		if m.syndicationSetup != nil {
 			m.syndicationSetup.width = msg.Width
-			m.syndicationSetup.height = msg.Height
+			m.syndicationSetup.height = m.height
 		}
 		if m.syndicationPost != nil {
 			m.syndicationPost.width = msg.Width
-			m.syndicationPost.height = msg.Height
+			m.syndicationPost.height = m.height
 		}
 		return m, nil
 
@@ -424,10 +503,21 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.menu.SetExternalRecording(externalActive, externalPIDs)
 			}
 
+			// Stop by itself once the maximum duration is recorded
+			if m.maxDurationReached() {
+				model, stopCmd := m.handleStop()
+				stopped := model.(AppModel)
+				stopped.processing.Notice = "⏱ Max duration reached, stopping"
+				return stopped, tea.Batch(tickCmd(), updateMonitors(), stopCmd)
+			}
+
+			// Remind about breaks during long recordings
+			breakCmd := m.checkBreakReminder()
 			return m, tea.Batch(
 				tickCmd(),
 				updateStatus(m.recorder),
 				updateMonitors(),
+				breakCmd,
 			)
 		}
 		return m, tickCmd()
@@ -466,6 +556,9 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case spinner.TickMsg:
+		if msg.ID != m.spinner.ID() {
+			return m.forwardSpinnerTick(msg)
+		}
 		var cmd tea.Cmd
 		m.spinner, cmd = m.spinner.Update(msg)
 		return m, cmd
@@ -509,10 +602,19 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, waitForProgressUpdate(m.progressChan)
 
+	case processingEncoderMsg:
+		if m.state == stateProcessing && m.processing != nil {
+			m.processing.SetStepEncoder(msg.Step, msg.Encoder)
+		}
+		return m, waitForProgressUpdate(m.progressChan)
+
 	case processingCompleteMsg:
 		if m.state == stateProcessing && m.processing != nil {
 			m.processing.Complete()
 			m.processingDone = true
+			if m.quitAfterProcessing && m.processing.Error == nil {
+				return m, tea.Quit
+			}
 			// Default to Upload button if YouTube is connected, else Menu button
 			cfg, _ := config.Load()
 			if cfg.IsYouTubeConnected() {
@@ -520,6 +622,10 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			} else {
 				m.processingBtn = ProcessingButtonMenu
 			}
+			// Open the output for review unless the upload prompt takes over
+			if m.processing.Error == nil && !(cfg.YouTube.AutoPromptUpload && cfg.IsYouTubeConnected()) {
+				return m, autoReviewCmd(cfg, m.recordingInfo)
+			}
 		}
 		return m, nil
 
@@ -565,21 +671,21 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case recordingSetupCompleteMsg:
 		// Recording setup is complete, save presets for next time and start countdown
+		m.recordingSetup.saveDraft()
 		_ = m.recordingSetup.SaveAllPresets()
 		m.metadata = m.recordingSetup.GetMetadata()
 		m.screen = ScreenRecording
-		m.state = stateCountdown
-		m.countdownNum = 5
-		go beep.Play(5)
-		return m, tea.Tick(time.Second, func(t time.Time) tea.Msg {
-			return countdownTickMsg{}
-		})
+		return m.startCountdown()
 
 	case backToMenuMsg:
 		// Return to main menu from history
 		m.screen = ScreenMenu
 		return m, nil
 
+	case storageBudgetMsg:
+		m.menu.SetStorageBudget(msg.plan)
+		return m, nil
+
 	case recordingsLoadedMsg:
 		// Forward to history model
 		if m.screen == ScreenHistory && m.history != nil {
@@ -598,6 +704,12 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case queueUploadsMsg:
+		return m.queueUploads(msg.items, msg.batch)
+
+	case uploadQueueEventMsg:
+		return m.handleQueueEvent(msg.event)
+
 	case startYouTubeUploadMsg:
 		// YouTube upload requested from history view
 		m.youtubeUpload = NewYouTubeUploadModelWithRecording(msg.videoPath, msg.recording)
@@ -606,6 +718,22 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.screen = ScreenYouTubeUpload
 		return m, m.youtubeUpload.Init()
 
+	case newRecordingLikeMsg:
+		// New recording with the settings of one in the history view. A new
+		// setup model gives it a fresh title and number, and its own folder.
+		if msg.recording == nil {
+			return m, nil
+		}
+		if m.recordingSetup != nil {
+			m.recordingSetup.StopPreview()
+		}
+		m.recordingSetup = NewRecordingSetupModel()
+		m.recordingSetup.width = m.width
+		m.recordingSetup.height = m.height
+		m.recordingSetup.cloneFrom(msg.recording)
+		m.screen = ScreenRecordingSetup
+		return m, m.recordingSetup.Init()
+
 	case startReprocessMsg:
 		// Reprocess recording requested from history view
 		if msg.recording == nil {
@@ -613,13 +741,7 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Clear previous processing status and errors before reprocessing
-		msg.recording.SetStatus(models.StatusProcessing)
-		msg.recording.Processing.Errors = nil
-		msg.recording.Processing.ErrorDetail = ""
-		msg.recording.Processing.Traceback = ""
-		msg.recording.Processing.ProcessedAt = time.Time{}
-		msg.recording.Processing.NormalizeApplied = false
-		msg.recording.Processing.VerticalCreated = false
+		msg.recording.ResetProcessing()
 		_ = msg.recording.Save()
 
 		// Set up for reprocessing
@@ -633,6 +755,7 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			msg.recording.Settings.ScreenEnabled,
 			msg.recording.Settings.WebcamEnabled,
 			msg.recording.Settings.VerticalEnabled,
+			msg.recording.Settings.WebcamOnlyEnabled,
 		)
 		// Skip the "Stopping recorders" step since we're reprocessing existing files
 		m.processing.SetStepByIndex(ProcessStepStopping, StepSkipped)
@@ -672,6 +795,7 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			msg.recording.Settings.ScreenEnabled,
 			msg.recording.Settings.WebcamEnabled,
 			msg.recording.Settings.VerticalEnabled,
+			msg.recording.Settings.WebcamOnlyEnabled,
 		)
 		// Skip the "Stopping recorders" step since recording was already stopped via systray
 		m.processing.SetStepByIndex(ProcessStepStopping, StepSkipped)
@@ -722,12 +846,75 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.status.IsRecording = true
 			m.status.IsPaused = false
 			m.state = stateRecording
+			m.continuousSince = time.Now()
 			updateGlobalAppState(true, m.blinkOn, "Recording")
 		}
 		return m, updateStatus(m.recorder)
+
+	case breakSplitCompleteMsg:
+		m.isResuming = false
+		if msg.err != nil {
+			m.err = msg.err
+		} else {
+			m.continuousSince = time.Now()
+		}
+		// The status update restores the paused state if the resume failed
+		return m, updateStatus(m.recorder)
 	}
 
-	return m, nil
+	// Results of commands started by a screen (clipboard copies, connection
+	// tests, bulk operations...) go back to that screen
+	return m.forwardToActiveScreen(msg)
+}
+
+// forwardSpinnerTick passes a tick of another spinner to the history and
+// YouTube models, which only take their own ticks. The upload spinner keeps
+// ticking while another screen is shown, like the upload progress.
+func (m AppModel) forwardSpinnerTick(msg spinner.TickMsg) (tea.Model, tea.Cmd) {
+	var historyCmd, setupCmd, uploadCmd tea.Cmd
+	if m.history != nil {
+		m.history, historyCmd = m.history.Update(msg)
+	}
+	if m.youtubeSetup != nil {
+		m.youtubeSetup, setupCmd = m.youtubeSetup.Update(msg)
+	}
+	if m.youtubeUpload != nil {
+		m.youtubeUpload, uploadCmd = m.youtubeUpload.Update(msg)
+	}
+	return m, tea.Batch(historyCmd, setupCmd, uploadCmd)
+}
+
+// forwardToActiveScreen passes a message the app does not handle itself to
+// the model of the current screen
+func (m AppModel) forwardToActiveScreen(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch m.screen {
+	case ScreenHistory:
+		if m.history != nil {
+			m.history, cmd = m.history.Update(msg)
+		}
+	case ScreenOptions:
+		if m.options != nil {
+			m.options, cmd = m.options.Update(msg)
+		}
+	case ScreenYouTubeSetup:
+		if m.youtubeSetup != nil {
+			m.youtubeSetup, cmd = m.youtubeSetup.Update(msg)
+		}
+	case ScreenYouTubeUpload:
+		if m.youtubeUpload != nil {
+			m.youtubeUpload, cmd = m.youtubeUpload.Update(msg)
+		}
+	case ScreenSyndicationSetup:
+		if m.syndicationSetup != nil {
+			m.syndicationSetup, cmd = m.syndicationSetup.Update(msg)
+		}
+	case ScreenSyndicationPost:
+		if m.syndicationPost != nil {
+			m.syndicationPost, cmd = m.syndicationPost.Update(msg)
+		}
+	}
+	return m, cmd
 }
 
 // handleKeyMsg handles keyboard input based on current state
@@ -777,7 +964,7 @@ func (m AppModel) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 					updateGlobalAppState(false, true, "Ready")
 					return m, nil
 				}
-			case "v", "m", "a", "o":
+			case "v", "w", "m", "a", "o":
 				// Media preview shortcuts
 				if cmd := HandleProcessingMediaKey(msg.String(), m.recordingInfo); cmd != nil {
 					return m, cmd
@@ -792,7 +979,7 @@ func (m AppModel) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if m.state == stateCountdown {
 		if key.Matches(msg, key.NewBinding(key.WithKeys("esc", "q"))) {
 			m.state = stateReady
-			m.countdownNum = 5
+			m.countdownNum = countdownSeconds()
 			m.screen = ScreenMenu
 			return m, nil
 		}
@@ -834,26 +1021,39 @@ func (m AppModel) handleMenuKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 // handleRecordingSetupKeys handles keys on the recording setup screen
 func (m AppModel) handleRecordingSetupKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Handle escape to go back
-	if key.Matches(msg, key.NewBinding(key.WithKeys("esc"))) {
+	if key.Matches(msg, key.NewBinding(key.WithKeys("esc"))) && !m.recordingSetup.IsCloning() {
+		m.recordingSetup.StopPreview()
+		m.recordingSetup.saveDraft()
 		m.screen = ScreenMenu
 		return m, nil
 	}
 
 	// Handle quit
 	if key.Matches(msg, key.NewBinding(key.WithKeys("ctrl+c"))) {
+		m.recordingSetup.StopPreview()
+		m.recordingSetup.saveDraft()
 		return m, tea.Quit
 	}
 
-	// Update the setup form
+	// Update the setup form, saved as a draft shortly after
 	newSetup, cmd := m.recordingSetup.Update(msg)
 	m.recordingSetup = newSetup
-	return m, cmd
+	return m, tea.Batch(cmd, m.recordingSetup.scheduleDraftSave())
 }
 
 // handleRecordingKeys handles keys on the recording screen
 func (m AppModel) handleRecordingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.quitConfirm {
+		return m.handleQuitConfirmKeys(msg)
+	}
+
 	switch {
 	case key.Matches(msg, key.NewBinding(key.WithKeys("q", "ctrl+c"))):
+		// Quitting would leave the recording without its processing, so ask first
+		if m.status.IsRecording || m.isPaused {
+			m.quitConfirm = true
+			return m, nil
+		}
 		return m, tea.Quit
 
 	case key.Matches(msg, key.NewBinding(key.WithKeys("left", "h"))):
@@ -915,6 +1115,25 @@ func (m AppModel) handleRecordingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleQuitConfirmKeys handles keys while asking whether to stop the
+// recording before quitting. Quitting anyway leaves the recorder running, so
+// the recording can still be stopped and processed later.
+func (m AppModel) handleQuitConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "s", "enter":
+		m.quitConfirm = false
+		m.quitAfterProcessing = true
+		return m.handleStop()
+
+	case "y", "q", "ctrl+c":
+		return m, tea.Quit
+
+	case "esc", "n":
+		m.quitConfirm = false
+	}
+	return m, nil
+}
+
 // handlePause handles pausing the recording
 func (m AppModel) handlePause() (tea.Model, tea.Cmd) {
 	// Don't allow pause if already pausing/resuming
@@ -951,6 +1170,73 @@ func (m AppModel) handleResume() (tea.Model, tea.Cmd) {
 	}
 }
 
+// breakReminderVisibleFor is how long a break reminder stays on screen
+const breakReminderVisibleFor = 30 * time.Second
+
+// checkBreakReminder fires a break reminder once the current unpaused stretch
+// of recording exceeds the configured interval. Pausing ends the stretch, so
+// paused time never counts. With auto-split, a part boundary is inserted by
+// pausing and immediately resuming. Nothing is sent to the desktop, so the
+// reminder does not end up in the screen recording.
+func (m *AppModel) checkBreakReminder() tea.Cmd {
+	if !m.breakReminder.Enabled() || !m.status.IsRecording || m.isPaused || m.isPausing || m.isResuming {
+		return nil
+	}
+
+	since := m.continuousSince
+	if since.IsZero() {
+		since = m.status.StartTime
+	}
+	if m.breakRemindedAt.After(since) {
+		since = m.breakRemindedAt
+	}
+	if time.Since(since) < m.breakReminder.Interval() {
+		return nil
+	}
+
+	m.breakRemindedAt = time.Now()
+	if !m.breakReminder.AutoSplit {
+		return nil
+	}
+
+	m.isResuming = true
+	rec := m.recorder
+	return func() tea.Msg {
+		if err := rec.Pause(); err != nil {
+			return breakSplitCompleteMsg{err: err}
+		}
+		return breakSplitCompleteMsg{err: rec.Resume()}
+	}
+}
+
+// maxDuration returns the time after which the recording stops by itself, or
+// 0 without a limit
+func (m AppModel) maxDuration() time.Duration {
+	if m.recordingInfo == nil {
+		return 0
+	}
+	return time.Duration(m.recordingInfo.Settings.MaxDuration) * time.Minute
+}
+
+// maxDurationReached returns true once the recording has recorded for its
+// maximum duration. The recorder's elapsed time is used, so paused time does
+// not count.
+func (m AppModel) maxDurationReached() bool {
+	limit := m.maxDuration()
+	if limit <= 0 || m.state != stateRecording || m.isPausing || m.isResuming {
+		return false
+	}
+	if !m.status.IsRecording && !m.status.IsPaused {
+		return false
+	}
+	return m.status.Elapsed() >= limit
+}
+
+// breakReminderVisible returns true while the last break reminder is shown
+func (m AppModel) breakReminderVisible() bool {
+	return !m.breakRemindedAt.IsZero() && time.Since(m.breakRemindedAt) < breakReminderVisibleFor
+}
+
 // handleStop handles stopping the recording
 func (m AppModel) handleStop() (tea.Model, tea.Cmd) {
 	// Stop recording - transition to processing state
@@ -965,6 +1251,7 @@ func (m AppModel) handleStop() (tea.Model, tea.Cmd) {
 			m.recordingInfo.Settings.ScreenEnabled,
 			m.recordingInfo.Settings.WebcamEnabled,
 			m.recordingInfo.Settings.VerticalEnabled,
+			m.recordingInfo.Settings.WebcamOnlyEnabled,
 		)
 	}
 
@@ -987,8 +1274,9 @@ func (m AppModel) handleHistoryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // handleOptionsKeys handles keys on the options screen
 func (m AppModel) handleOptionsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	// Handle escape - in presets mode, quit the app; otherwise go back to menu
-	if key.Matches(msg, key.NewBinding(key.WithKeys("esc"))) {
+	// Handle escape - in presets mode, quit the app; otherwise go back to menu.
+	// An open file browser handles escape itself.
+	if key.Matches(msg, key.NewBinding(key.WithKeys("esc"))) && !m.options.IsFileBrowserActive() {
 		if m.presetsMode {
 			return m, tea.Quit
 		}
@@ -1017,8 +1305,10 @@ func (m AppModel) handleOptionsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 func (m AppModel) handleMenuAction(action MenuItem) (tea.Model, tea.Cmd) {
 	switch action {
 	case MenuNewRecording:
-		// Go to recording setup screen — reuse existing model to preserve form state
+		// Go to recording setup screen — reuse existing model to preserve form state.
+		// Typing in it replaces the draft of the previous run.
 		m.screen = ScreenRecordingSetup
+		m.menu.SetSetupDraft(nil)
 		if m.recordingSetup == nil {
 			m.recordingSetup = NewRecordingSetupModel()
 			m.recordingSetup.width = m.width
@@ -1034,6 +1324,14 @@ func (m AppModel) handleMenuAction(action MenuItem) (tea.Model, tea.Cmd) {
 		m.history.height = m.height
 		return m, m.history.Init()
 
+	case MenuNeedsAttention:
+		m.screen = ScreenHistory
+		m.history = NewHistoryModel()
+		m.history.width = m.width
+		m.history.height = m.height
+		m.history.showAttentionOnLoad = true
+		return m, m.history.Init()
+
 	case MenuOptions:
 		m.screen = ScreenOptions
 		m.options = NewOptionsModel()
@@ -1048,6 +1346,30 @@ func (m AppModel) handleMenuAction(action MenuItem) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// countdownSeconds returns the configured length of the countdown before
+// recording starts
+func countdownSeconds() int {
+	cfg, _ := config.Load()
+	if cfg == nil {
+		return config.DefaultCountdownSeconds
+	}
+	return cfg.Countdown()
+}
+
+// startCountdown starts the countdown before recording, with a beep for its
+// first count. With no countdown configured recording starts immediately.
+func (m AppModel) startCountdown() (tea.Model, tea.Cmd) {
+	m.state = stateCountdown
+	m.countdownNum = countdownSeconds()
+	if m.countdownNum == 0 {
+		return m.handleCountdownTick()
+	}
+	go beep.Play(m.countdownNum)
+	return m, tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return countdownTickMsg{}
+	})
+}
+
 // handleCountdownTick handles countdown timer ticks
 func (m AppModel) handleCountdownTick() (tea.Model, tea.Cmd) {
 	if m.state != stateCountdown {
@@ -1092,26 +1414,16 @@ func (m AppModel) handleCountdownTick() (tea.Model, tea.Cmd) {
 
 		// Set recording settings from setup form
 		if m.recordingSetup != nil && m.recordingSetup.form != nil {
-			logoSelection := m.recordingSetup.GetLogoSelection()
-
-			m.recordingInfo.Settings.ScreenEnabled = m.recordingSetup.form.State.RecordScreen
-			m.recordingInfo.Settings.AudioEnabled = m.recordingSetup.form.State.RecordAudio
-			m.recordingInfo.Settings.WebcamEnabled = m.recordingSetup.form.State.RecordWebcam
-			m.recordingInfo.Settings.VerticalEnabled = m.recordingSetup.form.State.VerticalVideo && m.recordingSetup.form.State.RecordWebcam && m.recordingSetup.form.State.RecordScreen
-			m.recordingInfo.Settings.LogosEnabled = m.recordingSetup.form.State.AddLogos
-
-			// Logo details
-			m.recordingInfo.Settings.LeftLogo = logoSelection.LeftLogo
-			m.recordingInfo.Settings.RightLogo = logoSelection.RightLogo
-			m.recordingInfo.Settings.BottomLogo = logoSelection.BottomLogo
-			m.recordingInfo.Settings.TitleColor = logoSelection.TitleColor
-			m.recordingInfo.Settings.GifLoopMode = string(logoSelection.GifLoopMode)
+			m.recordingInfo.Settings = m.recordingSetup.GetRecordingSettings()
 
 			// Save background color from global config
 			cfg, _ := config.Load()
 			if cfg != nil && cfg.BgColor != "" {
 				m.recordingInfo.Settings.BgColor = cfg.BgColor
 			}
+			if cfg != nil && cfg.LogoFit != "" {
+				m.recordingInfo.Settings.LogoFit = string(cfg.LogoFit)
+			}
 		}
 
 		// Save initial recording.json
@@ -1122,18 +1434,25 @@ func (m AppModel) handleCountdownTick() (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// What was typed in the form is in recording.json now
+		if m.recordingSetup != nil {
+			m.recordingSetup.discardDraft()
+		}
+
 		// Set up recorder options
 		opts := recorder.Options{
 			OutputDir:      m.outputDir,
 			Monitor:        monitorName,
 			Metadata:       &m.metadata,
 			RecordingInfo:  m.recordingInfo,
-			CreateVertical: m.recordingSetup != nil && m.recordingSetup.form != nil && m.recordingSetup.form.State.VerticalVideo,
+			CreateVertical: m.recordingSetup != nil && m.recordingSetup.form != nil && m.recordingSetup.form.VerticalVideoEnabled(),
 		}
 
 		// Set audio/webcam/screen options from setup
 		if m.recordingSetup != nil && m.recordingSetup.form != nil {
 			opts.NoAudio = !m.recordingSetup.form.State.RecordAudio
+			opts.AudioDevice = m.recordingSetup.form.GetAudioDevice()
+			opts.WebcamDevice = m.recordingSetup.form.GetWebcamDevice()
 			opts.NoWebcam = !m.recordingSetup.form.State.RecordWebcam
 			opts.NoScreen = !m.recordingSetup.form.State.RecordScreen
 			// Set logo selection and save for future recordings
@@ -1146,10 +1465,18 @@ func (m AppModel) handleCountdownTick() (tea.Model, tea.Cmd) {
 			m.state = stateReady
 			m.screen = ScreenMenu
 		}
+
+		// Break reminders count continuous recording time from here
+		m.breakReminder = config.BreakReminder{}
+		if cfg, err := config.Load(); err == nil {
+			m.breakReminder = cfg.BreakReminder
+		}
+		m.continuousSince = time.Now()
+		m.breakRemindedAt = time.Time{}
 		return m, updateStatus(m.recorder)
 	}
 
-	// Play beep for counts 5-1 (not for 0/GO)
+	// Play a beep for every count of the countdown (not for 0/GO)
 	if m.countdownNum > 0 {
 		go beep.Play(m.countdownNum)
 	}
@@ -1182,6 +1509,10 @@ func waitForProgressUpdate(ch chan recorder.ProgressUpdate) tea.Cmd {
 			return processingCompleteMsg{}
 		}
 
+		if update.Encoder != "" {
+			return processingEncoderMsg{Step: update.Step, Encoder: update.Encoder}
+		}
+
 		// Check if this is a percent update (no status change, just progress)
 		if update.Percent >= 0 && !update.Completed && !update.Skipped && update.Error == nil {
 			return processingPercentMsg{
@@ -1206,6 +1537,30 @@ func (m AppModel) View() string {
 		return ""
 	}
 
+	screen := m.renderScreen()
+	GlobalAppState.Job = m.activeJob()
+	return lipgloss.JoinVertical(lipgloss.Left, screen, RenderStatusFooter(m.width))
+}
+
+// activeJob describes the processing or upload running in the background
+// for the status footer, or returns "" if there is none
+func (m AppModel) activeJob() string {
+	if m.state == stateProcessing && m.processing != nil && m.processing.IsProcessing {
+		step := m.processing.CurrentStep
+		if step >= 0 && step < len(m.processing.Steps) {
+			return "Processing: " + m.processing.Steps[step].Name
+		}
+		return "Processing"
+	}
+	if m.youtubeUpload != nil && m.youtubeUpload.isUploading {
+		return m.youtubeUpload.jobLabel()
+	}
+	return m.queueJobLabel()
+}
+
+// renderScreen renders the active screen, sized to leave room for the
+// status footer
+func (m AppModel) renderScreen() string {
 	// Show countdown screen if in countdown state
 	if m.state == stateCountdown {
 		return m.renderCountdownView()
@@ -1266,7 +1621,9 @@ func (m AppModel) renderRecordingScreen() string {
 
 	// Render footer
 	var helpText string
-	if m.status.IsRecording || m.isPaused {
+	if m.quitConfirm {
+		helpText = "s/enter: stop and quit • y: quit anyway • esc: keep recording"
+	} else if m.status.IsRecording || m.isPaused {
 		helpText = "←/→: select • space/enter: activate • p: pause/resume • s: stop • q: quit"
 	} else {
 		helpText = "esc: back to menu • q: quit"
@@ -1359,11 +1716,16 @@ func (m AppModel) renderRecordingContent(cursorMonitor string) string {
 
 	// Add duration display
 	if m.status.IsRecording || m.isPaused {
-		duration := time.Since(m.status.StartTime).Round(time.Second)
+		duration := m.status.Elapsed().Round(time.Second)
 		durationStyle := lipgloss.NewStyle().
 			Foreground(ColorWhite).
 			Bold(true)
 		durationText := durationStyle.Render(fmt.Sprintf("Duration: %s", duration))
+		if limit := m.maxDuration(); limit > 0 {
+			durationText += lipgloss.NewStyle().
+				Foreground(ColorGray).
+				Render(fmt.Sprintf(" of %s", maxDurationLabel(m.recordingInfo.Settings.MaxDuration)))
+		}
 		if m.status.CurrentPart > 0 {
 			durationText += lipgloss.NewStyle().
 				Foreground(ColorGray).
@@ -1372,8 +1734,25 @@ func (m AppModel) renderRecordingContent(cursorMonitor string) string {
 		sections = append(sections, "", durationText)
 	}
 
-	// Render Pause and Stop buttons
-	sections = append(sections, "", m.renderRecordingButtons())
+	// Transient break reminder
+	if m.breakReminderVisible() {
+		reminderStyle := lipgloss.NewStyle().
+			Foreground(ColorBlue).
+			Italic(true)
+		reminder := fmt.Sprintf("☕ Recording for %d min without a break, consider pausing or splitting the recording",
+			m.breakReminder.IntervalMinutes)
+		if m.breakReminder.AutoSplit {
+			reminder = fmt.Sprintf("☕ %d min without a break, a new part was started", m.breakReminder.IntervalMinutes)
+		}
+		sections = append(sections, "", reminderStyle.Render(reminder))
+	}
+
+	// Render Pause and Stop buttons, or the quit confirmation in their place
+	if m.quitConfirm {
+		sections = append(sections, "", m.renderQuitConfirm())
+	} else {
+		sections = append(sections, "", m.renderRecordingButtons())
+	}
 
 	// Show output directory path
 	if m.outputDir != "" {
@@ -1398,6 +1777,28 @@ func (m AppModel) renderRecordingContent(cursorMonitor string) string {
 	return contentStyle.Render(content)
 }
 
+// renderQuitConfirm renders the question asked when quitting while recording
+func (m AppModel) renderQuitConfirm() string {
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorOrange).
+		Padding(0, 2)
+	titleStyle := lipgloss.NewStyle().
+		Foreground(ColorOrange).
+		Bold(true)
+	textStyle := lipgloss.NewStyle().
+		Foreground(ColorWhite)
+
+	return boxStyle.Render(lipgloss.JoinVertical(lipgloss.Left,
+		titleStyle.Render("Quit while recording?"),
+		"",
+		textStyle.Render("s/enter: stop and process the recording, then quit"),
+		textStyle.Render("y: quit anyway, the recording keeps running"),
+		textStyle.Render("   (stop it later with kartoza-screencaster stop)"),
+		textStyle.Render("esc: keep recording"),
+	))
+}
+
 // renderRecordingButtons renders the Pause and Stop buttons
 func (m AppModel) renderRecordingButtons() string {
 	// Button styles
@@ -1479,12 +1880,12 @@ func (m AppModel) renderCountdownView() string {
 
 	if m.countdownNum > 0 {
 		bigText = getBigDigit(m.countdownNum)
-		switch m.countdownNum {
-		case 5, 4:
+		switch {
+		case m.countdownNum >= 4:
 			color = ColorOrange
-		case 3, 2:
+		case m.countdownNum >= 2:
 			color = lipgloss.Color("#FF8C00")
-		case 1:
+		default:
 			color = ColorRed
 		}
 	} else {
@@ -1545,7 +1946,11 @@ func (m AppModel) renderRecordingSetupScreen() string {
 	// Render the setup form (already wrapped in container)
 	content := m.recordingSetup.View()
 
-	footer := RenderHelpFooter("tab/↓: next • shift+tab/↑: prev • ←/→: select • enter: confirm • esc: back", m.width)
+	help := "tab/↓: next • shift+tab/↑: prev • ←/→: select • enter: confirm • ctrl+r: clone settings • esc: back"
+	if m.recordingSetup.IsCloning() {
+		help = "↑/↓: navigate • enter/1-9: clone • esc: back"
+	}
+	footer := RenderHelpFooter(help, m.width)
 
 	return LayoutWithHeaderFooter(header, content, footer, m.width, m.height)
 }
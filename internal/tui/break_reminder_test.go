@@ -0,0 +1,127 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// recordingFor returns a model that has recorded without a break for d
+func recordingFor(d time.Duration, reminder config.BreakReminder) AppModel {
+	return AppModel{
+		state:           stateRecording,
+		status:          models.RecordingStatus{IsRecording: true, StartTime: time.Now().Add(-d)},
+		breakReminder:   reminder,
+		continuousSince: time.Now().Add(-d),
+	}
+}
+
+func TestCheckBreakReminder_FiresOnceIntervalExpires(t *testing.T) {
+	reminder := config.BreakReminder{IntervalMinutes: 20}
+
+	m := recordingFor(19*time.Minute, reminder)
+	if cmd := m.checkBreakReminder(); cmd != nil || !m.breakRemindedAt.IsZero() {
+		t.Fatal("expected no reminder before the interval expires")
+	}
+
+	m = recordingFor(21*time.Minute, reminder)
+	if cmd := m.checkBreakReminder(); cmd != nil {
+		t.Error("expected no command without auto-split")
+	}
+	if m.breakRemindedAt.IsZero() || !m.breakReminderVisible() {
+		t.Fatal("expected a reminder once the interval expired")
+	}
+	if m.isResuming {
+		t.Error("expected the recording to carry on without auto-split")
+	}
+
+	// The stretch restarts at the reminder, so it does not fire again while shown
+	firstReminder := m.breakRemindedAt
+	m.checkBreakReminder()
+	if !m.breakRemindedAt.Equal(firstReminder) {
+		t.Error("expected no second reminder within the visible window")
+	}
+
+	// Without a continuous stretch the recording start counts
+	m = recordingFor(0, reminder)
+	m.continuousSince = time.Time{}
+	m.status.StartTime = time.Now().Add(-25 * time.Minute)
+	m.checkBreakReminder()
+	if m.breakRemindedAt.IsZero() {
+		t.Error("expected the recording start to be used without a continuous stretch")
+	}
+}
+
+func TestCheckBreakReminder_NotWhilePaused(t *testing.T) {
+	reminder := config.BreakReminder{IntervalMinutes: 20, AutoSplit: true}
+
+	tests := []struct {
+		name  string
+		setup func(m *AppModel)
+	}{
+		{"paused", func(m *AppModel) { m.isPaused = true }},
+		{"pausing", func(m *AppModel) { m.isPausing = true }},
+		{"resuming", func(m *AppModel) { m.isResuming = true }},
+		{"not recording", func(m *AppModel) { m.status.IsRecording = false }},
+		{"disabled", func(m *AppModel) { m.breakReminder = config.BreakReminder{} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := recordingFor(time.Hour, reminder)
+			tt.setup(&m)
+			if cmd := m.checkBreakReminder(); cmd != nil {
+				t.Error("expected no auto-split command")
+			}
+			if !m.breakRemindedAt.IsZero() {
+				t.Error("expected no reminder")
+			}
+		})
+	}
+}
+
+func TestCheckBreakReminder_NotWithinVisibleWindow(t *testing.T) {
+	m := recordingFor(time.Hour, config.BreakReminder{IntervalMinutes: 20})
+	m.breakRemindedAt = time.Now().Add(-breakReminderVisibleFor / 2)
+
+	shownAt := m.breakRemindedAt
+	m.checkBreakReminder()
+	if !m.breakRemindedAt.Equal(shownAt) {
+		t.Error("expected no new reminder while the last one is shown")
+	}
+	if !m.breakReminderVisible() {
+		t.Error("expected the last reminder to still be visible")
+	}
+
+	// Once hidden, the reminder stays hidden until the next interval expires
+	m.breakRemindedAt = time.Now().Add(-breakReminderVisibleFor - time.Second)
+	if m.breakReminderVisible() {
+		t.Error("expected the reminder to hide after the visible window")
+	}
+	shownAt = m.breakRemindedAt
+	m.checkBreakReminder()
+	if !m.breakRemindedAt.Equal(shownAt) {
+		t.Error("expected no new reminder before another interval passed")
+	}
+}
+
+func TestCheckBreakReminder_AutoSplit(t *testing.T) {
+	m := recordingFor(21*time.Minute, config.BreakReminder{IntervalMinutes: 20, AutoSplit: true})
+
+	if cmd := m.checkBreakReminder(); cmd == nil {
+		t.Fatal("expected a command that splits the recording")
+	}
+	if !m.isResuming {
+		t.Error("expected the model to wait for the split to finish")
+	}
+	if m.breakRemindedAt.IsZero() {
+		t.Error("expected the reminder to be shown alongside the split")
+	}
+
+	// While the split runs no further split is started
+	if cmd := m.checkBreakReminder(); cmd != nil {
+		t.Error("expected no second split while the first one runs")
+	}
+}
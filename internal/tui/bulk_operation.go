@@ -0,0 +1,359 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ========================================
+// Bulk operations - confirm, progress and summary for multi-item actions
+// ========================================
+
+// BulkItemStatus is the state of a single target in a bulk operation
+type BulkItemStatus int
+
+const (
+	BulkItemPending BulkItemStatus = iota
+	BulkItemRunning
+	BulkItemDone
+	BulkItemError
+	BulkItemCancelled
+)
+
+// BulkOperationStep is the current screen of a bulk operation
+type BulkOperationStep int
+
+const (
+	BulkStepConfirm BulkOperationStep = iota
+	BulkStepRunning
+	BulkStepSummary
+)
+
+// BulkTarget is one item a bulk operation acts on
+type BulkTarget struct {
+	Label string      // Shown in the confirmation and progress lists
+	Data  interface{} // Passed back to the operation function (e.g. a recording)
+}
+
+// BulkOperationFunc performs the operation on a single target. It runs in a
+// tea.Cmd goroutine, one target at a time.
+type BulkOperationFunc func(target BulkTarget) error
+
+// bulkItemDoneMsg reports that the operation finished for one target
+type bulkItemDoneMsg struct {
+	index int
+	err   error
+}
+
+// bulkOperationClosedMsg is sent when the user leaves the bulk operation,
+// either by cancelling the confirmation or dismissing the summary
+type bulkOperationClosedMsg struct {
+	ran       bool // false if the operation was cancelled before it started
+	succeeded int
+	failed    int
+}
+
+// bulkMaxListed is the number of targets listed on the confirmation screen
+const bulkMaxListed = 10
+
+// BulkOperationModel is a reusable confirmation-then-progress view for
+// actions applied to several items (delete, privacy change, reprocess...).
+// The parent screen forwards messages to it while it is active and closes it
+// on bulkOperationClosedMsg.
+type BulkOperationModel struct {
+	width  int
+	height int
+
+	title       string   // e.g. "Delete Recordings"
+	verb        string   // e.g. "Delete", used on the confirm button
+	destructive bool     // Shows a red warning on the confirmation screen
+	details     []string // What the operation does, listed above the targets
+
+	targets  []BulkTarget
+	statuses []BulkItemStatus
+	errors   []error
+	op       BulkOperationFunc
+
+	step            BulkOperationStep
+	current         int
+	cancelRequested bool
+}
+
+// NewBulkOperationModel creates a bulk operation for targets. verb names the
+// action on the confirm button; destructive operations get a warning.
+func NewBulkOperationModel(title, verb string, destructive bool, targets []BulkTarget, op BulkOperationFunc) *BulkOperationModel {
+	return &BulkOperationModel{
+		title:       title,
+		verb:        verb,
+		destructive: destructive,
+		targets:     targets,
+		statuses:    make([]BulkItemStatus, len(targets)),
+		errors:      make([]error, len(targets)),
+		op:          op,
+		step:        BulkStepConfirm,
+		current:     -1,
+	}
+}
+
+// SetSize updates the view dimensions
+func (m *BulkOperationModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// SetDetails sets lines describing what the operation does to each target,
+// shown on the confirmation screen
+func (m *BulkOperationModel) SetDetails(details []string) {
+	m.details = details
+}
+
+// Start runs the operation without asking for confirmation, for screens
+// that already showed the user what it will do
+func (m *BulkOperationModel) Start() tea.Cmd {
+	m.step = BulkStepRunning
+	return m.runNext()
+}
+
+// Step returns the current screen
+func (m *BulkOperationModel) Step() BulkOperationStep {
+	return m.step
+}
+
+// Statuses returns the per-target status, in target order
+func (m *BulkOperationModel) Statuses() []BulkItemStatus {
+	return m.statuses
+}
+
+// Update handles messages
+func (m *BulkOperationModel) Update(msg tea.Msg) (*BulkOperationModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.SetSize(msg.Width, msg.Height)
+
+	case bulkItemDoneMsg:
+		if msg.index != m.current {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.statuses[msg.index] = BulkItemError
+			m.errors[msg.index] = msg.err
+		} else {
+			m.statuses[msg.index] = BulkItemDone
+		}
+		return m, m.runNext()
+
+	case tea.KeyMsg:
+		return m.handleKeyMsg(msg)
+	}
+	return m, nil
+}
+
+func (m *BulkOperationModel) handleKeyMsg(msg tea.KeyMsg) (*BulkOperationModel, tea.Cmd) {
+	switch m.step {
+	case BulkStepConfirm:
+		switch msg.String() {
+		case "y", "Y":
+			return m, m.Start()
+		case "n", "N", "esc", "q":
+			return m, func() tea.Msg { return bulkOperationClosedMsg{} }
+		}
+
+	case BulkStepRunning:
+		switch msg.String() {
+		case "c", "esc":
+			// The running item finishes; remaining items are skipped
+			m.cancelRequested = true
+		}
+
+	case BulkStepSummary:
+		switch msg.String() {
+		case "enter", "esc", "q":
+			succeeded, failed := m.counts()
+			return m, func() tea.Msg {
+				return bulkOperationClosedMsg{ran: true, succeeded: succeeded, failed: failed}
+			}
+		}
+	}
+	return m, nil
+}
+
+// runNext starts the next pending target, or moves to the summary when all
+// targets are processed or the user cancelled
+func (m *BulkOperationModel) runNext() tea.Cmd {
+	next := m.current + 1
+	if m.cancelRequested || next >= len(m.targets) {
+		for i := next; i < len(m.targets); i++ {
+			m.statuses[i] = BulkItemCancelled
+		}
+		m.current = len(m.targets)
+		m.step = BulkStepSummary
+		return nil
+	}
+
+	m.current = next
+	m.statuses[next] = BulkItemRunning
+	target := m.targets[next]
+	op := m.op
+	return func() tea.Msg {
+		return bulkItemDoneMsg{index: next, err: op(target)}
+	}
+}
+
+// counts returns the number of succeeded and failed targets
+func (m *BulkOperationModel) counts() (succeeded, failed int) {
+	for _, s := range m.statuses {
+		switch s {
+		case BulkItemDone:
+			succeeded++
+		case BulkItemError:
+			failed++
+		}
+	}
+	return succeeded, failed
+}
+
+// View renders the current screen
+func (m *BulkOperationModel) View() string {
+	header := RenderHeader(m.title)
+
+	var content, help string
+	switch m.step {
+	case BulkStepConfirm:
+		content = m.renderConfirm()
+		help = "y: confirm • n/esc: cancel"
+	case BulkStepRunning:
+		content = m.renderProgress()
+		help = "c/esc: cancel remaining items"
+		if m.cancelRequested {
+			help = "cancelling after the current item..."
+		}
+	case BulkStepSummary:
+		content = m.renderProgress()
+		help = "enter/esc: close"
+	}
+
+	mainSection := lipgloss.JoinVertical(lipgloss.Center, header, "", content)
+	centeredMain := lipgloss.Place(m.width, m.height-2, lipgloss.Center, lipgloss.Top, mainSection)
+
+	helpStyle := lipgloss.NewStyle().Foreground(ColorGray).Italic(true)
+	helpFooter := lipgloss.NewStyle().Width(m.width).Align(lipgloss.Center)
+	return lipgloss.JoinVertical(lipgloss.Left, centeredMain, helpFooter.Render(helpStyle.Render(help)))
+}
+
+func (m *BulkOperationModel) renderConfirm() string {
+	borderColor := ColorOrange
+	if m.destructive {
+		borderColor = ColorRed
+	}
+	containerStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Padding(1, 3).
+		Width(70)
+	centered := lipgloss.NewStyle().Width(62).Align(lipgloss.Center)
+
+	var rows []string
+	if m.destructive {
+		warningStyle := centered.Foreground(ColorRed).Bold(true)
+		rows = append(rows, warningStyle.Render(fmt.Sprintf("⚠ %s ⚠", m.title)), "")
+	}
+
+	rows = append(rows, centered.Foreground(ColorWhite).Bold(true).
+		Render(fmt.Sprintf("%s %d item(s):", m.verb, len(m.targets))), "")
+
+	if len(m.details) > 0 {
+		detailStyle := lipgloss.NewStyle().Foreground(ColorOrange)
+		for _, d := range m.details {
+			rows = append(rows, detailStyle.Render("  "+truncateStr(d, 60)))
+		}
+		rows = append(rows, "")
+	}
+
+	itemStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+	for i, t := range m.targets {
+		if i == bulkMaxListed {
+			rows = append(rows, InactiveStyle.Render(fmt.Sprintf("  … and %d more", len(m.targets)-bulkMaxListed)))
+			break
+		}
+		rows = append(rows, itemStyle.Render("  • "+truncateStr(t.Label, 58)))
+	}
+	rows = append(rows, "")
+
+	yesColor := ColorOrange
+	if m.destructive {
+		yesColor = ColorRed
+	}
+	buttonStyle := lipgloss.NewStyle().Bold(true).Padding(0, 2).Border(lipgloss.RoundedBorder())
+	buttons := lipgloss.JoinHorizontal(lipgloss.Center,
+		buttonStyle.Foreground(yesColor).BorderForeground(yesColor).Render("Y - Yes, "+m.verb),
+		"    ",
+		buttonStyle.Foreground(ColorGreen).BorderForeground(ColorGreen).Render("N - No, Cancel"),
+	)
+	rows = append(rows, centered.Render(buttons))
+
+	return containerStyle.Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+func (m *BulkOperationModel) renderProgress() string {
+	containerStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorOrange).
+		Padding(1, 3).
+		Width(70)
+
+	finished := 0
+	for _, s := range m.statuses {
+		if s == BulkItemDone || s == BulkItemError || s == BulkItemCancelled {
+			finished++
+		}
+	}
+
+	var rows []string
+	rows = append(rows, TitleStyle.Render(fmt.Sprintf("%s: %d/%d", m.verb, finished, len(m.targets))), "")
+
+	for i, t := range m.targets {
+		icon, color := bulkStatusDisplay(m.statuses[i])
+		line := lipgloss.NewStyle().Foreground(color).Render(icon) + " " + truncateStr(t.Label, 56)
+		rows = append(rows, line)
+		if m.errors[i] != nil {
+			rows = append(rows, ErrorStyle.Render("    "+truncateStr(m.errors[i].Error(), 58)))
+		}
+	}
+
+	if m.step == BulkStepSummary {
+		succeeded, failed := m.counts()
+		cancelled := len(m.targets) - succeeded - failed
+		summary := fmt.Sprintf("%d succeeded", succeeded)
+		if failed > 0 {
+			summary += fmt.Sprintf(" • %d failed", failed)
+		}
+		if cancelled > 0 {
+			summary += fmt.Sprintf(" • %d cancelled", cancelled)
+		}
+		style := SuccessStyle
+		if failed > 0 {
+			style = ErrorStyle
+		}
+		rows = append(rows, "", style.Render(summary))
+	}
+
+	return containerStyle.Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// bulkStatusDisplay returns an icon and color for a bulk item status
+func bulkStatusDisplay(status BulkItemStatus) (string, lipgloss.Color) {
+	switch status {
+	case BulkItemRunning:
+		return "⟳", ColorOrange
+	case BulkItemDone:
+		return "✓", ColorGreen
+	case BulkItemError:
+		return "✗", ColorRed
+	case BulkItemCancelled:
+		return "–", ColorGray
+	default:
+		return "○", ColorGray
+	}
+}
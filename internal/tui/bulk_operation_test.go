@@ -0,0 +1,93 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func bulkKey(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+// runBulkCmd executes cmd and feeds its message back into the model
+func runBulkCmd(t *testing.T, m *BulkOperationModel, cmd tea.Cmd) (*BulkOperationModel, tea.Cmd) {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("expected a command")
+	}
+	return m.Update(cmd())
+}
+
+func TestBulkOperation_RunsAllTargets(t *testing.T) {
+	targets := []BulkTarget{{Label: "a"}, {Label: "b"}, {Label: "c"}}
+	var ran []string
+	m := NewBulkOperationModel("Test", "Process", false, targets, func(target BulkTarget) error {
+		ran = append(ran, target.Label)
+		if target.Label == "b" {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	m, cmd := m.Update(bulkKey("y"))
+	if m.Step() != BulkStepRunning {
+		t.Fatalf("expected running step, got %d", m.Step())
+	}
+	for m.Step() == BulkStepRunning {
+		m, cmd = runBulkCmd(t, m, cmd)
+	}
+
+	if len(ran) != 3 {
+		t.Errorf("expected 3 targets to run, got %v", ran)
+	}
+	want := []BulkItemStatus{BulkItemDone, BulkItemError, BulkItemDone}
+	for i, s := range m.Statuses() {
+		if s != want[i] {
+			t.Errorf("target %d: expected status %d, got %d", i, want[i], s)
+		}
+	}
+
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	closed, ok := cmd().(bulkOperationClosedMsg)
+	if !ok || !closed.ran || closed.succeeded != 2 || closed.failed != 1 {
+		t.Errorf("unexpected close message: %+v", closed)
+	}
+}
+
+func TestBulkOperation_CancelRemaining(t *testing.T) {
+	targets := []BulkTarget{{Label: "a"}, {Label: "b"}, {Label: "c"}}
+	m := NewBulkOperationModel("Test", "Process", false, targets, func(BulkTarget) error { return nil })
+
+	m, cmd := m.Update(bulkKey("y"))
+	m, _ = m.Update(bulkKey("c"))
+	m, _ = runBulkCmd(t, m, cmd)
+
+	if m.Step() != BulkStepSummary {
+		t.Fatalf("expected summary after cancel, got %d", m.Step())
+	}
+	want := []BulkItemStatus{BulkItemDone, BulkItemCancelled, BulkItemCancelled}
+	for i, s := range m.Statuses() {
+		if s != want[i] {
+			t.Errorf("target %d: expected status %d, got %d", i, want[i], s)
+		}
+	}
+}
+
+func TestBulkOperation_DeclineConfirmation(t *testing.T) {
+	called := false
+	m := NewBulkOperationModel("Test", "Delete", true, []BulkTarget{{Label: "a"}}, func(BulkTarget) error {
+		called = true
+		return nil
+	})
+
+	_, cmd := m.Update(bulkKey("n"))
+	closed, ok := cmd().(bulkOperationClosedMsg)
+	if !ok || closed.ran {
+		t.Errorf("expected a not-run close message, got %+v", closed)
+	}
+	if called {
+		t.Error("operation must not run when the confirmation is declined")
+	}
+}
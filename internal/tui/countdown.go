@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,6 +13,42 @@ import (
 // Big segment-style digit patterns (7-segment style)
 // Each digit is 7 lines tall
 var bigDigits = map[rune][]string{
+	'9': {
+		" ███████ ",
+		" █     █ ",
+		" █     █ ",
+		" ███████ ",
+		"       █ ",
+		"       █ ",
+		" ███████ ",
+	},
+	'8': {
+		" ███████ ",
+		" █     █ ",
+		" █     █ ",
+		" ███████ ",
+		" █     █ ",
+		" █     █ ",
+		" ███████ ",
+	},
+	'7': {
+		" ███████ ",
+		"       █ ",
+		"       █ ",
+		"       █ ",
+		"       █ ",
+		"       █ ",
+		"       █ ",
+	},
+	'6': {
+		" ███████ ",
+		" █       ",
+		" █       ",
+		" ███████ ",
+		" █     █ ",
+		" █     █ ",
+		" ███████ ",
+	},
 	'5': {
 		" ███████ ",
 		" █       ",
@@ -68,13 +105,23 @@ var bigDigits = map[rune][]string{
 	},
 }
 
-// getBigDigit returns the big digit pattern for a count number (1-5)
+// getBigDigit returns the big digit pattern for a count number, with the
+// digits side by side for counts of 10 and more
 func getBigDigit(count int) []string {
-	if count < 0 || count > 9 {
+	if count < 0 {
 		return nil
 	}
-	digit := rune('0' + count)
-	return bigDigits[digit]
+	var lines []string
+	for _, digit := range strconv.Itoa(count) {
+		pattern := bigDigits[digit]
+		if lines == nil {
+			lines = make([]string, len(pattern))
+		}
+		for i, line := range pattern {
+			lines[i] += line
+		}
+	}
+	return lines
 }
 
 // "GO!" in big letters
@@ -279,6 +326,7 @@ func (c *CountdownModel) IsCancelled() bool {
 
 // ShowCountdown displays the countdown and returns true if completed (not cancelled)
 func ShowCountdown() (bool, error) {
+	ApplyConfiguredTheme()
 	countdown := NewCountdownModel()
 	p := tea.NewProgram(countdown, tea.WithAltScreen())
 
@@ -0,0 +1,54 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+)
+
+func TestGetBigDigit(t *testing.T) {
+	for count := 0; count <= config.MaxCountdownSeconds; count++ {
+		if len(getBigDigit(count)) != 7 {
+			t.Errorf("getBigDigit(%d) has %d lines, want 7", count, len(getBigDigit(count)))
+		}
+	}
+	ten := getBigDigit(10)
+	if want := bigDigits['1'][0] + bigDigits['0'][0]; ten[0] != want {
+		t.Errorf("getBigDigit(10) = %q, want the digits side by side %q", ten[0], want)
+	}
+}
+
+func TestOptionsCountdown(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	m := NewOptionsModel()
+	if m.countdownSeconds != config.DefaultCountdownSeconds {
+		t.Fatalf("countdownSeconds = %d, want the default %d", m.countdownSeconds, config.DefaultCountdownSeconds)
+	}
+
+	m.focusedField = OptionsFieldCountdown
+	for i := 0; i < config.DefaultCountdownSeconds; i++ {
+		m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	}
+	if m.countdownSeconds != 0 {
+		t.Fatalf("countdownSeconds = %d, want 0", m.countdownSeconds)
+	}
+	m.save()
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Countdown() != 0 {
+		t.Errorf("saved countdown = %d, want 0 to start recording immediately", cfg.Countdown())
+	}
+	if countdownSeconds() != 0 {
+		t.Errorf("countdownSeconds() = %d, want the saved length", countdownSeconds())
+	}
+
+	// Wraps around to the longest countdown
+	m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	if m.countdownSeconds != config.MaxCountdownSeconds {
+		t.Errorf("countdownSeconds = %d, want %d", m.countdownSeconds, config.MaxCountdownSeconds)
+	}
+}
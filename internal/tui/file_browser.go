@@ -0,0 +1,410 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// fileEntry represents a file or directory in the browser
+type fileEntry struct {
+	name  string
+	path  string
+	isDir bool
+}
+
+// FileBrowserField represents which part of the file browser is focused
+type FileBrowserField int
+
+const (
+	FileBrowserFieldList FileBrowserField = iota
+	FileBrowserFieldPathInput
+)
+
+// FileBrowserResult reports the state of the file browser after a message
+type FileBrowserResult int
+
+const (
+	FileBrowserBrowsing FileBrowserResult = iota // Still open
+	FileBrowserCanceled                          // Closed without a selection
+	FileBrowserSelected                          // Closed, SelectedPath holds the choice
+)
+
+// FileBrowserModel is a full screen browser for selecting a directory or a
+// file. The owning screen forwards messages to Update while it is open and
+// renders View instead of its own content.
+type FileBrowserModel struct {
+	width  int
+	height int
+
+	title              string
+	selectingDirectory bool     // true when selecting directory, not file
+	extensions         []string // File extensions shown when selecting a file (empty = all)
+
+	currentDir   string
+	entries      []fileEntry
+	selected     int
+	scrollTop    int
+	pathInput    textinput.Model
+	field        FileBrowserField
+	selectedPath string
+}
+
+// NewDirectoryBrowser creates a file browser for selecting a directory,
+// starting in startDir (or the home directory if empty)
+func NewDirectoryBrowser(title, startDir string) *FileBrowserModel {
+	b := newFileBrowser(title, startDir)
+	b.selectingDirectory = true
+	b.loadEntries()
+	return b
+}
+
+// NewFileBrowser creates a file browser for selecting a file with one of the
+// given extensions (any file if none), starting in startDir (or the home
+// directory if empty)
+func NewFileBrowser(title, startDir string, extensions ...string) *FileBrowserModel {
+	b := newFileBrowser(title, startDir)
+	b.extensions = extensions
+	b.loadEntries()
+	return b
+}
+
+func newFileBrowser(title, startDir string) *FileBrowserModel {
+	if startDir == "" {
+		startDir, _ = os.UserHomeDir()
+	}
+
+	pathInput := textinput.New()
+	pathInput.Placeholder = "Enter or paste path..."
+	pathInput.CharLimit = 500
+	pathInput.Width = 50
+	pathInput.SetValue(startDir)
+
+	return &FileBrowserModel{
+		title:      title,
+		currentDir: startDir,
+		pathInput:  pathInput,
+		field:      FileBrowserFieldList,
+	}
+}
+
+// SetSize sets the size of the screen the browser is rendered in
+func (b *FileBrowserModel) SetSize(width, height int) {
+	b.width = width
+	b.height = height
+}
+
+// SelectedPath returns the selected directory or file once Update has
+// reported FileBrowserSelected
+func (b *FileBrowserModel) SelectedPath() string {
+	return b.selectedPath
+}
+
+// matchesExtension reports whether a file name is shown when selecting a file
+func (b *FileBrowserModel) matchesExtension(name string) bool {
+	if len(b.extensions) == 0 {
+		return true
+	}
+	ext := strings.ToLower(filepath.Ext(name))
+	for _, e := range b.extensions {
+		if ext == strings.ToLower(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadEntries loads the directory contents for the file browser
+func (b *FileBrowserModel) loadEntries() {
+	b.entries = nil
+	b.selected = 0
+	b.scrollTop = 0
+
+	entries, err := os.ReadDir(b.currentDir)
+	if err != nil {
+		return
+	}
+
+	// Add parent directory entry if not at root
+	if b.currentDir != "/" {
+		b.entries = append(b.entries, fileEntry{
+			name:  "..",
+			path:  filepath.Dir(b.currentDir),
+			isDir: true,
+		})
+	}
+
+	// Collect directories, and matching files when selecting a file
+	var dirs, files []fileEntry
+	for _, entry := range entries {
+		// Skip hidden files
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		fe := fileEntry{
+			name:  entry.Name(),
+			path:  filepath.Join(b.currentDir, entry.Name()),
+			isDir: entry.IsDir(),
+		}
+
+		if entry.IsDir() {
+			dirs = append(dirs, fe)
+		} else if !b.selectingDirectory && b.matchesExtension(entry.Name()) {
+			files = append(files, fe)
+		}
+	}
+
+	// Sort alphabetically, directories first
+	byName := func(list []fileEntry) {
+		sort.Slice(list, func(i, j int) bool {
+			return strings.ToLower(list[i].name) < strings.ToLower(list[j].name)
+		})
+	}
+	byName(dirs)
+	byName(files)
+
+	b.entries = append(b.entries, dirs...)
+	b.entries = append(b.entries, files...)
+}
+
+// navigate changes to dir and reloads the entries
+func (b *FileBrowserModel) navigate(dir string) {
+	b.currentDir = dir
+	b.pathInput.SetValue(dir)
+	b.loadEntries()
+}
+
+// Update handles a message while the browser is open and reports whether it
+// is still open, canceled or has a selection
+func (b *FileBrowserModel) Update(msg tea.Msg) (FileBrowserResult, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		b.SetSize(msg.Width, msg.Height)
+		return FileBrowserBrowsing, nil
+
+	case tea.KeyMsg:
+		// Handle path input mode
+		if b.field == FileBrowserFieldPathInput {
+			switch msg.String() {
+			case "esc":
+				b.field = FileBrowserFieldList
+				b.pathInput.Blur()
+				return FileBrowserBrowsing, nil
+			case "enter":
+				// Try to navigate to the entered path, or select an entered file
+				path := b.pathInput.Value()
+				b.field = FileBrowserFieldList
+				b.pathInput.Blur()
+				if info, err := os.Stat(path); err == nil {
+					if info.IsDir() {
+						b.currentDir = path
+						b.loadEntries()
+					} else if !b.selectingDirectory {
+						b.selectedPath = path
+						return FileBrowserSelected, nil
+					}
+				}
+				return FileBrowserBrowsing, nil
+			default:
+				var cmd tea.Cmd
+				b.pathInput, cmd = b.pathInput.Update(msg)
+				return FileBrowserBrowsing, cmd
+			}
+		}
+
+		// Handle file list mode
+		switch msg.String() {
+		case "esc", "q":
+			return FileBrowserCanceled, nil
+
+		case "up", "k":
+			if b.selected > 0 {
+				b.selected--
+				// Scroll up if needed
+				if b.selected < b.scrollTop {
+					b.scrollTop = b.selected
+				}
+			}
+			return FileBrowserBrowsing, nil
+
+		case "down", "j":
+			if b.selected < len(b.entries)-1 {
+				b.selected++
+				// Scroll down if needed
+				visibleHeight := b.height - 12 // Account for header, footer, path input
+				if b.selected >= b.scrollTop+visibleHeight {
+					b.scrollTop = b.selected - visibleHeight + 1
+				}
+			}
+			return FileBrowserBrowsing, nil
+
+		case "enter", " ":
+			if len(b.entries) > 0 && b.selected < len(b.entries) {
+				entry := b.entries[b.selected]
+				if entry.isDir {
+					// Navigate into directory
+					b.navigate(entry.path)
+				} else {
+					b.selectedPath = entry.path
+					return FileBrowserSelected, nil
+				}
+			}
+			return FileBrowserBrowsing, nil
+
+		case "s":
+			// Select current directory (when selecting directory)
+			if b.selectingDirectory {
+				b.selectedPath = b.currentDir
+				return FileBrowserSelected, nil
+			}
+			return FileBrowserBrowsing, nil
+
+		case "backspace":
+			// Go to parent directory
+			if b.currentDir != "/" {
+				b.navigate(filepath.Dir(b.currentDir))
+			}
+			return FileBrowserBrowsing, nil
+
+		case "tab", "/":
+			// Switch to path input
+			b.field = FileBrowserFieldPathInput
+			b.pathInput.Focus()
+			return FileBrowserBrowsing, textinput.Blink
+
+		case "~":
+			// Go to home directory
+			if home, err := os.UserHomeDir(); err == nil {
+				b.navigate(home)
+			}
+			return FileBrowserBrowsing, nil
+		}
+	}
+
+	return FileBrowserBrowsing, nil
+}
+
+// View renders the file browser with full screen layout
+func (b *FileBrowserModel) View() string {
+	header := RenderHeader(b.title)
+
+	// Styles
+	labelStyle := lipgloss.NewStyle().
+		Foreground(ColorGray).
+		Width(10).
+		Align(lipgloss.Right)
+
+	labelActiveStyle := lipgloss.NewStyle().
+		Foreground(ColorOrange).
+		Bold(true).
+		Width(10).
+		Align(lipgloss.Right)
+
+	dirStyle := lipgloss.NewStyle().
+		Foreground(ColorBlue)
+
+	fileStyle := lipgloss.NewStyle().
+		Foreground(ColorWhite)
+
+	selectedStyle := lipgloss.NewStyle().
+		Background(ColorOrange).
+		Foreground(lipgloss.Color("#000000"))
+
+	// Path input row
+	pathLabel := labelStyle.Render("Path: ")
+	if b.field == FileBrowserFieldPathInput {
+		pathLabel = labelActiveStyle.Render("Path: ")
+	}
+	pathRow := lipgloss.JoinHorizontal(lipgloss.Center, pathLabel, b.pathInput.View())
+
+	// Current directory display
+	dirLabel := labelStyle.Render("In: ")
+	dirRow := lipgloss.JoinHorizontal(lipgloss.Center, dirLabel, lipgloss.NewStyle().Foreground(ColorGray).Render(b.currentDir))
+
+	// File list - calculate available height more precisely
+	// Header: ~6 lines, path row: 1, dir row: 1, empty line: 1, footer: 2, margins: 3
+	visibleHeight := b.height - 14
+	if visibleHeight < 3 {
+		visibleHeight = 3
+	}
+
+	// Ensure scroll position keeps selected item visible
+	if b.selected < b.scrollTop {
+		b.scrollTop = b.selected
+	} else if b.selected >= b.scrollTop+visibleHeight {
+		b.scrollTop = b.selected - visibleHeight + 1
+	}
+
+	// Clamp scroll position
+	if b.scrollTop < 0 {
+		b.scrollTop = 0
+	}
+	maxScroll := len(b.entries) - visibleHeight
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+	if b.scrollTop > maxScroll {
+		b.scrollTop = maxScroll
+	}
+
+	var fileLines []string
+	endIdx := b.scrollTop + visibleHeight
+	if endIdx > len(b.entries) {
+		endIdx = len(b.entries)
+	}
+
+	for i := b.scrollTop; i < endIdx; i++ {
+		entry := b.entries[i]
+		icon := "📄 "
+		style := fileStyle
+		if entry.isDir {
+			icon = "📁 "
+			style = dirStyle
+		}
+		if i == b.selected && b.field == FileBrowserFieldList {
+			fileLines = append(fileLines, selectedStyle.Render("▶ "+icon+entry.name))
+		} else {
+			fileLines = append(fileLines, style.Render("  "+icon+entry.name))
+		}
+	}
+
+	// Add scroll indicators if needed
+	scrollInfo := ""
+	if len(b.entries) > visibleHeight {
+		scrollInfo = lipgloss.NewStyle().Foreground(ColorGray).Render(
+			fmt.Sprintf(" [%d-%d of %d]", b.scrollTop+1, endIdx, len(b.entries)))
+	}
+
+	fileList := lipgloss.JoinVertical(lipgloss.Left, fileLines...)
+	if len(b.entries) == 0 {
+		empty := "(no subdirectories)"
+		if !b.selectingDirectory {
+			empty = "(no matching files)"
+		}
+		fileList = lipgloss.NewStyle().Foreground(ColorGray).Italic(true).Render(empty)
+	}
+
+	// Content - use a fixed height box for the file list to prevent overflow
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		pathRow,
+		dirRow+scrollInfo,
+		"",
+		fileList,
+	)
+
+	// Help footer
+	helpText := "↑/k ↓/j: navigate • enter: open dir • s: select this dir • backspace: parent • ~: home • esc: cancel"
+	if !b.selectingDirectory {
+		helpText = "↑/k ↓/j: navigate • enter: open dir/select file • backspace: parent • ~: home • esc: cancel"
+	}
+	footer := RenderHelpFooter(helpText, b.width)
+
+	return LayoutWithHeaderFooter(header, content, footer, b.width, b.height)
+}
@@ -0,0 +1,229 @@
+package tui
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/muesli/termenv"
+)
+
+// Run "make update-goldens" (go test ./internal/tui -run Golden -update)
+// after an intended layout change and review the diff of testdata/golden
+var updateGoldens = flag.Bool("update", false, "update the golden files in testdata/golden")
+
+// assertGolden compares a rendered view with testdata/golden/<name>.golden,
+// or rewrites the file with -update
+func assertGolden(t *testing.T, name, view string) {
+	t.Helper()
+	path := filepath.Join("testdata", "golden", name+".golden")
+
+	if *updateGoldens {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(view), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("missing golden file, run make update-goldens: %v", err)
+	}
+	if view == string(want) {
+		return
+	}
+
+	// Report the first line that differs, the full views are long
+	got, wantLines := strings.Split(view, "\n"), strings.Split(string(want), "\n")
+	for i := 0; i < max(len(got), len(wantLines)); i++ {
+		var g, w string
+		if i < len(got) {
+			g = got[i]
+		}
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if g != w {
+			t.Fatalf("%s differs from %s at line %d:\n got: %q\nwant: %q\nrun make update-goldens if the change is intended",
+				name, path, i+1, g, w)
+		}
+	}
+}
+
+// goldenEnv renders without colours and with a fixed version in the header,
+// and keeps the user's configuration and recordings out of the views
+func goldenEnv(t *testing.T) {
+	t.Helper()
+	lipgloss.SetColorProfile(termenv.Ascii)
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_VIDEOS_DIR", t.TempDir())
+
+	saved := *GlobalAppState
+	GlobalAppState.Version = "0.0.0-test"
+	GlobalAppState.Status = "Ready"
+	GlobalAppState.TotalRecordings = 0
+	GlobalAppState.IsRecording = false
+	t.Cleanup(func() { *GlobalAppState = saved })
+}
+
+// goldenRecordings returns recordings covering every status and badge
+func goldenRecordings() []models.RecordingInfo {
+	start := time.Date(2025, 3, 14, 9, 30, 0, 0, time.UTC)
+	recs := []models.RecordingInfo{
+		{Status: models.StatusCompleted, Duration: 754 * time.Second},
+		{Status: models.StatusFailed, Duration: 95 * time.Second},
+		{Status: models.StatusNeedsMetadata, Duration: 42 * time.Second},
+		{Status: models.StatusProcessing, Duration: 1830 * time.Second},
+		{Status: models.StatusCompleted, Duration: 3725 * time.Second},
+	}
+	titles := []string{"QGIS intro", "GeoServer styling", "", "Sprint review", "Überblick: PostGIS rasters"}
+	topics := []string{"QGIS", "GeoServer", "", "Internal", "PostGIS"}
+	for i := range recs {
+		slug := strings.ReplaceAll(strings.ToLower(titles[i]), " ", "-")
+		if slug == "" {
+			slug = "untitled"
+		}
+		folder := start.AddDate(0, 0, -i).Format("2006-01-02") + "-" + slug
+		recs[i].StartTime = start.AddDate(0, 0, -i)
+		recs[i].EndTime = recs[i].StartTime.Add(recs[i].Duration)
+		recs[i].Metadata.Title = titles[i]
+		recs[i].Metadata.Topic = topics[i]
+		recs[i].Metadata.Presenter = "Tim"
+		recs[i].Metadata.FolderName = folder
+		recs[i].Files.FolderPath = "/videos/" + folder
+		recs[i].Files.TotalSize = int64(i+1) * 187 << 20
+	}
+	recs[0].Metadata.Description = "A first look at the QGIS interface."
+	recs[0].Files.MergedFile = recs[0].Files.FolderPath + "/screen-merged.mp4"
+	recs[0].Metadata.YouTubeUploads = []models.YouTubeUpload{{VideoID: "abc123", Privacy: "unlisted"}}
+	recs[4].Files.VerticalFile = recs[4].Files.FolderPath + "/screen-vertical.mp4"
+	return recs
+}
+
+// goldenHistory returns a history of the golden recordings at a size
+func goldenHistory(t *testing.T, width, height int) *HistoryModel {
+	t.Helper()
+	h := NewHistoryModel()
+	h.width, h.height = width, height
+	h.Update(recordingsLoadedMsg{recordings: goldenRecordings()})
+	return h
+}
+
+func TestGolden_HistoryList(t *testing.T) {
+	goldenEnv(t)
+	for _, size := range []struct {
+		name          string
+		width, height int
+	}{
+		{"80x24", 80, 24},
+		{"120x40", 120, 40},
+	} {
+		t.Run(size.name, func(t *testing.T) {
+			h := goldenHistory(t, size.width, size.height)
+			assertGolden(t, "history_list_"+size.name, h.View())
+		})
+	}
+}
+
+func TestGolden_HistoryListSelection(t *testing.T) {
+	goldenEnv(t)
+	h := goldenHistory(t, 120, 40)
+	h.Update(bulkKey(" "))
+	h.Update(bulkKey(" "))
+	assertGolden(t, "history_list_selection", h.View())
+}
+
+func TestGolden_HistoryDetail(t *testing.T) {
+	goldenEnv(t)
+	h := goldenHistory(t, 120, 40)
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if h.mode != HistoryDetailMode {
+		t.Fatalf("expected the detail view, mode %v", h.mode)
+	}
+	assertGolden(t, "history_detail", h.View())
+}
+
+func TestGolden_UploadMetadata(t *testing.T) {
+	goldenEnv(t)
+	rec := goldenRecordings()[0]
+	m := NewYouTubeUploadModelWithRecording(rec.Files.MergedFile, &rec)
+	m.width, m.height = 120, 40
+	m.step = YouTubeUploadStepMetadata
+	assertGolden(t, "upload_metadata", m.View())
+}
+
+func TestGolden_Processing(t *testing.T) {
+	goldenEnv(t)
+	start := time.Date(2025, 3, 14, 10, 0, 0, 0, time.UTC)
+	state := NewProcessingState()
+	state.StartTime = start
+	for i := range state.Steps {
+		state.Steps[i].StartTime = start.Add(time.Duration(i) * 2 * time.Second)
+		state.Steps[i].EndTime = state.Steps[i].StartTime.Add(1500 * time.Millisecond)
+	}
+
+	// Merging, with the earlier steps done and normalization skipped
+	state.IsProcessing = true
+	state.CurrentStep = ProcessStepMerging
+	state.Steps[ProcessStepStopping].Status = StepComplete
+	state.Steps[ProcessStepAnalyzing].Status = StepComplete
+	state.Steps[ProcessStepNormalizing].Status = StepSkipped
+	state.Steps[ProcessStepMerging].Status = StepRunning
+	state.Steps[ProcessStepMerging].Progress = 42
+	t.Run("running", func(t *testing.T) {
+		// The elapsed time of a running job is measured from now
+		running := *state
+		running.StartTime = time.Now()
+		view := RenderProcessingView(&running, 100, 30, 0, ProcessingButtonMenu, false, nil)
+		assertGolden(t, "processing_running", view)
+	})
+
+	state.IsProcessing = false
+	state.EndTime = start.Add(12 * time.Second)
+	state.Steps[ProcessStepMerging].Status = StepComplete
+	state.Steps[ProcessStepVertical].Status = StepComplete
+	rec := goldenRecordings()[4]
+	rec.Files.AudioFile = rec.Files.FolderPath + "/audio.wav"
+	rec.Files.MergedFile = rec.Files.FolderPath + "/screen-merged.mp4"
+	t.Run("complete", func(t *testing.T) {
+		view := RenderProcessingView(state, 100, 30, 0, ProcessingButtonUpload, true, &rec)
+		assertGolden(t, "processing_complete", view)
+	})
+}
+
+func TestGolden_StatusFooter(t *testing.T) {
+	goldenEnv(t)
+
+	t.Run("menu", func(t *testing.T) {
+		GlobalAppState.TotalRecordings = 5
+		model, _ := AppModel{screen: ScreenMenu, menu: NewMenuModel()}.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+		view := model.View()
+		lines := strings.Split(view, "\n")
+		if len(lines) > 30 || !strings.Contains(lines[len(lines)-1], "Rec: Off | YT: - | #5 | Ready") {
+			t.Fatalf("view has %d lines ending in %q, want the footer last within 30 lines", len(lines), lines[len(lines)-1])
+		}
+		assertGolden(t, "status_footer_menu", view)
+	})
+
+	t.Run("busy", func(t *testing.T) {
+		GlobalAppState.IsRecording = true
+		GlobalAppState.BlinkOn = true
+		GlobalAppState.YouTubeConnected = true
+		GlobalAppState.TotalRecordings = 12
+		GlobalAppState.Status = "Paused"
+		GlobalAppState.Job = "Uploading 42%"
+		GlobalAppState.UploadQueue = 3
+		GlobalAppState.UploadQueueFailed = 1
+		assertGolden(t, "status_footer_busy", RenderStatusFooter(100))
+	})
+}
@@ -11,12 +11,21 @@ import (
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/attention"
+	"github.com/kartoza/kartoza-screencaster/internal/cleanup"
+	"github.com/kartoza/kartoza-screencaster/internal/clipboard"
 	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/duplicates"
 	"github.com/kartoza/kartoza-screencaster/internal/models"
 	"github.com/kartoza/kartoza-screencaster/internal/monitor"
+	"github.com/kartoza/kartoza-screencaster/internal/proclog"
+	"github.com/kartoza/kartoza-screencaster/internal/retention"
+	"github.com/kartoza/kartoza-screencaster/internal/search"
+	"github.com/kartoza/kartoza-screencaster/internal/spellcheck"
 	"github.com/kartoza/kartoza-screencaster/internal/youtube"
 )
 
@@ -28,11 +37,27 @@ const (
 	HistoryDetailMode
 	HistoryEditMode
 	HistoryDeleteConfirmMode
+	HistoryBulkDeleteConfirmMode
+	HistoryTrashMode
 	HistoryYouTubePrivacyMode
 	HistoryYouTubeDeleteConfirmMode
 	HistoryYouTubeUploadMode
+	HistoryYouTubeLocalizationsMode
+	HistorySavedFiltersMode
+	HistoryRecentMode
+	HistoryDuplicatesMode
+	HistoryCleanupMode
+	HistoryStorageBudgetMode
+	HistoryVerifyUploadsMode
+	HistoryYouTubeSyncMode
 	HistoryReprocessConfirmMode
 	HistoryErrorDetailMode
+	HistoryRenameMode
+	HistoryBatchEditMode
+	HistoryDescriptionReplaceMode
+	HistoryAttentionMode
+	HistoryYouTubeMetadataMode
+	HistoryBatchUploadMode
 )
 
 // HistoryModel displays recording history with navigation
@@ -42,10 +67,33 @@ type HistoryModel struct {
 
 	// Data
 	recordings []models.RecordingInfo
+	totalSize  int64 // Storage used by all recordings, in bytes
 
-	// Scrolling - cursor is absolute position in recordings
+	// Scrolling - cursor is the position in the visible (filtered) recordings
 	cursor int
 
+	// Sort order of the recordings, cycled with s and reversed with S
+	// (each key starts in its default direction, see defaultAscending)
+	sortKey       HistorySort
+	sortAscending bool
+
+	// Search: index over recordings, the "/" filter input and the indexes of
+	// the recordings matching it (nil = no filter)
+	searchIndex        *search.Index
+	searchInput        textinput.Model
+	searching          bool // Filter input has focus
+	fuzzySearch        bool // Fuzzy matching ranked by quality instead of substrings
+	filteredRecordings []int
+
+	// Saved filter applied with f (nil = none) and the screen listing them
+	activeFilter *config.SavedFilter
+	savedFilters *SavedFiltersModel
+
+	// Recently opened recordings (folder paths, newest first) and the
+	// selection in the list reached with R
+	recent       []string
+	recentCursor int
+
 	// View mode
 	mode HistoryViewMode
 
@@ -58,10 +106,65 @@ type HistoryModel struct {
 	// State
 	err     error
 	loading bool
+	spinner tickingSpinner // Animated while loading
+
+	// Progress of the running load, sampled every loadProgressInterval
+	loadCounter *loadProgress
+	loadStarted time.Time
+	loadElapsed time.Duration
+	loadScanned int
+	loadTotal   int
 
 	// Delete confirmation state
 	deleteConfirmRecording *models.RecordingInfo
 	deleteError            string
+	deleteReturnMode       HistoryViewMode // Mode shown after the confirmation
+
+	// Recordings marked with space for bulk delete, keyed by folder path
+	selected map[string]bool
+
+	// Metadata change applied to all selected recordings, and the bulk
+	// operation saving it (or deleting the selection) once confirmed
+	batchEdit *batchEditForm
+	bulkOp    *BulkOperationModel
+
+	// Search and replace over the descriptions of the selected recordings
+	replace *descriptionReplace
+
+	// Deleted recordings in the trash folder, and the trash actions
+	trash             []retention.TrashedRecording
+	trashCursor       int
+	trashConfirmEmpty bool
+	trashNotice       string
+	trashErrors       []string
+
+	// Groups of likely duplicates and the selection across all of them
+	duplicateGroups []duplicates.Group
+	duplicateCursor int
+
+	// Cleanup of files the recordings no longer reference
+	cleanupReports      []cleanup.Report
+	cleanupErrors       []error // Folders that could not be scanned
+	cleanupScanning     bool
+	cleanupRemoving     bool
+	cleanupIncludeMedia bool            // Also remove unreferenced audio/video
+	cleanupResult       *cleanup.Result // Set once files were removed
+
+	// Recordings suggested to get back under the storage budget
+	budgetPlan   retention.BudgetPlan
+	budgetMoving bool
+	budgetResult *budgetMovedMsg // Set once the suggestions were moved
+
+	// Check that uploaded videos still exist on YouTube
+	verifyRunning bool
+	verifyResult  *uploadsVerifiedMsg
+	verifyCleared int // Recordings whose stale entries were cleared (-1 = not yet)
+
+	// Sync of title, privacy and playlist changes made on YouTube
+	syncRunning bool
+	syncResult  *youtubeSyncedMsg
+	syncChanges []youtubeChange
+	syncApplied int // Recordings updated (-1 = not yet)
 
 	// YouTube action state
 	youtubePrivacyOptions  []string
@@ -69,12 +172,51 @@ type HistoryModel struct {
 	youtubeActionError     string
 	youtubeActionSuccess   string
 	youtubeActionLoading   bool
+	youtubeActionRetry     *retryNotice // Rate limit/retry notice of the running action
+
+	// Editor for the translated titles and descriptions of the YouTube video
+	localizationEditor *LocalizationEditorModel
+
+	// Form for the title, description and tags of the YouTube video
+	youtubeMetadata *youtubeMetadataForm
+
+	// Upload of all pending recordings, kept to show its progress and outcome
+	batchUpload *batchUploadForm
+
+	// Typed confirmation required before deleting a public video from YouTube
+	youtubeDeleteInput textinput.Model
+
+	// New name of the recording folder and why it cannot be used
+	renameInput textinput.Model
+	renameError string
 
 	// Error detail view scroll position
 	errorViewScrollOffset int
 
+	// Transient confirmation, e.g. after copying a path, shown in place of the help line
+	copyNotice      string
+	copyNoticeError bool
+	copyNoticeID    int
+
 	// When true, automatically navigate to edit the latest needs_metadata recording on load
 	editRecordingOnLoad bool
+
+	// When true, open the details of the latest recording on load
+	openLatestOnLoad bool
+
+	// When true, show the storage budget suggestions on load if over budget
+	showStorageBudgetOnLoad bool
+
+	// Needs attention dashboard: shown on load when true, and returned to
+	// when leaving a recording opened from it while attentionReturn is set
+	showAttentionOnLoad bool
+	attention           attention.Report
+	attentionCursor     int
+	attentionReturn     bool
+
+	// When true, put the cursor on the recording opened last once loaded, so
+	// reopening the history keeps its place
+	restoreCursorOnLoad bool
 }
 
 // NewHistoryModel creates a new history model
@@ -86,11 +228,22 @@ func NewHistoryModel() *HistoryModel {
 		topics = models.DefaultTopics()
 	}
 
+	searchInput := textinput.New()
+	searchInput.Placeholder = "Filter by title, topic, presenter or folder"
+	searchInput.Prompt = "/ "
+	searchInput.CharLimit = 100
+	searchInput.Width = 40
+
 	h := &HistoryModel{
 		cursor:                0,
 		loading:               true,
+		spinner:               newTickingSpinner(),
 		mode:                  HistoryListMode,
 		topics:                topics,
+		searchInput:           searchInput,
+		fuzzySearch:           cfg.FuzzySearch,
+		recent:                cfg.RecentRecordings,
+		restoreCursorOnLoad:   true,
 		youtubePrivacyOptions: []string{"unlisted", "private", "public"},
 	}
 
@@ -99,7 +252,15 @@ func NewHistoryModel() *HistoryModel {
 
 // Init initializes the history view
 func (h *HistoryModel) Init() tea.Cmd {
-	return h.loadRecordings()
+	return h.reload()
+}
+
+// reload loads the recordings again behind the loading view, with a spinner
+// and the progress of large libraries
+func (h *HistoryModel) reload() tea.Cmd {
+	h.loading = true
+	load := h.loadRecordings()
+	return tea.Batch(load, h.spinner.start(h.loading), loadProgressTick(h.loadCounter))
 }
 
 // getVisibleCount returns how many entries can fit on screen
@@ -118,9 +279,310 @@ func (h *HistoryModel) getVisibleCount() int {
 	return count
 }
 
+// visibleCount returns the number of recordings shown in the list
+func (h *HistoryModel) visibleCount() int {
+	if h.filteredRecordings != nil {
+		return len(h.filteredRecordings)
+	}
+	return len(h.recordings)
+}
+
+// visibleRecording returns the recording at a position of the list, or nil
+func (h *HistoryModel) visibleRecording(pos int) *models.RecordingInfo {
+	if pos < 0 || pos >= h.visibleCount() {
+		return nil
+	}
+	if h.filteredRecordings != nil {
+		return &h.recordings[h.filteredRecordings[pos]]
+	}
+	return &h.recordings[pos]
+}
+
+// rebuildSearchIndex indexes the recordings after the list changed and
+// reapplies the filter. The storage used by the recordings is summed again.
+func (h *HistoryModel) rebuildSearchIndex() {
+	h.searchIndex = search.NewIndex(h.recordings)
+	h.totalSize = totalRecordingsSize(h.recordings)
+	h.applyFilter()
+}
+
+// applyFilter updates the visible recordings from the filter input and keeps
+// the cursor in range
+func (h *HistoryModel) applyFilter() {
+	query := strings.TrimSpace(h.searchInput.Value())
+	switch {
+	case h.searchIndex == nil || (query == "" && h.activeFilter == nil):
+		h.filteredRecordings = nil
+	case h.fuzzySearch:
+		h.filteredRecordings = h.searchIndex.SearchFuzzy(query)
+	default:
+		h.filteredRecordings = h.searchIndex.Search(query)
+	}
+	if h.filteredRecordings != nil && h.activeFilter != nil {
+		h.filteredRecordings = h.searchIndex.Narrow(h.filteredRecordings, search.Filter{
+			Status: h.activeFilter.Status,
+			Topic:  h.activeFilter.Topic,
+			Period: search.Period(h.activeFilter.Period),
+		}, time.Now())
+	}
+	if h.cursor >= h.visibleCount() {
+		h.cursor = h.visibleCount() - 1
+	}
+	if h.cursor < 0 {
+		h.cursor = 0
+	}
+}
+
+// toggleFuzzySearch switches between fuzzy and exact matching, remembering
+// the choice in the config
+func (h *HistoryModel) toggleFuzzySearch() {
+	h.fuzzySearch = !h.fuzzySearch
+	if cfg, err := config.Load(); err == nil {
+		cfg.FuzzySearch = h.fuzzySearch
+		_ = config.Save(cfg)
+	}
+	h.cursor = 0
+	h.applyFilter()
+}
+
+// clearFilter closes the filter input and shows all recordings again
+func (h *HistoryModel) clearFilter() {
+	h.searching = false
+	h.searchInput.Blur()
+	h.searchInput.SetValue("")
+	h.activeFilter = nil
+	h.filteredRecordings = nil
+	h.cursor = 0
+}
+
+// openSavedFilters shows the saved filters, offering the current filter as
+// the template for a new one
+func (h *HistoryModel) openSavedFilters() {
+	cfg, _ := config.Load()
+	current := config.SavedFilter{Text: strings.TrimSpace(h.searchInput.Value())}
+	if h.activeFilter != nil {
+		current.Status = h.activeFilter.Status
+		current.Topic = h.activeFilter.Topic
+		current.Period = h.activeFilter.Period
+	}
+	h.savedFilters = NewSavedFiltersModel(cfg.GetSavedFilters(), h.topics, current)
+	h.savedFilters.SetSize(h.width, h.height)
+	h.mode = HistorySavedFiltersMode
+}
+
+// applySavedFilter replaces the current filter with a saved filter
+func (h *HistoryModel) applySavedFilter(f config.SavedFilter) {
+	h.searching = false
+	h.searchInput.Blur()
+	h.searchInput.SetValue(f.Text)
+	h.activeFilter = &f
+	h.cursor = 0
+	h.applyFilter()
+}
+
+// openRecording opens the detail view of a recording (or edit mode if it
+// needs metadata) and remembers it as recently opened
+func (h *HistoryModel) openRecording(r *models.RecordingInfo) tea.Cmd {
+	rec := *r
+	h.selectedRecording = &rec
+
+	if cfg, err := config.Load(); err == nil {
+		cfg.AddRecentRecording(rec.Files.FolderPath)
+		_ = config.Save(cfg)
+		h.recent = cfg.RecentRecordings
+	}
+
+	// If recording needs metadata, go directly to edit mode
+	if rec.Status == models.StatusNeedsMetadata {
+		h.mode = HistoryEditMode
+		h.initEditForm()
+		return textinput.Blink
+	}
+
+	h.mode = HistoryDetailMode
+	return nil
+}
+
+// recentRecordings returns the indexes into recordings of the recently
+// opened recordings that still exist, newest first
+func (h *HistoryModel) recentRecordings() []int {
+	var indexes []int
+	for _, path := range h.recent {
+		for i := range h.recordings {
+			if h.recordings[i].Files.FolderPath == path {
+				indexes = append(indexes, i)
+				break
+			}
+		}
+	}
+	return indexes
+}
+
+// updateRecentMode handles input in the recently opened recordings list
+func (h *HistoryModel) updateRecentMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	recent := h.recentRecordings()
+	switch msg.String() {
+	case "ctrl+c":
+		return h, tea.Quit
+
+	case "esc", "q", "R":
+		h.mode = HistoryListMode
+
+	case "up", "k":
+		if h.recentCursor > 0 {
+			h.recentCursor--
+		}
+
+	case "down", "j":
+		if h.recentCursor < len(recent)-1 {
+			h.recentCursor++
+		}
+
+	case "enter":
+		if h.recentCursor < len(recent) {
+			return h, h.jumpToRecording(recent[h.recentCursor])
+		}
+		h.mode = HistoryListMode
+
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		// Number keys open one of the first nine directly
+		if n := int(msg.String()[0] - '1'); n < len(recent) {
+			h.recentCursor = n
+			return h, h.jumpToRecording(recent[n])
+		}
+	}
+	return h, nil
+}
+
+// latestRecording returns the index into recordings of the most recently
+// started recording that match accepts, or -1 if there is none. A nil match
+// accepts every recording.
+func latestRecording(recordings []models.RecordingInfo, match func(*models.RecordingInfo) bool) int {
+	latest := -1
+	for i := range recordings {
+		if match != nil && !match(&recordings[i]) {
+			continue
+		}
+		if latest < 0 || recordings[i].StartTime.After(recordings[latest].StartTime) {
+			latest = i
+		}
+	}
+	return latest
+}
+
+// jumpToRecording moves the list cursor to the recording at index idx of
+// recordings, clearing a filter that hides it, and opens it
+func (h *HistoryModel) jumpToRecording(idx int) tea.Cmd {
+	pos := -1
+	for p := 0; p < h.visibleCount(); p++ {
+		if h.visibleRecording(p) == &h.recordings[idx] {
+			pos = p
+			break
+		}
+	}
+	if pos < 0 {
+		h.clearFilter()
+		pos = idx
+	}
+	h.cursor = pos
+	return h.openRecording(&h.recordings[idx])
+}
+
+// renderRecentView renders the recently opened recordings list
+func (h *HistoryModel) renderRecentView() string {
+	header := RenderHeader("Recently Opened")
+
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+	selectedStyle := lipgloss.NewStyle().
+		Background(ColorOrange).
+		Foreground(lipgloss.Color("#000000"))
+
+	var rows []string
+	for i, idx := range h.recentRecordings() {
+		rec := h.recordings[idx]
+		title := rec.Metadata.Title
+		if title == "" {
+			title = filepath.Base(rec.Files.FolderPath)
+		}
+		line := fmt.Sprintf("%d  %-40s %-12s %s", i+1, truncateStr(title, 40), truncateStr(rec.Metadata.Topic, 12), rec.StartTime.Format("2006-01-02"))
+		if i == h.recentCursor {
+			rows = append(rows, selectedStyle.Render("▶ "+line))
+		} else {
+			rows = append(rows, valueStyle.Render("  "+line))
+		}
+	}
+	if len(rows) == 0 {
+		rows = append(rows, grayStyle.Italic(true).Render("No recently opened recordings"))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	footer := RenderHelpFooter("↑/↓: navigate • enter/1-9: open • esc: back", h.width)
+	return LayoutWithHeaderFooter(header, content, footer, h.width, h.height)
+}
+
+// updateSavedFiltersMode handles input while the saved filters are shown
+func (h *HistoryModel) updateSavedFiltersMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return h, tea.Quit
+	}
+
+	result, cmd := h.savedFilters.Update(msg)
+	switch result {
+	case SavedFiltersChanged:
+		if cfg, err := config.Load(); err == nil {
+			cfg.SavedFilters = h.savedFilters.Filters()
+			cfg.SavedFiltersConfigured = true
+			_ = config.Save(cfg)
+		}
+	case SavedFiltersClosed:
+		h.savedFilters = nil
+		h.mode = HistoryListMode
+	case SavedFiltersApplied:
+		h.applySavedFilter(h.savedFilters.Selected())
+		h.savedFilters = nil
+		h.mode = HistoryListMode
+	}
+	return h, cmd
+}
+
+// updateSearchInput handles keys while the filter input has focus. The list
+// can still be navigated with the arrow keys.
+func (h *HistoryModel) updateSearchInput(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return h, tea.Quit
+	case "esc":
+		h.clearFilter()
+		return h, nil
+	case "enter":
+		// Keep the filter and return to the list
+		h.searching = false
+		h.searchInput.Blur()
+		return h, nil
+	case "up", "down", "pgup", "pgdown":
+		return h.updateListMode(msg)
+	case "ctrl+f":
+		h.toggleFuzzySearch()
+		return h, nil
+	}
+
+	var cmd tea.Cmd
+	h.searchInput, cmd = h.searchInput.Update(msg)
+	h.cursor = 0
+	h.applyFilter()
+	return h, cmd
+}
+
 // Update handles messages
 func (h *HistoryModel) Update(msg tea.Msg) (*HistoryModel, tea.Cmd) {
 	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		return h, h.spinner.update(msg, h.loading)
+
+	case loadProgressMsg:
+		return h, h.handleLoadProgress(msg)
+
 	case tea.WindowSizeMsg:
 		h.width = msg.Width
 		h.height = msg.Height
@@ -132,10 +594,22 @@ func (h *HistoryModel) Update(msg tea.Msg) (*HistoryModel, tea.Cmd) {
 			}
 			h.editForm.SetSize(h.width, contentHeight)
 		}
+		if h.localizationEditor != nil {
+			h.localizationEditor.SetSize(h.width, h.height)
+		}
+		if h.savedFilters != nil {
+			h.savedFilters.SetSize(h.width, h.height)
+		}
+		if h.bulkOp != nil {
+			h.bulkOp.SetSize(h.width, h.height)
+		}
 
 	case tea.KeyMsg:
 		switch h.mode {
 		case HistoryListMode:
+			if h.searching {
+				return h.updateSearchInput(msg)
+			}
 			return h.updateListMode(msg)
 		case HistoryDetailMode:
 			return h.updateDetailMode(msg)
@@ -143,32 +617,104 @@ func (h *HistoryModel) Update(msg tea.Msg) (*HistoryModel, tea.Cmd) {
 			return h.updateEditMode(msg)
 		case HistoryDeleteConfirmMode:
 			return h.updateDeleteConfirmMode(msg)
+		case HistoryBulkDeleteConfirmMode:
+			return h.updateBulkDeleteConfirmMode(msg)
+		case HistoryTrashMode:
+			return h.updateTrashMode(msg)
 		case HistoryYouTubePrivacyMode:
 			return h.updateYouTubePrivacyMode(msg)
 		case HistoryYouTubeDeleteConfirmMode:
 			return h.updateYouTubeDeleteConfirmMode(msg)
+		case HistoryYouTubeLocalizationsMode:
+			return h.updateYouTubeLocalizationsMode(msg)
+		case HistorySavedFiltersMode:
+			return h.updateSavedFiltersMode(msg)
+		case HistoryRecentMode:
+			return h.updateRecentMode(msg)
+		case HistoryDuplicatesMode:
+			return h.updateDuplicatesMode(msg)
+		case HistoryCleanupMode:
+			return h.updateCleanupMode(msg)
+		case HistoryStorageBudgetMode:
+			return h.updateStorageBudgetMode(msg)
+		case HistoryVerifyUploadsMode:
+			return h.updateVerifyUploadsMode(msg)
+		case HistoryYouTubeSyncMode:
+			return h.updateYouTubeSyncMode(msg)
 		case HistoryReprocessConfirmMode:
 			return h.updateReprocessConfirmMode(msg)
 		case HistoryErrorDetailMode:
 			return h.updateErrorDetailMode(msg)
+		case HistoryRenameMode:
+			return h.updateRenameMode(msg)
+		case HistoryBatchEditMode:
+			return h.updateBatchEditMode(msg)
+		case HistoryDescriptionReplaceMode:
+			return h.updateDescriptionReplaceMode(msg)
+		case HistoryAttentionMode:
+			return h.updateAttentionMode(msg)
+		case HistoryYouTubeMetadataMode:
+			return h.updateYouTubeMetadataMode(msg)
+		case HistoryBatchUploadMode:
+			return h.updateBatchUploadMode(msg)
 		}
 
 	case recordingsLoadedMsg:
 		h.loading = false
 		h.recordings = msg.recordings
 		h.err = msg.err
+		sortRecordings(h.recordings, h.sortKey, h.sortAscending)
+		h.rebuildSearchIndex()
+		h.pruneSelection()
+		h.budgetPlan = planStorageBudget(h.recordings)
+
+		// Start on the recording opened last, wherever the sort put it
+		if h.restoreCursorOnLoad {
+			h.restoreCursorOnLoad = false
+			if recent := h.recentRecordings(); len(recent) > 0 {
+				h.cursor = recent[0]
+			}
+		}
+
+		// Opened from the needs attention item of the menu
+		if h.showAttentionOnLoad {
+			h.showAttentionOnLoad = false
+			h.openAttention()
+			return h, nil
+		}
+
+		// Opened from the over-budget prompt of the menu
+		if h.showStorageBudgetOnLoad {
+			h.showStorageBudgetOnLoad = false
+			if h.budgetPlan.OverBudget() {
+				h.openStorageBudget()
+				return h, nil
+			}
+		}
+
+		// Opened from the latest recording shortcut of the menu
+		if h.openLatestOnLoad && msg.err == nil {
+			h.openLatestOnLoad = false
+			if idx := latestRecording(h.recordings, nil); idx >= 0 {
+				return h, h.jumpToRecording(idx)
+			}
+		}
 
 		// If edit-recording mode, find and open the latest needs_metadata recording
-		if h.editRecordingOnLoad && msg.err == nil && len(msg.recordings) > 0 {
+		if h.editRecordingOnLoad && msg.err == nil {
 			h.editRecordingOnLoad = false
-			for i, rec := range h.recordings {
-				if rec.Status == models.StatusNeedsMetadata {
-					h.cursor = i
-					h.selectedRecording = &h.recordings[i]
-					h.mode = HistoryEditMode
-					h.initEditForm()
-					return h, textinput.Blink
-				}
+			idx := latestRecording(h.recordings, func(rec *models.RecordingInfo) bool {
+				return rec.Status == models.StatusNeedsMetadata
+			})
+			if idx >= 0 {
+				h.cursor = idx
+				h.selectedRecording = &h.recordings[idx]
+				h.mode = HistoryEditMode
+				h.initEditForm()
+				return h, textinput.Blink
+			}
+			if len(h.recordings) > 0 {
+				return h, h.showNotice("No recording needs metadata", false)
 			}
 		}
 
@@ -193,18 +739,45 @@ func (h *HistoryModel) Update(msg tea.Msg) (*HistoryModel, tea.Cmd) {
 						break
 					}
 				}
+				h.rebuildSearchIndex()
 			}
 		}
 
 	case youtubePrivacyChangedMsg:
 		h.youtubeActionLoading = false
 		if msg.err != nil {
-			h.youtubeActionError = msg.err.Error()
+			h.youtubeActionError = youtube.FriendlyError(msg.err)
 		} else {
 			h.youtubeActionSuccess = "Privacy updated to " + msg.newPrivacy
 			// Update local metadata
-			if h.selectedRecording != nil && h.selectedRecording.Metadata.YouTube != nil {
-				h.selectedRecording.Metadata.YouTube.Privacy = msg.newPrivacy
+			if h.selectedRecording != nil && h.selectedRecording.Metadata.PrimaryYouTube() != nil {
+				h.selectedRecording.Metadata.SetYouTubePrivacy(h.selectedRecording.Metadata.PrimaryYouTube().VideoID, msg.newPrivacy)
+				_ = h.selectedRecording.Save()
+				// Update in list
+				for i := range h.recordings {
+					if h.recordings[i].Files.FolderPath == h.selectedRecording.Files.FolderPath {
+						h.recordings[i] = *h.selectedRecording
+						break
+					}
+				}
+			}
+			h.mode = HistoryDetailMode
+		}
+
+	case youtubeMetadataFetchedMsg:
+		h.handleYouTubeMetadataFetched(msg)
+
+	case youtubeMetadataUpdatedMsg:
+		h.handleYouTubeMetadataUpdated(msg)
+
+	case youtubeLocalizationsSetMsg:
+		h.youtubeActionLoading = false
+		if msg.err != nil {
+			h.youtubeActionError = youtube.FriendlyError(msg.err)
+		} else {
+			h.youtubeActionSuccess = fmt.Sprintf("Translations updated (%d languages)", len(msg.localizations))
+			if h.selectedRecording != nil && h.selectedRecording.Metadata.PrimaryYouTube() != nil {
+				h.selectedRecording.Metadata.SetYouTubeLocalizations(h.selectedRecording.Metadata.PrimaryYouTube().VideoID, msg.localizations)
 				_ = h.selectedRecording.Save()
 				// Update in list
 				for i := range h.recordings {
@@ -214,18 +787,19 @@ func (h *HistoryModel) Update(msg tea.Msg) (*HistoryModel, tea.Cmd) {
 					}
 				}
 			}
+			h.localizationEditor = nil
 			h.mode = HistoryDetailMode
 		}
 
 	case youtubeVideoDeletedMsg:
 		h.youtubeActionLoading = false
 		if msg.err != nil {
-			h.youtubeActionError = msg.err.Error()
+			h.youtubeActionError = youtube.FriendlyError(msg.err)
 		} else {
 			h.youtubeActionSuccess = "Video deleted from YouTube"
-			// Clear YouTube metadata
+			// Move YouTube metadata into the deletion history
 			if h.selectedRecording != nil {
-				h.selectedRecording.Metadata.YouTube = nil
+				h.selectedRecording.Metadata.RecordYouTubeDeletion()
 				_ = h.selectedRecording.Save()
 				// Update in list
 				for i := range h.recordings {
@@ -238,6 +812,51 @@ func (h *HistoryModel) Update(msg tea.Msg) (*HistoryModel, tea.Cmd) {
 			h.mode = HistoryDetailMode
 		}
 
+	case pathCopiedMsg:
+		if msg.err != nil {
+			return h, h.showNotice(msg.err.Error(), true)
+		}
+		return h, h.showNotice("Copied "+msg.label+": "+msg.path, false)
+
+	case cleanupScannedMsg:
+		h.cleanupScanning = false
+		h.cleanupReports = msg.reports
+		h.cleanupErrors = msg.errs
+
+	case cleanupDoneMsg:
+		h.cleanupRemoving = false
+		h.cleanupResult = &msg.result
+
+	case budgetMovedMsg:
+		h.handleBudgetMoved(msg)
+
+	case bulkItemDoneMsg:
+		if h.bulkOp != nil {
+			var cmd tea.Cmd
+			h.bulkOp, cmd = h.bulkOp.Update(msg)
+			return h, cmd
+		}
+
+	case bulkOperationClosedMsg:
+		if h.bulkOp != nil && h.mode == HistoryDescriptionReplaceMode {
+			return h, h.closeReplace(msg)
+		} else if h.bulkOp != nil && h.mode == HistoryBulkDeleteConfirmMode {
+			h.closeBulkDelete(msg)
+		} else if h.bulkOp != nil {
+			h.closeBatchEdit(msg)
+		}
+
+	case uploadsVerifiedMsg:
+		h.handleUploadsVerified(msg)
+
+	case youtubeSyncedMsg:
+		h.handleYouTubeSynced(msg)
+
+	case copyNoticeExpiredMsg:
+		if msg.id == h.copyNoticeID {
+			h.copyNotice = ""
+		}
+
 	case startYouTubeUploadMsg:
 		// This is handled by the parent app model
 		return h, func() tea.Msg { return msg }
@@ -253,15 +872,36 @@ func (h *HistoryModel) updateListMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
 		return h, tea.Quit
 
 	case "esc", "q":
+		// Esc clears an active filter, then the selection, before leaving
+		// the history
+		if msg.String() == "esc" && h.filteredRecordings != nil {
+			h.clearFilter()
+			return h, nil
+		}
+		if msg.String() == "esc" && len(h.selected) > 0 {
+			h.selected = nil
+			return h, nil
+		}
 		return h, func() tea.Msg { return backToMenuMsg{} }
 
+	case "/":
+		h.searching = true
+		h.searchInput.Focus()
+		return h, textinput.Blink
+
+	case "ctrl+f":
+		h.toggleFuzzySearch()
+
+	case "f":
+		h.openSavedFilters()
+
 	case "up", "k":
 		if h.cursor > 0 {
 			h.cursor--
 		}
 
 	case "down", "j":
-		if h.cursor < len(h.recordings)-1 {
+		if h.cursor < h.visibleCount()-1 {
 			h.cursor++
 		}
 
@@ -269,8 +909,8 @@ func (h *HistoryModel) updateListMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
 		h.cursor = 0
 
 	case "end", "G":
-		if len(h.recordings) > 0 {
-			h.cursor = len(h.recordings) - 1
+		if h.visibleCount() > 0 {
+			h.cursor = h.visibleCount() - 1
 		}
 
 	case "pgup":
@@ -281,47 +921,247 @@ func (h *HistoryModel) updateListMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
 
 	case "pgdown":
 		h.cursor += h.getVisibleCount()
-		if h.cursor >= len(h.recordings) {
-			h.cursor = len(h.recordings) - 1
+		if h.cursor >= h.visibleCount() {
+			h.cursor = h.visibleCount() - 1
 		}
 		if h.cursor < 0 {
 			h.cursor = 0
 		}
 
-	case "enter", " ":
-		// Open detail view (or edit mode if recording needs metadata)
-		if len(h.recordings) > 0 && h.cursor < len(h.recordings) {
-			rec := h.recordings[h.cursor]
-			h.selectedRecording = &rec
+	case "enter":
+		if r := h.visibleRecording(h.cursor); r != nil {
+			return h, h.openRecording(r)
+		}
 
-			// If recording needs metadata, go directly to edit mode
-			if rec.Status == models.StatusNeedsMetadata {
-				h.mode = HistoryEditMode
-				h.initEditForm()
-				return h, textinput.Blink
+	case " ":
+		// Mark for bulk delete and move on to the next recording
+		if r := h.visibleRecording(h.cursor); r != nil {
+			h.toggleSelected(r)
+			if h.cursor < h.visibleCount()-1 {
+				h.cursor++
 			}
+		}
 
-			h.mode = HistoryDetailMode
+	case "D":
+		h.confirmBulkDelete()
+
+	case "e":
+		// Change the metadata of all marked recordings at once
+		return h, h.startBatchEdit()
+
+	case "u":
+		// Upload the marked recordings one after another in the background
+		return h, h.queueSelectedUploads()
+
+	case "U":
+		// Upload every completed recording that is not on YouTube yet
+		return h, h.startBatchUpload()
+
+	case "F":
+		// Find and replace text in the descriptions of the marked recordings
+		return h, h.startDescriptionReplace()
+
+	case "s":
+		h.cycleSortKey()
+
+	case "S":
+		h.sortAscending = !h.sortAscending
+		h.resort()
+
+	case "R":
+		// Jump to a recently opened recording
+		if len(h.recentRecordings()) > 0 {
+			h.recentCursor = 0
+			h.mode = HistoryRecentMode
 		}
 
 	case "r":
-		h.loading = true
 		h.cursor = 0
-		return h, h.loadRecordings()
+		return h, h.reload()
+
+	case "c", "C":
+		if rec := h.visibleRecording(h.cursor); rec != nil {
+			return h, copyRecordingPath(rec, msg.String() == "C")
+		}
 
 	case "d":
-		// Delete selected recording (with confirmation)
-		if len(h.recordings) > 0 && h.cursor < len(h.recordings) {
-			rec := h.recordings[h.cursor]
-			h.deleteConfirmRecording = &rec
-			h.deleteError = ""
-			h.mode = HistoryDeleteConfirmMode
+		// Delete the marked recordings, or the highlighted one if none are
+		// marked (with confirmation)
+		if len(h.selected) > 0 {
+			h.confirmBulkDelete()
+		} else if r := h.visibleRecording(h.cursor); r != nil {
+			h.confirmDelete(r)
+		}
+
+	case "ctrl+d":
+		h.duplicateGroups = duplicates.Find(h.recordings)
+		h.duplicateCursor = 0
+		h.mode = HistoryDuplicatesMode
+
+	case "X":
+		return h, h.startCleanup()
+
+	case "T":
+		h.openTrash()
+
+	case "V":
+		return h, h.startVerifyUploads()
+
+	case "Y":
+		return h, h.startYouTubeSync()
+
+	case "b":
+		if h.budgetPlan.OverBudget() {
+			h.openStorageBudget()
 		}
+
+	case "x":
+		if showStorageBudgetPrompt(h.budgetPlan) {
+			storageBudgetDismissed = true
+		}
+
+	case "a":
+		h.openAttention()
 	}
 
 	return h, nil
 }
 
+// confirmDelete asks to confirm deleting a recording, returning to the
+// current mode afterwards
+func (h *HistoryModel) confirmDelete(r *models.RecordingInfo) {
+	rec := *r
+	h.deleteConfirmRecording = &rec
+	h.deleteError = ""
+	h.deleteReturnMode = h.mode
+	h.mode = HistoryDeleteConfirmMode
+}
+
+// closeDeleteConfirm leaves the delete confirmation, refreshing the
+// duplicates if it was opened from there
+func (h *HistoryModel) closeDeleteConfirm() {
+	h.mode = h.deleteReturnMode
+	h.deleteConfirmRecording = nil
+	h.deleteError = ""
+	if h.mode == HistoryDuplicatesMode {
+		h.duplicateGroups = duplicates.Find(h.recordings)
+		if h.duplicateCursor >= h.duplicateCount() {
+			h.duplicateCursor = max(h.duplicateCount()-1, 0)
+		}
+	}
+}
+
+// duplicateCount returns the number of recordings in all duplicate groups
+func (h *HistoryModel) duplicateCount() int {
+	n := 0
+	for _, g := range h.duplicateGroups {
+		n += len(g.Recordings)
+	}
+	return n
+}
+
+// duplicateAt returns the recording at a position across all duplicate
+// groups, or nil
+func (h *HistoryModel) duplicateAt(pos int) *models.RecordingInfo {
+	for _, g := range h.duplicateGroups {
+		if pos < len(g.Recordings) {
+			return &g.Recordings[pos]
+		}
+		pos -= len(g.Recordings)
+	}
+	return nil
+}
+
+// updateDuplicatesMode handles input in the duplicates view
+func (h *HistoryModel) updateDuplicatesMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return h, tea.Quit
+
+	case "esc", "q":
+		h.duplicateGroups = nil
+		h.mode = HistoryListMode
+
+	case "up", "k":
+		if h.duplicateCursor > 0 {
+			h.duplicateCursor--
+		}
+
+	case "down", "j":
+		if h.duplicateCursor < h.duplicateCount()-1 {
+			h.duplicateCursor++
+		}
+
+	case "c", "C":
+		if rec := h.duplicateAt(h.duplicateCursor); rec != nil {
+			return h, copyRecordingPath(rec, msg.String() == "C")
+		}
+
+	case "d":
+		if rec := h.duplicateAt(h.duplicateCursor); rec != nil {
+			h.confirmDelete(rec)
+		}
+	}
+	return h, nil
+}
+
+// renderDuplicatesView renders the groups of likely duplicates
+func (h *HistoryModel) renderDuplicatesView() string {
+	header := RenderHeader("Likely Duplicates")
+
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	groupStyle := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true)
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+	selectedStyle := lipgloss.NewStyle().
+		Background(ColorOrange).
+		Foreground(lipgloss.Color("#000000"))
+
+	var rows []string
+	if len(h.duplicateGroups) == 0 {
+		rows = append(rows, grayStyle.Italic(true).Render("No likely duplicates found"))
+	}
+	pos, selectedRow := 0, 0
+	for i, g := range h.duplicateGroups {
+		if i > 0 {
+			rows = append(rows, "")
+		}
+		rows = append(rows, groupStyle.Render(fmt.Sprintf("Group %d · %d recordings", i+1, len(g.Recordings))))
+		for j, rec := range g.Recordings {
+			title := rec.Metadata.Title
+			if title == "" {
+				title = filepath.Base(rec.Files.FolderPath)
+			}
+			note := ""
+			if j == 0 {
+				note = "oldest"
+			}
+			line := fmt.Sprintf("%-40s %s %8s %10s  %s", truncateStr(title, 40), rec.StartTime.Format("2006-01-02 15:04"),
+				models.FormatDuration(rec.Duration), models.FormatFileSize(rec.Files.TotalSize), note)
+			if pos == h.duplicateCursor {
+				selectedRow = len(rows)
+				rows = append(rows, selectedStyle.Render("▶ "+line))
+			} else {
+				rows = append(rows, valueStyle.Render("  "+line))
+			}
+			pos++
+		}
+	}
+
+	// Keep the selection on screen by dropping rows from the top
+	if avail := h.height - 8; avail > 0 && len(rows) > avail {
+		start := min(max(selectedRow-avail/2, 0), len(rows)-avail)
+		rows = rows[start : start+avail]
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	helpText := "↑/↓: navigate • d: delete • c: copy path • esc: back"
+	footer := lipgloss.NewStyle().
+		Width(h.width).
+		Align(lipgloss.Center).
+		Render(h.renderHelpOrNotice(grayStyle.Italic(true), helpText))
+	return LayoutWithHeaderFooter(header, content, footer, h.width, h.height)
+}
+
 // updateDeleteConfirmMode handles input in delete confirmation mode
 func (h *HistoryModel) updateDeleteConfirmMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
 	switch msg.String() {
@@ -330,17 +1170,14 @@ func (h *HistoryModel) updateDeleteConfirmMode(msg tea.KeyMsg) (*HistoryModel, t
 
 	case "esc", "n", "N":
 		// Cancel deletion
-		h.mode = HistoryListMode
-		h.deleteConfirmRecording = nil
-		h.deleteError = ""
+		h.closeDeleteConfirm()
 
 	case "y", "Y":
-		// Confirm deletion
+		// Confirm deletion, which moves the recording to the trash
 		if h.deleteConfirmRecording != nil {
 			folderPath := h.deleteConfirmRecording.Files.FolderPath
-			err := os.RemoveAll(folderPath)
-			if err != nil {
-				h.deleteError = fmt.Sprintf("Failed to delete: %v", err)
+			if _, err := retention.MoveToTrash(config.GetDefaultVideosDir(), folderPath, time.Now()); err != nil {
+				h.deleteError = fmt.Sprintf("Failed to move to trash: %v", err)
 				return h, nil
 			}
 
@@ -352,15 +1189,11 @@ func (h *HistoryModel) updateDeleteConfirmMode(msg tea.KeyMsg) (*HistoryModel, t
 				}
 			}
 
-			// Adjust cursor if needed
-			if h.cursor >= len(h.recordings) && h.cursor > 0 {
-				h.cursor--
-			}
+			// Reindex, which also keeps the cursor in range
+			h.rebuildSearchIndex()
 
-			// Return to list mode
-			h.mode = HistoryListMode
-			h.deleteConfirmRecording = nil
-			h.deleteError = ""
+			// Return to the list (or the duplicates)
+			h.closeDeleteConfirm()
 
 			// Update global recording count
 			updateGlobalAppState(GlobalAppState.IsRecording, GlobalAppState.BlinkOn, GlobalAppState.Status)
@@ -378,7 +1211,7 @@ func (h *HistoryModel) updateDetailMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd)
 
 	case "esc", "q":
 		// Go back to list
-		h.mode = HistoryListMode
+		h.backToList()
 		h.selectedRecording = nil
 		h.editForm = nil
 		h.youtubeActionError = ""
@@ -395,28 +1228,13 @@ func (h *HistoryModel) updateDetailMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd)
 	case "u":
 		// Upload to YouTube (only if not already uploaded)
 		if h.selectedRecording != nil && !h.selectedRecording.Metadata.IsPublishedToYouTube() {
-			// Check if YouTube is connected
-			cfg, _ := config.Load()
-			if !cfg.IsYouTubeConnected() {
-				h.youtubeActionError = "YouTube not connected. Go to Options > YouTube to set up."
-				return h, nil
-			}
-			// Find video file to upload
-			videoPath := h.selectedRecording.Files.MergedFile
-			if videoPath == "" {
-				videoPath = h.selectedRecording.Files.VideoFile
-			}
-			if videoPath == "" {
-				h.youtubeActionError = "No video file found to upload"
-				return h, nil
-			}
-			// Send message to parent to start upload
-			return h, func() tea.Msg {
-				return startYouTubeUploadMsg{
-					recording: h.selectedRecording,
-					videoPath: videoPath,
-				}
-			}
+			return h, h.startYouTubeUpload()
+		}
+
+	case "U":
+		// Upload again, e.g. to a second channel
+		if h.selectedRecording != nil && h.selectedRecording.Metadata.IsPublishedToYouTube() {
+			return h, h.startYouTubeUpload()
 		}
 
 	case "p":
@@ -426,7 +1244,7 @@ func (h *HistoryModel) updateDetailMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd)
 			h.youtubeActionError = ""
 			h.youtubeActionSuccess = ""
 			// Set current privacy as selected
-			currentPrivacy := h.selectedRecording.Metadata.YouTube.Privacy
+			currentPrivacy := h.selectedRecording.Metadata.PrimaryYouTube().Privacy
 			for i, p := range h.youtubePrivacyOptions {
 				if p == currentPrivacy {
 					h.youtubeSelectedPrivacy = i
@@ -435,12 +1253,41 @@ func (h *HistoryModel) updateDetailMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd)
 			}
 		}
 
+	case "E":
+		// Edit the title, description and tags on YouTube (only if already uploaded)
+		if h.selectedRecording != nil && h.selectedRecording.Metadata.IsPublishedToYouTube() {
+			return h, h.startYouTubeMetadataEdit()
+		}
+
+	case "t":
+		// Edit translated titles and descriptions (only if already uploaded)
+		if h.selectedRecording != nil && h.selectedRecording.Metadata.IsPublishedToYouTube() {
+			yt := h.selectedRecording.Metadata.PrimaryYouTube()
+			// Uploads made before languages were stored use the default language
+			cfg, _ := config.Load()
+			language := youtube.DefaultLanguageFor(yt.Language,
+				youtube.DefaultLanguageFor(cfg.YouTube.DefaultLanguage, spellcheck.Language))
+			h.mode = HistoryYouTubeLocalizationsMode
+			h.youtubeActionError = ""
+			h.youtubeActionSuccess = ""
+			h.localizationEditor = NewLocalizationEditor(h.selectedRecording.Metadata.Title, language, yt.Localizations)
+			h.localizationEditor.SetSize(h.width, h.height)
+		}
+
 	case "x":
 		// Delete from YouTube (only if already uploaded)
 		if h.selectedRecording != nil && h.selectedRecording.Metadata.IsPublishedToYouTube() {
 			h.mode = HistoryYouTubeDeleteConfirmMode
 			h.youtubeActionError = ""
 			h.youtubeActionSuccess = ""
+			if h.requiresTypedDeleteConfirm() {
+				h.youtubeDeleteInput = textinput.New()
+				h.youtubeDeleteInput.Placeholder = youtubeDeleteConfirmWord
+				h.youtubeDeleteInput.CharLimit = len(youtubeDeleteConfirmWord)
+				h.youtubeDeleteInput.Width = 20
+				h.youtubeDeleteInput.Focus()
+				return h, textinput.Blink
+			}
 		}
 
 	case "r":
@@ -470,6 +1317,13 @@ func (h *HistoryModel) updateDetailMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd)
 			}
 		}
 
+	case "w":
+		// Play the webcam-only video
+		if h.selectedRecording != nil && h.selectedRecording.Status == models.StatusCompleted &&
+			h.selectedRecording.Files.WebcamOnlyFile != "" {
+			return h, h.openVideoInPlayer(h.selectedRecording.Files.WebcamOnlyFile)
+		}
+
 	case "m":
 		// Play merged video
 		if h.selectedRecording != nil && h.selectedRecording.Status == models.StatusCompleted {
@@ -506,9 +1360,134 @@ func (h *HistoryModel) updateDetailMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd)
 				return h, h.openFolderInFileManager(folderPath)
 			}
 		}
+
+	case "l":
+		// Open the processing log
+		if h.selectedRecording != nil {
+			return h, h.openProcessingLog(h.selectedRecording)
+		}
+
+	case "c", "C":
+		// Copy the folder path (c) or the merged video path (C)
+		if h.selectedRecording != nil {
+			return h, copyRecordingPath(h.selectedRecording, msg.String() == "C")
+		}
+
+	case "n":
+		// Rename the recording folder
+		if h.selectedRecording != nil {
+			return h, h.startRename()
+		}
+
+	case "N":
+		// Start a new recording with the settings of this one
+		if h.selectedRecording != nil {
+			rec := h.selectedRecording
+			return h, func() tea.Msg { return newRecordingLikeMsg{recording: rec} }
+		}
+
+	case "y":
+		// Copy the YouTube URL (only if already uploaded)
+		if h.selectedRecording != nil {
+			return h, copyYouTubeURL(h.selectedRecording)
+		}
+	}
+
+	return h, nil
+}
+
+// uploadVideoPath returns the video of a recording to upload, or "" if it
+// has none
+func uploadVideoPath(rec *models.RecordingInfo) string {
+	if rec.Files.MergedFile != "" {
+		return rec.Files.MergedFile
+	}
+	return rec.Files.VideoFile
+}
+
+// queueSelectedUploads adds the marked recordings that are not on YouTube
+// yet to the upload queue, with the settings the upload form would start
+// with. The queued recordings are unmarked.
+func (h *HistoryModel) queueSelectedUploads() tea.Cmd {
+	cfg, _ := config.Load()
+	if !cfg.IsYouTubeConnected() {
+		h.showNotice("YouTube not connected. Go to Options > YouTube to set up.", true)
+		return nil
+	}
+
+	var items []youtube.QueueItem
+	skipped := 0
+	for _, rec := range h.selectedRecordings() {
+		rec := rec
+		videoPath := uploadVideoPath(&rec)
+		if rec.Status != models.StatusCompleted || videoPath == "" || rec.Metadata.IsPublishedToYouTube() {
+			skipped++
+			continue
+		}
+		items = append(items, NewYouTubeUploadModelWithRecording(videoPath, &rec).queueItems()...)
+		delete(h.selected, rec.Files.FolderPath)
+	}
+
+	notice := fmt.Sprintf("Queued %d upload(s)", len(items))
+	if skipped > 0 {
+		notice += fmt.Sprintf(", skipped %d not completed or already on YouTube", skipped)
+	}
+	cmd := h.showNotice(notice, len(items) == 0)
+	if len(items) == 0 {
+		return cmd
+	}
+	return tea.Batch(cmd, func() tea.Msg { return queueUploadsMsg{items: items} })
+}
+
+// showNotice shows a confirmation or error in place of the help line for a
+// few seconds
+func (h *HistoryModel) showNotice(text string, isError bool) tea.Cmd {
+	h.copyNoticeID++
+	id := h.copyNoticeID
+	h.copyNotice = text
+	h.copyNoticeError = isError
+	return tea.Tick(copyNoticeDuration, func(time.Time) tea.Msg {
+		return copyNoticeExpiredMsg{id: id}
+	})
+}
+
+// replaceRecording updates a recording in the list, e.g. after a queued
+// upload of it completed
+func (h *HistoryModel) replaceRecording(rec models.RecordingInfo) {
+	for i := range h.recordings {
+		if h.recordings[i].Files.FolderPath == rec.Files.FolderPath {
+			h.recordings[i] = rec
+			if h.selectedRecording != nil && h.selectedRecording.Files.FolderPath == rec.Files.FolderPath {
+				h.selectedRecording = &h.recordings[i]
+			}
+			h.rebuildSearchIndex()
+			return
+		}
+	}
+}
+
+// startYouTubeUpload opens the upload form for the selected recording, or
+// sets an error if YouTube is not connected or there is no video to upload
+func (h *HistoryModel) startYouTubeUpload() tea.Cmd {
+	cfg, _ := config.Load()
+	if !cfg.IsYouTubeConnected() {
+		h.youtubeActionError = "YouTube not connected. Go to Options > YouTube to set up."
+		return nil
+	}
+	// Find video file to upload
+	videoPath := uploadVideoPath(h.selectedRecording)
+	if videoPath == "" {
+		h.youtubeActionError = "No video file found to upload"
+		return nil
+	}
+	// Send message to parent to start upload
+	rec := h.selectedRecording
+	return func() tea.Msg {
+		return startYouTubeUploadMsg{
+			recording: rec,
+			videoPath: videoPath,
+		}
 	}
-
-	return h, nil
 }
 
 // updateEditMode handles input in edit mode
@@ -569,9 +1548,9 @@ func (h *HistoryModel) updateYouTubePrivacyMode(msg tea.KeyMsg) (*HistoryModel,
 		}
 
 	case "enter":
-		if h.selectedRecording != nil && h.selectedRecording.Metadata.YouTube != nil {
+		if h.selectedRecording != nil && h.selectedRecording.Metadata.PrimaryYouTube() != nil {
 			newPrivacy := h.youtubePrivacyOptions[h.youtubeSelectedPrivacy]
-			if newPrivacy != h.selectedRecording.Metadata.YouTube.Privacy {
+			if newPrivacy != h.selectedRecording.Metadata.PrimaryYouTube().Privacy {
 				h.youtubeActionLoading = true
 				return h, h.changeYouTubePrivacy(newPrivacy)
 			}
@@ -582,8 +1561,76 @@ func (h *HistoryModel) updateYouTubePrivacyMode(msg tea.KeyMsg) (*HistoryModel,
 	return h, nil
 }
 
+// youtubeDeleteConfirmWord must be typed to confirm deleting a public video
+const youtubeDeleteConfirmWord = "DELETE"
+
+// requiresTypedDeleteConfirm returns true if the selected video is public and
+// deleting it needs a typed confirmation (it may have views and comments)
+func (h *HistoryModel) requiresTypedDeleteConfirm() bool {
+	if h.selectedRecording == nil {
+		return false
+	}
+	yt := h.selectedRecording.Metadata.PrimaryYouTube()
+	return yt != nil && yt.Privacy == "public"
+}
+
+// updateYouTubeLocalizationsMode handles input in the YouTube translations editor
+func (h *HistoryModel) updateYouTubeLocalizationsMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return h, tea.Quit
+	}
+	if h.youtubeActionLoading {
+		return h, nil
+	}
+
+	result, cmd := h.localizationEditor.Update(msg)
+	switch result {
+	case LocalizationEditorCanceled:
+		h.localizationEditor = nil
+		h.youtubeActionError = ""
+		h.mode = HistoryDetailMode
+	case LocalizationEditorSaved:
+		h.youtubeActionError = ""
+		h.youtubeActionLoading = true
+		return h, h.setYouTubeLocalizations(h.localizationEditor.defaultLanguage, h.localizationEditor.Localizations())
+	}
+	return h, cmd
+}
+
 // updateYouTubeDeleteConfirmMode handles input in YouTube delete confirmation mode
 func (h *HistoryModel) updateYouTubeDeleteConfirmMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	if h.youtubeActionLoading {
+		if msg.String() == "ctrl+c" {
+			return h, tea.Quit
+		}
+		return h, nil
+	}
+
+	// Public videos require typing the confirmation word
+	if h.requiresTypedDeleteConfirm() {
+		switch msg.String() {
+		case "ctrl+c":
+			return h, tea.Quit
+		case "esc":
+			h.mode = HistoryDetailMode
+			h.youtubeActionError = ""
+			h.youtubeDeleteInput.Blur()
+		case "enter":
+			if strings.TrimSpace(h.youtubeDeleteInput.Value()) != youtubeDeleteConfirmWord {
+				h.youtubeActionError = fmt.Sprintf("Type %s to confirm", youtubeDeleteConfirmWord)
+				return h, nil
+			}
+			h.youtubeActionError = ""
+			h.youtubeActionLoading = true
+			return h, h.deleteFromYouTube()
+		default:
+			var cmd tea.Cmd
+			h.youtubeDeleteInput, cmd = h.youtubeDeleteInput.Update(msg)
+			return h, cmd
+		}
+		return h, nil
+	}
+
 	switch msg.String() {
 	case "ctrl+c":
 		return h, tea.Quit
@@ -593,7 +1640,7 @@ func (h *HistoryModel) updateYouTubeDeleteConfirmMode(msg tea.KeyMsg) (*HistoryM
 		h.youtubeActionError = ""
 
 	case "y", "Y":
-		if h.selectedRecording != nil && h.selectedRecording.Metadata.YouTube != nil {
+		if h.selectedRecording != nil && h.selectedRecording.Metadata.PrimaryYouTube() != nil {
 			h.youtubeActionLoading = true
 			return h, h.deleteFromYouTube()
 		}
@@ -661,50 +1708,84 @@ func (h *HistoryModel) updateErrorDetailMode(msg tea.KeyMsg) (*HistoryModel, tea
 		if h.selectedRecording != nil {
 			h.mode = HistoryReprocessConfirmMode
 		}
+
+	case "l":
+		// Open the full processing log; without one, go back to the
+		// detail view where the reason is shown
+		if h.selectedRecording != nil {
+			if processingLogPath(h.selectedRecording) == "" {
+				h.mode = HistoryDetailMode
+				h.errorViewScrollOffset = 0
+			}
+			return h, h.openProcessingLog(h.selectedRecording)
+		}
 	}
 
 	return h, nil
 }
 
+// videoUploader creates an uploader for the account that uploaded the
+// recording's video, falling back to the last used (or legacy) account
+func videoUploader(ctx context.Context, rec *models.RecordingInfo, retry *retryNotice) (*youtube.Uploader, error) {
+	channelID := ""
+	if yt := rec.Metadata.PrimaryYouTube(); yt != nil {
+		channelID = yt.ChannelID
+	}
+	return channelUploader(ctx, channelID, retry)
+}
+
+// channelUploader creates an uploader for the account of a channel, falling
+// back to the last used (or legacy) account
+func channelUploader(ctx context.Context, channelID string, retry *retryNotice) (*youtube.Uploader, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the account that matches the video's channel ID
+	var clientID, clientSecret, accountID string
+	if channelID != "" {
+		if acc := cfg.YouTube.GetAccountByChannelID(channelID); acc != nil {
+			clientID = acc.ClientID
+			clientSecret = acc.ClientSecret
+			accountID = acc.ID
+		}
+	}
+	// Fallback to last used account or legacy
+	if clientID == "" {
+		if acc := cfg.YouTube.GetLastUsedAccount(); acc != nil {
+			clientID = acc.ClientID
+			clientSecret = acc.ClientSecret
+			accountID = acc.ID
+		} else {
+			clientID = cfg.YouTube.ClientID
+			clientSecret = cfg.YouTube.ClientSecret
+			accountID = "legacy"
+		}
+	}
+
+	auth := youtube.NewAuthForAccount(clientID, clientSecret, config.GetConfigDir(), accountID)
+	uploader, err := youtube.NewUploader(ctx, auth)
+	if err != nil {
+		return nil, err
+	}
+	uploader.SetRetryNotify(retry.set)
+	return uploader, nil
+}
+
 // changeYouTubePrivacy changes the privacy setting of a YouTube video
 func (h *HistoryModel) changeYouTubePrivacy(newPrivacy string) tea.Cmd {
 	rec := h.selectedRecording
+	retry := &retryNotice{}
+	h.youtubeActionRetry = retry
 	return func() tea.Msg {
 		ctx := context.Background()
-		cfg, err := config.Load()
-		if err != nil {
-			return youtubePrivacyChangedMsg{err: err}
-		}
-
-		// Find the account that matches the video's channel ID
-		var clientID, clientSecret, accountID string
-		if rec.Metadata.YouTube != nil && rec.Metadata.YouTube.ChannelID != "" {
-			if acc := cfg.YouTube.GetAccountByChannelID(rec.Metadata.YouTube.ChannelID); acc != nil {
-				clientID = acc.ClientID
-				clientSecret = acc.ClientSecret
-				accountID = acc.ID
-			}
-		}
-		// Fallback to last used account or legacy
-		if clientID == "" {
-			if acc := cfg.YouTube.GetLastUsedAccount(); acc != nil {
-				clientID = acc.ClientID
-				clientSecret = acc.ClientSecret
-				accountID = acc.ID
-			} else {
-				clientID = cfg.YouTube.ClientID
-				clientSecret = cfg.YouTube.ClientSecret
-				accountID = "legacy"
-			}
-		}
-
-		auth := youtube.NewAuthForAccount(clientID, clientSecret, config.GetConfigDir(), accountID)
-		uploader, err := youtube.NewUploader(ctx, auth)
+		uploader, err := videoUploader(ctx, rec, retry)
 		if err != nil {
 			return youtubePrivacyChangedMsg{err: err}
 		}
 
-		err = uploader.UpdateVideoPrivacy(ctx, rec.Metadata.YouTube.VideoID, youtube.PrivacyStatus(newPrivacy))
+		err = uploader.UpdateVideoPrivacy(ctx, rec.Metadata.PrimaryYouTube().VideoID, youtube.PrivacyStatus(newPrivacy))
 		if err != nil {
 			return youtubePrivacyChangedMsg{err: err}
 		}
@@ -713,45 +1794,45 @@ func (h *HistoryModel) changeYouTubePrivacy(newPrivacy string) tea.Cmd {
 	}
 }
 
-// deleteFromYouTube deletes the video from YouTube
-func (h *HistoryModel) deleteFromYouTube() tea.Cmd {
+// setYouTubeLocalizations replaces the translated titles and descriptions of
+// the selected recording's YouTube video, whose title and description are
+// in defaultLanguage
+func (h *HistoryModel) setYouTubeLocalizations(defaultLanguage string, localizations map[string]models.Localization) tea.Cmd {
 	rec := h.selectedRecording
+	retry := &retryNotice{}
+	h.youtubeActionRetry = retry
 	return func() tea.Msg {
 		ctx := context.Background()
-		cfg, err := config.Load()
+		uploader, err := videoUploader(ctx, rec, retry)
 		if err != nil {
-			return youtubeVideoDeletedMsg{err: err}
+			return youtubeLocalizationsSetMsg{err: err}
 		}
 
-		// Find the account that matches the video's channel ID
-		var clientID, clientSecret, accountID string
-		if rec.Metadata.YouTube != nil && rec.Metadata.YouTube.ChannelID != "" {
-			if acc := cfg.YouTube.GetAccountByChannelID(rec.Metadata.YouTube.ChannelID); acc != nil {
-				clientID = acc.ClientID
-				clientSecret = acc.ClientSecret
-				accountID = acc.ID
-			}
+		apiLocalizations := make(map[string]youtube.Localization, len(localizations))
+		for lang, l := range localizations {
+			apiLocalizations[lang] = youtube.Localization{Title: l.Title, Description: l.Description}
 		}
-		// Fallback to last used account or legacy
-		if clientID == "" {
-			if acc := cfg.YouTube.GetLastUsedAccount(); acc != nil {
-				clientID = acc.ClientID
-				clientSecret = acc.ClientSecret
-				accountID = acc.ID
-			} else {
-				clientID = cfg.YouTube.ClientID
-				clientSecret = cfg.YouTube.ClientSecret
-				accountID = "legacy"
-			}
+		if err := uploader.SetLocalizations(ctx, rec.Metadata.PrimaryYouTube().VideoID, defaultLanguage, apiLocalizations); err != nil {
+			return youtubeLocalizationsSetMsg{err: err}
 		}
 
-		auth := youtube.NewAuthForAccount(clientID, clientSecret, config.GetConfigDir(), accountID)
-		uploader, err := youtube.NewUploader(ctx, auth)
+		return youtubeLocalizationsSetMsg{localizations: localizations}
+	}
+}
+
+// deleteFromYouTube deletes the video from YouTube
+func (h *HistoryModel) deleteFromYouTube() tea.Cmd {
+	rec := h.selectedRecording
+	retry := &retryNotice{}
+	h.youtubeActionRetry = retry
+	return func() tea.Msg {
+		ctx := context.Background()
+		uploader, err := videoUploader(ctx, rec, retry)
 		if err != nil {
 			return youtubeVideoDeletedMsg{err: err}
 		}
 
-		err = uploader.DeleteVideo(ctx, rec.Metadata.YouTube.VideoID)
+		err = uploader.DeleteVideo(ctx, rec.Metadata.PrimaryYouTube().VideoID)
 		if err != nil {
 			return youtubeVideoDeletedMsg{err: err}
 		}
@@ -790,6 +1871,102 @@ func (h *HistoryModel) openFolderInFileManager(folderPath string) tea.Cmd {
 	}
 }
 
+// processingLogPath returns the processing log of rec, or "" if it has none.
+// Recordings processed before logs were kept only have one after reprocessing.
+func processingLogPath(rec *models.RecordingInfo) string {
+	path := rec.Processing.LogFile
+	if path == "" && rec.Files.FolderPath != "" {
+		path = filepath.Join(rec.Files.FolderPath, proclog.FileName)
+	}
+	if path == "" {
+		return ""
+	}
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
+
+// openProcessingLog opens the recording's processing log in the default
+// text viewer
+func (h *HistoryModel) openProcessingLog(rec *models.RecordingInfo) tea.Cmd {
+	path := processingLogPath(rec)
+	if path == "" {
+		h.youtubeActionError = "No processing log yet: reprocess the recording (r) to create one"
+		return nil
+	}
+	h.youtubeActionError = ""
+	return func() tea.Msg {
+		var cmd *exec.Cmd
+		switch runtime.GOOS {
+		case "darwin":
+			cmd = exec.Command("open", path)
+		case "windows":
+			cmd = exec.Command("cmd", "/c", "start", "", path)
+		default:
+			cmd = exec.Command("xdg-open", path)
+		}
+		_ = cmd.Start() // Don't wait for it to finish
+		return videoOpenedMsg{}
+	}
+}
+
+// copyNoticeDuration is how long the copy confirmation replaces the help line
+const copyNoticeDuration = 3 * time.Second
+
+// pathCopiedMsg reports the result of copying a recording path
+type pathCopiedMsg struct {
+	label string
+	path  string
+	err   error
+}
+
+// copyNoticeExpiredMsg clears the copy confirmation unless a newer one replaced it
+type copyNoticeExpiredMsg struct {
+	id int
+}
+
+// copyRecordingPath copies the recording's folder path to the clipboard, or
+// with merged the merged video path (falling back to the folder if the
+// recording has no merged video)
+func copyRecordingPath(rec *models.RecordingInfo, merged bool) tea.Cmd {
+	label, path := "folder path", rec.Files.FolderPath
+	if merged && rec.Files.MergedFile != "" {
+		label, path = "video path", rec.Files.MergedFile
+	}
+	if path == "" {
+		return nil
+	}
+	return func() tea.Msg {
+		return pathCopiedMsg{label: label, path: path, err: clipboard.Copy(path)}
+	}
+}
+
+// copyYouTubeURL copies the URL of the recording's YouTube video to the
+// clipboard, if it has been uploaded
+func copyYouTubeURL(rec *models.RecordingInfo) tea.Cmd {
+	if !rec.Metadata.IsPublishedToYouTube() || rec.Metadata.PrimaryYouTube().VideoURL == "" {
+		return nil
+	}
+	url := rec.Metadata.PrimaryYouTube().VideoURL
+	return func() tea.Msg {
+		return pathCopiedMsg{label: "YouTube URL", path: url, err: clipboard.Copy(url)}
+	}
+}
+
+// renderHelpOrNotice renders the help line, replaced by the copy
+// confirmation while one is showing
+func (h *HistoryModel) renderHelpOrNotice(helpStyle lipgloss.Style, helpText string) string {
+	if h.copyNotice == "" {
+		return helpStyle.Render(helpText)
+	}
+	color := ColorGreen
+	if h.copyNoticeError {
+		color = ColorRed
+	}
+	return lipgloss.NewStyle().Foreground(color).Bold(true).Render(truncateStr(h.copyNotice, h.width-4))
+}
+
 // videoOpenedMsg indicates video player was launched
 type videoOpenedMsg struct{}
 
@@ -835,6 +2012,9 @@ func (h *HistoryModel) initEditForm() {
 		Logos:      logos,
 		OnConfirm:  nil, // Will be handled by ctrl+s
 		OnCancel:   nil, // Will be handled by esc
+
+		SourceResolution: rec.Environment.MonitorResolution,
+		SourceDuration:   rec.SourceDuration(),
 	})
 
 	// Populate with existing values
@@ -848,6 +2028,7 @@ func (h *HistoryModel) initEditForm() {
 	h.editForm.State.RecordWebcam = rec.Settings.WebcamEnabled
 	h.editForm.State.RecordScreen = rec.Settings.ScreenEnabled
 	h.editForm.State.VerticalVideo = rec.Settings.VerticalEnabled
+	h.editForm.State.WebcamOnly = rec.Settings.WebcamOnlyEnabled
 	h.editForm.State.AddLogos = rec.Settings.LogosEnabled
 
 	// Set logo indices from existing settings
@@ -873,6 +2054,23 @@ func (h *HistoryModel) initEditForm() {
 		}
 	}
 
+	// Set output resolution index (empty keeps the configured default)
+	if rec.Settings.OutputResolution != "" {
+		h.editForm.State.SelectedResolutionIdx = config.OutputResolutionIndex(config.OutputResolution(rec.Settings.OutputResolution))
+	}
+	h.editForm.State.SelectedFrameRateIdx = config.FrameRateIndex(rec.Settings.FrameRate)
+
+	// Set landscape webcam overlay placement (empty keeps the configured default)
+	if rec.Settings.PiPCorner != "" {
+		h.editForm.State.SelectedPiPCornerIdx = config.PiPCornerIndex(config.PiPCorner(rec.Settings.PiPCorner))
+	}
+	if rec.Settings.PiPSize != "" {
+		h.editForm.State.SelectedPiPSizeIdx = config.PiPSizeIndex(config.PiPSize(rec.Settings.PiPSize))
+	}
+	h.editForm.SetVerticalWebcam(rec.Settings.VerticalWebcamPosition, rec.Settings.VerticalWebcamSize)
+	h.editForm.SetFilterPresets(rec.Settings.FilterPresets)
+	h.editForm.SetTrim(rec.Settings.Trim())
+
 	// Set form size (account for header ~6 lines and footer ~2 lines)
 	contentHeight := h.height - 8
 	if contentHeight < 10 {
@@ -880,7 +2078,7 @@ func (h *HistoryModel) initEditForm() {
 	}
 	h.editForm.SetSize(h.width, contentHeight)
 
-	// Focus the title field
+	// Focus the configured initial field
 	h.editForm.Focus()
 }
 
@@ -916,6 +2114,19 @@ func (h *HistoryModel) saveRecording() tea.Cmd {
 		return nil
 	}
 
+	// The output cannot be larger than the screen it was recorded from
+	if err := h.editForm.resolutionError(); err != "" {
+		h.editForm.State.ErrorMsg = err
+		h.editForm.State.SuccessMsg = ""
+		return nil
+	}
+	trimStart, trimEnd, err := h.editForm.GetTrim()
+	if err != nil {
+		h.editForm.State.ErrorMsg = err.Error()
+		h.editForm.State.SuccessMsg = ""
+		return nil
+	}
+
 	h.isSaving = true
 	h.editForm.State.IsSaving = true
 	h.editForm.State.ErrorMsg = ""
@@ -935,6 +2146,7 @@ func (h *HistoryModel) saveRecording() tea.Cmd {
 	h.selectedRecording.Settings.WebcamEnabled = h.editForm.State.RecordWebcam
 	h.selectedRecording.Settings.ScreenEnabled = h.editForm.State.RecordScreen
 	h.selectedRecording.Settings.VerticalEnabled = h.editForm.State.VerticalVideo
+	h.selectedRecording.Settings.WebcamOnlyEnabled = h.editForm.WebcamOnlyEnabled()
 	h.selectedRecording.Settings.LogosEnabled = h.editForm.State.AddLogos
 	h.selectedRecording.Settings.LeftLogo = h.resolveLogoPath(h.editForm.State.SelectedLeftIdx)
 	h.selectedRecording.Settings.RightLogo = h.resolveLogoPath(h.editForm.State.SelectedRightIdx)
@@ -945,6 +2157,21 @@ func (h *HistoryModel) saveRecording() tea.Cmd {
 	if h.editForm.State.SelectedGifLoopIdx >= 0 && h.editForm.State.SelectedGifLoopIdx < len(config.GifLoopModes) {
 		h.selectedRecording.Settings.GifLoopMode = string(config.GifLoopModes[h.editForm.State.SelectedGifLoopIdx])
 	}
+	if h.editForm.State.SelectedResolutionIdx >= 0 && h.editForm.State.SelectedResolutionIdx < len(config.OutputResolutions) {
+		h.selectedRecording.Settings.OutputResolution = string(config.OutputResolutions[h.editForm.State.SelectedResolutionIdx])
+	}
+	if h.editForm.State.SelectedPiPCornerIdx >= 0 && h.editForm.State.SelectedPiPCornerIdx < len(config.PiPCorners) {
+		h.selectedRecording.Settings.PiPCorner = string(config.PiPCorners[h.editForm.State.SelectedPiPCornerIdx])
+	}
+	if h.editForm.State.SelectedPiPSizeIdx >= 0 && h.editForm.State.SelectedPiPSizeIdx < len(config.PiPSizes) {
+		h.selectedRecording.Settings.PiPSize = string(config.PiPSizes[h.editForm.State.SelectedPiPSizeIdx])
+	}
+	h.selectedRecording.Settings.VerticalWebcamPosition = string(h.editForm.GetVerticalWebcamPosition())
+	h.selectedRecording.Settings.VerticalWebcamSize = string(h.editForm.GetVerticalWebcamSize())
+	h.selectedRecording.Settings.FrameRate = h.editForm.GetFrameRate()
+	h.selectedRecording.Settings.FilterPresets = h.editForm.GetFilterPresets()
+	h.selectedRecording.Settings.TrimStart = trimStart.Seconds()
+	h.selectedRecording.Settings.TrimEnd = trimEnd.Seconds()
 
 	rec := h.selectedRecording
 	return func() tea.Msg {
@@ -970,14 +2197,46 @@ func (h *HistoryModel) View() string {
 		return h.renderEditView()
 	case HistoryDeleteConfirmMode:
 		return h.renderDeleteConfirmView()
+	case HistoryBulkDeleteConfirmMode:
+		return h.bulkOp.View()
+	case HistoryTrashMode:
+		return h.renderTrashView()
 	case HistoryYouTubePrivacyMode:
 		return h.renderYouTubePrivacyView()
 	case HistoryYouTubeDeleteConfirmMode:
 		return h.renderYouTubeDeleteConfirmView()
+	case HistoryYouTubeLocalizationsMode:
+		return h.renderYouTubeLocalizationsView()
+	case HistoryYouTubeMetadataMode:
+		return h.renderYouTubeMetadataView()
+	case HistoryBatchUploadMode:
+		return h.renderBatchUploadView()
+	case HistorySavedFiltersMode:
+		return h.savedFilters.View()
+	case HistoryRecentMode:
+		return h.renderRecentView()
+	case HistoryDuplicatesMode:
+		return h.renderDuplicatesView()
+	case HistoryCleanupMode:
+		return h.renderCleanupView()
+	case HistoryStorageBudgetMode:
+		return h.renderStorageBudgetView()
+	case HistoryVerifyUploadsMode:
+		return h.renderVerifyUploadsView()
+	case HistoryYouTubeSyncMode:
+		return h.renderYouTubeSyncView()
 	case HistoryReprocessConfirmMode:
 		return h.renderReprocessConfirmView()
 	case HistoryErrorDetailMode:
 		return h.renderErrorDetailView()
+	case HistoryRenameMode:
+		return h.renderRenameView()
+	case HistoryBatchEditMode:
+		return h.renderBatchEditView()
+	case HistoryDescriptionReplaceMode:
+		return h.renderDescriptionReplaceView()
+	case HistoryAttentionMode:
+		return h.renderAttentionView()
 	default:
 		return h.renderListView()
 	}
@@ -992,7 +2251,7 @@ func (h *HistoryModel) renderListView() string {
 			Foreground(ColorGray).
 			Align(lipgloss.Center)
 
-		mainContent := loadingStyle.Render("Loading recordings...")
+		mainContent := h.spinner.View() + " " + loadingStyle.Render("Loading recordings...")
 
 		mainSection := lipgloss.JoinVertical(
 			lipgloss.Center,
@@ -1000,6 +2259,9 @@ func (h *HistoryModel) renderListView() string {
 			"",
 			mainContent,
 		)
+		if progress := h.renderLoadProgress(); progress != "" {
+			mainSection = lipgloss.JoinVertical(lipgloss.Center, mainSection, "", progress)
+		}
 
 		centeredMain := lipgloss.Place(
 			h.width,
@@ -1087,6 +2349,20 @@ func (h *HistoryModel) renderListView() string {
 
 	// Position info
 	positionInfo := fmt.Sprintf("Recording %d of %d", h.cursor+1, len(h.recordings))
+	if h.filteredRecordings != nil {
+		positionInfo = fmt.Sprintf("Recording %d of %d (filtered from %d)", h.cursor+1, h.visibleCount(), len(h.recordings))
+		if h.visibleCount() == 0 {
+			positionInfo = fmt.Sprintf("No recordings match (%d in total)", len(h.recordings))
+		}
+	}
+	if h.filteredRecordings != nil && h.fuzzySearch {
+		positionInfo += " · fuzzy, best first"
+	} else {
+		positionInfo += " · " + h.sortLabel()
+	}
+	if len(h.selected) > 0 {
+		positionInfo += fmt.Sprintf(" · %d selected", len(h.selected))
+	}
 	posStyle := lipgloss.NewStyle().
 		Foreground(ColorGray).
 		Align(lipgloss.Center)
@@ -1100,14 +2376,37 @@ func (h *HistoryModel) renderListView() string {
 
 	tableWithScroll := lipgloss.JoinHorizontal(lipgloss.Top, table, " ", scrollBar)
 
-	infoLine := posStyle.Render(positionInfo)
+	infoLine := posStyle.Render(positionInfo + " · ")
+	infoLine += renderStorageUsage(h.totalSize, len(h.recordings), h.budgetPlan.Budget)
+
+	// Filter input, shown while typing or while a filter is active
+	searchLine := ""
+	if h.searching || h.filteredRecordings != nil {
+		searchLine = h.searchInput.View()
+		if f := h.activeFilter; f != nil {
+			// The text of the saved filter is in the input, show the rest
+			name := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true).Render("★ " + f.Name)
+			if f.Status != "" || f.Topic != "" || f.Period != "" {
+				rest := *f
+				rest.Text = ""
+				name += lipgloss.NewStyle().Foreground(ColorGray).Render(" (" + describeSavedFilter(rest) + ")")
+			}
+			searchLine = name + "  " + searchLine
+		}
+	}
+
+	// The over-budget prompt takes the blank line above the table
+	budgetLine := ""
+	if showStorageBudgetPrompt(h.budgetPlan) {
+		budgetLine = renderStorageBudgetPrompt(h.budgetPlan)
+	}
 
 	mainSection := lipgloss.JoinVertical(
 		lipgloss.Center,
 		header,
-		"",
+		searchLine,
 		infoLine,
-		"",
+		budgetLine,
 		tableWithScroll,
 	)
 
@@ -1123,15 +2422,35 @@ func (h *HistoryModel) renderListView() string {
 		Width(h.width).
 		Align(lipgloss.Center)
 
-	helpText := "↑/↓: navigate • enter: view details • d: delete • r: refresh • esc/q: back"
+	helpText := "↑/↓: navigate • enter: view details • /: filter • f: saved filters • R: recent • c: copy path • d: delete • space: select • s/S: sort • ctrl+d: duplicates • X: clean up • T: trash • U: upload all pending • V: verify uploads • Y: sync from YouTube • r: refresh • esc/q: back"
+	if len(h.selected) > 0 && !h.searching {
+		helpText = "↑/↓: navigate • space: select • e: edit • F: replace • u: upload • d: delete • esc: clear selection"
+	} else if h.searching {
+		helpText = "type to filter • ↑/↓: navigate • ctrl+f: fuzzy/exact • enter: keep filter • esc: clear filter"
+	} else if h.filteredRecordings != nil {
+		helpText = "↑/↓: navigate • enter: view details • /: edit filter • f: saved filters • c: copy path • d: delete • esc: clear filter"
+	}
 
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		centeredMain,
-		helpFooter.Render(helpStyle.Render(helpText)),
+		helpFooter.Render(h.renderHelpOrNotice(helpStyle, helpText)),
 	)
 }
 
+// normalizationLabel describes the loudness normalization of a processed
+// recording. Recordings processed before the target was stored only say
+// whether it was applied.
+func normalizationLabel(p models.ProcessingInfo) string {
+	switch {
+	case !p.NormalizeApplied:
+		return "Not normalized"
+	case p.NormalizedLUFS != 0:
+		return fmt.Sprintf("Normalized to %g LUFS", p.NormalizedLUFS)
+	}
+	return "Normalized"
+}
+
 // renderDetailView renders the detail view for a selected recording
 func (h *HistoryModel) renderDetailView() string {
 	if h.selectedRecording == nil {
@@ -1214,8 +2533,20 @@ func (h *HistoryModel) renderDetailView() string {
 		valueStyle.Render(rec.StartTime.Format("Monday, January 2, 2006")),
 	))
 
-	// Duration
+	// Duration, once trimmed
 	durationStr := models.FormatDuration(rec.Duration)
+	if rec.Settings.Trimmed() {
+		start, end := rec.Settings.Trim()
+		from, to := "0:00", "end"
+		if start > 0 {
+			from = models.FormatTrimTime(start)
+		}
+		if end > 0 {
+			to = models.FormatTrimTime(end)
+		}
+		durationStr = fmt.Sprintf("%s (trimmed %s–%s of %s)", models.FormatDuration(rec.TrimmedDuration()),
+			from, to, models.FormatDuration(rec.SourceDuration()))
+	}
 	rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
 		labelStyle.Render("Duration:"),
 		"  ",
@@ -1251,6 +2582,20 @@ func (h *HistoryModel) renderDetailView() string {
 			fileStyle.Render(filepath.Base(rec.Files.VerticalFile)+" ("+models.FormatFileSize(rec.Files.VerticalSize)+")"),
 		))
 	}
+	if rec.Files.WebcamOnlyFile != "" {
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+			labelStyle.Render("Webcam only:"),
+			"  ",
+			fileStyle.Render(filepath.Base(rec.Files.WebcamOnlyFile)+" ("+models.FormatFileSize(rec.Files.WebcamOnlySize)+")"),
+		))
+	}
+	if rec.Files.AudioFile != "" && rec.Status == models.StatusCompleted {
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+			labelStyle.Render("Audio:"),
+			"  ",
+			fileStyle.Render(normalizationLabel(rec.Processing)),
+		))
+	}
 
 	// Divider
 	rows = append(rows, "")
@@ -1343,7 +2688,7 @@ func (h *HistoryModel) renderDetailView() string {
 		rows = append(rows, ytStatusRow)
 		rows = append(rows, "")
 
-		yt := rec.Metadata.YouTube
+		yt := rec.Metadata.PrimaryYouTube()
 
 		// Video URL
 		linkStyle := lipgloss.NewStyle().
@@ -1355,14 +2700,49 @@ func (h *HistoryModel) renderDetailView() string {
 			linkStyle.Render(yt.VideoURL),
 		))
 
-		// Privacy
+		// Flagged by upload verification
+		if yt.MissingSince != "" {
+			missingTime, _ := time.Parse(time.RFC3339, yt.MissingSince)
+			rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+				ytLabelStyle.Render("Missing:"),
+				"  ",
+				lipgloss.NewStyle().Foreground(ColorRed).Bold(true).Render("💔 gone from YouTube since "+missingTime.Format("Jan 2, 2006")),
+			))
+		}
+
+		// Privacy, or when a private video is published
 		privacyStyle := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true)
 		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
 			ytLabelStyle.Render("Privacy:"),
 			"  ",
-			privacyStyle.Render(yt.Privacy),
+			privacyStyle.Render(yt.PrivacyLabel(time.Now())),
+		))
+
+		// License, highlighted when Creative Commons
+		licenseStyle := valueStyle
+		if youtube.License(yt.License) == youtube.LicenseCreativeCommons {
+			licenseStyle = lipgloss.NewStyle().Foreground(ColorGreen).Bold(true)
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+			ytLabelStyle.Render("License:"),
+			"  ",
+			licenseStyle.Render(youtube.License(yt.License).Label()),
 		))
 
+		// Translations
+		if len(yt.Localizations) > 0 {
+			langs := make([]string, 0, len(yt.Localizations))
+			for lang := range yt.Localizations {
+				langs = append(langs, lang)
+			}
+			sort.Strings(langs)
+			rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+				ytLabelStyle.Render("Translations:"),
+				"  ",
+				valueStyle.Render(strings.Join(langs, ", ")),
+			))
+		}
+
 		// Playlist
 		if yt.PlaylistName != "" {
 			rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
@@ -1381,6 +2761,29 @@ func (h *HistoryModel) renderDetailView() string {
 				valueStyle.Render(uploadTime.Format("Jan 2, 2006 15:04")),
 			))
 		}
+
+		// All destinations when uploaded to several channels
+		if uploads := rec.Metadata.AllYouTubeUploads(); len(uploads) > 1 {
+			rows = append(rows, "")
+			rows = append(rows, ytLabelStyle.Render("Destinations:"))
+			for _, u := range uploads {
+				channel := u.ChannelName
+				if channel == "" {
+					channel = "Unknown channel"
+				}
+				rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+					ytLabelStyle.Render(""),
+					"  ",
+					valueStyle.Render(channel+" "),
+					privacyStyle.Render("("+u.PrivacyLabel(time.Now())+")"),
+				))
+				rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+					ytLabelStyle.Render(""),
+					"  ",
+					linkStyle.Render(u.VideoURL),
+				))
+			}
+		}
 	} else {
 		// Not on YouTube
 		ytStatusStyle := lipgloss.NewStyle().
@@ -1391,6 +2794,32 @@ func (h *HistoryModel) renderDetailView() string {
 		rows = append(rows, ytStatusStyle.Render("Not published to YouTube"))
 	}
 
+	// Deleted-from-YouTube history
+	if len(rec.Metadata.YouTubeDeletions) > 0 {
+		rows = append(rows, "")
+		rows = append(rows, ytLabelStyle.Render("Deleted:"))
+		deletedStyle := lipgloss.NewStyle().Foreground(ColorGray)
+		for i := len(rec.Metadata.YouTubeDeletions) - 1; i >= 0; i-- {
+			d := rec.Metadata.YouTubeDeletions[i]
+			deletedAt := d.DeletedAt
+			if t, err := time.Parse(time.RFC3339, d.DeletedAt); err == nil {
+				deletedAt = t.Format("Jan 2, 2006 15:04")
+			}
+			rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+				ytLabelStyle.Render(""),
+				"  ",
+				deletedStyle.Render(fmt.Sprintf("%s • %s (%s)", deletedAt, d.VideoURL, d.Privacy)),
+			))
+			if d.Title != "" && d.Title != rec.Metadata.Title {
+				rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+					ytLabelStyle.Render(""),
+					"  ",
+					deletedStyle.Italic(true).Render("\""+truncateStr(d.Title, 40)+"\""),
+				))
+			}
+		}
+	}
+
 	// Success/Error messages
 	editSuccess := ""
 	if h.editForm != nil {
@@ -1429,7 +2858,7 @@ func (h *HistoryModel) renderDetailView() string {
 
 	var helpText string
 	if rec.Status == models.StatusFailed {
-		helpText = "o: open folder • e: edit • r: reprocess • v: view error details • esc: back"
+		helpText = "o: open folder • c: copy path • e: edit • n: rename • N: record again • r: reprocess • v: view error details • l: log • esc: back"
 	} else if rec.Status == models.StatusCompleted {
 		// Build video playback options based on available files
 		var videoOptions string
@@ -1442,14 +2871,17 @@ func (h *HistoryModel) renderDetailView() string {
 		} else if hasMerged {
 			videoOptions = "v: play • m: merged"
 		}
+		if rec.Files.WebcamOnlyFile != "" {
+			videoOptions += " • w: webcam only"
+		}
 
 		if rec.Metadata.IsPublishedToYouTube() {
-			helpText = videoOptions + " • a: audio • o: folder • e: edit • r: reprocess • p: privacy • x: del YT • esc"
+			helpText = videoOptions + " • a: audio • o: folder • c/C: copy path • y: copy URL • l: log • e: edit • n: rename • N: record again • r: reprocess • E: edit on YT • p: privacy • t: translations • U: upload again • x: del YT • esc"
 		} else {
-			helpText = videoOptions + " • a: audio • o: folder • e: edit • r: reprocess • u: upload • esc"
+			helpText = videoOptions + " • a: audio • o: folder • c/C: copy path • l: log • e: edit • n: rename • N: record again • r: reprocess • u: upload • esc"
 		}
 	} else {
-		helpText = "o: open folder • e: edit • r: reprocess • esc: back"
+		helpText = "o: open folder • c: copy path • e: edit • n: rename • N: record again • r: reprocess • l: log • esc: back"
 	}
 
 	mainSection := lipgloss.JoinVertical(
@@ -1474,7 +2906,7 @@ func (h *HistoryModel) renderDetailView() string {
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		centeredMain,
-		helpFooter.Render(helpStyle.Render(helpText)),
+		helpFooter.Render(h.renderHelpOrNotice(helpStyle, helpText)),
 	)
 }
 
@@ -1514,7 +2946,7 @@ func (h *HistoryModel) renderDeleteConfirmView() string {
 	// Warning message
 	rows = append(rows, warningStyle.Width(62).Render("⚠ DELETE RECORDING ⚠"))
 	rows = append(rows, "")
-	rows = append(rows, warningStyle.Width(62).Render("This action cannot be undone!"))
+	rows = append(rows, warningStyle.Width(62).Render("The recording is moved to the trash (T in the history)"))
 	rows = append(rows, "")
 
 	// Recording details
@@ -1636,12 +3068,12 @@ func (h *HistoryModel) renderEditView() string {
 
 // renderScrollBar renders a visual scroll indicator
 func (h *HistoryModel) renderScrollBar() string {
-	if len(h.recordings) == 0 {
+	if h.visibleCount() == 0 {
 		return ""
 	}
 
 	visibleCount := h.getVisibleCount()
-	totalEntries := len(h.recordings)
+	totalEntries := h.visibleCount()
 
 	barHeight := h.height - 16
 	if barHeight < 5 {
@@ -1689,7 +3121,7 @@ func (h *HistoryModel) renderScrollBar() string {
 // renderScrollableTable renders the visible portion of the recordings table
 func (h *HistoryModel) renderScrollableTable() string {
 	visibleCount := h.getVisibleCount()
-	totalEntries := len(h.recordings)
+	totalEntries := h.visibleCount()
 
 	startIdx := h.cursor - visibleCount/2
 	if startIdx < 0 {
@@ -1704,7 +3136,10 @@ func (h *HistoryModel) renderScrollableTable() string {
 		}
 	}
 
-	visibleRecordings := h.recordings[startIdx:endIdx]
+	visibleRecordings := make([]models.RecordingInfo, 0, endIdx-startIdx)
+	for pos := startIdx; pos < endIdx; pos++ {
+		visibleRecordings = append(visibleRecordings, *h.visibleRecording(pos))
+	}
 
 	// Column headers
 	headerStyle := lipgloss.NewStyle().
@@ -1756,8 +3191,11 @@ func (h *HistoryModel) renderScrollableTable() string {
 			statusIcon = statusIcon + "🎬"
 		}
 
-		// Add YouTube indicator if video has been uploaded
-		if rec.Metadata.YouTube != nil && rec.Metadata.YouTube.VideoID != "" {
+		// Add YouTube indicator if video has been uploaded, or a broken
+		// link if verification found it gone
+		if rec.Metadata.HasMissingYouTubeVideo() {
+			statusIcon = statusIcon + "💔"
+		} else if rec.Metadata.IsPublishedToYouTube() {
 			statusIcon = statusIcon + "📺"
 		}
 
@@ -1790,11 +3228,19 @@ func (h *HistoryModel) renderScrollableTable() string {
 			)
 		}
 
+		// Checkboxes are shown once something is marked for bulk delete
+		mark := "  "
+		if h.selected[rec.Files.FolderPath] {
+			mark = "☑ "
+		} else if len(h.selected) > 0 {
+			mark = "☐ "
+		}
+
 		var row2 string
 		if isSelected {
-			row2 = selectedDescStyle.Render("  📁 " + folder)
+			row2 = selectedDescStyle.Render(mark + "📁 " + folder)
 		} else {
-			row2 = descStyle.Render("  📁 " + folder)
+			row2 = descStyle.Render(mark + "📁 " + folder)
 		}
 
 		rows = append(rows, row1, row2)
@@ -1840,42 +3286,19 @@ func (h *HistoryModel) renderScrollableTable() string {
 
 // loadRecordings loads all recordings from the screencasts folder
 func (h *HistoryModel) loadRecordings() tea.Cmd {
+	progress := &loadProgress{}
+	h.loadCounter = progress
+	h.loadStarted = time.Now()
+	h.loadElapsed, h.loadScanned, h.loadTotal = 0, 0, 0
 	return func() tea.Msg {
-		videosDir := config.GetDefaultVideosDir()
-
-		// Check if directory exists
-		if _, err := os.Stat(videosDir); os.IsNotExist(err) {
-			return recordingsLoadedMsg{recordings: nil, err: nil}
-		}
-
-		entries, err := os.ReadDir(videosDir)
-		if err != nil {
-			return recordingsLoadedMsg{recordings: nil, err: err}
-		}
-
-		var recordings []models.RecordingInfo
-
-		for _, entry := range entries {
-			if !entry.IsDir() {
-				continue
-			}
-
-			folderPath := filepath.Join(videosDir, entry.Name())
-			info, err := models.LoadRecordingInfo(folderPath)
-			if err != nil {
-				// Skip folders without valid recording.json
-				continue
-			}
-
-			recordings = append(recordings, *info)
-		}
-
-		// Sort by date, newest first
-		sort.Slice(recordings, func(i, j int) bool {
-			return recordings[i].StartTime.After(recordings[j].StartTime)
+		// Folders without a valid recording.json are skipped, and a missing
+		// videos directory is an empty history. Sorted by the current sort
+		// key when the message arrives.
+		recordings, err := retention.LoadRecordingsProgress(config.GetDefaultVideosDir(), func(scanned, total int) {
+			progress.scanned.Store(int64(scanned))
+			progress.total.Store(int64(total))
 		})
-
-		return recordingsLoadedMsg{recordings: recordings, err: nil}
+		return recordingsLoadedMsg{recordings: recordings, err: err}
 	}
 }
 
@@ -1909,7 +3332,7 @@ func getStatusDisplay(status string) (string, lipgloss.Color) {
 
 // renderYouTubePrivacyView renders the YouTube privacy change view
 func (h *HistoryModel) renderYouTubePrivacyView() string {
-	if h.selectedRecording == nil || h.selectedRecording.Metadata.YouTube == nil {
+	if h.selectedRecording == nil || h.selectedRecording.Metadata.PrimaryYouTube() == nil {
 		return "No recording selected"
 	}
 
@@ -1947,7 +3370,7 @@ func (h *HistoryModel) renderYouTubePrivacyView() string {
 	// Current privacy
 	rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
 		labelStyle.Render("Current Privacy: "),
-		valueStyle.Render(rec.Metadata.YouTube.Privacy),
+		valueStyle.Render(rec.Metadata.PrimaryYouTube().PrivacyLabel(time.Now())),
 	))
 	rows = append(rows, "")
 
@@ -1997,6 +3420,9 @@ func (h *HistoryModel) renderYouTubePrivacyView() string {
 			Width(52)
 		rows = append(rows, "")
 		rows = append(rows, loadingStyle.Render("Updating privacy..."))
+		if retry := h.youtubeActionRetry.String(); retry != "" {
+			rows = append(rows, renderRetryNotice(retry))
+		}
 	}
 
 	content := containerStyle.Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
@@ -2032,9 +3458,29 @@ func (h *HistoryModel) renderYouTubePrivacyView() string {
 	)
 }
 
+// renderYouTubeLocalizationsView renders the translations editor with the
+// progress or error of saving them
+func (h *HistoryModel) renderYouTubeLocalizationsView() string {
+	if h.localizationEditor == nil {
+		return "No recording selected"
+	}
+
+	var status string
+	switch {
+	case h.youtubeActionLoading:
+		status = lipgloss.NewStyle().Foreground(ColorOrange).Bold(true).Render("Saving translations to YouTube...")
+		if retry := h.youtubeActionRetry.String(); retry != "" {
+			status = lipgloss.JoinVertical(lipgloss.Left, status, renderRetryNotice(retry))
+		}
+	case h.youtubeActionError != "":
+		status = lipgloss.NewStyle().Foreground(ColorRed).Bold(true).Width(60).Render(h.youtubeActionError)
+	}
+	return h.localizationEditor.View(status)
+}
+
 // renderYouTubeDeleteConfirmView renders the YouTube delete confirmation view
 func (h *HistoryModel) renderYouTubeDeleteConfirmView() string {
-	if h.selectedRecording == nil || h.selectedRecording.Metadata.YouTube == nil {
+	if h.selectedRecording == nil || h.selectedRecording.Metadata.PrimaryYouTube() == nil {
 		return "No recording selected"
 	}
 
@@ -2077,7 +3523,7 @@ func (h *HistoryModel) renderYouTubeDeleteConfirmView() string {
 	linkStyle := lipgloss.NewStyle().Foreground(ColorBlue).Underline(true)
 	rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
 		labelStyle.Render("URL: "),
-		linkStyle.Render(rec.Metadata.YouTube.VideoURL),
+		linkStyle.Render(rec.Metadata.PrimaryYouTube().VideoURL),
 	))
 	rows = append(rows, "")
 
@@ -2091,6 +3537,23 @@ func (h *HistoryModel) renderYouTubeDeleteConfirmView() string {
 	rows = append(rows, warningStyle.Render("The video will be permanently deleted from YouTube."))
 	rows = append(rows, "")
 
+	typedConfirm := h.requiresTypedDeleteConfirm()
+	if typedConfirm {
+		publicStyle := lipgloss.NewStyle().
+			Foreground(ColorRed).
+			Bold(true).
+			Align(lipgloss.Center).
+			Width(52)
+		rows = append(rows, publicStyle.Render("This video is PUBLIC."))
+		rows = append(rows, publicStyle.Render("Its views, likes and comments will be lost."))
+		rows = append(rows, "")
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+			labelStyle.Render(fmt.Sprintf("Type %s to confirm: ", youtubeDeleteConfirmWord)),
+			h.youtubeDeleteInput.View(),
+		))
+		rows = append(rows, "")
+	}
+
 	// Error message
 	if h.youtubeActionError != "" {
 		errorStyle := lipgloss.NewStyle().
@@ -2110,6 +3573,9 @@ func (h *HistoryModel) renderYouTubeDeleteConfirmView() string {
 			Align(lipgloss.Center).
 			Width(52)
 		rows = append(rows, loadingStyle.Render("Deleting from YouTube..."))
+		if retry := h.youtubeActionRetry.String(); retry != "" {
+			rows = append(rows, renderRetryNotice(retry))
+		}
 		rows = append(rows, "")
 	}
 
@@ -2128,10 +3594,16 @@ func (h *HistoryModel) renderYouTubeDeleteConfirmView() string {
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(ColorGreen)
 
+	yesLabel := "Y - Yes, Delete"
+	noLabel := "N - No, Cancel"
+	if typedConfirm {
+		yesLabel = "Enter - Delete"
+		noLabel = "Esc - Cancel"
+	}
 	buttons := lipgloss.JoinHorizontal(lipgloss.Center,
-		yesStyle.Render("Y - Yes, Delete"),
+		yesStyle.Render(yesLabel),
 		"    ",
-		noStyle.Render("N - No, Cancel"),
+		noStyle.Render(noLabel),
 	)
 	buttonRow := lipgloss.NewStyle().Width(52).Align(lipgloss.Center).Render(buttons)
 	rows = append(rows, buttonRow)
@@ -2142,6 +3614,10 @@ func (h *HistoryModel) renderYouTubeDeleteConfirmView() string {
 	helpStyle := lipgloss.NewStyle().
 		Foreground(ColorGray).
 		Italic(true)
+	helpText := "y: confirm delete • n/esc: cancel"
+	if typedConfirm {
+		helpText = "type " + youtubeDeleteConfirmWord + " then enter: confirm delete • esc: cancel"
+	}
 
 	mainSection := lipgloss.JoinVertical(
 		lipgloss.Center,
@@ -2165,7 +3641,7 @@ func (h *HistoryModel) renderYouTubeDeleteConfirmView() string {
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		centeredMain,
-		helpFooter.Render(helpStyle.Render("y: confirm delete • n/esc: cancel")),
+		helpFooter.Render(helpStyle.Render(helpText)),
 	)
 }
 
@@ -2221,6 +3697,29 @@ func (h *HistoryModel) renderErrorDetailView() string {
 		contentLines = append(contentLines, "")
 	}
 
+	// Processing log
+	if logPath := processingLogPath(rec); logPath != "" {
+		sectionStyle := lipgloss.NewStyle().
+			Foreground(ColorOrange).
+			Bold(true)
+		contentLines = append(contentLines, sectionStyle.Render("PROCESSING LOG (press 'l' to open):"))
+		contentLines = append(contentLines, logPath)
+		contentLines = append(contentLines, "")
+	}
+
+	// Commands of the last run, in the order they ran
+	if len(rec.Processing.Commands) > 0 {
+		sectionStyle := lipgloss.NewStyle().
+			Foreground(ColorOrange).
+			Bold(true)
+		contentLines = append(contentLines, sectionStyle.Render("COMMANDS:"))
+		contentLines = append(contentLines, strings.Repeat("─", 60))
+		for _, command := range rec.Processing.Commands {
+			contentLines = append(contentLines, "$ "+command)
+		}
+		contentLines = append(contentLines, "")
+	}
+
 	// Traceback
 	if rec.Processing.Traceback != "" {
 		sectionStyle := lipgloss.NewStyle().
@@ -2308,7 +3807,7 @@ func (h *HistoryModel) renderErrorDetailView() string {
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		centeredMain,
-		helpFooter.Render(helpStyle.Render("↑/↓: scroll • pgup/pgdn: page • r: reprocess • esc: back")),
+		helpFooter.Render(helpStyle.Render("↑/↓: scroll • pgup/pgdn: page • r: reprocess • l: open log • esc: back")),
 	)
 }
 
@@ -2331,6 +3830,11 @@ type youtubePrivacyChangedMsg struct {
 	err        error
 }
 
+type youtubeLocalizationsSetMsg struct {
+	localizations map[string]models.Localization
+	err           error
+}
+
 type youtubeVideoDeletedMsg struct {
 	err error
 }
@@ -2344,6 +3848,11 @@ type startReprocessMsg struct {
 	recording *models.RecordingInfo
 }
 
+// newRecordingLikeMsg starts a new recording with the settings of another
+type newRecordingLikeMsg struct {
+	recording *models.RecordingInfo
+}
+
 // recordingSavedNeedsProcessingMsg signals that a recording was saved and needs processing
 type recordingSavedNeedsProcessingMsg struct {
 	recording *models.RecordingInfo
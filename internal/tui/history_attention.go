@@ -0,0 +1,149 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/attention"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// openAttention shows the recordings that need attention, computed from the
+// loaded recordings. Leaving a recording opened from it comes back to it.
+func (h *HistoryModel) openAttention() {
+	h.attention = attention.Collect(h.recordings, h.budgetPlan, attention.UnfinishedUpload)
+	h.attentionCursor = min(h.attentionCursor, max(len(h.attention.Items)-1, 0))
+	h.attentionReturn = true
+	h.mode = HistoryAttentionMode
+}
+
+// backToList leaves a recording or the storage budget view, for the needs
+// attention dashboard if they were opened from it
+func (h *HistoryModel) backToList() {
+	if h.attentionReturn {
+		h.openAttention()
+		return
+	}
+	h.mode = HistoryListMode
+}
+
+// updateAttentionMode handles input in the needs attention dashboard
+func (h *HistoryModel) updateAttentionMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return h, tea.Quit
+
+	case "esc", "q":
+		h.attentionReturn = false
+		h.mode = HistoryListMode
+
+	case "up", "k":
+		if h.attentionCursor > 0 {
+			h.attentionCursor--
+		}
+
+	case "down", "j":
+		if h.attentionCursor < len(h.attention.Items)-1 {
+			h.attentionCursor++
+		}
+
+	case "r":
+		h.showAttentionOnLoad = true
+		return h, h.reload()
+
+	case "enter":
+		if h.attentionCursor < len(h.attention.Items) {
+			return h, h.openAttentionItem(h.attention.Items[h.attentionCursor])
+		}
+	}
+	return h, nil
+}
+
+// openAttentionItem opens the view that deals with an item: the edit form
+// for missing metadata, the error of a failed processing, the storage budget
+// suggestions, else the detail view to upload or verify the recording
+func (h *HistoryModel) openAttentionItem(item attention.Item) tea.Cmd {
+	if item.Bucket == attention.OverBudget {
+		h.openStorageBudget()
+		return nil
+	}
+	idx := -1
+	for i := range h.recordings {
+		if h.recordings[i].Files.FolderPath == item.FolderPath {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return nil
+	}
+
+	// Needs metadata opens straight into the edit form
+	cmd := h.jumpToRecording(idx)
+	if item.Bucket == attention.FailedProcessing && h.mode == HistoryDetailMode {
+		h.mode = HistoryErrorDetailMode
+		h.errorViewScrollOffset = 0
+	}
+	return cmd
+}
+
+// renderAttentionView renders the needs attention dashboard
+func (h *HistoryModel) renderAttentionView() string {
+	header := RenderHeader("Needs Attention")
+
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	bucketStyle := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true)
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+	selectedStyle := lipgloss.NewStyle().
+		Background(ColorOrange).
+		Foreground(lipgloss.Color("#000000"))
+
+	var rows []string
+	if len(h.attention.Items) == 0 {
+		rows = append(rows, grayStyle.Italic(true).Render("Nothing needs attention"))
+	}
+	pos, selectedRow := 0, 0
+	for _, b := range attention.Buckets {
+		count := h.attention.Count(b)
+		if count == 0 {
+			continue
+		}
+		if len(rows) > 0 {
+			rows = append(rows, "")
+		}
+		heading := fmt.Sprintf("%s (%d)", b.Label(), count)
+		if b == attention.OverBudget {
+			budget := h.attention.Budget
+			heading += fmt.Sprintf(" · %s used of %s", models.FormatFileSize(budget.Used), models.FormatFileSize(budget.Budget))
+		}
+		rows = append(rows, bucketStyle.Render(heading))
+		for _, item := range h.attention.Items {
+			if item.Bucket != b {
+				continue
+			}
+			line := fmt.Sprintf("%-40s %s", truncateStr(item.Title, 40), truncateStr(item.Reason, 60))
+			if pos == h.attentionCursor {
+				selectedRow = len(rows)
+				rows = append(rows, selectedStyle.Render("▶ "+line))
+			} else {
+				rows = append(rows, valueStyle.Render("  "+line))
+			}
+			pos++
+		}
+	}
+
+	// Keep the selection on screen by dropping rows from the top
+	if avail := h.height - 8; avail > 0 && len(rows) > avail {
+		start := min(max(selectedRow-avail/2, 0), len(rows)-avail)
+		rows = rows[start : start+avail]
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	helpText := "↑/↓: navigate • enter: open • r: refresh • esc: back"
+	footer := lipgloss.NewStyle().
+		Width(h.width).
+		Align(lipgloss.Center).
+		Render(h.renderHelpOrNotice(grayStyle.Italic(true), helpText))
+	return LayoutWithHeaderFooter(header, content, footer, h.width, h.height)
+}
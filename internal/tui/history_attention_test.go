@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestHistoryAttention_OpensItems(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+
+	h := historyWithRecordings("Fine", "Untitled", "Broken", "Removed")
+	h.recordings[1].Status = models.StatusNeedsMetadata
+	h.recordings[2].Status = models.StatusFailed
+	h.recordings[2].Processing.Errors = []string{"failed to merge recordings: exit status 1\nOutput: ..."}
+	h.recordings[3].Metadata.AddYouTubeUpload(models.YouTubeUpload{VideoID: "abc", MissingSince: "2026-03-04T10:00:00Z"})
+
+	h.Update(bulkKey("a"))
+	if h.mode != HistoryAttentionMode {
+		t.Fatalf("mode %v, want the needs attention dashboard", h.mode)
+	}
+	view := h.View()
+	for _, want := range []string{"Needs metadata (1)", "Processing failed (1)", "Gone from YouTube (1)", "failed to merge recordings: exit status 1"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected the dashboard to show %q", want)
+		}
+	}
+	if strings.Contains(view, "Fine") {
+		t.Error("expected the completed recording not to be listed")
+	}
+
+	// Missing metadata opens the edit form
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if h.mode != HistoryEditMode || h.selectedRecording.Metadata.Title != "Untitled" {
+		t.Fatalf("mode %v, want the edit form of the untitled recording", h.mode)
+	}
+
+	// A failed processing opens its error, and leaving the recording comes back
+	h.openAttention()
+	h.Update(tea.KeyMsg{Type: tea.KeyDown})
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if h.mode != HistoryErrorDetailMode || h.selectedRecording.Metadata.Title != "Broken" {
+		t.Fatalf("mode %v, want the error of the failed recording", h.mode)
+	}
+	h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if h.mode != HistoryAttentionMode || h.attentionCursor != 1 {
+		t.Fatalf("mode %v cursor %d, want back on the dashboard", h.mode, h.attentionCursor)
+	}
+
+	h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if h.mode != HistoryListMode {
+		t.Fatalf("mode %v, want the list", h.mode)
+	}
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if h.mode != HistoryListMode {
+		t.Errorf("mode %v, want a recording opened from the list to go back to it", h.mode)
+	}
+}
+
+func TestHistoryErrorDetail_ShowsCommands(t *testing.T) {
+	h := historyWithRecordings("Broken")
+	rec := &h.recordings[0]
+	rec.Status = models.StatusFailed
+	rec.Processing.Errors = []string{"failed to merge recordings: exit status 1"}
+	rec.Processing.Commands = []string{
+		"ffmpeg -i audio.wav -af loudnorm=print_format=json -f null -",
+		"ffmpeg -y -i screen.mp4 -i audio-normalized.wav screen-merged.mp4",
+	}
+	h.selectedRecording = rec
+	h.mode = HistoryErrorDetailMode
+
+	view := h.View()
+	for _, want := range []string{"COMMANDS:", "$ ffmpeg -i audio.wav", "$ ffmpeg -y -i screen.mp4"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected the error details to show %q", want)
+		}
+	}
+}
+
+func TestHistoryAttention_OnLoad(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+
+	h := NewHistoryModel()
+	h.width, h.height = 120, 40
+	h.showAttentionOnLoad = true
+	h.Update(recordingsLoadedMsg{recordings: []models.RecordingInfo{{Status: models.StatusCompleted}}})
+	if h.mode != HistoryAttentionMode {
+		t.Fatalf("mode %v, want the needs attention dashboard", h.mode)
+	}
+	if !strings.Contains(h.View(), "Nothing needs attention") {
+		t.Error("expected the dashboard to say nothing needs attention")
+	}
+}
@@ -0,0 +1,274 @@
+package tui
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/batchedit"
+)
+
+// batchEditField is a field of the batch metadata edit form
+type batchEditField int
+
+const (
+	batchEditPresenter batchEditField = iota
+	batchEditTopic
+	batchEditAddTag
+	batchEditRemoveTag
+	batchEditPrivacy
+	batchEditFieldCount
+)
+
+// batchEditForm is the change applied to all selected recordings. Fields
+// left empty or unchanged keep each recording's own value.
+type batchEditForm struct {
+	focus      batchEditField
+	presenter  textinput.Model
+	addTag     textinput.Model
+	removeTag  textinput.Model
+	topicIdx   int // 0 = unchanged, else index+1 into the history topics
+	privacyIdx int // 0 = unchanged, else index+1 into batchedit.Privacies
+	err        string
+}
+
+func newBatchEditInput(placeholder string) textinput.Model {
+	input := textinput.New()
+	input.Placeholder = placeholder
+	input.CharLimit = 100
+	input.Width = 40
+	return input
+}
+
+// startBatchEdit opens the batch metadata edit for the selected recordings
+func (h *HistoryModel) startBatchEdit() tea.Cmd {
+	if len(h.selectedRecordings()) == 0 {
+		return nil
+	}
+	h.batchEdit = &batchEditForm{
+		presenter: newBatchEditInput("unchanged"),
+		addTag:    newBatchEditInput("none"),
+		removeTag: newBatchEditInput("none"),
+	}
+	h.batchEdit.presenter.Focus()
+	h.bulkOp = nil
+	h.mode = HistoryBatchEditMode
+	return textinput.Blink
+}
+
+// edit returns the change the form describes
+func (f *batchEditForm) edit(topics []string) batchedit.Edit {
+	e := batchedit.Edit{
+		Presenter: f.presenter.Value(),
+		AddTag:    f.addTag.Value(),
+		RemoveTag: f.removeTag.Value(),
+	}
+	if f.topicIdx > 0 && f.topicIdx <= len(topics) {
+		e.Topic = topics[f.topicIdx-1]
+	}
+	if f.privacyIdx > 0 && f.privacyIdx <= len(batchedit.Privacies) {
+		e.Privacy = batchedit.Privacies[f.privacyIdx-1]
+	}
+	return e
+}
+
+// input returns the text input of the focused field, or nil for a selector
+func (f *batchEditForm) input() *textinput.Model {
+	switch f.focus {
+	case batchEditPresenter:
+		return &f.presenter
+	case batchEditAddTag:
+		return &f.addTag
+	case batchEditRemoveTag:
+		return &f.removeTag
+	}
+	return nil
+}
+
+// setFocus moves the focus to field, focusing its text input
+func (f *batchEditForm) setFocus(field batchEditField) {
+	if input := f.input(); input != nil {
+		input.Blur()
+	}
+	f.focus = (field + batchEditFieldCount) % batchEditFieldCount
+	if input := f.input(); input != nil {
+		input.Focus()
+	}
+}
+
+// topicNames returns the names of the topics a recording can be moved to
+func (h *HistoryModel) topicNames() []string {
+	names := make([]string, len(h.topics))
+	for i, t := range h.topics {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// applyBatchEdit asks to confirm the edit, then saves it to each selected
+// recording with the bulk operation view
+func (h *HistoryModel) applyBatchEdit() {
+	edit := h.batchEdit.edit(h.topicNames())
+	if err := edit.Validate(); err != nil {
+		h.batchEdit.err = err.Error()
+		return
+	}
+	h.batchEdit.err = ""
+
+	var targets []BulkTarget
+	for _, rec := range h.selectedRecordings() {
+		label := rec.Metadata.Title
+		if label == "" {
+			label = filepath.Base(rec.Files.FolderPath)
+		}
+		targets = append(targets, BulkTarget{Label: label, Data: rec.Files.FolderPath})
+	}
+	h.bulkOp = NewBulkOperationModel("Edit Recordings", "Edit", false, targets, func(t BulkTarget) error {
+		return batchedit.ApplyTo(t.Data.(string), edit)
+	})
+	h.bulkOp.SetDetails(edit.Changes())
+	h.bulkOp.SetSize(h.width, h.height)
+}
+
+// closeBatchEdit updates the recordings the edit was saved to and returns
+// to the list, or to the form if the edit was not confirmed. Recordings that
+// could not be saved stay marked so the edit can be tried again.
+func (h *HistoryModel) closeBatchEdit(msg bulkOperationClosedMsg) {
+	op := h.bulkOp
+	h.bulkOp = nil
+	if !msg.ran {
+		return
+	}
+
+	edit := h.batchEdit.edit(h.topicNames())
+	edited := map[string]bool{}
+	for i, status := range op.Statuses() {
+		if status == BulkItemDone {
+			folder := op.targets[i].Data.(string)
+			edited[folder] = true
+			delete(h.selected, folder)
+		}
+	}
+	for i := range h.recordings {
+		if edited[h.recordings[i].Files.FolderPath] {
+			edit.Apply(&h.recordings[i].Metadata)
+		}
+	}
+	h.rebuildSearchIndex()
+	h.batchEdit = nil
+	h.mode = HistoryListMode
+}
+
+// updateBatchEditMode handles input in the batch edit form and, once
+// applied, passes it to the bulk operation
+func (h *HistoryModel) updateBatchEditMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return h, tea.Quit
+	}
+	if h.bulkOp != nil {
+		var cmd tea.Cmd
+		h.bulkOp, cmd = h.bulkOp.Update(msg)
+		return h, cmd
+	}
+
+	f := h.batchEdit
+	switch msg.String() {
+	case "esc":
+		h.batchEdit = nil
+		h.mode = HistoryListMode
+		return h, nil
+
+	case "enter":
+		h.applyBatchEdit()
+		return h, nil
+
+	case "tab", "down":
+		f.setFocus(f.focus + 1)
+		return h, nil
+
+	case "shift+tab", "up":
+		f.setFocus(f.focus - 1)
+		return h, nil
+
+	case "left", "right":
+		dir := 1
+		if msg.String() == "left" {
+			dir = -1
+		}
+		switch f.focus {
+		case batchEditTopic:
+			n := len(h.topics) + 1
+			f.topicIdx = (f.topicIdx + dir + n) % n
+			return h, nil
+		case batchEditPrivacy:
+			n := len(batchedit.Privacies) + 1
+			f.privacyIdx = (f.privacyIdx + dir + n) % n
+			return h, nil
+		}
+	}
+
+	if input := f.input(); input != nil {
+		var cmd tea.Cmd
+		*input, cmd = input.Update(msg)
+		return h, cmd
+	}
+	return h, nil
+}
+
+// renderBatchEditView renders the batch edit form, or the progress and
+// summary of saving it
+func (h *HistoryModel) renderBatchEditView() string {
+	if h.bulkOp != nil {
+		return h.bulkOp.View()
+	}
+	header := RenderHeader("Edit Selected Recordings")
+
+	f := h.batchEdit
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	labelStyle := lipgloss.NewStyle().Foreground(ColorGray).Width(14)
+	activeLabelStyle := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true).Width(14)
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+
+	label := func(field batchEditField, text string) string {
+		if f.focus == field {
+			return activeLabelStyle.Render(text)
+		}
+		return labelStyle.Render(text)
+	}
+	selector := func(field batchEditField, value string) string {
+		if f.focus == field {
+			return valueStyle.Render("◀ " + value + " ▶")
+		}
+		return valueStyle.Render(value)
+	}
+
+	topic := "unchanged"
+	if f.topicIdx > 0 && f.topicIdx <= len(h.topics) {
+		topic = h.topics[f.topicIdx-1].Name
+	}
+	privacy := "unchanged"
+	if f.privacyIdx > 0 && f.privacyIdx <= len(batchedit.Privacies) {
+		privacy = batchedit.Privacies[f.privacyIdx-1]
+	}
+
+	rows := []string{
+		grayStyle.Render(fmt.Sprintf("Changes are saved to each of the %d selected recording(s).", len(h.selectedRecordings()))),
+		"",
+		label(batchEditPresenter, "Presenter:") + f.presenter.View(),
+		label(batchEditTopic, "Topic:") + selector(batchEditTopic, topic),
+		label(batchEditAddTag, "Add tag:") + f.addTag.View(),
+		label(batchEditRemoveTag, "Remove tag:") + f.removeTag.View(),
+		label(batchEditPrivacy, "Privacy:") + selector(batchEditPrivacy, privacy),
+		"",
+		grayStyle.Render("Tags and privacy are used the next time a recording is uploaded; videos already on YouTube keep their privacy."),
+	}
+	if f.err != "" {
+		rows = append(rows, "", lipgloss.NewStyle().Foreground(ColorRed).Render(f.err))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	footer := RenderHelpFooter("tab/↑/↓: field • ←/→: change • enter: apply • esc: cancel", h.width)
+	return LayoutWithHeaderFooter(header, content, footer, h.width, h.height)
+}
@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestHistoryBatchEdit_SavesEachRecording(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	h := historyWithRecordings("QGIS intro", "QGIS plugins", "GeoServer")
+	for i := range h.recordings[:2] {
+		h.recordings[i].Files.FolderPath = t.TempDir()
+		h.recordings[i].Metadata.Presenter = "Tim"
+		if err := h.recordings[i].Save(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The third recording has no recording.json, so saving it fails
+	h.recordings[2].Files.FolderPath = filepath.Join(t.TempDir(), "missing")
+	h.rebuildSearchIndex()
+
+	h.Update(bulkKey(" "))
+	h.Update(bulkKey(" "))
+	h.Update(bulkKey(" "))
+	h.Update(bulkKey("e"))
+	if h.mode != HistoryBatchEditMode {
+		t.Fatalf("expected e to open the batch edit, mode %v", h.mode)
+	}
+
+	// Nothing to change yet
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if h.bulkOp != nil || h.batchEdit.err == "" {
+		t.Fatal("expected an empty edit to be refused")
+	}
+
+	h.Update(bulkKey("Tim Sutton"))
+	h.Update(tea.KeyMsg{Type: tea.KeyTab})
+	h.Update(tea.KeyMsg{Type: tea.KeyTab})
+	h.Update(bulkKey("workshop"))
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if h.bulkOp == nil || h.bulkOp.Step() != BulkStepConfirm {
+		t.Fatal("expected enter to ask for confirmation")
+	}
+	if view := h.View(); !strings.Contains(view, "presenter: Tim Sutton") || !strings.Contains(view, "add tag: workshop") {
+		t.Error("expected the confirmation to list the changes")
+	}
+
+	_, cmd := h.Update(bulkKey("y"))
+	for cmd != nil {
+		_, cmd = h.Update(cmd())
+	}
+	if h.bulkOp.Step() != BulkStepSummary {
+		t.Fatalf("expected the summary after all recordings, step %v", h.bulkOp.Step())
+	}
+	if view := h.View(); !strings.Contains(view, "2 succeeded") || !strings.Contains(view, "1 failed") {
+		t.Error("expected a summary of the succeeded and failed recordings")
+	}
+
+	_, cmd = h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	h.Update(cmd())
+	if h.mode != HistoryListMode || h.bulkOp != nil {
+		t.Fatalf("expected to return to the list, mode %v", h.mode)
+	}
+	for _, rec := range h.recordings[:2] {
+		saved, err := models.LoadRecordingInfo(rec.Files.FolderPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if saved.Metadata.Presenter != "Tim Sutton" || saved.Metadata.UploadDefaults == nil || saved.Metadata.UploadDefaults.Tags[0] != "workshop" {
+			t.Errorf("saved metadata = %+v, want the new presenter and tag", saved.Metadata)
+		}
+		if rec.Metadata.Presenter != "Tim Sutton" {
+			t.Errorf("listed presenter = %q, want the list updated", rec.Metadata.Presenter)
+		}
+	}
+	if len(h.selected) != 1 || !h.selected[h.recordings[2].Files.FolderPath] {
+		t.Errorf("selected %v, want only the failed recording still marked", h.selected)
+	}
+}
@@ -0,0 +1,395 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
+)
+
+// batchUploadField is a field of the upload all pending form
+type batchUploadField int
+
+const (
+	batchUploadAccount batchUploadField = iota
+	batchUploadPrivacy
+	batchUploadPlaylist
+	batchUploadFieldCount
+)
+
+// batchUploadForm asks once for the account, privacy and playlist used to
+// upload every completed recording that is not on YouTube yet, then shows
+// the progress and outcome of the uploads
+type batchUploadForm struct {
+	pending        []models.RecordingInfo
+	accounts       []youtube.Account
+	account        int
+	privacyOptions []youtube.PrivacyStatus
+	privacy        int
+	playlist       textinput.Model // Playlist title, empty for none
+	focus          batchUploadField
+	batch          *uploadBatch // Set once the uploads are queued
+}
+
+// batchUploadFailure is an upload of the batch that failed
+type batchUploadFailure struct {
+	title string
+	err   string
+}
+
+// uploadBatch follows the uploads of the pending recordings queued at once.
+// The app updates it from the upload queue's events, the history renders it.
+type uploadBatch struct {
+	videos   map[string]bool // Video paths of the uploads in the batch
+	total    int
+	done     int
+	current  string  // Title of the recording being uploaded
+	percent  float64 // Share of the current video sent, 0-1
+	uploaded []string
+	failed   []batchUploadFailure
+}
+
+// pendingUploads returns the completed recordings that are not on YouTube
+// yet and have a video to upload
+func pendingUploads(recordings []models.RecordingInfo) []models.RecordingInfo {
+	var pending []models.RecordingInfo
+	for _, rec := range recordings {
+		if rec.Status == models.StatusCompleted && !rec.Metadata.IsPublishedToYouTube() && uploadVideoPath(&rec) != "" {
+			pending = append(pending, rec)
+		}
+	}
+	return pending
+}
+
+// startBatchUpload opens the upload all pending form, or the progress of
+// the batch being uploaded or last uploaded
+func (h *HistoryModel) startBatchUpload() tea.Cmd {
+	if h.batchUpload != nil && h.batchUpload.batch != nil {
+		h.mode = HistoryBatchUploadMode
+		return nil
+	}
+
+	cfg, _ := config.Load()
+	if !cfg.IsYouTubeConnected() {
+		return h.showNotice("YouTube not connected. Go to Options > YouTube to set up.", true)
+	}
+	pending := pendingUploads(h.recordings)
+	if len(pending) == 0 {
+		return h.showNotice("No completed recordings waiting to be uploaded", false)
+	}
+
+	f := &batchUploadForm{
+		pending:        pending,
+		accounts:       cfg.YouTube.GetAccounts(),
+		privacyOptions: []youtube.PrivacyStatus{youtube.PrivacyUnlisted, youtube.PrivacyPrivate, youtube.PrivacyPublic},
+	}
+	if len(f.accounts) == 0 {
+		// Connected without accounts, e.g. with credentials from the
+		// environment, uploads like the upload form with the legacy config
+		f.accounts = []youtube.Account{{
+			ID:           "legacy",
+			Name:         cfg.YouTube.ChannelName,
+			ClientID:     cfg.YouTube.ClientID,
+			ClientSecret: cfg.YouTube.ClientSecret,
+			ChannelName:  cfg.YouTube.ChannelName,
+			ChannelID:    cfg.YouTube.ChannelID,
+		}}
+	}
+	if acc := cfg.YouTube.GetDefaultAccount(); acc != nil {
+		for i := range f.accounts {
+			if f.accounts[i].ID == acc.ID {
+				f.account = i
+				break
+			}
+		}
+	}
+	for i, p := range f.privacyOptions {
+		if p == cfg.YouTube.DefaultPrivacy {
+			f.privacy = i
+		}
+	}
+
+	f.playlist = textinput.New()
+	f.playlist.Placeholder = "None"
+	f.playlist.CharLimit = 150
+	f.playlist.Width = 40
+	if acc := f.selectedAccount(); acc != nil {
+		f.playlist.SetValue(acc.DefaultPlaylistName)
+	}
+
+	h.batchUpload = f
+	h.mode = HistoryBatchUploadMode
+	return nil
+}
+
+// selectedAccount returns the account the batch is uploaded to
+func (f *batchUploadForm) selectedAccount() *youtube.Account {
+	if f.account < 0 || f.account >= len(f.accounts) {
+		return nil
+	}
+	return &f.accounts[f.account]
+}
+
+// selectAccount moves the account selection by delta. A playlist that was
+// the default of the previous account becomes that of the new one.
+func (f *batchUploadForm) selectAccount(delta int) {
+	if len(f.accounts) < 2 {
+		return
+	}
+	prev := f.selectedAccount()
+	f.account = (f.account + delta + len(f.accounts)) % len(f.accounts)
+	if f.playlist.Value() == prev.DefaultPlaylistName {
+		f.playlist.SetValue(f.selectedAccount().DefaultPlaylistName)
+	}
+}
+
+// queueItems returns an upload queue item for each pending recording, with
+// the settings the upload form would start with and the account, privacy
+// and playlist chosen for the batch. Sensitive topics stay private.
+func (f *batchUploadForm) queueItems() []youtube.QueueItem {
+	acc := f.selectedAccount()
+	if acc == nil {
+		return nil
+	}
+	target := uploadTarget{account: *acc, playlistName: strings.TrimSpace(f.playlist.Value())}
+	if target.playlistName != "" && target.playlistName == acc.DefaultPlaylistName {
+		target.playlistID = acc.DefaultPlaylistID
+	}
+
+	var items []youtube.QueueItem
+	for i := range f.pending {
+		rec := &f.pending[i]
+		u := NewYouTubeUploadModelWithRecording(uploadVideoPath(rec), rec)
+		opts := u.uploadOptions(target)
+		if !u.sensitiveTopic {
+			opts.PrivacyStatus = f.privacyOptions[f.privacy]
+		}
+		items = append(items, youtube.QueueItem{
+			Recording:    rec,
+			VideoPath:    u.videoPath,
+			Options:      opts,
+			Account:      target.account,
+			PlaylistName: target.playlistName,
+		})
+	}
+	return items
+}
+
+// newUploadBatch creates the batch that follows the uploads of items
+func newUploadBatch(items []youtube.QueueItem) *uploadBatch {
+	b := &uploadBatch{videos: make(map[string]bool)}
+	for _, item := range items {
+		b.videos[item.VideoPath] = true
+	}
+	b.total = len(b.videos)
+	return b
+}
+
+// includes returns true if the queue item is an upload of the batch
+func (b *uploadBatch) includes(item youtube.QueueItem) bool {
+	return b.videos[item.VideoPath]
+}
+
+// drop leaves out an upload that could not be queued, e.g. because the
+// video was already in the queue
+func (b *uploadBatch) drop(item youtube.QueueItem) {
+	if b.videos[item.VideoPath] {
+		delete(b.videos, item.VideoPath)
+		b.total--
+	}
+}
+
+// handle records the progress or outcome of an upload of the batch
+func (b *uploadBatch) handle(event youtube.QueueEvent) {
+	title := event.Item.Options.Title
+	if event.Item.Recording != nil && event.Item.Recording.Metadata.Title != "" {
+		title = event.Item.Recording.Metadata.Title
+	}
+	if !event.Done {
+		b.current = title
+		b.percent = event.Percent
+		return
+	}
+	b.done++
+	b.current = ""
+	b.percent = 0
+	if event.Err != nil {
+		b.failed = append(b.failed, batchUploadFailure{title: title, err: youtube.FriendlyError(event.Err)})
+	} else {
+		b.uploaded = append(b.uploaded, title)
+	}
+}
+
+// finished returns true once every upload of the batch succeeded or failed
+func (b *uploadBatch) finished() bool {
+	return b.done >= b.total
+}
+
+// label describes the upload in progress, e.g. "Uploading video 3 of 12, 45%"
+func (b *uploadBatch) label() string {
+	return fmt.Sprintf("Uploading video %d of %d, %d%%", min(b.done+1, b.total), b.total, int(b.percent*100))
+}
+
+// summary counts the uploads that succeeded and failed
+func (b *uploadBatch) summary() string {
+	s := fmt.Sprintf("Uploaded %d of %d recording(s)", len(b.uploaded), b.total)
+	if len(b.failed) > 0 {
+		s += fmt.Sprintf(", %d failed", len(b.failed))
+	}
+	return s
+}
+
+// updateBatchUploadMode handles input in the upload all pending form and
+// its progress view
+func (h *HistoryModel) updateBatchUploadMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	f := h.batchUpload
+	if msg.String() == "ctrl+c" {
+		return h, tea.Quit
+	}
+
+	if f.batch != nil {
+		// The uploads keep running in the background. Leaving the summary
+		// of a finished batch clears it.
+		if msg.String() == "esc" || msg.String() == "q" || msg.String() == "enter" {
+			if f.batch.finished() {
+				h.batchUpload = nil
+			}
+			h.mode = HistoryListMode
+		}
+		return h, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		h.batchUpload = nil
+		h.mode = HistoryListMode
+		return h, nil
+
+	case "tab", "down":
+		f.setFocus(f.focus + 1)
+		return h, nil
+
+	case "shift+tab", "up":
+		f.setFocus(f.focus - 1)
+		return h, nil
+
+	case "enter":
+		items := f.queueItems()
+		if len(items) == 0 {
+			return h, nil
+		}
+		f.batch = newUploadBatch(items)
+		return h, func() tea.Msg { return queueUploadsMsg{items: items, batch: f.batch} }
+
+	case "left", "right":
+		delta := 1
+		if msg.String() == "left" {
+			delta = -1
+		}
+		switch f.focus {
+		case batchUploadAccount:
+			f.selectAccount(delta)
+			return h, nil
+		case batchUploadPrivacy:
+			f.privacy = (f.privacy + delta + len(f.privacyOptions)) % len(f.privacyOptions)
+			return h, nil
+		}
+	}
+
+	if f.focus == batchUploadPlaylist {
+		var cmd tea.Cmd
+		f.playlist, cmd = f.playlist.Update(msg)
+		return h, cmd
+	}
+	return h, nil
+}
+
+// setFocus moves the focus to field, focusing the playlist input if it is
+// the playlist
+func (f *batchUploadForm) setFocus(field batchUploadField) {
+	f.focus = (field + batchUploadFieldCount) % batchUploadFieldCount
+	if f.focus == batchUploadPlaylist {
+		f.playlist.Focus()
+	} else {
+		f.playlist.Blur()
+	}
+}
+
+// renderBatchUploadView renders the upload all pending form, or the
+// progress and summary of the batch
+func (h *HistoryModel) renderBatchUploadView() string {
+	f := h.batchUpload
+	if f == nil {
+		return "No uploads pending"
+	}
+	header := RenderHeader("Upload All Pending")
+
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+	labelStyle := lipgloss.NewStyle().Foreground(ColorGray).Width(12)
+	activeLabelStyle := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true).Width(12)
+	label := func(field batchUploadField, text string) string {
+		if f.batch == nil && f.focus == field {
+			return activeLabelStyle.Render(text)
+		}
+		return labelStyle.Render(text)
+	}
+
+	accountName := "No account"
+	if acc := f.selectedAccount(); acc != nil {
+		accountName = accountDisplayName(*acc)
+	}
+	playlist := f.playlist.View()
+	if f.batch != nil {
+		playlist = valueStyle.Render(f.playlist.Value())
+	}
+	rows := []string{
+		lipgloss.JoinHorizontal(lipgloss.Top, label(batchUploadAccount, "Account:"), valueStyle.Render("◀ "+accountName+" ▶")),
+		lipgloss.JoinHorizontal(lipgloss.Top, label(batchUploadPrivacy, "Privacy:"), valueStyle.Render("◀ "+string(f.privacyOptions[f.privacy])+" ▶")),
+		lipgloss.JoinHorizontal(lipgloss.Top, label(batchUploadPlaylist, "Playlist:"), playlist),
+		"",
+	}
+
+	helpText := "↑/↓: field • ←/→: change • enter: upload all • esc: cancel"
+	if b := f.batch; b == nil {
+		rows = append(rows, grayStyle.Render(fmt.Sprintf("%d recording(s) will be uploaded one after another:", len(f.pending))))
+		for i, rec := range f.pending {
+			if i == 10 {
+				rows = append(rows, grayStyle.Render(fmt.Sprintf("  … and %d more", len(f.pending)-i)))
+				break
+			}
+			rows = append(rows, valueStyle.Render("  "+truncateStr(rec.Metadata.Title, 60)))
+		}
+	} else {
+		helpText = "esc: back (uploads continue in the background)"
+		if b.finished() {
+			rows = append(rows, lipgloss.NewStyle().Foreground(ColorGreen).Bold(true).Render(b.summary()))
+			helpText = "esc: back"
+		} else {
+			progress := b.label()
+			if b.current != "" {
+				progress += " · " + truncateStr(b.current, 40)
+			}
+			rows = append(rows, lipgloss.NewStyle().Foreground(ColorOrange).Bold(true).Render(progress))
+		}
+		rows = append(rows, "")
+		for _, title := range b.uploaded {
+			rows = append(rows, lipgloss.NewStyle().Foreground(ColorGreen).Render("✓ "+truncateStr(title, 60)))
+		}
+		for _, failure := range b.failed {
+			rows = append(rows, lipgloss.NewStyle().Foreground(ColorRed).Render("✗ "+truncateStr(failure.title, 40)+": "+truncateStr(failure.err, 60)))
+		}
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	footer := lipgloss.NewStyle().
+		Width(h.width).
+		Align(lipgloss.Center).
+		Render(h.renderHelpOrNotice(grayStyle.Italic(true), helpText))
+	return LayoutWithHeaderFooter(header, content, footer, h.width, h.height)
+}
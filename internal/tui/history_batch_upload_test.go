@@ -0,0 +1,105 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
+)
+
+func TestHistoryBatchUpload_UploadsAllPending(t *testing.T) {
+	connectYouTubeForTest(t)
+	defer func(badge, failed int) {
+		GlobalAppState.UploadQueue, GlobalAppState.UploadQueueFailed = badge, failed
+	}(GlobalAppState.UploadQueue, GlobalAppState.UploadQueueFailed)
+
+	h := historyWithRecordings("QGIS intro", "GeoServer", "Published", "Still processing", "PostGIS")
+	for i := range h.recordings {
+		rec := &h.recordings[i]
+		rec.Files.FolderPath = t.TempDir()
+		rec.Files.MergedFile = filepath.Join(rec.Files.FolderPath, "merged.mp4")
+		if err := os.WriteFile(rec.Files.MergedFile, []byte("video"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		rec.Status = models.StatusCompleted
+		if err := rec.Save(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	h.recordings[2].Metadata.YouTubeUploads = []models.YouTubeUpload{{VideoID: "abc123"}}
+	h.recordings[3].Status = models.StatusProcessing
+
+	h.Update(bulkKey("U"))
+	if h.mode != HistoryBatchUploadMode || len(h.batchUpload.pending) != 3 {
+		t.Fatalf("mode %v, want the form for the 3 completed recordings not on YouTube", h.mode)
+	}
+
+	// One privacy and playlist for the whole batch
+	h.Update(tea.KeyMsg{Type: tea.KeyDown})
+	h.Update(tea.KeyMsg{Type: tea.KeyRight})
+	h.Update(tea.KeyMsg{Type: tea.KeyDown})
+	h.Update(bulkKey("Training"))
+	_, cmd := h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	items := queuedUploads(cmd)
+	if len(items) != 3 {
+		t.Fatalf("queued %d uploads, want 3", len(items))
+	}
+	for _, item := range items {
+		if item.Options.PrivacyStatus != youtube.PrivacyPrivate || item.PlaylistName != "Training" {
+			t.Errorf("queued %q as %s in %q, want private in Training", item.Options.Title, item.Options.PrivacyStatus, item.PlaylistName)
+		}
+	}
+
+	m := AppModel{screen: ScreenHistory, history: h}
+	release := make(chan struct{})
+	m.uploadQueue = youtube.NewUploadQueue(func(ctx context.Context, item youtube.QueueItem, progress func(read, total int64)) (*youtube.UploadResult, error) {
+		if item.Recording.Metadata.Title == "QGIS intro" {
+			progress(45, 100)
+			<-release
+		}
+		if item.Recording.Metadata.Title == "GeoServer" {
+			return nil, errors.New("quota exceeded")
+		}
+		return &youtube.UploadResult{VideoID: "new123", VideoURL: "https://youtu.be/new123"}, nil
+	})
+	model, cmd := m.Update(queueUploadsMsg{items: items, batch: h.batchUpload.batch})
+
+	// The progress of the first upload is shown with its place in the batch
+	model, cmd = model.Update(cmd())
+	if job := model.(AppModel).queueJobLabel(); job != "Uploading video 1 of 3, 45%" {
+		t.Errorf("job = %q, want the aggregate progress", job)
+	}
+	close(release)
+
+	// A failure does not stop the uploads after it
+	for model.(AppModel).uploadQueueWaiting > 0 {
+		model, cmd = model.Update(cmd())
+	}
+	b := h.batchUpload.batch
+	if !b.finished() || len(b.uploaded) != 2 || len(b.failed) != 1 || b.failed[0].title != "GeoServer" {
+		t.Fatalf("uploaded %v failed %v, want 2 uploaded and GeoServer failed", b.uploaded, b.failed)
+	}
+	if !strings.Contains(h.copyNotice, "Uploaded 2 of 3 recording(s), 1 failed") {
+		t.Errorf("notice %q, want the summary", h.copyNotice)
+	}
+	view := h.View()
+	for _, want := range []string{"Uploaded 2 of 3", "✓ PostGIS", "✗ GeoServer"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected the summary to show %q", want)
+		}
+	}
+	if !h.recordings[4].Metadata.IsPublishedToYouTube() {
+		t.Error("expected the history to show the uploads as published")
+	}
+
+	h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if h.mode != HistoryListMode || h.batchUpload != nil {
+		t.Errorf("mode %v, want the summary cleared on leaving it", h.mode)
+	}
+}
@@ -0,0 +1,242 @@
+package tui
+
+import (
+	"fmt"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/retention"
+)
+
+// storageBudgetWarnFraction is the share of the storage budget above which
+// the total size of the recordings is shown in orange
+const storageBudgetWarnFraction = 0.8
+
+// storageBudgetDismissed hides the over-budget prompt on the menu and in the
+// history for the rest of the session
+var storageBudgetDismissed bool
+
+// storageBudgetMsg carries the storage budget plan of the videos directory
+type storageBudgetMsg struct {
+	plan retention.BudgetPlan
+}
+
+// openStorageBudgetMsg opens the history with the storage budget suggestions
+type openStorageBudgetMsg struct{}
+
+// budgetMovedMsg reports the suggested recordings that were archived or
+// moved to the trash
+type budgetMovedMsg struct {
+	action config.RetentionAction
+	moved  []string // Original folder paths of the moved recordings
+	errs   []error
+}
+
+// planStorageBudget returns the storage budget plan for recordings, using
+// the budget from the config
+func planStorageBudget(recordings []models.RecordingInfo) retention.BudgetPlan {
+	cfg, _ := config.Load()
+	if cfg == nil {
+		return retention.BudgetPlan{}
+	}
+	return retention.PlanBudget(recordings, cfg.StorageBudgetBytes())
+}
+
+// checkStorageBudget loads the recordings and checks them against the
+// storage budget
+func checkStorageBudget() tea.Cmd {
+	return func() tea.Msg {
+		recordings, _ := retention.LoadRecordings(config.GetDefaultVideosDir())
+		return storageBudgetMsg{plan: planStorageBudget(recordings)}
+	}
+}
+
+// showStorageBudgetPrompt returns true if the over-budget prompt should be shown
+func showStorageBudgetPrompt(plan retention.BudgetPlan) bool {
+	return plan.OverBudget() && !storageBudgetDismissed
+}
+
+// renderStorageBudgetPrompt renders the one line over-budget prompt
+func renderStorageBudgetPrompt(plan retention.BudgetPlan) string {
+	return lipgloss.NewStyle().Foreground(ColorOrange).Bold(true).Render(
+		fmt.Sprintf("⚠ Recordings use %s of the %s storage budget • b: review suggestions • x: dismiss",
+			models.FormatFileSize(plan.Used), models.FormatFileSize(plan.Budget)))
+}
+
+// totalRecordingsSize returns the storage used by all recordings
+func totalRecordingsSize(recordings []models.RecordingInfo) int64 {
+	var total int64
+	for _, rec := range recordings {
+		total += rec.Files.TotalSize
+	}
+	return total
+}
+
+// storageUsageColor returns the color of the total size of the recordings:
+// orange when nearing the storage budget and red when over it
+func storageUsageColor(total, budget int64) lipgloss.Color {
+	switch {
+	case budget > 0 && total > budget:
+		return ColorRed
+	case budget > 0 && float64(total) >= float64(budget)*storageBudgetWarnFraction:
+		return ColorOrange
+	}
+	return ColorGray
+}
+
+// renderStorageUsage renders the storage used by count recordings
+func renderStorageUsage(total int64, count int, budget int64) string {
+	noun := "recordings"
+	if count == 1 {
+		noun = "recording"
+	}
+	return lipgloss.NewStyle().Foreground(storageUsageColor(total, budget)).Render(
+		fmt.Sprintf("Total: %s across %d %s", models.FormatFileSize(total), count, noun))
+}
+
+// openStorageBudget shows the recordings suggested to get back under budget
+func (h *HistoryModel) openStorageBudget() {
+	h.budgetResult = nil
+	h.mode = HistoryStorageBudgetMode
+}
+
+// updateStorageBudgetMode handles input in the storage budget view
+func (h *HistoryModel) updateStorageBudgetMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return h, tea.Quit
+
+	case "esc", "q":
+		if !h.budgetMoving {
+			h.budgetResult = nil
+			h.backToList()
+		}
+
+	case "x":
+		if !h.budgetMoving {
+			storageBudgetDismissed = true
+			h.budgetResult = nil
+			h.backToList()
+		}
+
+	case "a", "t":
+		if h.budgetMoving || h.budgetResult != nil || len(h.budgetPlan.Suggestions) == 0 {
+			return h, nil
+		}
+		action := config.RetentionActionArchive
+		if msg.String() == "t" {
+			action = config.RetentionActionTrash
+		}
+		h.budgetMoving = true
+		suggestions := h.budgetPlan.Suggestions
+		return h, func() tea.Msg {
+			destDir := retention.DestinationDir(config.GetDefaultVideosDir(), action)
+			result := budgetMovedMsg{action: action}
+			for _, c := range suggestions {
+				if _, err := retention.Move(c.FolderPath, destDir); err != nil {
+					result.errs = append(result.errs, err)
+					continue
+				}
+				result.moved = append(result.moved, c.FolderPath)
+			}
+			return result
+		}
+	}
+	return h, nil
+}
+
+// handleBudgetMoved removes the moved recordings from the list and plans the
+// budget again
+func (h *HistoryModel) handleBudgetMoved(msg budgetMovedMsg) {
+	h.budgetMoving = false
+	h.budgetResult = &msg
+
+	moved := make(map[string]bool, len(msg.moved))
+	for _, path := range msg.moved {
+		moved[path] = true
+	}
+	kept := h.recordings[:0]
+	for _, rec := range h.recordings {
+		if !moved[rec.Files.FolderPath] {
+			kept = append(kept, rec)
+		}
+	}
+	h.recordings = kept
+	h.rebuildSearchIndex()
+	h.budgetPlan = planStorageBudget(h.recordings)
+
+	updateGlobalAppState(GlobalAppState.IsRecording, GlobalAppState.BlinkOn, GlobalAppState.Status)
+}
+
+// renderStorageBudgetView renders the recordings suggested to get back under
+// the storage budget
+func (h *HistoryModel) renderStorageBudgetView() string {
+	header := RenderHeader("Storage Budget")
+
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+	warnStyle := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true)
+
+	plan := h.budgetPlan
+	rows := []string{
+		valueStyle.Render(fmt.Sprintf("Recordings use %s of the %s budget",
+			models.FormatFileSize(plan.Used), models.FormatFileSize(plan.Budget))),
+		"",
+	}
+	helpText := "x: dismiss until restart • esc: back"
+
+	switch {
+	case h.budgetMoving:
+		rows = append(rows, grayStyle.Render("Moving recordings..."))
+
+	case h.budgetResult != nil:
+		dest := "archive"
+		if h.budgetResult.action == config.RetentionActionTrash {
+			dest = "trash"
+		}
+		rows = append(rows, lipgloss.NewStyle().Foreground(ColorGreen).Bold(true).Render(
+			fmt.Sprintf("Moved %d recording(s) to the %s", len(h.budgetResult.moved), dest)))
+		for _, err := range h.budgetResult.errs {
+			rows = append(rows, lipgloss.NewStyle().Foreground(ColorRed).Render(truncateStr(err.Error(), h.width-4)))
+		}
+		helpText = "esc: back"
+
+	case !plan.OverBudget():
+		rows = append(rows, grayStyle.Italic(true).Render("The recordings are within the budget"))
+		helpText = "esc: back"
+
+	case len(plan.Suggestions) == 0:
+		rows = append(rows, grayStyle.Italic(true).Render("No completed recordings are uploaded to YouTube, nothing to suggest"))
+
+	default:
+		rows = append(rows, grayStyle.Render("Oldest uploaded recordings, largest first:"))
+		limit := max(h.height-18, 3)
+		for i, c := range plan.Suggestions {
+			if i == limit {
+				rows = append(rows, grayStyle.Render(fmt.Sprintf("... and %d more recording(s)", len(plan.Suggestions)-limit)))
+				break
+			}
+			title := c.Title
+			if title == "" {
+				title = filepath.Base(c.FolderPath)
+			}
+			rows = append(rows, valueStyle.Render(fmt.Sprintf("%s  %-40s %10s",
+				c.RecordedAt.Format("2006-01-02"), truncateStr(title, 40), models.FormatFileSize(c.Size))))
+		}
+
+		rows = append(rows, "")
+		line := fmt.Sprintf("Moving %d recording(s) frees %s", len(plan.Suggestions), models.FormatFileSize(plan.Freed))
+		if !plan.Sufficient() {
+			line += ", not enough to get under the budget"
+		}
+		rows = append(rows, warnStyle.Render(line))
+		helpText = "a: archive all • t: move all to trash • x: dismiss until restart • esc: back"
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	footer := RenderHelpFooter(helpText, h.width)
+	return LayoutWithHeaderFooter(header, content, footer, h.width, h.height)
+}
@@ -0,0 +1,127 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/retention"
+)
+
+func TestHistoryStorageBudget_ArchivesSuggestions(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_VIDEOS_DIR", t.TempDir())
+	t.Cleanup(func() { storageBudgetDismissed = false })
+
+	cfg, _ := config.Load()
+	cfg.StorageBudgetGB = 1
+	if err := config.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	videosDir := config.GetDefaultVideosDir()
+	now := time.Now()
+	var recordings []models.RecordingInfo
+	for i, title := range []string{"Sprint review", "QGIS intro", "Local draft"} {
+		var rec models.RecordingInfo
+		rec.Status = models.StatusCompleted
+		rec.StartTime = now.AddDate(0, 0, -i*10)
+		rec.Metadata.Title = title
+		rec.Files.FolderPath = filepath.Join(videosDir, title)
+		rec.Files.TotalSize = 1 << 29
+		if title != "Local draft" {
+			rec.Metadata.YouTubeUploads = []models.YouTubeUpload{{VideoID: "abc123"}}
+		}
+		if err := os.MkdirAll(rec.Files.FolderPath, 0755); err != nil {
+			t.Fatal(err)
+		}
+		recordings = append(recordings, rec)
+	}
+
+	h := NewHistoryModel()
+	h.width, h.height = 120, 40
+	h.Update(recordingsLoadedMsg{recordings: recordings})
+	if !h.budgetPlan.OverBudget() || len(h.budgetPlan.Suggestions) != 1 || h.budgetPlan.Suggestions[0].Title != "QGIS intro" {
+		t.Fatalf("expected the oldest uploaded recording to be suggested, got %+v", h.budgetPlan)
+	}
+	if !strings.Contains(h.View(), "storage budget") {
+		t.Error("expected the list to show the over-budget prompt")
+	}
+	if !strings.Contains(h.View(), "Total: 1.5 GB across 3 recordings") {
+		t.Error("expected the list to show the storage used by the recordings")
+	}
+
+	h.Update(bulkKey("b"))
+	if h.mode != HistoryStorageBudgetMode {
+		t.Fatalf("expected b to open the suggestions, mode %v", h.mode)
+	}
+	_ = h.View()
+
+	_, cmd := h.Update(bulkKey("a"))
+	if cmd == nil {
+		t.Fatal("expected a to archive the suggestions")
+	}
+	h.Update(cmd())
+	if h.budgetResult == nil || len(h.budgetResult.moved) != 1 || len(h.budgetResult.errs) != 0 {
+		t.Fatalf("budgetResult = %+v, want 1 recording moved", h.budgetResult)
+	}
+	if _, err := os.Stat(filepath.Join(videosDir, config.ArchiveDirName, "QGIS intro")); err != nil {
+		t.Errorf("expected the recording in the archive: %v", err)
+	}
+	if len(h.recordings) != 2 || h.budgetPlan.OverBudget() {
+		t.Errorf("expected 2 recordings left within budget, got %d, %+v", len(h.recordings), h.budgetPlan)
+	}
+	if h.totalSize != 1<<30 {
+		t.Errorf("totalSize = %d after archiving, want %d", h.totalSize, 1<<30)
+	}
+
+	h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if h.mode != HistoryListMode {
+		t.Errorf("expected to return to the list, mode %v", h.mode)
+	}
+}
+
+func TestMenuStorageBudget_Dismiss(t *testing.T) {
+	t.Cleanup(func() { storageBudgetDismissed = false })
+
+	m := NewMenuModel()
+	m.width, m.height = 120, 40
+	m.SetStorageBudget(retention.BudgetPlan{Budget: 1 << 30, Used: 2 << 30})
+	if !strings.Contains(m.View(), "storage budget") {
+		t.Fatal("expected the menu to show the over-budget prompt")
+	}
+	if _, cmd := m.Update(bulkKey("b")); cmd == nil {
+		t.Error("expected b to open the suggestions")
+	} else if _, ok := cmd().(openStorageBudgetMsg); !ok {
+		t.Error("expected b to send openStorageBudgetMsg")
+	}
+
+	m.Update(bulkKey("x"))
+	if strings.Contains(m.View(), "storage budget") {
+		t.Error("expected x to dismiss the prompt")
+	}
+}
+
+func TestStorageUsageColor(t *testing.T) {
+	const gb = 1 << 30
+	tests := []struct {
+		total, budget int64
+		want          lipgloss.Color
+	}{
+		{total: 500 * gb, budget: 0, want: ColorGray},
+		{total: 70 * gb, budget: 100 * gb, want: ColorGray},
+		{total: 80 * gb, budget: 100 * gb, want: ColorOrange},
+		{total: 101 * gb, budget: 100 * gb, want: ColorRed},
+	}
+	for _, tt := range tests {
+		if got := storageUsageColor(tt.total, tt.budget); got != tt.want {
+			t.Errorf("storageUsageColor(%d, %d) = %v, want %v", tt.total, tt.budget, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package tui
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/retention"
+)
+
+// toggleSelected marks or unmarks a recording for bulk delete
+func (h *HistoryModel) toggleSelected(rec *models.RecordingInfo) {
+	if h.selected == nil {
+		h.selected = map[string]bool{}
+	}
+	folder := rec.Files.FolderPath
+	if h.selected[folder] {
+		delete(h.selected, folder)
+	} else {
+		h.selected[folder] = true
+	}
+}
+
+// selectedRecordings returns the recordings marked for bulk delete in list
+// order
+func (h *HistoryModel) selectedRecordings() []models.RecordingInfo {
+	var recs []models.RecordingInfo
+	for _, rec := range h.recordings {
+		if h.selected[rec.Files.FolderPath] {
+			recs = append(recs, rec)
+		}
+	}
+	return recs
+}
+
+// pruneSelection drops selected folders that are no longer in the history,
+// e.g. after a refresh
+func (h *HistoryModel) pruneSelection() {
+	if len(h.selected) == 0 {
+		return
+	}
+	present := make(map[string]bool, len(h.recordings))
+	for _, rec := range h.recordings {
+		present[rec.Files.FolderPath] = true
+	}
+	for folder := range h.selected {
+		if !present[folder] {
+			delete(h.selected, folder)
+		}
+	}
+}
+
+// confirmBulkDelete asks to confirm moving the selected recordings to the
+// trash, then moves them one by one with the bulk operation view
+func (h *HistoryModel) confirmBulkDelete() {
+	recs := h.selectedRecordings()
+	if len(recs) == 0 {
+		return
+	}
+
+	var targets []BulkTarget
+	var size int64
+	for _, rec := range recs {
+		title := rec.Metadata.Title
+		if title == "" {
+			title = filepath.Base(rec.Files.FolderPath)
+		}
+		label := fmt.Sprintf("%s (%s, %s)", title, rec.StartTime.Format("2006-01-02"), models.FormatFileSize(rec.Files.TotalSize))
+		targets = append(targets, BulkTarget{Label: label, Data: rec.Files.FolderPath})
+		size += rec.Files.TotalSize
+	}
+
+	videosDir, now := config.GetDefaultVideosDir(), time.Now()
+	h.bulkOp = NewBulkOperationModel("Delete Selected Recordings", "Delete", true, targets, func(t BulkTarget) error {
+		_, err := retention.MoveToTrash(videosDir, t.Data.(string), now)
+		return err
+	})
+	h.bulkOp.SetDetails([]string{
+		fmt.Sprintf("move %s to the trash", models.FormatFileSize(size)),
+		"restore them from the trash with T in the history",
+	})
+	h.bulkOp.SetSize(h.width, h.height)
+	h.mode = HistoryBulkDeleteConfirmMode
+}
+
+// closeBulkDelete removes the recordings moved to the trash from the list
+// and returns to it. Recordings that could not be moved stay selected so
+// the delete can be tried again.
+func (h *HistoryModel) closeBulkDelete(msg bulkOperationClosedMsg) {
+	op := h.bulkOp
+	h.bulkOp = nil
+	h.mode = HistoryListMode
+	if !msg.ran {
+		return
+	}
+
+	deleted := map[string]bool{}
+	for i, status := range op.Statuses() {
+		if status == BulkItemDone {
+			folder := op.targets[i].Data.(string)
+			deleted[folder] = true
+			delete(h.selected, folder)
+		}
+	}
+	kept := h.recordings[:0]
+	for _, rec := range h.recordings {
+		if !deleted[rec.Files.FolderPath] {
+			kept = append(kept, rec)
+		}
+	}
+	h.recordings = kept
+
+	// Reindex, which also keeps the cursor in range
+	h.rebuildSearchIndex()
+
+	// Update global recording count
+	updateGlobalAppState(GlobalAppState.IsRecording, GlobalAppState.BlinkOn, GlobalAppState.Status)
+}
+
+// updateBulkDeleteConfirmMode passes input to the bulk delete operation
+func (h *HistoryModel) updateBulkDeleteConfirmMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return h, tea.Quit
+	}
+	var cmd tea.Cmd
+	h.bulkOp, cmd = h.bulkOp.Update(msg)
+	return h, cmd
+}
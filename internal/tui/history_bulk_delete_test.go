@@ -0,0 +1,111 @@
+package tui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestHistoryBulkDelete_SpaceSelectsAndEscClears(t *testing.T) {
+	h := historyWithRecordings("QGIS intro", "QGIS intro take 2", "GeoServer")
+
+	h.Update(bulkKey(" "))
+	if !h.selected["/videos/QGIS intro"] || h.cursor != 1 {
+		t.Fatalf("expected space to select the first recording and move down, selected %v, cursor %d", h.selected, h.cursor)
+	}
+	h.Update(bulkKey(" "))
+	if len(h.selected) != 2 {
+		t.Fatalf("expected 2 selected, got %v", h.selected)
+	}
+	if view := h.View(); !strings.Contains(view, "☑") || !strings.Contains(view, "2 selected") {
+		t.Error("expected checkboxes and the selection count in the list")
+	}
+
+	h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if len(h.selected) != 0 || h.mode != HistoryListMode {
+		t.Errorf("expected esc to clear the selection and stay in the list, selected %v", h.selected)
+	}
+}
+
+func TestHistoryBulkDelete_ReportsFailures(t *testing.T) {
+	t.Setenv("XDG_VIDEOS_DIR", t.TempDir())
+	h := historyWithRecordings("QGIS intro", "QGIS intro take 2", "GeoServer")
+	first, third := t.TempDir(), t.TempDir()
+	h.recordings[0].Files.FolderPath = first
+	h.recordings[0].Files.TotalSize = 100
+	// A trailing dot is rejected by os.RemoveAll
+	h.recordings[1].Files.FolderPath = t.TempDir() + "/."
+	h.recordings[2].Files.FolderPath = third
+	h.recordings[2].Files.TotalSize = 50
+	h.rebuildSearchIndex()
+
+	h.Update(bulkKey(" "))
+	h.Update(bulkKey(" "))
+	h.Update(bulkKey(" "))
+	h.Update(bulkKey("D"))
+	if h.mode != HistoryBulkDeleteConfirmMode || h.bulkOp == nil || h.bulkOp.Step() != BulkStepConfirm {
+		t.Fatalf("expected D to ask for confirmation, mode %v", h.mode)
+	}
+	if view := h.View(); !strings.Contains(view, "Delete 3 item(s)") || !strings.Contains(view, "move 150 B to the trash") {
+		t.Error("expected the confirmation to show the number and size of the recordings")
+	}
+
+	_, cmd := h.Update(bulkKey("y"))
+	runBulkOp(h, cmd)
+	if h.bulkOp.Step() != BulkStepSummary {
+		t.Fatalf("expected the summary after all recordings, step %v", h.bulkOp.Step())
+	}
+	if view := h.View(); !strings.Contains(view, "2 succeeded") || !strings.Contains(view, "1 failed") {
+		t.Error("expected a summary of the deleted and failed recordings")
+	}
+	for _, dir := range []string{first, third} {
+		if _, err := os.Stat(dir); !os.IsNotExist(err) {
+			t.Errorf("%s was not moved to the trash: %v", dir, err)
+		}
+	}
+
+	_, cmd = h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runBulkOp(h, cmd)
+	if h.mode != HistoryListMode || h.bulkOp != nil {
+		t.Fatalf("expected to return to the list, mode %v", h.mode)
+	}
+	if len(h.recordings) != 1 || len(h.selected) != 1 || !h.selected[h.recordings[0].Files.FolderPath] {
+		t.Errorf("expected only the failed recording to remain, still selected; recordings %d, selected %v", len(h.recordings), h.selected)
+	}
+}
+
+func TestHistoryBulkDelete_Cancel(t *testing.T) {
+	h := historyWithRecordings("QGIS intro", "GeoServer")
+	h.Update(bulkKey(" "))
+	h.Update(bulkKey("D"))
+	_, cmd := h.Update(bulkKey("n"))
+	runBulkOp(h, cmd)
+	if h.mode != HistoryListMode || h.bulkOp != nil || len(h.recordings) != 2 || len(h.selected) != 1 {
+		t.Errorf("expected n to return to the list with nothing deleted, mode %v", h.mode)
+	}
+}
+
+func TestHistoryBulkDelete_NothingSelected(t *testing.T) {
+	h := historyWithRecordings("QGIS intro")
+	h.Update(bulkKey("D"))
+	if h.mode != HistoryListMode {
+		t.Errorf("expected D without a selection to do nothing, mode %v", h.mode)
+	}
+}
+
+func TestHistoryBulkDelete_DeleteKeyUsesSelection(t *testing.T) {
+	h := historyWithRecordings("QGIS intro", "GeoServer")
+	h.Update(bulkKey("d"))
+	if h.mode != HistoryDeleteConfirmMode {
+		t.Fatalf("expected d without a selection to delete the highlighted recording, mode %v", h.mode)
+	}
+	h.Update(bulkKey("n"))
+
+	h.Update(bulkKey(" "))
+	h.Update(bulkKey("d"))
+	if h.mode != HistoryBulkDeleteConfirmMode {
+		t.Errorf("expected d with a selection to delete the selection, mode %v", h.mode)
+	}
+}
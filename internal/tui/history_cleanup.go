@@ -0,0 +1,162 @@
+package tui
+
+import (
+	"fmt"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/cleanup"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// cleanupScannedMsg carries the leftover files found in the recording folders
+type cleanupScannedMsg struct {
+	reports []cleanup.Report
+	errs    []error
+}
+
+// cleanupDoneMsg reports the files removed by a cleanup
+type cleanupDoneMsg struct {
+	result cleanup.Result
+}
+
+// startCleanup opens the cleanup view and scans the recording folders
+func (h *HistoryModel) startCleanup() tea.Cmd {
+	h.mode = HistoryCleanupMode
+	h.cleanupReports = nil
+	h.cleanupScanning = true
+	h.cleanupIncludeMedia = false
+	h.cleanupResult = nil
+	h.cleanupErrors = nil
+
+	recordings := append([]models.RecordingInfo(nil), h.recordings...)
+	return func() tea.Msg {
+		reports, errs := cleanup.ScanAll(recordings)
+		return cleanupScannedMsg{reports: reports, errs: errs}
+	}
+}
+
+// cleanupTotals returns the number and size of the files a cleanup would
+// remove, and the size of the unreferenced media
+func (h *HistoryModel) cleanupTotals() (files int, size, media int64) {
+	for _, r := range h.cleanupReports {
+		for _, f := range r.Files {
+			if f.Kind == cleanup.KindMedia {
+				media += f.Size
+				if !h.cleanupIncludeMedia {
+					continue
+				}
+			}
+			files++
+			size += f.Size
+		}
+	}
+	return files, size, media
+}
+
+// updateCleanupMode handles input in the cleanup view
+func (h *HistoryModel) updateCleanupMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return h, tea.Quit
+
+	case "esc", "q", "n":
+		if !h.cleanupScanning && !h.cleanupRemoving {
+			h.cleanupReports = nil
+			h.cleanupResult = nil
+			h.mode = HistoryListMode
+		}
+
+	case "m":
+		// Unreferenced media may be raw footage, only included on request
+		if h.cleanupResult == nil {
+			h.cleanupIncludeMedia = !h.cleanupIncludeMedia
+		}
+
+	case "y", "Y":
+		if files, _, _ := h.cleanupTotals(); files > 0 && h.cleanupResult == nil && !h.cleanupRemoving {
+			h.cleanupRemoving = true
+			reports, includeMedia := h.cleanupReports, h.cleanupIncludeMedia
+			return h, func() tea.Msg {
+				return cleanupDoneMsg{result: cleanup.Remove(reports, includeMedia)}
+			}
+		}
+	}
+	return h, nil
+}
+
+// renderCleanupView renders the leftover files and the cleanup result
+func (h *HistoryModel) renderCleanupView() string {
+	header := RenderHeader("Clean Up Leftover Files")
+
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+	warnStyle := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true)
+
+	var rows []string
+	helpText := "esc: back"
+
+	switch {
+	case h.cleanupScanning:
+		rows = append(rows, grayStyle.Render("Scanning recording folders..."))
+
+	case h.cleanupRemoving:
+		rows = append(rows, grayStyle.Render("Removing files..."))
+
+	case h.cleanupResult != nil:
+		rows = append(rows, lipgloss.NewStyle().Foreground(ColorGreen).Bold(true).Render(
+			fmt.Sprintf("Removed %d file(s), reclaimed %s", h.cleanupResult.Removed, models.FormatFileSize(h.cleanupResult.Reclaimed))))
+		for _, err := range h.cleanupResult.Errors {
+			rows = append(rows, lipgloss.NewStyle().Foreground(ColorRed).Render(truncateStr(err.Error(), h.width-4)))
+		}
+
+	case len(h.cleanupReports) == 0:
+		rows = append(rows, grayStyle.Italic(true).Render("No leftover files found"))
+
+	default:
+		// One line per recording, as many as fit
+		limit := max(h.height-16, 3)
+		for i, r := range h.cleanupReports {
+			if i == limit {
+				rows = append(rows, grayStyle.Render(fmt.Sprintf("... and %d more recording(s)", len(h.cleanupReports)-limit)))
+				break
+			}
+			title := r.Title
+			if title == "" {
+				title = filepath.Base(r.FolderPath)
+			}
+			line := fmt.Sprintf("%-40s %3d file(s) %10s", truncateStr(title, 40), len(r.Files), models.FormatFileSize(r.Size(cleanup.KindIntermediate)))
+			if media := r.Size(cleanup.KindMedia); media > 0 {
+				line += fmt.Sprintf("  + %s media", models.FormatFileSize(media))
+			}
+			rows = append(rows, valueStyle.Render(line))
+		}
+
+		files, size, media := h.cleanupTotals()
+		rows = append(rows, "")
+		if media > 0 {
+			if h.cleanupIncludeMedia {
+				rows = append(rows, lipgloss.NewStyle().Foreground(ColorRed).Bold(true).Render(
+					fmt.Sprintf("Including %s of unreferenced audio/video, which may be raw footage", models.FormatFileSize(media))))
+			} else {
+				rows = append(rows, grayStyle.Render(
+					fmt.Sprintf("%s of unreferenced audio/video is kept, press m to include it", models.FormatFileSize(media))))
+			}
+		}
+		if files > 0 {
+			rows = append(rows, warnStyle.Render(fmt.Sprintf("Remove %d file(s) and reclaim %s? (y/n)", files, models.FormatFileSize(size))))
+			helpText = "y: remove • m: include unreferenced media • esc: cancel"
+		} else {
+			helpText = "m: include unreferenced media • esc: back"
+		}
+	}
+
+	for _, err := range h.cleanupErrors {
+		rows = append(rows, lipgloss.NewStyle().Foreground(ColorRed).Render(truncateStr("Could not scan "+err.Error(), h.width-4)))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	footer := RenderHelpFooter(helpText, h.width)
+	return LayoutWithHeaderFooter(header, content, footer, h.width, h.height)
+}
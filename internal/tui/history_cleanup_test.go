@@ -0,0 +1,45 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHistoryCleanup_KeepsMediaUnlessIncluded(t *testing.T) {
+	h := historyWithRecordings("QGIS intro")
+	dir := t.TempDir()
+	h.recordings[0].Files.FolderPath = dir
+	for name, size := range map[string]int{"audio-filtered.wav": 100, "webcam_part000.mp4": 40} {
+		if err := os.WriteFile(filepath.Join(dir, name), make([]byte, size), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, cmd := h.Update(bulkKey("X"))
+	if h.mode != HistoryCleanupMode || cmd == nil {
+		t.Fatalf("expected X to start scanning, mode %v", h.mode)
+	}
+	h.Update(cmd())
+	if files, size, media := h.cleanupTotals(); files != 1 || size != 100 || media != 40 {
+		t.Fatalf("cleanupTotals() = %d files, %d bytes, %d media; want 1, 100, 40", files, size, media)
+	}
+	_ = h.View()
+
+	_, cmd = h.Update(bulkKey("y"))
+	if cmd == nil {
+		t.Fatal("expected y to remove the files")
+	}
+	h.Update(cmd())
+	if h.cleanupResult == nil || h.cleanupResult.Removed != 1 || h.cleanupResult.Reclaimed != 100 {
+		t.Fatalf("cleanupResult = %+v, want 1 file and 100 bytes", h.cleanupResult)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "webcam_part000.mp4")); err != nil {
+		t.Errorf("unreferenced media was removed without m: %v", err)
+	}
+
+	h.Update(bulkKey("n"))
+	if h.mode != HistoryListMode {
+		t.Errorf("expected to return to the list, mode %v", h.mode)
+	}
+}
@@ -0,0 +1,32 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestHistoryDetail_CopyYouTubeURL(t *testing.T) {
+	h := historyWithRecordings("Published", "Local only")
+	h.recordings[0].Metadata.YouTubeUploads = []models.YouTubeUpload{{
+		VideoID:  "abc123",
+		VideoURL: "https://youtu.be/abc123",
+	}}
+
+	h.selectedRecording = &h.recordings[0]
+	h.mode = HistoryDetailMode
+	if _, cmd := h.Update(bulkKey("y")); cmd == nil {
+		t.Error("expected y to copy the YouTube URL of a published recording")
+	}
+
+	h.selectedRecording = &h.recordings[1]
+	if _, cmd := h.Update(bulkKey("y")); cmd != nil {
+		t.Error("expected y to do nothing for a recording that is not on YouTube")
+	}
+
+	// The copy is confirmed like a copied path
+	h.Update(pathCopiedMsg{label: "YouTube URL", path: "https://youtu.be/abc123"})
+	if h.copyNotice != "Copied YouTube URL: https://youtu.be/abc123" || h.copyNoticeError {
+		t.Errorf("unexpected copy notice %q", h.copyNotice)
+	}
+}
@@ -0,0 +1,54 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestHistoryDuplicates_DeleteReturnsToGroups(t *testing.T) {
+	t.Setenv("XDG_VIDEOS_DIR", t.TempDir())
+	h := historyWithRecordings("QGIS intro", "QGIS intro take 2", "Sprint review")
+	dir := t.TempDir()
+	for i := range h.recordings {
+		h.recordings[i].Files.FolderPath = filepath.Join(dir, h.recordings[i].Metadata.Title)
+		if err := os.Mkdir(h.recordings[i].Files.FolderPath, 0755); err != nil {
+			t.Fatal(err)
+		}
+		h.recordings[i].Duration = 10 * time.Minute
+		h.recordings[i].StartTime = time.Date(2026, time.March, 2, 9, i, 0, 0, time.UTC)
+	}
+	h.rebuildSearchIndex()
+
+	h.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if h.mode != HistoryDuplicatesMode || len(h.duplicateGroups) != 1 || h.duplicateCount() != 2 {
+		t.Fatalf("expected one group of 2 duplicates, mode %v, %d groups", h.mode, len(h.duplicateGroups))
+	}
+	_ = h.View()
+
+	// Delete the second copy
+	h.Update(tea.KeyMsg{Type: tea.KeyDown})
+	h.Update(bulkKey("d"))
+	if h.mode != HistoryDeleteConfirmMode || h.deleteConfirmRecording.Metadata.Title != "QGIS intro take 2" {
+		t.Fatalf("expected to confirm deleting the second copy, mode %v", h.mode)
+	}
+	h.Update(bulkKey("y"))
+
+	if h.mode != HistoryDuplicatesMode {
+		t.Fatalf("expected to return to the duplicates, mode %v", h.mode)
+	}
+	if len(h.duplicateGroups) != 0 || len(h.recordings) != 2 {
+		t.Errorf("expected no duplicates left and 2 recordings, got %d groups, %d recordings", len(h.duplicateGroups), len(h.recordings))
+	}
+	if _, err := os.Stat(filepath.Join(dir, "QGIS intro take 2")); !os.IsNotExist(err) {
+		t.Errorf("expected the folder to be moved to the trash, stat error %v", err)
+	}
+
+	h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if h.mode != HistoryListMode {
+		t.Errorf("expected esc to return to the list, mode %v", h.mode)
+	}
+}
@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func latestTestRecordings() []models.RecordingInfo {
+	start := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	recordings := make([]models.RecordingInfo, 3)
+	for i, title := range []string{"Old untitled", "Newest", "New untitled"} {
+		recordings[i].Metadata.Title = title
+		recordings[i].Files.FolderPath = "/videos/" + title
+		recordings[i].Status = models.StatusCompleted
+	}
+	recordings[0].StartTime = start
+	recordings[0].Status = models.StatusNeedsMetadata
+	recordings[1].StartTime = start.Add(2 * time.Hour)
+	recordings[2].StartTime = start.Add(time.Hour)
+	recordings[2].Status = models.StatusNeedsMetadata
+	return recordings
+}
+
+func TestHistory_OpenLatestOnLoad(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+
+	h := NewHistoryModel()
+	h.width, h.height = 120, 40
+	h.openLatestOnLoad = true
+	h.Update(recordingsLoadedMsg{recordings: latestTestRecordings()})
+	if h.mode != HistoryDetailMode || h.selectedRecording.Metadata.Title != "Newest" {
+		t.Fatalf("mode %v, want the details of the newest recording", h.mode)
+	}
+}
+
+func TestHistory_EditLatestOnLoad(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+
+	h := NewHistoryModel()
+	h.width, h.height = 120, 40
+	h.editRecordingOnLoad = true
+	h.Update(recordingsLoadedMsg{recordings: latestTestRecordings()})
+	if h.mode != HistoryEditMode || h.selectedRecording.Metadata.Title != "New untitled" {
+		t.Fatalf("mode %v, want the edit form of the newest recording needing metadata", h.mode)
+	}
+
+	// Nothing to finish leaves the list with a notice
+	recordings := latestTestRecordings()
+	recordings[0].Status, recordings[2].Status = models.StatusCompleted, models.StatusCompleted
+	h = NewHistoryModel()
+	h.editRecordingOnLoad = true
+	h.Update(recordingsLoadedMsg{recordings: recordings})
+	if h.mode != HistoryListMode || h.copyNotice != "No recording needs metadata" {
+		t.Errorf("mode %v notice %q, want the list with a notice", h.mode, h.copyNotice)
+	}
+}
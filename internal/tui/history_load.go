@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// loadProgressInterval is how often the progress of loading the recordings
+// is shown
+const loadProgressInterval = 200 * time.Millisecond
+
+// loadProgress counts the folders scanned while the recordings load. It is
+// written by the loading command and read when a loadProgressMsg arrives.
+type loadProgress struct {
+	scanned atomic.Int64
+	total   atomic.Int64
+}
+
+// loadProgressMsg samples the progress of a load
+type loadProgressMsg struct {
+	progress *loadProgress
+	at       time.Time
+}
+
+// loadProgressTick schedules the next sample of progress
+func loadProgressTick(progress *loadProgress) tea.Cmd {
+	return tea.Tick(loadProgressInterval, func(t time.Time) tea.Msg {
+		return loadProgressMsg{progress: progress, at: t}
+	})
+}
+
+// handleLoadProgress copies the progress of the running load into the model
+// and samples it again until the recordings have loaded. Samples of an
+// earlier load are dropped.
+func (h *HistoryModel) handleLoadProgress(msg loadProgressMsg) tea.Cmd {
+	if !h.loading || msg.progress != h.loadCounter {
+		return nil
+	}
+	h.loadScanned = int(msg.progress.scanned.Load())
+	h.loadTotal = int(msg.progress.total.Load())
+	h.loadElapsed = msg.at.Sub(h.loadStarted)
+	return loadProgressTick(msg.progress)
+}
+
+// renderLoadProgress renders the folders scanned so far as a progress bar
+// with an estimate of the time left, or nothing before the first sample
+func (h *HistoryModel) renderLoadProgress() string {
+	if h.loadTotal == 0 {
+		return ""
+	}
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+
+	status := fmt.Sprintf("%d/%d folders", h.loadScanned, h.loadTotal)
+	if h.loadScanned > 0 && h.loadScanned < h.loadTotal && h.loadElapsed > time.Second {
+		left := h.loadElapsed * time.Duration(h.loadTotal-h.loadScanned) / time.Duration(h.loadScanned)
+		status += fmt.Sprintf(" • about %s left", left.Round(time.Second))
+	}
+
+	width := min(max(h.width-30, 10), 50)
+	percent := float64(h.loadScanned) / float64(h.loadTotal) * 100
+	return lipgloss.JoinVertical(lipgloss.Center,
+		renderProgressBar(percent, width),
+		grayStyle.Render(status),
+	)
+}
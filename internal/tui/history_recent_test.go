@@ -0,0 +1,68 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+)
+
+func TestHistoryRecent_JumpsToOpenedRecording(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	h := historyWithRecordings("QGIS intro", "Sprint review", "QGIS plugins")
+
+	// Open the last and then the first recording
+	h.Update(tea.KeyMsg{Type: tea.KeyEnd})
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	h.Update(tea.KeyMsg{Type: tea.KeyHome})
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if got := h.recentRecordings(); len(got) != 2 || got[0] != 0 || got[1] != 2 {
+		t.Fatalf("recentRecordings() = %v, want [0 2]", got)
+	}
+
+	// Hide the second recent recording behind a filter, then jump to it
+	h.Update(bulkKey("/"))
+	h.Update(bulkKey("intro"))
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	h.Update(bulkKey("R"))
+	if h.mode != HistoryRecentMode {
+		t.Fatalf("expected R to open the recent recordings, mode %v", h.mode)
+	}
+	h.Update(bulkKey("2"))
+	if h.mode != HistoryDetailMode || h.selectedRecording.Metadata.Title != "QGIS plugins" {
+		t.Fatalf("expected the details of QGIS plugins, mode %v", h.mode)
+	}
+	if h.filteredRecordings != nil || h.cursor != 2 {
+		t.Errorf("expected the filter cleared and the cursor on the recording, cursor %d", h.cursor)
+	}
+
+	// Remembered across sessions
+	cfg, _ := config.Load()
+	if len(cfg.RecentRecordings) != 2 || cfg.RecentRecordings[0] != "/videos/QGIS plugins" {
+		t.Errorf("saved recent recordings = %v", cfg.RecentRecordings)
+	}
+}
+
+func TestHistoryRecent_ReopenKeepsPlace(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	h := historyWithRecordings("QGIS intro", "Sprint review", "QGIS plugins")
+	h.Update(tea.KeyMsg{Type: tea.KeyDown})
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// Reopened, in a different order
+	h = historyWithRecordings("QGIS plugins", "QGIS intro", "Sprint review")
+	if rec := h.visibleRecording(h.cursor); rec == nil || rec.Metadata.Title != "Sprint review" {
+		t.Fatalf("expected the cursor on the recording opened last, cursor %d", h.cursor)
+	}
+
+	// A refresh starts from the top again
+	h.Update(bulkKey("r"))
+	h.Update(recordingsLoadedMsg{recordings: h.recordings})
+	if h.cursor != 0 {
+		t.Errorf("expected a refresh to reset the cursor, cursor %d", h.cursor)
+	}
+}
@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"path/filepath"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// startRename opens the folder name input for the selected recording
+func (h *HistoryModel) startRename() tea.Cmd {
+	if h.selectedRecording == nil || h.selectedRecording.Files.FolderPath == "" {
+		return nil
+	}
+	h.renameInput = textinput.New()
+	h.renameInput.CharLimit = 200
+	h.renameInput.Width = 50
+	h.renameInput.SetValue(filepath.Base(h.selectedRecording.Files.FolderPath))
+	h.renameInput.Focus()
+	h.renameError = ""
+	h.youtubeActionError = ""
+	h.youtubeActionSuccess = ""
+	h.mode = HistoryRenameMode
+	return textinput.Blink
+}
+
+// renameSelected renames the folder of the selected recording and updates
+// the recording in the list and the recently opened recordings
+func (h *HistoryModel) renameSelected(newName string) error {
+	rec := h.selectedRecording
+	oldPath := rec.Files.FolderPath
+	err := rec.RenameFolder(newName)
+	if rec.Files.FolderPath == oldPath {
+		return err
+	}
+
+	// The folder was moved, even if recording.json could not be saved
+	for i := range h.recordings {
+		if h.recordings[i].Files.FolderPath == oldPath {
+			h.recordings[i] = *rec
+			break
+		}
+	}
+	if h.selected[oldPath] {
+		delete(h.selected, oldPath)
+		h.selected[rec.Files.FolderPath] = true
+	}
+	h.rebuildSearchIndex()
+
+	if cfg, err := config.Load(); err == nil {
+		cfg.RenameRecentRecording(oldPath, rec.Files.FolderPath)
+		_ = config.Save(cfg)
+		h.recent = cfg.RecentRecordings
+	}
+	return err
+}
+
+// updateRenameMode handles input in the folder rename view
+func (h *HistoryModel) updateRenameMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return h, tea.Quit
+
+	case "esc":
+		h.renameError = ""
+		h.mode = HistoryDetailMode
+		return h, nil
+
+	case "enter":
+		name := h.renameInput.Value()
+		if err := h.renameSelected(name); err != nil {
+			h.renameError = err.Error()
+			return h, nil
+		}
+		h.renameError = ""
+		h.youtubeActionSuccess = "Folder renamed to " + name
+		h.mode = HistoryDetailMode
+		return h, nil
+	}
+
+	var cmd tea.Cmd
+	h.renameInput, cmd = h.renameInput.Update(msg)
+	// Validate while typing
+	h.renameError = ""
+	if err := models.ValidateFolderName(h.renameInput.Value()); err != nil {
+		h.renameError = err.Error()
+	}
+	return h, cmd
+}
+
+// renderRenameView renders the folder name input
+func (h *HistoryModel) renderRenameView() string {
+	header := RenderHeader("Rename Folder")
+
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	labelStyle := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true)
+
+	var rows []string
+	if rec := h.selectedRecording; rec != nil {
+		rows = append(rows,
+			grayStyle.Render("Current: "+rec.Files.FolderPath),
+			"",
+			labelStyle.Render("New folder name:"),
+			h.renameInput.View(),
+		)
+	}
+	if h.renameError != "" {
+		rows = append(rows, "", lipgloss.NewStyle().Foreground(ColorRed).Render(truncateStr(h.renameError, h.width-4)))
+	}
+	rows = append(rows, "",
+		grayStyle.Render("The folder is renamed on disk and the paths in recording.json are updated."))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	footer := RenderHelpFooter("enter: rename • esc: cancel", h.width)
+	return LayoutWithHeaderFooter(header, content, footer, h.width, h.height)
+}
@@ -0,0 +1,66 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestHistoryRename_RenamesFolderFromDetail(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	dir := t.TempDir()
+	h := historyWithRecordings("QGIS intro", "Sprint review")
+	for i := range h.recordings {
+		h.recordings[i].Status = models.StatusCompleted
+		h.recordings[i].Files.FolderPath = filepath.Join(dir, h.recordings[i].Metadata.Title)
+		if err := os.Mkdir(h.recordings[i].Files.FolderPath, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := h.recordings[i].Save(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	h.rebuildSearchIndex()
+
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	h.Update(bulkKey("n"))
+	if h.mode != HistoryRenameMode || h.renameInput.Value() != "QGIS intro" {
+		t.Fatalf("expected the rename input with the folder name, mode %v, value %q", h.mode, h.renameInput.Value())
+	}
+
+	// Invalid and colliding names are refused
+	h.renameInput.SetValue("")
+	h.Update(bulkKey("a/b"))
+	if !strings.Contains(h.renameError, "cannot contain") {
+		t.Errorf("expected a slash to be rejected while typing, error %q", h.renameError)
+	}
+	h.renameInput.SetValue("Sprint review")
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if h.mode != HistoryRenameMode || !strings.Contains(h.renameError, "already exists") {
+		t.Fatalf("expected a collision to be refused, mode %v, error %q", h.mode, h.renameError)
+	}
+
+	h.renameInput.SetValue("001-qgis-intro")
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if h.mode != HistoryDetailMode || h.renameError != "" {
+		t.Fatalf("expected the rename to return to the details, mode %v, error %q", h.mode, h.renameError)
+	}
+	renamed := filepath.Join(dir, "001-qgis-intro")
+	if _, err := os.Stat(filepath.Join(renamed, "recording.json")); err != nil {
+		t.Errorf("expected the folder to be renamed on disk: %v", err)
+	}
+	if h.selectedRecording.Files.FolderPath != renamed || h.recordings[0].Files.FolderPath != renamed {
+		t.Errorf("expected the recording to use the new folder, got %s", h.recordings[0].Files.FolderPath)
+	}
+
+	// The recently opened recording follows the rename
+	cfg, _ := config.Load()
+	if len(cfg.RecentRecordings) != 1 || cfg.RecentRecordings[0] != renamed {
+		t.Errorf("recent recordings = %v", cfg.RecentRecordings)
+	}
+}
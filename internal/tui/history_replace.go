@@ -0,0 +1,550 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/batchedit"
+)
+
+// replaceField is a field of the description search and replace form
+type replaceField int
+
+const (
+	replaceSearch replaceField = iota
+	replaceWith
+	replaceYouTube
+	replaceFieldCount
+)
+
+// replaceExcerptContext is the number of characters shown either side of
+// a change in the preview
+const replaceExcerptContext = 30
+
+// descriptionReplace is a search and replace over the descriptions of the
+// selected recordings, previewed before it is saved
+type descriptionReplace struct {
+	focus     replaceField
+	search    textinput.Model
+	replace   textinput.Model
+	connected bool // YouTube is connected, so videos can be updated too
+	youtube   bool // Also update the descriptions of the videos on YouTube
+
+	changes []batchedit.DescriptionChange // Preview, nil while editing the form
+	scroll  int
+	run     *replaceRun // Replacement or undo being saved
+	err     string
+}
+
+// videoDescription is the description of a YouTube video before and after
+// a replacement
+type videoDescription struct {
+	folder    string // Recording the video belongs to
+	channelID string
+	videoID   string
+	before    string
+	after     string
+}
+
+// replaceTarget is one recording a replacement or its undo is saved to
+type replaceTarget struct {
+	change *batchedit.DescriptionChange // nil if only videos are restored
+	videos []videoDescription
+}
+
+// replaceRun records what a replacement saved so it can be undone. The
+// bulk operation adds to it from its goroutine.
+type replaceRun struct {
+	replacement batchedit.Replacement
+	undo        bool
+	retry       *retryNotice
+
+	mu      sync.Mutex
+	changes []batchedit.DescriptionChange // Descriptions saved to recording.json
+	videos  []videoDescription            // Descriptions changed on YouTube
+}
+
+// lastDescriptionReplace is the last replacement saved. It is kept while
+// the app runs so it can still be undone after leaving the history.
+var lastDescriptionReplace *replaceRun
+
+// startDescriptionReplace opens the search and replace form for the
+// selected recordings, or to undo the last replacement
+func (h *HistoryModel) startDescriptionReplace() tea.Cmd {
+	if len(h.selectedRecordings()) == 0 && lastDescriptionReplace == nil {
+		return nil
+	}
+	h.replace = &descriptionReplace{
+		search:    newBatchEditInput("text to find, e.g. an old link"),
+		replace:   newBatchEditInput("replacement, empty to remove"),
+		connected: checkYouTubeConnected(),
+	}
+	h.replace.search.CharLimit = 500
+	h.replace.replace.CharLimit = 500
+	h.replace.search.Focus()
+	h.bulkOp = nil
+	h.mode = HistoryDescriptionReplaceMode
+	return textinput.Blink
+}
+
+// input returns the text input of the focused field, or nil for the
+// YouTube toggle
+func (r *descriptionReplace) input() *textinput.Model {
+	switch r.focus {
+	case replaceSearch:
+		return &r.search
+	case replaceWith:
+		return &r.replace
+	}
+	return nil
+}
+
+// setFocus moves the focus to field, focusing its text input
+func (r *descriptionReplace) setFocus(field replaceField) {
+	if input := r.input(); input != nil {
+		input.Blur()
+	}
+	r.focus = (field + replaceFieldCount) % replaceFieldCount
+	if input := r.input(); input != nil {
+		input.Focus()
+	}
+}
+
+// replacement returns the replacement the form describes
+func (r *descriptionReplace) replacement() batchedit.Replacement {
+	return batchedit.Replacement{Search: r.search.Value(), Replace: r.replace.Value()}
+}
+
+// previewReplace shows the changes the replacement makes to the selected
+// recordings
+func (h *HistoryModel) previewReplace() {
+	r := h.replace
+	replacement := r.replacement()
+	if err := replacement.Validate(); err != nil {
+		r.err = err.Error()
+		return
+	}
+	if len(h.selectedRecordings()) == 0 {
+		r.err = "mark the recordings to change with space first"
+		return
+	}
+	changes := replacement.Preview(h.selectedRecordings())
+	if len(changes) == 0 {
+		r.err = fmt.Sprintf("no selected description contains %q", replacement.Search)
+		return
+	}
+	r.err = ""
+	r.changes = changes
+	r.scroll = 0
+}
+
+// youtubeVideos returns the YouTube videos of the recording in folder
+func (h *HistoryModel) youtubeVideos(folder string) []videoDescription {
+	var videos []videoDescription
+	for _, rec := range h.recordings {
+		if rec.Files.FolderPath != folder {
+			continue
+		}
+		for _, upload := range rec.Metadata.AllYouTubeUploads() {
+			if upload.VideoID != "" {
+				videos = append(videos, videoDescription{folder: folder, channelID: upload.ChannelID, videoID: upload.VideoID})
+			}
+		}
+	}
+	return videos
+}
+
+// applyReplace saves the previewed changes, and updates the videos on
+// YouTube if asked to
+func (h *HistoryModel) applyReplace() tea.Cmd {
+	r := h.replace
+	run := &replaceRun{replacement: r.replacement(), retry: &retryNotice{}}
+	var targets []BulkTarget
+	for i := range r.changes {
+		c := r.changes[i]
+		target := replaceTarget{change: &c}
+		if r.youtube {
+			target.videos = h.youtubeVideos(c.Folder)
+		}
+		targets = append(targets, BulkTarget{Label: c.Title, Data: target})
+	}
+	r.run = run
+	h.bulkOp = NewBulkOperationModel("Replace in Descriptions", "Replace", false, targets, run.save)
+	h.bulkOp.SetSize(h.width, h.height)
+	return h.bulkOp.Start()
+}
+
+// undoReplace asks to confirm, then restores the descriptions the last
+// replacement changed
+func (h *HistoryModel) undoReplace() {
+	last := lastDescriptionReplace
+	if last == nil {
+		return
+	}
+	run := &replaceRun{replacement: last.replacement, undo: true, retry: &retryNotice{}}
+
+	byFolder := map[string]*replaceTarget{}
+	var folders []string
+	target := func(folder string) *replaceTarget {
+		if byFolder[folder] == nil {
+			byFolder[folder] = &replaceTarget{}
+			folders = append(folders, folder)
+		}
+		return byFolder[folder]
+	}
+	titles := map[string]string{}
+	for _, c := range last.changes {
+		reverse := c.Reverse()
+		target(c.Folder).change = &reverse
+		titles[c.Folder] = c.Title
+	}
+	for _, v := range last.videos {
+		t := target(v.folder)
+		t.videos = append(t.videos, v)
+	}
+	sort.Strings(folders)
+
+	var targets []BulkTarget
+	for _, folder := range folders {
+		label := titles[folder]
+		if label == "" {
+			label = folder
+		}
+		targets = append(targets, BulkTarget{Label: label, Data: *byFolder[folder]})
+	}
+	h.replace.run = run
+	h.bulkOp = NewBulkOperationModel("Undo Replacement", "Undo", false, targets, run.save)
+	h.bulkOp.SetDetails([]string{fmt.Sprintf("restore %q where it was replaced with %q", last.replacement.Search, last.replacement.Replace)})
+	h.bulkOp.SetSize(h.width, h.height)
+}
+
+// save saves one recording's description and updates its videos on
+// YouTube. It runs in the bulk operation's goroutine.
+func (run *replaceRun) save(t BulkTarget) error {
+	target := t.Data.(replaceTarget)
+	if c := target.change; c != nil {
+		if err := batchedit.ApplyDescription(*c); err != nil {
+			return err
+		}
+		run.mu.Lock()
+		run.changes = append(run.changes, *c)
+		run.mu.Unlock()
+	}
+
+	ctx := context.Background()
+	for _, video := range target.videos {
+		if err := run.saveVideo(ctx, video); err != nil {
+			if target.change != nil {
+				return fmt.Errorf("saved, but not on YouTube: %w", err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// saveVideo replaces the text in the description of a video on YouTube, or
+// restores the description it had before the replacement
+func (run *replaceRun) saveVideo(ctx context.Context, video videoDescription) error {
+	uploader, err := channelUploader(ctx, video.channelID, run.retry)
+	if err != nil {
+		return err
+	}
+
+	if run.undo {
+		// Only a description nobody edited since is restored
+		restored := false
+		_, err := uploader.UpdateVideoDescription(ctx, video.videoID, func(current string) string {
+			if current != video.after {
+				return current
+			}
+			restored = true
+			return video.before
+		})
+		if err != nil {
+			return err
+		}
+		if !restored {
+			return fmt.Errorf("the description of video %s was changed on YouTube since", video.videoID)
+		}
+	} else {
+		r := run.replacement
+		before, err := uploader.UpdateVideoDescription(ctx, video.videoID, func(current string) string {
+			return strings.ReplaceAll(current, r.Search, r.Replace)
+		})
+		if err != nil {
+			return err
+		}
+		video.before = before
+		video.after = strings.ReplaceAll(before, r.Search, r.Replace)
+		if video.after == video.before {
+			return nil
+		}
+	}
+
+	run.mu.Lock()
+	run.videos = append(run.videos, video)
+	run.mu.Unlock()
+	return nil
+}
+
+// without returns what is left of the replacement once undone was saved,
+// or nil if everything was undone
+func (run *replaceRun) without(undone *replaceRun) *replaceRun {
+	folders := map[string]bool{}
+	for _, c := range undone.changes {
+		folders[c.Folder] = true
+	}
+	videos := map[string]bool{}
+	for _, v := range undone.videos {
+		videos[v.videoID] = true
+	}
+
+	rest := &replaceRun{replacement: run.replacement}
+	for _, c := range run.changes {
+		if !folders[c.Folder] {
+			rest.changes = append(rest.changes, c)
+		}
+	}
+	for _, v := range run.videos {
+		if !videos[v.videoID] {
+			rest.videos = append(rest.videos, v)
+		}
+	}
+	if len(rest.changes) == 0 && len(rest.videos) == 0 {
+		return nil
+	}
+	return rest
+}
+
+// closeReplace updates the listed descriptions once a replacement or its
+// undo was saved, and returns to the list. A replacement becomes the one
+// that can be undone; recordings it could not be saved to stay marked.
+func (h *HistoryModel) closeReplace(msg bulkOperationClosedMsg) tea.Cmd {
+	op := h.bulkOp
+	h.bulkOp = nil
+	run := h.replace.run
+	h.replace.run = nil
+	if !msg.ran || run == nil {
+		return nil
+	}
+
+	run.mu.Lock()
+	defer run.mu.Unlock()
+	saved := map[string]string{}
+	for _, c := range run.changes {
+		saved[c.Folder] = c.After
+	}
+	for i := range h.recordings {
+		if desc, ok := saved[h.recordings[i].Files.FolderPath]; ok {
+			h.recordings[i].Metadata.Description = desc
+		}
+	}
+	h.rebuildSearchIndex()
+	h.replace = nil
+	h.mode = HistoryListMode
+
+	if run.undo {
+		if lastDescriptionReplace != nil {
+			lastDescriptionReplace = lastDescriptionReplace.without(run)
+		}
+		return h.showNotice(fmt.Sprintf("Restored %d description(s)", len(run.changes)), msg.failed > 0)
+	}
+
+	if len(run.changes) > 0 || len(run.videos) > 0 {
+		lastDescriptionReplace = run
+	}
+	for i, status := range op.Statuses() {
+		if status == BulkItemDone {
+			delete(h.selected, op.targets[i].Data.(replaceTarget).change.Folder)
+		}
+	}
+	text := fmt.Sprintf("Replaced text in %d description(s)", len(run.changes))
+	if len(run.videos) > 0 {
+		text += fmt.Sprintf(" and %d video(s)", len(run.videos))
+	}
+	return h.showNotice(text+" • F then ctrl+z: undo", msg.failed > 0)
+}
+
+// updateDescriptionReplaceMode handles input in the search and replace
+// form and its preview, and passes it to the bulk operation once applied
+func (h *HistoryModel) updateDescriptionReplaceMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return h, tea.Quit
+	}
+	if h.bulkOp != nil {
+		var cmd tea.Cmd
+		h.bulkOp, cmd = h.bulkOp.Update(msg)
+		return h, cmd
+	}
+
+	r := h.replace
+	if r.changes != nil {
+		switch msg.String() {
+		case "esc":
+			r.changes = nil
+		case "up", "k":
+			if r.scroll > 0 {
+				r.scroll--
+			}
+		case "down", "j":
+			if r.scroll < len(r.changes)-1 {
+				r.scroll++
+			}
+		case "y", "enter":
+			return h, h.applyReplace()
+		}
+		return h, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		h.replace = nil
+		h.mode = HistoryListMode
+		return h, nil
+
+	case "enter":
+		h.previewReplace()
+		return h, nil
+
+	case "ctrl+z":
+		h.undoReplace()
+		return h, nil
+
+	case "tab", "down":
+		r.setFocus(r.focus + 1)
+		return h, nil
+
+	case "shift+tab", "up":
+		r.setFocus(r.focus - 1)
+		return h, nil
+
+	case " ", "left", "right":
+		if r.focus == replaceYouTube {
+			r.youtube = r.connected && !r.youtube
+			return h, nil
+		}
+	}
+
+	if input := r.input(); input != nil {
+		var cmd tea.Cmd
+		*input, cmd = input.Update(msg)
+		return h, cmd
+	}
+	return h, nil
+}
+
+// renderDescriptionReplaceView renders the search and replace form, the
+// preview of its changes, or the progress of saving them
+func (h *HistoryModel) renderDescriptionReplaceView() string {
+	if h.bulkOp != nil {
+		return h.bulkOp.View()
+	}
+	if h.replace.changes != nil {
+		return h.renderReplacePreview()
+	}
+	header := RenderHeader("Replace in Descriptions")
+
+	r := h.replace
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	labelStyle := lipgloss.NewStyle().Foreground(ColorGray).Width(16)
+	activeLabelStyle := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true).Width(16)
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+
+	label := func(field replaceField, text string) string {
+		if r.focus == field {
+			return activeLabelStyle.Render(text)
+		}
+		return labelStyle.Render(text)
+	}
+
+	youtubeValue := "no"
+	switch {
+	case !r.connected:
+		youtubeValue = "no (YouTube is not connected)"
+	case r.youtube:
+		youtubeValue = "yes, also update the videos"
+	}
+	if r.focus == replaceYouTube {
+		youtubeValue = "◀ " + youtubeValue + " ▶"
+	}
+
+	rows := []string{
+		grayStyle.Render(fmt.Sprintf("Replaces text in the descriptions of the %d selected recording(s).", len(h.selectedRecordings()))),
+		"",
+		label(replaceSearch, "Find:") + r.search.View(),
+		label(replaceWith, "Replace with:") + r.replace.View(),
+		label(replaceYouTube, "Update YouTube:") + valueStyle.Render(youtubeValue),
+		"",
+		grayStyle.Render("The search is case sensitive. The changes are shown before anything is saved."),
+	}
+	if r.err != "" {
+		rows = append(rows, "", lipgloss.NewStyle().Foreground(ColorRed).Render(r.err))
+	}
+
+	help := "tab/↑/↓: field • space: toggle • enter: preview • esc: cancel"
+	if last := lastDescriptionReplace; last != nil {
+		undo := fmt.Sprintf("Last replacement: %q → %q in %d description(s)", last.replacement.Search, last.replacement.Replace, len(last.changes))
+		if len(last.videos) > 0 {
+			undo += fmt.Sprintf(" and %d video(s)", len(last.videos))
+		}
+		rows = append(rows, "", grayStyle.Render(truncateStr(undo, h.width-4)))
+		help = "tab/↑/↓: field • space: toggle • enter: preview • ctrl+z: undo last • esc: cancel"
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	footer := RenderHelpFooter(help, h.width)
+	return LayoutWithHeaderFooter(header, content, footer, h.width, h.height)
+}
+
+// renderReplacePreview lists each description the replacement changes,
+// with the changed text before and after
+func (h *HistoryModel) renderReplacePreview() string {
+	header := RenderHeader("Preview Replacement")
+
+	r := h.replace
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	titleStyle := lipgloss.NewStyle().Foreground(ColorWhite).Bold(true)
+	beforeStyle := lipgloss.NewStyle().Foreground(ColorRed)
+	afterStyle := lipgloss.NewStyle().Foreground(ColorGreen)
+
+	occurrences, videos := 0, 0
+	for _, c := range r.changes {
+		occurrences += c.Count
+		if r.youtube {
+			videos += len(h.youtubeVideos(c.Folder))
+		}
+	}
+	summary := fmt.Sprintf("%d occurrence(s) in %d of %d selected description(s)", occurrences, len(r.changes), len(h.selectedRecordings()))
+	if videos > 0 {
+		summary += fmt.Sprintf(", and %d video(s) on YouTube", videos)
+	}
+	rows := []string{grayStyle.Render(summary), ""}
+
+	// Each change takes four lines
+	width := max(h.width-8, 20)
+	visible := max((h.height-10)/4, 1)
+	end := min(r.scroll+visible, len(r.changes))
+	for _, c := range r.changes[r.scroll:end] {
+		before, after := c.Excerpt(replaceExcerptContext)
+		rows = append(rows,
+			titleStyle.Render(truncateStr(fmt.Sprintf("%s (%d×)", c.Title, c.Count), width)),
+			beforeStyle.Render("- "+truncateStr(before, width-2)),
+			afterStyle.Render("+ "+truncateStr(after, width-2)),
+			"",
+		)
+	}
+	if end < len(r.changes) {
+		rows = append(rows, grayStyle.Render(fmt.Sprintf("… %d more", len(r.changes)-end)))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	footer := RenderHelpFooter("↑/↓: scroll • y/enter: apply • esc: back", h.width)
+	return LayoutWithHeaderFooter(header, content, footer, h.width, h.height)
+}
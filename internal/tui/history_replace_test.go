@@ -0,0 +1,112 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// runBulkOp feeds the commands of a bulk operation back to the history
+// until it is done
+func runBulkOp(h *HistoryModel, cmd tea.Cmd) {
+	for cmd != nil {
+		_, cmd = h.Update(cmd())
+	}
+}
+
+func TestHistoryDescriptionReplace_PreviewsAppliesAndUndoes(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Cleanup(func() { lastDescriptionReplace = nil })
+
+	h := historyWithRecordings("QGIS intro", "GeoServer", "PostGIS")
+	descriptions := []string{
+		"Slides at https://old.example.org/qgis",
+		"No links",
+		"Data: https://old.example.org/data and https://old.example.org/more",
+	}
+	for i := range h.recordings {
+		h.recordings[i].Files.FolderPath = t.TempDir()
+		h.recordings[i].Metadata.Description = descriptions[i]
+		if err := h.recordings[i].Save(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	h.rebuildSearchIndex()
+	for range h.recordings {
+		h.Update(bulkKey(" "))
+	}
+
+	h.Update(bulkKey("F"))
+	if h.mode != HistoryDescriptionReplaceMode {
+		t.Fatalf("expected F to open the search and replace, mode %v", h.mode)
+	}
+	h.Update(bulkKey("https://old.example.org"))
+	h.Update(tea.KeyMsg{Type: tea.KeyTab})
+	h.Update(bulkKey("https://kartoza.com"))
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if len(h.replace.changes) != 2 {
+		t.Fatalf("previewed %d changes (%s), want the 2 descriptions with the link", len(h.replace.changes), h.replace.err)
+	}
+	view := h.View()
+	if !strings.Contains(view, "3 occurrence(s) in 2 of 3") || !strings.Contains(view, "+ Slides at https://kartoza.com/qgis") {
+		t.Error("expected the preview to show the changed text")
+	}
+	if saved, _ := models.LoadRecordingInfo(h.recordings[0].Files.FolderPath); saved.Metadata.Description != descriptions[0] {
+		t.Fatal("expected nothing to be saved before the preview is applied")
+	}
+
+	_, cmd := h.Update(bulkKey("y"))
+	runBulkOp(h, cmd)
+	if h.bulkOp == nil || h.bulkOp.Step() != BulkStepSummary {
+		t.Fatal("expected the summary once the descriptions were saved")
+	}
+	_, cmd = h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	h.Update(cmd())
+	if h.mode != HistoryListMode || !strings.Contains(h.copyNotice, "2 description(s)") {
+		t.Fatalf("mode %v, notice %q; want the list with the replacement reported", h.mode, h.copyNotice)
+	}
+	saved, err := models.LoadRecordingInfo(h.recordings[2].Files.FolderPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Data: https://kartoza.com/data and https://kartoza.com/more"; saved.Metadata.Description != want || h.recordings[2].Metadata.Description != want {
+		t.Errorf("description = %q, want %q saved and listed", saved.Metadata.Description, want)
+	}
+	if len(h.selected) != 1 || !h.selected[h.recordings[1].Files.FolderPath] {
+		t.Errorf("selected %v, want only the unchanged recording still marked", h.selected)
+	}
+
+	// Undo, after leaving and opening the history again
+	original := map[string]string{}
+	for i, rec := range h.recordings {
+		original[rec.Files.FolderPath] = descriptions[i]
+	}
+	recordings := h.recordings
+	h = NewHistoryModel()
+	h.Update(recordingsLoadedMsg{recordings: recordings})
+	h.Update(bulkKey("F"))
+	h.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	if h.bulkOp == nil || h.bulkOp.Step() != BulkStepConfirm {
+		t.Fatal("expected ctrl+z to ask to confirm the undo")
+	}
+	_, cmd = h.Update(bulkKey("y"))
+	runBulkOp(h, cmd)
+	_, cmd = h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	h.Update(cmd())
+	for _, rec := range h.recordings {
+		saved, err := models.LoadRecordingInfo(rec.Files.FolderPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := original[rec.Files.FolderPath]
+		if saved.Metadata.Description != want || rec.Metadata.Description != want {
+			t.Errorf("description = %q, want %q restored", saved.Metadata.Description, want)
+		}
+	}
+	if lastDescriptionReplace != nil {
+		t.Error("expected nothing left to undo")
+	}
+}
@@ -0,0 +1,127 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func historyWithRecordings(titles ...string) *HistoryModel {
+	recordings := make([]models.RecordingInfo, len(titles))
+	for i, title := range titles {
+		recordings[i].Metadata.Title = title
+		recordings[i].Files.FolderPath = "/videos/" + title
+	}
+	h := NewHistoryModel()
+	h.width, h.height = 120, 40
+	h.Update(recordingsLoadedMsg{recordings: recordings})
+	return h
+}
+
+func TestHistorySearch_FiltersAndClears(t *testing.T) {
+	h := historyWithRecordings("QGIS intro", "Sprint review", "QGIS plugins")
+
+	h.Update(bulkKey("/"))
+	if !h.searching {
+		t.Fatal("expected / to open the filter input")
+	}
+	h.Update(bulkKey("qgis"))
+	if h.visibleCount() != 2 {
+		t.Fatalf("expected 2 matches, got %d", h.visibleCount())
+	}
+
+	h.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if rec := h.visibleRecording(h.cursor); rec == nil || rec.Metadata.Title != "QGIS plugins" {
+		t.Fatalf("cursor on %v, want QGIS plugins", rec)
+	}
+
+	// Enter keeps the filter, esc then clears it instead of leaving
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if h.searching || h.visibleCount() != 2 {
+		t.Fatalf("expected the filter to stay after enter, searching=%v count=%d", h.searching, h.visibleCount())
+	}
+	if _, cmd := h.Update(tea.KeyMsg{Type: tea.KeyEsc}); cmd != nil {
+		t.Error("esc with an active filter should not leave the history")
+	}
+	if h.filteredRecordings != nil || h.visibleCount() != 3 || h.cursor != 0 {
+		t.Errorf("expected all 3 recordings after esc, got %d (cursor %d)", h.visibleCount(), h.cursor)
+	}
+}
+
+func TestHistorySearch_NoMatches(t *testing.T) {
+	h := historyWithRecordings("QGIS intro")
+
+	h.Update(bulkKey("/"))
+	h.Update(bulkKey("kubernetes"))
+	if h.visibleCount() != 0 || h.visibleRecording(h.cursor) != nil {
+		t.Fatalf("expected no matches, got %d", h.visibleCount())
+	}
+	_ = h.View() // Must render without an index out of range
+}
+
+func TestHistorySearch_MatchesFolderName(t *testing.T) {
+	h := historyWithRecordings("QGIS intro", "Sprint review")
+	h.recordings[1].Files.FolderPath = "/videos/014-standup-take-2"
+	h.rebuildSearchIndex()
+
+	h.Update(bulkKey("/"))
+	h.Update(bulkKey("Take-2"))
+	if rec := h.visibleRecording(0); h.visibleCount() != 1 || rec == nil || rec.Metadata.Title != "Sprint review" {
+		t.Fatalf("expected only the renamed folder to match, got %d", h.visibleCount())
+	}
+	if view := h.View(); !strings.Contains(view, "Recording 1 of 1 (filtered from 2)") {
+		t.Error("expected the position line to count the filtered recordings")
+	}
+}
+
+func TestHistorySearch_SavedFilter(t *testing.T) {
+	h := historyWithRecordings("QGIS intro", "Broken QGIS capture", "Broken review")
+	h.recordings[1].Status = models.StatusFailed
+	h.recordings[2].Status = models.StatusFailed
+	h.rebuildSearchIndex()
+
+	h.applySavedFilter(config.SavedFilter{Name: "Failed", Status: models.StatusFailed})
+	if h.visibleCount() != 2 {
+		t.Fatalf("expected 2 failed recordings, got %d", h.visibleCount())
+	}
+
+	// Typing narrows the saved filter further
+	h.Update(bulkKey("/"))
+	h.Update(bulkKey("qgis"))
+	if rec := h.visibleRecording(0); h.visibleCount() != 1 || rec.Metadata.Title != "Broken QGIS capture" {
+		t.Fatalf("expected only the failed QGIS recording, got %d", h.visibleCount())
+	}
+
+	h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if h.activeFilter != nil || h.visibleCount() != 3 {
+		t.Errorf("expected esc to clear the saved filter, got %d recordings", h.visibleCount())
+	}
+}
+
+func TestSavedFiltersModel_NewFromCurrent(t *testing.T) {
+	current := config.SavedFilter{Text: "qgis", Status: models.StatusFailed}
+	m := NewSavedFiltersModel(nil, models.DefaultTopics(), current)
+
+	m.Update(bulkKey("n"))
+	if !m.editing {
+		t.Fatal("expected n to open a new filter")
+	}
+	if result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter}); result != SavedFiltersOpen || m.editError == "" {
+		t.Fatal("expected a filter without a name to be rejected")
+	}
+	m.Update(bulkKey("Broken QGIS"))
+	if result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter}); result != SavedFiltersChanged {
+		t.Fatalf("expected the new filter to be stored, got %v", result)
+	}
+
+	want := config.SavedFilter{Name: "Broken QGIS", Text: "qgis", Status: models.StatusFailed}
+	if got := m.Filters(); len(got) != 1 || got[0] != want {
+		t.Fatalf("Filters() = %+v, want [%+v]", got, want)
+	}
+	if result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter}); result != SavedFiltersApplied || m.Selected() != want {
+		t.Errorf("expected enter to apply the filter, got %v", result)
+	}
+}
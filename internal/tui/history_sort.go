@@ -0,0 +1,131 @@
+package tui
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// HistorySort is the key the history list is sorted by
+type HistorySort int
+
+const (
+	HistorySortDate HistorySort = iota
+	HistorySortSize
+	HistorySortDuration
+	HistorySortStatus
+	HistorySortTitle
+)
+
+// historySorts is the order s cycles through the sort keys
+var historySorts = []HistorySort{HistorySortDate, HistorySortDuration, HistorySortSize, HistorySortTitle, HistorySortStatus}
+
+// Label returns the name of the sort key shown in the position line
+func (s HistorySort) Label() string {
+	switch s {
+	case HistorySortSize:
+		return "size"
+	case HistorySortDuration:
+		return "duration"
+	case HistorySortStatus:
+		return "status"
+	case HistorySortTitle:
+		return "title"
+	}
+	return "date"
+}
+
+// defaultAscending returns the direction a sort key starts in: A to Z for
+// titles, newest, largest or longest first otherwise
+func (s HistorySort) defaultAscending() bool {
+	return s == HistorySortTitle
+}
+
+// statusRank orders the statuses for sorting, finished recordings lowest so
+// the ones needing attention come first when descending
+func statusRank(status string) int {
+	switch status {
+	case models.StatusCompleted:
+		return 0
+	case models.StatusFailed:
+		return 2
+	case models.StatusNeedsMetadata:
+		return 3
+	case models.StatusProcessing:
+		return 4
+	case models.StatusPaused:
+		return 5
+	case models.StatusRecording:
+		return 6
+	}
+	return 1
+}
+
+// compareRecordings returns a negative number if a sorts before b in
+// ascending order of key, a positive number if after and 0 if equal
+func compareRecordings(a, b *models.RecordingInfo, key HistorySort) int {
+	cmp := func(x, y int64) int {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	switch key {
+	case HistorySortSize:
+		return cmp(a.Files.TotalSize, b.Files.TotalSize)
+	case HistorySortDuration:
+		return cmp(int64(a.Duration), int64(b.Duration))
+	case HistorySortStatus:
+		return cmp(int64(statusRank(a.Status)), int64(statusRank(b.Status)))
+	case HistorySortTitle:
+		return strings.Compare(strings.ToLower(a.Metadata.Title), strings.ToLower(b.Metadata.Title))
+	}
+	return cmp(a.StartTime.UnixNano(), b.StartTime.UnixNano())
+}
+
+// sortRecordings sorts recordings in place by key. Recordings with equal keys
+// are ordered newest first and otherwise keep their order, so the result is
+// predictable.
+func sortRecordings(recordings []models.RecordingInfo, key HistorySort, ascending bool) {
+	sort.SliceStable(recordings, func(i, j int) bool {
+		a, b := &recordings[i], &recordings[j]
+		if c := compareRecordings(a, b, key); c != 0 {
+			return (c < 0) == ascending
+		}
+		return compareRecordings(a, b, HistorySortDate) > 0
+	})
+}
+
+// sortLabel describes the current sort for the position line, e.g.
+// "sorted by size ↓"
+func (h *HistoryModel) sortLabel() string {
+	arrow := "↓"
+	if h.sortAscending {
+		arrow = "↑"
+	}
+	return "sorted by " + h.sortKey.Label() + " " + arrow
+}
+
+// resort sorts the recordings by the current key, rebuilds the search index
+// and moves the cursor to the top
+func (h *HistoryModel) resort() {
+	sortRecordings(h.recordings, h.sortKey, h.sortAscending)
+	h.cursor = 0
+	h.rebuildSearchIndex()
+}
+
+// cycleSortKey switches to the next sort key, in its default direction
+func (h *HistoryModel) cycleSortKey() {
+	for i, key := range historySorts {
+		if key == h.sortKey {
+			h.sortKey = historySorts[(i+1)%len(historySorts)]
+			break
+		}
+	}
+	h.sortAscending = h.sortKey.defaultAscending()
+	h.resort()
+}
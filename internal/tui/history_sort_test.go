@@ -0,0 +1,102 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// titles returns the titles of the recordings in list order
+func titles(recordings []models.RecordingInfo) string {
+	names := make([]string, len(recordings))
+	for i, rec := range recordings {
+		names[i] = rec.Metadata.Title
+	}
+	return strings.Join(names, ",")
+}
+
+func TestSortRecordings(t *testing.T) {
+	start := time.Date(2026, time.March, 1, 10, 0, 0, 0, time.UTC)
+	rec := func(title string, days int, size int64, status string) models.RecordingInfo {
+		var r models.RecordingInfo
+		r.Metadata.Title = title
+		r.StartTime = start.AddDate(0, 0, days)
+		r.Files.TotalSize = size
+		r.Duration = time.Duration(size) * time.Second
+		r.Status = status
+		return r
+	}
+	recordings := []models.RecordingInfo{
+		rec("a", 0, 20, models.StatusCompleted),
+		rec("b", 1, 10, models.StatusFailed),
+		rec("c", 2, 20, models.StatusCompleted),
+		rec("d", 3, 30, models.StatusRecording),
+	}
+
+	tests := []struct {
+		key       HistorySort
+		ascending bool
+		want      string
+	}{
+		{HistorySortDate, false, "d,c,b,a"},
+		{HistorySortDate, true, "a,b,c,d"},
+		// Equal sizes are ordered newest first in both directions
+		{HistorySortSize, false, "d,c,a,b"},
+		{HistorySortSize, true, "b,c,a,d"},
+		{HistorySortDuration, false, "d,c,a,b"},
+		{HistorySortStatus, false, "d,b,c,a"},
+		{HistorySortTitle, true, "a,b,c,d"},
+		{HistorySortTitle, false, "d,c,b,a"},
+	}
+	for _, tt := range tests {
+		sortRecordings(recordings, tt.key, tt.ascending)
+		if got := titles(recordings); got != tt.want {
+			t.Errorf("sort by %s (ascending %v) = %s, want %s", tt.key.Label(), tt.ascending, got, tt.want)
+		}
+	}
+}
+
+func TestHistorySort_CycleAndReverse(t *testing.T) {
+	h := historyWithRecordings("small", "large", "medium")
+	for i, size := range []int64{10, 30, 20} {
+		h.recordings[i].Files.TotalSize = size
+		h.recordings[i].Duration = time.Duration(size) * time.Minute
+	}
+	h.cursor = 2
+
+	h.Update(bulkKey("s"))
+	if h.sortKey != HistorySortDuration || h.cursor != 0 || titles(h.recordings) != "large,medium,small" {
+		t.Fatalf("expected longest first with the cursor at the top, got %s (cursor %d)", titles(h.recordings), h.cursor)
+	}
+	if !strings.Contains(h.View(), "Recording 1 of 3 · sorted by duration ↓") {
+		t.Error("expected the position line to show the sort")
+	}
+
+	h.Update(bulkKey("S"))
+	if !h.sortAscending || titles(h.recordings) != "small,medium,large" {
+		t.Errorf("expected S to reverse the order, got %s", titles(h.recordings))
+	}
+
+	// The index follows the new order
+	h.Update(bulkKey("/"))
+	h.Update(bulkKey("m"))
+	if rec := h.visibleRecording(0); rec == nil || rec.Metadata.Title != "small" {
+		t.Errorf("expected the filter to list matches in sort order, got %v", rec)
+	}
+
+	// Titles start A to Z, whatever the previous direction
+	h.cycleSortKey()
+	h.cycleSortKey()
+	if h.sortKey != HistorySortTitle || !h.sortAscending || titles(h.recordings) != "large,medium,small" {
+		t.Errorf("expected titles A to Z, got %s by %s", titles(h.recordings), h.sortLabel())
+	}
+
+	for range historySorts[3:] {
+		h.cycleSortKey()
+	}
+	if h.sortKey != HistorySortDate || h.sortAscending {
+		t.Errorf("expected s to cycle back to newest first, got %s", h.sortLabel())
+	}
+}
@@ -0,0 +1,332 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
+)
+
+// remoteVideo is the state of an uploaded video on YouTube
+type remoteVideo struct {
+	youtube.VideoState
+	PlaylistID   string // Stored playlist if it still contains the video, else ""
+	PlaylistName string
+
+	playlistUnknown bool // The playlist could not be checked
+}
+
+// youtubeSyncedMsg carries the state of the uploaded videos on YouTube
+type youtubeSyncedMsg struct {
+	videos  map[string]remoteVideo // By video ID, without the videos that were not found
+	checked int
+	skipped int // Videos of channels without a configured account
+	errs    []error
+}
+
+// youtubeChange is a difference between the stored metadata of an upload
+// and YouTube
+type youtubeChange struct {
+	folderPath string
+	recording  string // Title of the recording, for display
+	videoID    string
+	field      string // "Title", "Privacy" or "Playlist"
+	old, new   string
+	playlistID string // New playlist ID of a playlist change
+}
+
+// youtubeChanges compares the stored metadata of the recordings with the
+// videos on YouTube. The recording title is compared with the primary upload
+// only; privacy and playlist with every upload.
+func youtubeChanges(recordings []models.RecordingInfo, videos map[string]remoteVideo) []youtubeChange {
+	var changes []youtubeChange
+	for _, rec := range recordings {
+		add := func(videoID, field, old, new, playlistID string) {
+			changes = append(changes, youtubeChange{
+				folderPath: rec.Files.FolderPath,
+				recording:  rec.Metadata.Title,
+				videoID:    videoID,
+				field:      field,
+				old:        old,
+				new:        new,
+				playlistID: playlistID,
+			})
+		}
+
+		if yt := rec.Metadata.PrimaryYouTube(); yt != nil {
+			if remote, ok := videos[yt.VideoID]; ok && remote.Title != "" && remote.Title != rec.Metadata.Title {
+				add(yt.VideoID, "Title", rec.Metadata.Title, remote.Title, "")
+			}
+		}
+		for _, upload := range rec.Metadata.AllYouTubeUploads() {
+			remote, ok := videos[upload.VideoID]
+			if !ok {
+				continue
+			}
+			if remote.Privacy != "" && remote.Privacy != upload.Privacy {
+				add(upload.VideoID, "Privacy", upload.Privacy, remote.Privacy, "")
+			}
+			if remote.playlistUnknown {
+				continue
+			}
+			if remote.PlaylistID != upload.PlaylistID || (remote.PlaylistID != "" && remote.PlaylistName != upload.PlaylistName) {
+				add(upload.VideoID, "Playlist", upload.PlaylistName, remote.PlaylistName, remote.PlaylistID)
+			}
+		}
+	}
+	return changes
+}
+
+// applyYouTubeChange updates the stored metadata of a recording to match YouTube
+func applyYouTubeChange(rec *models.RecordingInfo, c youtubeChange) {
+	switch c.field {
+	case "Title":
+		rec.Metadata.Title = c.new
+	case "Privacy":
+		rec.Metadata.SetYouTubePrivacy(c.videoID, c.new)
+	case "Playlist":
+		rec.Metadata.SetYouTubePlaylist(c.videoID, c.playlistID, c.new)
+	}
+}
+
+// startYouTubeSync opens the sync view and fetches the title, privacy and
+// playlist of every uploaded video with the account of its channel
+func (h *HistoryModel) startYouTubeSync() tea.Cmd {
+	h.mode = HistoryYouTubeSyncMode
+	h.syncResult = nil
+	h.syncChanges = nil
+	h.syncApplied = -1
+	byChannel := uploadsByChannel(h.recordings)
+	if len(byChannel) == 0 {
+		h.syncResult = &youtubeSyncedMsg{}
+		return nil
+	}
+
+	// Stored playlist of each video, to check it still contains the video
+	playlists := map[string]string{}
+	for _, rec := range h.recordings {
+		for _, upload := range rec.Metadata.AllYouTubeUploads() {
+			if upload.PlaylistID != "" {
+				playlists[upload.VideoID] = upload.PlaylistID
+			}
+		}
+	}
+
+	h.syncRunning = true
+	retry := &retryNotice{}
+	h.youtubeActionRetry = retry
+	return func() tea.Msg {
+		ctx := context.Background()
+		result := youtubeSyncedMsg{videos: map[string]remoteVideo{}}
+		cfg, err := config.Load()
+		if err != nil {
+			result.errs = append(result.errs, err)
+			return result
+		}
+
+		channels := make([]string, 0, len(byChannel))
+		for channelID := range byChannel {
+			channels = append(channels, channelID)
+		}
+		sort.Strings(channels)
+
+		for _, channelID := range channels {
+			ids := byChannel[channelID]
+			// Another account cannot see private videos or playlists
+			if channelID != "" && cfg.YouTube.GetAccountByChannelID(channelID) == nil {
+				result.skipped += len(ids)
+				continue
+			}
+			uploader, err := channelUploader(ctx, channelID, retry)
+			if err != nil {
+				result.errs = append(result.errs, err)
+				continue
+			}
+			states, err := uploader.VideoStates(ctx, ids)
+			if err != nil {
+				result.errs = append(result.errs, err)
+				continue
+			}
+			result.checked += len(ids)
+
+			// Playlist names are only needed when a video is in one
+			var names map[string]string
+			for _, id := range ids {
+				state, ok := states[id]
+				if !ok {
+					continue
+				}
+				video := remoteVideo{VideoState: state}
+				if playlistID := playlists[id]; playlistID != "" {
+					if names == nil {
+						names = map[string]string{}
+						list, err := uploader.ListPlaylists(ctx)
+						if err != nil {
+							result.errs = append(result.errs, err)
+						}
+						for _, p := range list {
+							names[p.ID] = p.Title
+						}
+					}
+					name, ok := names[playlistID]
+					switch {
+					case ok:
+						contains, err := uploader.PlaylistContains(ctx, playlistID, id)
+						if err != nil {
+							result.errs = append(result.errs, err)
+							video.playlistUnknown = true
+						} else if contains {
+							video.PlaylistID, video.PlaylistName = playlistID, name
+						}
+					case len(names) == 0:
+						// The playlists could not be listed
+						video.playlistUnknown = true
+					}
+				}
+				result.videos[id] = video
+			}
+		}
+		return result
+	}
+}
+
+// handleYouTubeSynced compares the fetched videos with the stored metadata
+func (h *HistoryModel) handleYouTubeSynced(msg youtubeSyncedMsg) {
+	h.syncRunning = false
+	h.syncResult = &msg
+	h.syncChanges = youtubeChanges(h.recordings, msg.videos)
+}
+
+// applyYouTubeSync stores the changes made on YouTube in the recordings
+func (h *HistoryModel) applyYouTubeSync() {
+	updated := 0
+	for i := range h.recordings {
+		rec := &h.recordings[i]
+		changed := false
+		for _, c := range h.syncChanges {
+			if c.folderPath == rec.Files.FolderPath {
+				applyYouTubeChange(rec, c)
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		if err := rec.Save(); err != nil {
+			h.youtubeActionError = fmt.Sprintf("Failed to save %s: %v", rec.Metadata.Title, err)
+			continue
+		}
+		updated++
+	}
+	h.syncApplied = updated
+	h.rebuildSearchIndex()
+}
+
+// updateYouTubeSyncMode handles input in the YouTube sync view
+func (h *HistoryModel) updateYouTubeSyncMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return h, tea.Quit
+
+	case "esc", "q", "n":
+		if !h.syncRunning {
+			h.syncResult = nil
+			h.syncChanges = nil
+			h.youtubeActionError = ""
+			h.mode = HistoryListMode
+		}
+
+	case "y", "Y":
+		if !h.syncRunning && h.syncApplied < 0 && len(h.syncChanges) > 0 {
+			h.applyYouTubeSync()
+		}
+	}
+	return h, nil
+}
+
+// renderYouTubeSyncView renders the changes made on YouTube
+func (h *HistoryModel) renderYouTubeSyncView() string {
+	header := RenderHeader("Sync From YouTube")
+
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+	oldStyle := lipgloss.NewStyle().Foreground(ColorRed)
+	newStyle := lipgloss.NewStyle().Foreground(ColorGreen)
+	warnStyle := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true)
+
+	var rows []string
+	helpText := "esc: back"
+
+	if h.syncRunning {
+		rows = append(rows, grayStyle.Render("Fetching the uploaded videos from YouTube..."))
+		if retry := h.youtubeActionRetry.String(); retry != "" {
+			rows = append(rows, renderRetryNotice(retry))
+		}
+	} else if r := h.syncResult; r != nil {
+		switch {
+		case r.checked == 0 && r.skipped == 0 && len(r.errs) == 0:
+			rows = append(rows, grayStyle.Italic(true).Render("No recordings have been uploaded to YouTube"))
+		case len(h.syncChanges) == 0:
+			rows = append(rows, lipgloss.NewStyle().Foreground(ColorGreen).Bold(true).Render(
+				fmt.Sprintf("The %d checked video(s) match the stored metadata", len(r.videos))))
+		default:
+			rows = append(rows, warnStyle.Render(
+				fmt.Sprintf("%d change(s) made on YouTube:", len(h.syncChanges))))
+		}
+
+		rows = append(rows, "")
+		limit := max(h.height-18, 3)
+		for i, c := range h.syncChanges {
+			if i == limit {
+				rows = append(rows, grayStyle.Render(fmt.Sprintf("... and %d more change(s)", len(h.syncChanges)-limit)))
+				break
+			}
+			old, new := c.old, c.new
+			if old == "" {
+				old = "(none)"
+			}
+			if new == "" {
+				new = "(none)"
+			}
+			rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+				valueStyle.Render(fmt.Sprintf("%-30s %-9s ", truncateStr(c.recording, 30), c.field+":")),
+				oldStyle.Render(truncateStr(old, 30)),
+				grayStyle.Render(" → "),
+				newStyle.Render(truncateStr(new, 30)),
+			))
+		}
+
+		if missing := r.checked - len(r.videos); missing > 0 {
+			rows = append(rows, grayStyle.Render(
+				fmt.Sprintf("%d video(s) not found on YouTube, press V in the list to verify uploads", missing)))
+		}
+		if r.skipped > 0 {
+			rows = append(rows, grayStyle.Render(
+				fmt.Sprintf("%d video(s) not checked: their channel has no account set up here", r.skipped)))
+		}
+		for _, err := range r.errs {
+			rows = append(rows, lipgloss.NewStyle().Foreground(ColorRed).Render(truncateStr(youtube.FriendlyError(err), h.width-4)))
+		}
+
+		switch {
+		case h.syncApplied >= 0:
+			rows = append(rows, "", lipgloss.NewStyle().Foreground(ColorGreen).Bold(true).Render(
+				fmt.Sprintf("Updated %d recording(s)", h.syncApplied)))
+		case len(h.syncChanges) > 0:
+			rows = append(rows, "", warnStyle.Render("Update the stored metadata? (y/n)"))
+			helpText = "y: update • esc: discard"
+		}
+		if h.youtubeActionError != "" {
+			rows = append(rows, lipgloss.NewStyle().Foreground(ColorRed).Render(truncateStr(h.youtubeActionError, h.width-4)))
+		}
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	footer := RenderHelpFooter(helpText, h.width)
+	return LayoutWithHeaderFooter(header, content, footer, h.width, h.height)
+}
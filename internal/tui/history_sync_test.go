@@ -0,0 +1,70 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
+)
+
+func TestYouTubeChanges(t *testing.T) {
+	h := historyWithRecordings("QGIS intro", "Sprint review")
+	h.recordings[0].Metadata.YouTubeUploads = []models.YouTubeUpload{{
+		VideoID: "a1", Privacy: "unlisted", PlaylistID: "PL1", PlaylistName: "Tutorials",
+	}}
+	h.recordings[1].Metadata.YouTubeUploads = []models.YouTubeUpload{{VideoID: "b1", Privacy: "private"}}
+
+	videos := map[string]remoteVideo{
+		// Renamed, made public and removed from its playlist
+		"a1": {VideoState: youtube.VideoState{Title: "QGIS introduction", Privacy: "public"}},
+		// Unchanged, the playlist could not be checked
+		"b1": {VideoState: youtube.VideoState{Title: "Sprint review", Privacy: "private"}, playlistUnknown: true},
+	}
+	changes := youtubeChanges(h.recordings, videos)
+	if len(changes) != 3 {
+		t.Fatalf("expected 3 changes, got %+v", changes)
+	}
+	want := []struct{ field, old, new string }{
+		{"Title", "QGIS intro", "QGIS introduction"},
+		{"Privacy", "unlisted", "public"},
+		{"Playlist", "Tutorials", ""},
+	}
+	for i, w := range want {
+		if c := changes[i]; c.field != w.field || c.old != w.old || c.new != w.new || c.videoID != "a1" {
+			t.Errorf("change %d = %+v, want %+v", i, c, w)
+		}
+	}
+}
+
+func TestHistoryYouTubeSync_AppliesChanges(t *testing.T) {
+	h := historyWithRecordings("QGIS intro")
+	h.recordings[0].Files.FolderPath = t.TempDir()
+	h.recordings[0].Metadata.YouTubeUploads = []models.YouTubeUpload{{VideoID: "a1", Privacy: "unlisted"}}
+
+	if _, cmd := h.Update(bulkKey("Y")); cmd == nil || h.mode != HistoryYouTubeSyncMode {
+		t.Fatalf("expected Y to start syncing, mode %v", h.mode)
+	}
+	h.Update(youtubeSyncedMsg{checked: 1, videos: map[string]remoteVideo{
+		"a1": {
+			VideoState: youtube.VideoState{Title: "QGIS intro", Privacy: "public"},
+			PlaylistID: "PL1", PlaylistName: "Tutorials",
+		},
+	}})
+	if len(h.syncChanges) != 2 {
+		t.Fatalf("expected privacy and playlist changes, got %+v", h.syncChanges)
+	}
+	if view := h.View(); !strings.Contains(view, "unlisted") || !strings.Contains(view, "public") {
+		t.Error("expected the view to show the old and new privacy")
+	}
+
+	h.Update(bulkKey("y"))
+	yt := h.recordings[0].Metadata.PrimaryYouTube()
+	if h.syncApplied != 1 || yt.Privacy != "public" || yt.PlaylistID != "PL1" || yt.PlaylistName != "Tutorials" {
+		t.Fatalf("expected the changes to be stored, applied %d, got %+v", h.syncApplied, yt)
+	}
+	saved, err := models.LoadRecordingInfo(h.recordings[0].Files.FolderPath)
+	if err != nil || saved.Metadata.PrimaryYouTube().Privacy != "public" {
+		t.Errorf("expected the changes to be saved, err %v", err)
+	}
+}
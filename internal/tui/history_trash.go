@@ -0,0 +1,174 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/retention"
+)
+
+// openTrash lists the deleted recordings in the trash
+func (h *HistoryModel) openTrash() {
+	h.trashCursor = 0
+	h.trashConfirmEmpty = false
+	h.trashNotice = ""
+	h.trashErrors = nil
+	h.loadTrash()
+	h.mode = HistoryTrashMode
+}
+
+// loadTrash reads the trash folder, keeping the cursor in range
+func (h *HistoryModel) loadTrash() {
+	trashed, err := retention.LoadTrash(config.GetDefaultVideosDir())
+	h.trash = trashed
+	if err != nil {
+		h.trashErrors = append(h.trashErrors, err.Error())
+	}
+	if h.trashCursor >= len(h.trash) {
+		h.trashCursor = max(len(h.trash)-1, 0)
+	}
+}
+
+// trashSize returns the total size of the recordings in the trash
+func (h *HistoryModel) trashSize() int64 {
+	var size int64
+	for _, t := range h.trash {
+		if t.Info != nil {
+			size += t.Info.Files.TotalSize
+		}
+	}
+	return size
+}
+
+// updateTrashMode handles input in the trash view
+func (h *HistoryModel) updateTrashMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	if h.trashConfirmEmpty {
+		switch msg.String() {
+		case "ctrl+c":
+			return h, tea.Quit
+		case "y", "Y":
+			removed, freed, errs := retention.EmptyTrash(config.GetDefaultVideosDir())
+			h.trashConfirmEmpty = false
+			h.trashNotice = fmt.Sprintf("Removed %d recording(s), freed %s", removed, models.FormatFileSize(freed))
+			h.trashErrors = nil
+			for _, err := range errs {
+				h.trashErrors = append(h.trashErrors, err.Error())
+			}
+			h.loadTrash()
+		case "esc", "n", "N":
+			h.trashConfirmEmpty = false
+		}
+		return h, nil
+	}
+
+	switch msg.String() {
+	case "ctrl+c":
+		return h, tea.Quit
+
+	case "esc", "q", "T":
+		h.trash = nil
+		h.mode = HistoryListMode
+
+	case "up", "k":
+		if h.trashCursor > 0 {
+			h.trashCursor--
+		}
+
+	case "down", "j":
+		if h.trashCursor < len(h.trash)-1 {
+			h.trashCursor++
+		}
+
+	case "enter", "u":
+		// Restore into the videos folder and reload the history
+		if h.trashCursor < len(h.trash) {
+			t := h.trash[h.trashCursor]
+			h.trashErrors = nil
+			dest, err := retention.Restore(config.GetDefaultVideosDir(), t)
+			if err != nil {
+				h.trashNotice = ""
+				h.trashErrors = []string{err.Error()}
+				return h, nil
+			}
+			h.trashNotice = "Restored " + trashedTitle(t) + " to " + dest
+			h.loadTrash()
+			return h, h.loadRecordings()
+		}
+
+	case "E":
+		if len(h.trash) > 0 {
+			h.trashConfirmEmpty = true
+			h.trashNotice = ""
+		}
+	}
+	return h, nil
+}
+
+// trashedTitle returns the title of a trashed recording, or its folder name
+func trashedTitle(t retention.TrashedRecording) string {
+	if t.Info != nil && t.Info.Metadata.Title != "" {
+		return t.Info.Metadata.Title
+	}
+	return t.OriginalName
+}
+
+// renderTrashView renders the deleted recordings and the trash actions
+func (h *HistoryModel) renderTrashView() string {
+	header := RenderHeader("Trash")
+
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+	selectedStyle := lipgloss.NewStyle().
+		Background(ColorOrange).
+		Foreground(lipgloss.Color("#000000"))
+
+	var rows []string
+	helpText := "↑/↓: navigate • enter/u: restore • E: empty trash • esc: back"
+
+	if len(h.trash) == 0 {
+		rows = append(rows, grayStyle.Italic(true).Render("The trash is empty"))
+		helpText = "esc: back"
+	} else {
+		rows = append(rows, grayStyle.Render(fmt.Sprintf("%d deleted recording(s), %s", len(h.trash), models.FormatFileSize(h.trashSize()))), "")
+
+		// Keep the selection on screen
+		limit := max(h.height-14, 3)
+		start := min(max(h.trashCursor-limit/2, 0), max(len(h.trash)-limit, 0))
+		for i := start; i < len(h.trash) && i < start+limit; i++ {
+			t := h.trash[i]
+			deleted, size := "", ""
+			if !t.TrashedAt.IsZero() {
+				deleted = "deleted " + t.TrashedAt.Format("2006-01-02 15:04")
+			}
+			if t.Info != nil {
+				size = models.FormatFileSize(t.Info.Files.TotalSize)
+			}
+			line := fmt.Sprintf("%-40s %-24s %10s", truncateStr(trashedTitle(t), 40), deleted, size)
+			if i == h.trashCursor {
+				rows = append(rows, selectedStyle.Render("▶ "+line))
+			} else {
+				rows = append(rows, valueStyle.Render("  "+line))
+			}
+		}
+	}
+
+	if h.trashConfirmEmpty {
+		rows = append(rows, "",
+			lipgloss.NewStyle().Foreground(ColorRed).Bold(true).Render(
+				fmt.Sprintf("Permanently delete %d recording(s) and free %s? This cannot be undone. (y/n)", len(h.trash), models.FormatFileSize(h.trashSize()))))
+		helpText = "y: empty trash • n/esc: cancel"
+	}
+	if h.trashNotice != "" {
+		rows = append(rows, "", lipgloss.NewStyle().Foreground(ColorGreen).Bold(true).Render(truncateStr(h.trashNotice, h.width-4)))
+	}
+	for _, err := range h.trashErrors {
+		rows = append(rows, lipgloss.NewStyle().Foreground(ColorRed).Render(truncateStr(err, h.width-4)))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	footer := RenderHelpFooter(helpText, h.width)
+	return LayoutWithHeaderFooter(header, content, footer, h.width, h.height)
+}
@@ -0,0 +1,77 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+)
+
+func TestHistoryTrash_DeleteRestoreAndEmpty(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_VIDEOS_DIR", t.TempDir())
+	videosDir := config.GetDefaultVideosDir()
+	for _, name := range []string{"intro", "review"} {
+		dir := filepath.Join(videosDir, name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		data := []byte(`{"status":"completed","metadata":{"title":"` + name + `"}}`)
+		if err := os.WriteFile(filepath.Join(dir, "recording.json"), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	h := NewHistoryModel()
+	h.width, h.height = 120, 40
+	h.Update(h.loadRecordings()())
+	if len(h.recordings) != 2 {
+		t.Fatalf("expected 2 recordings, got %d", len(h.recordings))
+	}
+
+	// Deleting moves the recording to the trash, out of the history
+	h.Update(bulkKey("d"))
+	h.Update(bulkKey("y"))
+	h.Update(h.loadRecordings()())
+	if len(h.recordings) != 1 {
+		t.Fatalf("expected the deleted recording to leave the history, got %d", len(h.recordings))
+	}
+
+	h.Update(bulkKey("T"))
+	if h.mode != HistoryTrashMode || len(h.trash) != 1 {
+		t.Fatalf("expected the trash with 1 recording, mode %v, %d trashed", h.mode, len(h.trash))
+	}
+	deleted := h.trash[0].OriginalName
+
+	// Restoring puts it back and reloads the history
+	_, cmd := h.Update(bulkKey("u"))
+	if cmd == nil {
+		t.Fatal("expected u to restore the recording and reload the history")
+	}
+	h.Update(cmd())
+	if len(h.trash) != 0 || len(h.recordings) != 2 {
+		t.Fatalf("expected the recording to be restored, %d trashed, %d recordings", len(h.trash), len(h.recordings))
+	}
+	if _, err := os.Stat(filepath.Join(videosDir, deleted)); err != nil {
+		t.Errorf("expected %s to be restored: %v", deleted, err)
+	}
+
+	// Emptying the trash asks first
+	h.Update(bulkKey("T"))
+	h.Update(bulkKey("d"))
+	h.Update(bulkKey("y"))
+	h.Update(bulkKey("T"))
+	h.Update(bulkKey("E"))
+	if view := h.View(); !strings.Contains(view, "Permanently delete 1 recording(s)") {
+		t.Error("expected a confirmation before emptying the trash")
+	}
+	h.Update(bulkKey("y"))
+	if len(h.trash) != 0 || !strings.HasPrefix(h.trashNotice, "Removed 1 recording(s)") {
+		t.Errorf("expected an empty trash, %d trashed, notice %q", len(h.trash), h.trashNotice)
+	}
+	if trashed, _ := os.ReadDir(filepath.Join(videosDir, config.TrashDirName)); len(trashed) != 0 {
+		t.Errorf("expected the trash folder to be empty, got %d entries", len(trashed))
+	}
+}
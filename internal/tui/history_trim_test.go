@@ -0,0 +1,83 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestHistoryEdit_TrimsRecording(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+
+	h := historyWithRecordings("QGIS intro")
+	rec := &h.recordings[0]
+	rec.Files.FolderPath = t.TempDir()
+	rec.Processing.SourceDuration = 600
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	h.openRecording(rec)
+	h.Update(bulkKey("e"))
+	if h.mode != HistoryEditMode {
+		t.Fatalf("mode %v, want the edit form", h.mode)
+	}
+
+	// Type a time into the focused trim field and leave it
+	enterTrim := func(value string) {
+		h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		h.Update(bulkKey(value))
+		h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	}
+	h.editForm.State.FocusedField = FormFieldVerticalVideo
+	h.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if h.editForm.State.FocusedField != FormFieldTrimStart {
+		t.Fatalf("focused %v, want the trim start after vertical video", h.editForm.State.FocusedField)
+	}
+	enterTrim("1:30")
+	h.Update(tea.KeyMsg{Type: tea.KeyDown})
+	enterTrim("20")
+
+	// The end is before the start
+	if _, cmd := h.Update(tea.KeyMsg{Type: tea.KeyCtrlS}); cmd != nil || !strings.Contains(h.editForm.State.ErrorMsg, "must be before the end") {
+		t.Fatalf("error %q, want the trim refused", h.editForm.State.ErrorMsg)
+	}
+
+	h.editForm.State.TrimEndInput.SetValue("")
+	enterTrim("12:00")
+	if _, cmd := h.Update(tea.KeyMsg{Type: tea.KeyCtrlS}); cmd != nil || !strings.Contains(h.editForm.State.ErrorMsg, "end of the recording (10:00)") {
+		t.Fatalf("error %q, want an end after the recording refused", h.editForm.State.ErrorMsg)
+	}
+
+	h.editForm.State.TrimEndInput.SetValue("")
+	enterTrim("540")
+	if view := h.editForm.View(); !strings.Contains(view, "Keeps 7m30s of 10m00s") {
+		t.Error("expected the form to show the length kept")
+	}
+	_, cmd := h.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if cmd == nil {
+		t.Fatalf("expected the trim to be saved, error %q", h.editForm.State.ErrorMsg)
+	}
+	h.Update(cmd())
+
+	saved, err := models.LoadRecordingInfo(rec.Files.FolderPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Settings.TrimStart != 90 || saved.Settings.TrimEnd != 540 {
+		t.Errorf("trim = %v-%v, want 90-540 seconds", saved.Settings.TrimStart, saved.Settings.TrimEnd)
+	}
+
+	h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if view := h.View(); !strings.Contains(view, "7m30s (trimmed 1:30–9:00 of 10m00s)") {
+		t.Error("expected the detail view to show the trimmed duration")
+	}
+
+	// Editing again starts from the saved trim
+	h.Update(bulkKey("e"))
+	if start, end, err := h.editForm.GetTrim(); err != nil || start.Seconds() != 90 || end.Seconds() != 540 {
+		t.Errorf("GetTrim() = %v, %v, %v; want the saved trim", start, end, err)
+	}
+}
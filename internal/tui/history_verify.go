@@ -0,0 +1,244 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// uploadsVerifiedMsg reports which uploaded videos are gone from YouTube
+type uploadsVerifiedMsg struct {
+	checked map[string]bool // Video IDs that were checked
+	missing map[string]bool // Checked video IDs that no longer exist
+	skipped int             // Videos of channels without a configured account
+	errs    []error
+}
+
+// uploadsByChannel returns the IDs of the uploaded videos of the recordings,
+// grouped by channel ID ("" for uploads made before channels were recorded)
+func uploadsByChannel(recordings []models.RecordingInfo) map[string][]string {
+	seen := map[string]bool{}
+	byChannel := map[string][]string{}
+	for _, rec := range recordings {
+		for _, upload := range rec.Metadata.AllYouTubeUploads() {
+			if upload.VideoID == "" || seen[upload.VideoID] {
+				continue
+			}
+			seen[upload.VideoID] = true
+			byChannel[upload.ChannelID] = append(byChannel[upload.ChannelID], upload.VideoID)
+		}
+	}
+	return byChannel
+}
+
+// startVerifyUploads opens the verification view and checks every uploaded
+// video on YouTube with the account of its channel
+func (h *HistoryModel) startVerifyUploads() tea.Cmd {
+	h.mode = HistoryVerifyUploadsMode
+	h.verifyResult = nil
+	h.verifyCleared = -1
+	byChannel := uploadsByChannel(h.recordings)
+	if len(byChannel) == 0 {
+		h.verifyResult = &uploadsVerifiedMsg{}
+		return nil
+	}
+
+	h.verifyRunning = true
+	retry := &retryNotice{}
+	h.youtubeActionRetry = retry
+	return func() tea.Msg {
+		ctx := context.Background()
+		result := uploadsVerifiedMsg{checked: map[string]bool{}, missing: map[string]bool{}}
+		cfg, err := config.Load()
+		if err != nil {
+			result.errs = append(result.errs, err)
+			return result
+		}
+
+		channels := make([]string, 0, len(byChannel))
+		for channelID := range byChannel {
+			channels = append(channels, channelID)
+		}
+		sort.Strings(channels)
+
+		for _, channelID := range channels {
+			ids := byChannel[channelID]
+			// Another account cannot see private videos, which would look deleted
+			if channelID != "" && cfg.YouTube.GetAccountByChannelID(channelID) == nil {
+				result.skipped += len(ids)
+				continue
+			}
+			uploader, err := channelUploader(ctx, channelID, retry)
+			if err != nil {
+				result.errs = append(result.errs, err)
+				continue
+			}
+			existing, err := uploader.ExistingVideos(ctx, ids)
+			if err != nil {
+				result.errs = append(result.errs, err)
+				continue
+			}
+			for _, id := range ids {
+				result.checked[id] = true
+				if !existing[id] {
+					result.missing[id] = true
+				}
+			}
+		}
+		return result
+	}
+}
+
+// handleUploadsVerified flags the uploads that are gone and unflags the ones
+// that exist again, saving the recordings that changed
+func (h *HistoryModel) handleUploadsVerified(msg uploadsVerifiedMsg) {
+	h.verifyRunning = false
+	h.verifyResult = &msg
+	for i := range h.recordings {
+		rec := &h.recordings[i]
+		changed := false
+		for _, upload := range rec.Metadata.AllYouTubeUploads() {
+			if msg.checked[upload.VideoID] && rec.Metadata.SetYouTubeMissing(upload.VideoID, msg.missing[upload.VideoID]) {
+				changed = true
+			}
+		}
+		if changed {
+			_ = rec.Save()
+		}
+	}
+}
+
+// missingUploadRecordings returns the indexes of the recordings with a
+// video that is gone from YouTube
+func (h *HistoryModel) missingUploadRecordings() []int {
+	var indexes []int
+	for i := range h.recordings {
+		if h.recordings[i].Metadata.HasMissingYouTubeVideo() {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// clearMissingUploads removes the stale YouTube metadata of the recordings
+// whose videos are gone, keeping them in the deletion history
+func (h *HistoryModel) clearMissingUploads() {
+	cleared := 0
+	for _, i := range h.missingUploadRecordings() {
+		rec := &h.recordings[i]
+		rec.Metadata.ClearMissingYouTubeUploads()
+		if err := rec.Save(); err != nil {
+			h.youtubeActionError = fmt.Sprintf("Failed to save %s: %v", rec.Metadata.Title, err)
+			continue
+		}
+		cleared++
+	}
+	h.verifyCleared = cleared
+	h.budgetPlan = planStorageBudget(h.recordings)
+}
+
+// updateVerifyUploadsMode handles input in the upload verification view
+func (h *HistoryModel) updateVerifyUploadsMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return h, tea.Quit
+
+	case "esc", "q", "n":
+		if !h.verifyRunning {
+			h.verifyResult = nil
+			h.youtubeActionError = ""
+			h.mode = HistoryListMode
+		}
+
+	case "y", "Y":
+		if !h.verifyRunning && h.verifyCleared < 0 && len(h.missingUploadRecordings()) > 0 {
+			h.clearMissingUploads()
+		}
+	}
+	return h, nil
+}
+
+// renderVerifyUploadsView renders the result of the upload verification
+func (h *HistoryModel) renderVerifyUploadsView() string {
+	header := RenderHeader("Verify YouTube Uploads")
+
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+	warnStyle := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true)
+	errorStyle := lipgloss.NewStyle().Foreground(ColorRed)
+
+	var rows []string
+	helpText := "esc: back"
+
+	if h.verifyRunning {
+		rows = append(rows, grayStyle.Render("Checking the uploaded videos on YouTube..."))
+		if retry := h.youtubeActionRetry.String(); retry != "" {
+			rows = append(rows, renderRetryNotice(retry))
+		}
+	} else if r := h.verifyResult; r != nil {
+		switch {
+		case len(r.checked) == 0 && r.skipped == 0 && len(r.errs) == 0:
+			rows = append(rows, grayStyle.Italic(true).Render("No recordings have been uploaded to YouTube"))
+		case len(r.missing) == 0:
+			rows = append(rows, lipgloss.NewStyle().Foreground(ColorGreen).Bold(true).Render(
+				fmt.Sprintf("All %d checked video(s) are still on YouTube", len(r.checked))))
+		default:
+			rows = append(rows, warnStyle.Render(
+				fmt.Sprintf("%d of %d checked video(s) are gone from YouTube", len(r.missing), len(r.checked))))
+		}
+		if r.skipped > 0 {
+			rows = append(rows, grayStyle.Render(
+				fmt.Sprintf("%d video(s) not checked: their channel has no account set up here", r.skipped)))
+		}
+		for _, err := range r.errs {
+			rows = append(rows, errorStyle.Render(truncateStr(err.Error(), h.width-4)))
+		}
+	}
+
+	if !h.verifyRunning {
+		if missing := h.missingUploadRecordings(); len(missing) > 0 {
+			rows = append(rows, "")
+			limit := max(h.height-18, 3)
+			for n, i := range missing {
+				if n == limit {
+					rows = append(rows, grayStyle.Render(fmt.Sprintf("... and %d more recording(s)", len(missing)-limit)))
+					break
+				}
+				rec := h.recordings[i]
+				for _, upload := range rec.Metadata.AllYouTubeUploads() {
+					if upload.MissingSince == "" {
+						continue
+					}
+					channel := upload.ChannelName
+					if channel == "" {
+						channel = "YouTube"
+					}
+					rows = append(rows, valueStyle.Render(fmt.Sprintf("💔 %-40s %-20s %s",
+						truncateStr(rec.Metadata.Title, 40), truncateStr(channel, 20), upload.VideoURL)))
+				}
+			}
+			rows = append(rows, "")
+			if h.verifyCleared < 0 {
+				rows = append(rows, warnStyle.Render(
+					fmt.Sprintf("Clear the stale YouTube entries of %d recording(s)? (y/n)", len(missing))))
+				helpText = "y: clear stale entries • esc: keep them flagged"
+			}
+		}
+		if h.verifyCleared >= 0 {
+			rows = append(rows, lipgloss.NewStyle().Foreground(ColorGreen).Bold(true).Render(
+				fmt.Sprintf("Cleared the stale YouTube entries of %d recording(s)", h.verifyCleared)))
+		}
+		if h.youtubeActionError != "" {
+			rows = append(rows, errorStyle.Render(truncateStr(h.youtubeActionError, h.width-4)))
+		}
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	footer := RenderHelpFooter(helpText, h.width)
+	return LayoutWithHeaderFooter(header, content, footer, h.width, h.height)
+}
@@ -0,0 +1,58 @@
+package tui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestUploadsByChannel(t *testing.T) {
+	h := historyWithRecordings("QGIS intro", "Sprint review", "Draft")
+	h.recordings[0].Metadata.AddYouTubeUpload(models.YouTubeUpload{VideoID: "a1", ChannelID: "UC1"})
+	h.recordings[0].Metadata.AddYouTubeUpload(models.YouTubeUpload{VideoID: "b1", ChannelID: "UC2"})
+	h.recordings[1].Metadata.LegacyYouTube = &models.YouTubeUpload{VideoID: "legacy"}
+
+	want := map[string][]string{"UC1": {"a1"}, "UC2": {"b1"}, "": {"legacy"}}
+	if got := uploadsByChannel(h.recordings); !reflect.DeepEqual(got, want) {
+		t.Errorf("uploadsByChannel() = %v, want %v", got, want)
+	}
+}
+
+func TestHistoryVerifyUploads_FlagsAndClears(t *testing.T) {
+	h := historyWithRecordings("QGIS intro", "Sprint review")
+	for i, id := range []string{"gone", "kept"} {
+		h.recordings[i].Files.FolderPath = t.TempDir()
+		h.recordings[i].Metadata.YouTubeUploads = []models.YouTubeUpload{{VideoID: id, VideoURL: "https://youtu.be/" + id}}
+	}
+
+	if _, cmd := h.Update(bulkKey("V")); cmd == nil || h.mode != HistoryVerifyUploadsMode || !h.verifyRunning {
+		t.Fatalf("expected V to start verifying, mode %v", h.mode)
+	}
+	h.Update(uploadsVerifiedMsg{
+		checked: map[string]bool{"gone": true, "kept": true},
+		missing: map[string]bool{"gone": true},
+	})
+	if !h.recordings[0].Metadata.HasMissingYouTubeVideo() || h.recordings[1].Metadata.HasMissingYouTubeVideo() {
+		t.Fatal("expected only the deleted video to be flagged")
+	}
+	saved, err := models.LoadRecordingInfo(h.recordings[0].Files.FolderPath)
+	if err != nil || !saved.Metadata.HasMissingYouTubeVideo() {
+		t.Fatalf("expected the flag to be saved, err %v", err)
+	}
+	if view := h.View(); !strings.Contains(view, "1 of 2 checked video(s) are gone") {
+		t.Error("expected the view to report the missing video")
+	}
+
+	h.Update(bulkKey("y"))
+	if h.verifyCleared != 1 || h.recordings[0].Metadata.IsPublishedToYouTube() {
+		t.Fatalf("expected the stale entry to be cleared, cleared %d", h.verifyCleared)
+	}
+	if d := h.recordings[0].Metadata.YouTubeDeletions; len(d) != 1 || d[0].VideoID != "gone" {
+		t.Errorf("expected the stale upload in the deletion history, got %+v", d)
+	}
+	if !h.recordings[1].Metadata.IsPublishedToYouTube() {
+		t.Error("the existing upload must be kept")
+	}
+}
@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// historyWithUpload returns a history showing the details of a recording
+// uploaded with the given privacy, followed by a second channel's upload
+func historyWithUpload(t *testing.T, privacy string) *HistoryModel {
+	t.Helper()
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+
+	h := historyWithRecordings("QGIS intro")
+	rec := &h.recordings[0]
+	rec.Files.FolderPath = t.TempDir()
+	rec.Status = models.StatusCompleted
+	rec.Metadata.AddYouTubeUpload(models.YouTubeUpload{VideoID: "a1", Privacy: privacy, ChannelName: "Personal"})
+	rec.Metadata.AddYouTubeUpload(models.YouTubeUpload{VideoID: "b1", Privacy: "unlisted", ChannelName: "Brand"})
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	h.openRecording(rec)
+	return h
+}
+
+func TestHistoryYouTubeDelete_PublicNeedsTypedConfirm(t *testing.T) {
+	h := historyWithUpload(t, "public")
+
+	h.Update(bulkKey("x"))
+	if h.mode != HistoryYouTubeDeleteConfirmMode || !h.requiresTypedDeleteConfirm() {
+		t.Fatalf("mode %v, want the typed delete confirmation", h.mode)
+	}
+
+	// y is typed into the input rather than confirming
+	if _, cmd := h.Update(bulkKey("y")); h.youtubeActionLoading {
+		t.Fatalf("expected y not to delete a public video, cmd %v", cmd)
+	}
+	for _, typed := range []string{"y", "DELET", "delete"} {
+		h.youtubeDeleteInput.SetValue(typed)
+		if _, cmd := h.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil || h.youtubeActionLoading {
+			t.Fatalf("expected enter with %q to be refused", typed)
+		}
+		if h.youtubeActionError == "" {
+			t.Errorf("expected an error asking to type %s after %q", youtubeDeleteConfirmWord, typed)
+		}
+	}
+
+	h.youtubeDeleteInput.SetValue("")
+	h.Update(bulkKey("DELETE"))
+	if _, cmd := h.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd == nil || !h.youtubeActionLoading {
+		t.Fatal("expected typing DELETE to delete the video")
+	}
+	if h.youtubeActionError != "" {
+		t.Errorf("error %q, want it cleared", h.youtubeActionError)
+	}
+}
+
+func TestHistoryYouTubeDelete_UnlistedKeepsYesNo(t *testing.T) {
+	h := historyWithUpload(t, "unlisted")
+
+	h.Update(bulkKey("x"))
+	if h.mode != HistoryYouTubeDeleteConfirmMode || h.requiresTypedDeleteConfirm() {
+		t.Fatalf("mode %v, want the y/n delete confirmation", h.mode)
+	}
+	h.Update(bulkKey("n"))
+	if h.mode != HistoryDetailMode {
+		t.Fatalf("mode %v, want n to go back to the details", h.mode)
+	}
+
+	h.Update(bulkKey("x"))
+	if _, cmd := h.Update(bulkKey("y")); cmd == nil || !h.youtubeActionLoading {
+		t.Fatal("expected y to delete an unlisted video")
+	}
+}
+
+func TestHistoryYouTubeDelete_RecordsDeletion(t *testing.T) {
+	h := historyWithUpload(t, "unlisted")
+	h.Update(bulkKey("x"))
+	h.Update(bulkKey("y"))
+
+	h.Update(youtubeVideoDeletedMsg{})
+	if h.mode != HistoryDetailMode || h.youtubeActionLoading {
+		t.Fatalf("mode %v, want the details once deleted", h.mode)
+	}
+	if yt := h.recordings[0].Metadata.PrimaryYouTube(); yt == nil || yt.VideoID != "b1" {
+		t.Errorf("primary = %+v, want the other channel's video promoted in the list", yt)
+	}
+
+	saved, err := models.LoadRecordingInfo(h.recordings[0].Files.FolderPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := saved.Metadata.AllYouTubeUploads(); len(got) != 1 || got[0].VideoID != "b1" {
+		t.Errorf("saved uploads = %+v, want only b1 left", got)
+	}
+	if d := saved.Metadata.YouTubeDeletions; len(d) != 1 || d[0].VideoID != "a1" || d[0].ChannelName != "Personal" {
+		t.Errorf("saved deletions = %+v, want a1 recorded", d)
+	}
+}
@@ -0,0 +1,317 @@
+package tui
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
+)
+
+// youtubeMetadataField is a field of the YouTube metadata form
+type youtubeMetadataField int
+
+const (
+	youtubeMetadataTitle youtubeMetadataField = iota
+	youtubeMetadataDescription
+	youtubeMetadataTags
+	youtubeMetadataUpdateLocal
+	youtubeMetadataFieldCount
+)
+
+// youtubeMetadataForm edits the title, description and tags of a published
+// video, with the same inputs as the recording edit form
+type youtubeMetadataForm struct {
+	focus       youtubeMetadataField
+	title       textinput.Model
+	description textarea.Model
+	tags        textinput.Model
+	updateLocal bool // Also save the title and description to the recording
+	fetching    bool // Fetching the current metadata from YouTube
+	err         string
+}
+
+// youtubeMetadataFetchedMsg carries the metadata of the video on YouTube
+type youtubeMetadataFetchedMsg struct {
+	title       string
+	description string
+	tags        []string
+	err         error
+}
+
+// youtubeMetadataUpdatedMsg is sent when the metadata of the video was
+// changed on YouTube
+type youtubeMetadataUpdatedMsg struct {
+	title       string
+	description string
+	tags        []string
+	updateLocal bool
+	err         error
+}
+
+// newYouTubeMetadataForm creates the form filled in with the stored metadata
+// of the selected recording, until the metadata on YouTube is fetched
+func newYouTubeMetadataForm(title, description string, tags []string) *youtubeMetadataForm {
+	// YouTube descriptions may be longer than the recording form allows,
+	// e.g. with the chapters added at upload
+	descInput := newDescriptionInput()
+	descInput.CharLimit = 5000
+	descInput.SetHeight(8)
+
+	f := &youtubeMetadataForm{title: newTitleInput(), description: descInput, tags: newTagsInput()}
+	f.setValues(title, description, tags)
+	f.title.Focus()
+	return f
+}
+
+// setValues fills in the form
+func (f *youtubeMetadataForm) setValues(title, description string, tags []string) {
+	f.title.SetValue(title)
+	f.description.SetValue(description)
+	f.tags.SetValue(strings.Join(tags, ", "))
+}
+
+// setFocus moves the focus to field, focusing its input
+func (f *youtubeMetadataForm) setFocus(field youtubeMetadataField) {
+	f.title.Blur()
+	f.description.Blur()
+	f.tags.Blur()
+	f.focus = (field + youtubeMetadataFieldCount) % youtubeMetadataFieldCount
+	switch f.focus {
+	case youtubeMetadataTitle:
+		f.title.Focus()
+	case youtubeMetadataDescription:
+		f.description.Focus()
+	case youtubeMetadataTags:
+		f.tags.Focus()
+	}
+}
+
+// startYouTubeMetadataEdit opens the form to change the title, description
+// and tags of the selected recording's YouTube video, and fetches them from
+// YouTube so changes made there are not lost
+func (h *HistoryModel) startYouTubeMetadataEdit() tea.Cmd {
+	rec := h.selectedRecording
+	h.youtubeMetadata = newYouTubeMetadataForm(rec.Metadata.Title, rec.Metadata.Description, rec.Metadata.PrimaryYouTube().Tags)
+	h.youtubeMetadata.fetching = true
+	h.youtubeActionError = ""
+	h.youtubeActionSuccess = ""
+	h.mode = HistoryYouTubeMetadataMode
+
+	retry := &retryNotice{}
+	h.youtubeActionRetry = retry
+	videoID := rec.Metadata.PrimaryYouTube().VideoID
+	fetch := func() tea.Msg {
+		ctx := context.Background()
+		uploader, err := videoUploader(ctx, rec, retry)
+		if err != nil {
+			return youtubeMetadataFetchedMsg{err: err}
+		}
+		video, err := uploader.GetVideoInfo(ctx, videoID)
+		if err != nil {
+			return youtubeMetadataFetchedMsg{err: err}
+		}
+		return youtubeMetadataFetchedMsg{
+			title:       video.Snippet.Title,
+			description: video.Snippet.Description,
+			tags:        video.Snippet.Tags,
+		}
+	}
+	return tea.Batch(textinput.Blink, fetch)
+}
+
+// handleYouTubeMetadataFetched fills in the form with the metadata on
+// YouTube. If it could not be fetched, the stored metadata can still be
+// edited.
+func (h *HistoryModel) handleYouTubeMetadataFetched(msg youtubeMetadataFetchedMsg) {
+	f := h.youtubeMetadata
+	if f == nil || !f.fetching {
+		return
+	}
+	f.fetching = false
+	if msg.err != nil {
+		f.err = "Could not fetch the metadata from YouTube, editing the stored one: " + youtube.FriendlyError(msg.err)
+		return
+	}
+	f.setValues(msg.title, msg.description, msg.tags)
+}
+
+// updateYouTubeMetadata changes the title, description and tags of the
+// selected recording's YouTube video, with the account of its channel.
+// updateLocal is passed back to save the title and description to the
+// recording too.
+func (h *HistoryModel) updateYouTubeMetadata(title, description string, tags []string, updateLocal bool) tea.Cmd {
+	rec := h.selectedRecording
+	retry := &retryNotice{}
+	h.youtubeActionRetry = retry
+	return func() tea.Msg {
+		ctx := context.Background()
+		uploader, err := videoUploader(ctx, rec, retry)
+		if err != nil {
+			return youtubeMetadataUpdatedMsg{err: err}
+		}
+
+		err = uploader.UpdateVideoMetadata(ctx, rec.Metadata.PrimaryYouTube().VideoID, title, description, tags)
+		if err != nil {
+			return youtubeMetadataUpdatedMsg{err: err}
+		}
+
+		return youtubeMetadataUpdatedMsg{title: title, description: description, tags: tags, updateLocal: updateLocal}
+	}
+}
+
+// handleYouTubeMetadataUpdated stores the new tags in recording.json and the
+// list. The recording's own title and description are only replaced if the
+// user chose to.
+func (h *HistoryModel) handleYouTubeMetadataUpdated(msg youtubeMetadataUpdatedMsg) {
+	h.youtubeActionLoading = false
+	if msg.err != nil {
+		h.youtubeActionError = youtube.FriendlyError(msg.err)
+		return
+	}
+	h.youtubeMetadata = nil
+	h.mode = HistoryDetailMode
+	rec := h.selectedRecording
+	if rec == nil || rec.Metadata.PrimaryYouTube() == nil {
+		return
+	}
+
+	if msg.updateLocal {
+		rec.Metadata.Title = msg.title
+		rec.Metadata.Description = msg.description
+	}
+	rec.Metadata.SetYouTubeTags(rec.Metadata.PrimaryYouTube().VideoID, msg.tags)
+	if err := rec.Save(); err != nil {
+		h.youtubeActionError = "Updated on YouTube, but failed to save recording.json: " + err.Error()
+	} else {
+		h.youtubeActionSuccess = "Title, description and tags updated on YouTube"
+		if msg.updateLocal {
+			h.youtubeActionSuccess += " and in the recording"
+		}
+	}
+	h.replaceRecording(*rec)
+}
+
+// updateYouTubeMetadataMode handles input in the YouTube metadata form
+func (h *HistoryModel) updateYouTubeMetadataMode(msg tea.KeyMsg) (*HistoryModel, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return h, tea.Quit
+	}
+	if h.youtubeActionLoading {
+		return h, nil
+	}
+
+	f := h.youtubeMetadata
+	if f.fetching && msg.String() != "esc" {
+		return h, nil
+	}
+	switch msg.String() {
+	case "esc":
+		h.youtubeMetadata = nil
+		h.youtubeActionError = ""
+		h.mode = HistoryDetailMode
+		return h, nil
+
+	case "ctrl+s":
+		title := strings.TrimSpace(f.title.Value())
+		description := f.description.Value()
+		tags := youtube.ParseTags(f.tags.Value())
+		if err := youtube.ValidateVideoMetadata(title, description, tags); err != nil {
+			f.err = err.Error()
+			return h, nil
+		}
+		f.err = ""
+		h.youtubeActionError = ""
+		h.youtubeActionLoading = true
+		return h, h.updateYouTubeMetadata(title, description, tags, f.updateLocal)
+
+	case "tab":
+		f.setFocus(f.focus + 1)
+		return h, nil
+
+	case "shift+tab":
+		f.setFocus(f.focus - 1)
+		return h, nil
+
+	case " ":
+		if f.focus == youtubeMetadataUpdateLocal {
+			f.updateLocal = !f.updateLocal
+			return h, nil
+		}
+
+	case "enter":
+		// New lines go in the description, elsewhere enter moves on
+		if f.focus != youtubeMetadataDescription {
+			f.setFocus(f.focus + 1)
+			return h, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	switch f.focus {
+	case youtubeMetadataTitle:
+		f.title, cmd = f.title.Update(msg)
+	case youtubeMetadataDescription:
+		f.description, cmd = f.description.Update(msg)
+	case youtubeMetadataTags:
+		f.tags, cmd = f.tags.Update(msg)
+	}
+	return h, cmd
+}
+
+// renderYouTubeMetadataView renders the YouTube metadata form with the
+// progress or error of saving it
+func (h *HistoryModel) renderYouTubeMetadataView() string {
+	f := h.youtubeMetadata
+	if f == nil || h.selectedRecording == nil || h.selectedRecording.Metadata.PrimaryYouTube() == nil {
+		return "No recording selected"
+	}
+	header := RenderHeader("Edit on YouTube")
+
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	labelStyle := lipgloss.NewStyle().Foreground(ColorGray).Width(14)
+	activeLabelStyle := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true).Width(14)
+	label := func(field youtubeMetadataField, text string) string {
+		if f.focus == field {
+			return activeLabelStyle.Render(text)
+		}
+		return labelStyle.Render(text)
+	}
+
+	checkbox := "[ ]"
+	if f.updateLocal {
+		checkbox = "[✓]"
+	}
+
+	rows := []string{
+		grayStyle.Render("Changes the video " + h.selectedRecording.Metadata.PrimaryYouTube().VideoURL),
+		"",
+		lipgloss.JoinHorizontal(lipgloss.Top, label(youtubeMetadataTitle, "Title:"), f.title.View()),
+		lipgloss.JoinHorizontal(lipgloss.Top, label(youtubeMetadataDescription, "Description:"), f.description.View()),
+		lipgloss.JoinHorizontal(lipgloss.Top, label(youtubeMetadataTags, "Tags:"), f.tags.View()),
+		lipgloss.JoinHorizontal(lipgloss.Top, label(youtubeMetadataUpdateLocal, "Recording:"),
+			lipgloss.NewStyle().Foreground(ColorWhite).Render(checkbox+" Also use this title and description for the recording")),
+		"",
+	}
+
+	switch {
+	case h.youtubeActionLoading:
+		rows = append(rows, lipgloss.NewStyle().Foreground(ColorOrange).Bold(true).Render("Saving to YouTube..."))
+		if retry := h.youtubeActionRetry.String(); retry != "" {
+			rows = append(rows, renderRetryNotice(retry))
+		}
+	case f.fetching:
+		rows = append(rows, grayStyle.Italic(true).Render("Fetching the current metadata from YouTube..."))
+	case h.youtubeActionError != "":
+		rows = append(rows, lipgloss.NewStyle().Foreground(ColorRed).Bold(true).Width(72).Render(h.youtubeActionError))
+	case f.err != "":
+		rows = append(rows, lipgloss.NewStyle().Foreground(ColorRed).Width(72).Render(f.err))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	footer := RenderHelpFooter("tab: next field • space: toggle • ctrl+s: save to YouTube • esc: cancel", h.width)
+	return LayoutWithHeaderFooter(header, content, footer, h.width, h.height)
+}
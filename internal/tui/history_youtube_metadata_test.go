@@ -0,0 +1,136 @@
+package tui
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestHistoryYouTubeMetadata_UpdatesVideo(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+
+	h := historyWithRecordings("QGIS intor")
+	rec := &h.recordings[0]
+	rec.Files.FolderPath = t.TempDir()
+	rec.Status = models.StatusCompleted
+	rec.Metadata.AddYouTubeUpload(models.YouTubeUpload{VideoID: "abc", ChannelID: "UC1", Tags: []string{"qgis"}})
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	h.openRecording(rec)
+
+	_, cmd := h.Update(bulkKey("E"))
+	if h.mode != HistoryYouTubeMetadataMode || cmd == nil {
+		t.Fatalf("mode %v, want the YouTube metadata form fetching the video", h.mode)
+	}
+	if _, cmd := h.Update(tea.KeyMsg{Type: tea.KeyCtrlS}); cmd != nil {
+		t.Fatal("expected saving to wait for the metadata from YouTube")
+	}
+
+	// The form shows what YouTube has, e.g. the chapters added at upload
+	h.Update(youtubeMetadataFetchedMsg{title: "QGIS intor", description: "Intro\n\n0:00 Start", tags: []string{"qgis", "gis"}})
+	f := h.youtubeMetadata
+	if f.description.Value() != "Intro\n\n0:00 Start" || f.tags.Value() != "qgis, gis" {
+		t.Fatalf("form %q %q, want the metadata from YouTube", f.description.Value(), f.tags.Value())
+	}
+
+	f.title.SetValue("")
+	if _, cmd := h.Update(tea.KeyMsg{Type: tea.KeyCtrlS}); cmd != nil || f.err != "title is required" {
+		t.Fatalf("error %q, want an empty title refused", f.err)
+	}
+
+	h.Update(bulkKey("QGIS intro"))
+	h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if f.focus != youtubeMetadataDescription {
+		t.Fatalf("focus %v, want enter to move from the title to the description", f.focus)
+	}
+	h.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if f.focus != youtubeMetadataTags {
+		t.Fatalf("focus %v, want tab to move to the tags", f.focus)
+	}
+	h.Update(tea.KeyMsg{Type: tea.KeyTab})
+	h.Update(bulkKey(" "))
+	if f.focus != youtubeMetadataUpdateLocal || !f.updateLocal {
+		t.Fatalf("focus %v, want space to choose to update the recording too", f.focus)
+	}
+	_, cmd = h.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if cmd == nil || !h.youtubeActionLoading {
+		t.Fatalf("expected the metadata to be sent to YouTube, error %q", f.err)
+	}
+
+	h.Update(youtubeMetadataUpdatedMsg{title: "QGIS intro", description: "Intro\n\n0:00 Start", tags: []string{"qgis", "gis"}, updateLocal: true})
+	if h.mode != HistoryDetailMode || h.youtubeActionError != "" {
+		t.Fatalf("mode %v error %q, want the details", h.mode, h.youtubeActionError)
+	}
+	if h.recordings[0].Metadata.Title != "QGIS intro" {
+		t.Errorf("list title %q, want the row refreshed", h.recordings[0].Metadata.Title)
+	}
+
+	saved, err := models.LoadRecordingInfo(rec.Files.FolderPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Metadata.Title != "QGIS intro" || !strings.HasPrefix(saved.Metadata.Description, "Intro") {
+		t.Errorf("saved %q %q, want the new title and description", saved.Metadata.Title, saved.Metadata.Description)
+	}
+	if !reflect.DeepEqual(saved.Metadata.PrimaryYouTube().Tags, []string{"qgis", "gis"}) {
+		t.Errorf("saved tags %v, want the new tags", saved.Metadata.PrimaryYouTube().Tags)
+	}
+}
+
+func TestHistoryYouTubeMetadata_KeepsLocalMetadataByDefault(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+
+	h := historyWithRecordings("QGIS intro")
+	rec := &h.recordings[0]
+	rec.Files.FolderPath = t.TempDir()
+	rec.Metadata.Description = "Local notes"
+	rec.Metadata.AddYouTubeUpload(models.YouTubeUpload{VideoID: "abc"})
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	h.openRecording(rec)
+
+	h.Update(bulkKey("E"))
+	if h.youtubeMetadata.updateLocal {
+		t.Fatal("expected the recording to be left alone unless chosen")
+	}
+	h.Update(youtubeMetadataUpdatedMsg{title: "QGIS intro | Kartoza", description: "For YouTube", tags: []string{"qgis"}})
+
+	saved, err := models.LoadRecordingInfo(rec.Files.FolderPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Metadata.Title != "QGIS intro" || saved.Metadata.Description != "Local notes" {
+		t.Errorf("saved %q %q, want the recording's own title and description kept", saved.Metadata.Title, saved.Metadata.Description)
+	}
+	if !reflect.DeepEqual(saved.Metadata.PrimaryYouTube().Tags, []string{"qgis"}) {
+		t.Errorf("saved tags %v, want the new tags", saved.Metadata.PrimaryYouTube().Tags)
+	}
+}
+
+func TestHistoryYouTubeMetadata_FetchFailureEditsStored(t *testing.T) {
+	h := historyWithRecordings("QGIS intro")
+	rec := &h.recordings[0]
+	rec.Metadata.Description = "Stored"
+	rec.Metadata.AddYouTubeUpload(models.YouTubeUpload{VideoID: "abc"})
+	h.selectedRecording = rec
+	h.mode = HistoryDetailMode
+
+	h.Update(bulkKey("E"))
+	h.Update(youtubeMetadataFetchedMsg{err: errors.New("network is unreachable")})
+	f := h.youtubeMetadata
+	if f.fetching || f.description.Value() != "Stored" || !strings.Contains(f.err, "editing the stored one") {
+		t.Errorf("form %q error %q, want the stored metadata editable", f.description.Value(), f.err)
+	}
+
+	h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if h.mode != HistoryDetailMode || h.youtubeMetadata != nil {
+		t.Errorf("mode %v, want esc to go back to the details", h.mode)
+	}
+}
@@ -0,0 +1,394 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
+)
+
+// LocalizationEditorField represents which part of the localization editor
+// is focused while editing a translation
+type LocalizationEditorField int
+
+const (
+	LocalizationEditorFieldLanguage LocalizationEditorField = iota
+	LocalizationEditorFieldTitle
+	LocalizationEditorFieldDescription
+)
+
+// LocalizationEditorResult reports the state of the localization editor
+// after a message
+type LocalizationEditorResult int
+
+const (
+	LocalizationEditorEditing  LocalizationEditorResult = iota // Still open
+	LocalizationEditorCanceled                                 // Closed without saving
+	LocalizationEditorSaved                                    // Save requested, Localizations holds the translations
+)
+
+// LocalizationEditorModel is a full screen editor for the translated titles
+// and descriptions of a YouTube video. The owning screen forwards messages
+// to Update while it is open and renders View instead of its own content.
+type LocalizationEditorModel struct {
+	width  int
+	height int
+
+	title           string // Video title, shown for reference
+	defaultLanguage string // Language of the original title, not translatable
+
+	localizations map[string]models.Localization
+	languages     []string // Sorted keys of localizations
+	selected      int
+
+	// Translation being edited; editing is false in the list
+	editing          bool
+	isNew            bool
+	field            LocalizationEditorField
+	languageIdx      int // Index into youtube.Languages
+	titleInput       textinput.Model
+	descriptionInput textinput.Model
+	editError        string
+}
+
+// NewLocalizationEditor creates an editor for the translations of a video
+// whose title is in defaultLanguage
+func NewLocalizationEditor(title, defaultLanguage string, localizations map[string]models.Localization) *LocalizationEditorModel {
+	titleInput := textinput.New()
+	titleInput.Placeholder = "Translated title"
+	titleInput.CharLimit = 100
+	titleInput.Width = 50
+
+	descInput := textinput.New()
+	descInput.Placeholder = "Translated description"
+	descInput.CharLimit = 5000
+	descInput.Width = 50
+
+	e := &LocalizationEditorModel{
+		title:            title,
+		defaultLanguage:  defaultLanguage,
+		localizations:    make(map[string]models.Localization, len(localizations)),
+		titleInput:       titleInput,
+		descriptionInput: descInput,
+	}
+	for lang, l := range localizations {
+		e.localizations[lang] = l
+	}
+	e.sortLanguages()
+	return e
+}
+
+// SetSize sets the size of the screen the editor is rendered in
+func (e *LocalizationEditorModel) SetSize(width, height int) {
+	e.width = width
+	e.height = height
+}
+
+// Localizations returns the edited translations
+func (e *LocalizationEditorModel) Localizations() map[string]models.Localization {
+	return e.localizations
+}
+
+// sortLanguages refreshes the sorted language list and keeps the selection
+// in range
+func (e *LocalizationEditorModel) sortLanguages() {
+	e.languages = e.languages[:0]
+	for lang := range e.localizations {
+		e.languages = append(e.languages, lang)
+	}
+	sort.Strings(e.languages)
+	if e.selected >= len(e.languages) {
+		e.selected = len(e.languages) - 1
+	}
+	if e.selected < 0 {
+		e.selected = 0
+	}
+}
+
+// available reports whether a language can be picked for a new translation
+func (e *LocalizationEditorModel) available(code string) bool {
+	if code == e.defaultLanguage {
+		return false
+	}
+	_, exists := e.localizations[code]
+	return !exists
+}
+
+// startEdit opens the translation of lang, or a new translation if lang is
+// empty. A new translation is not opened when all languages have one.
+func (e *LocalizationEditorModel) startEdit(lang string) tea.Cmd {
+	e.isNew = lang == ""
+	if e.isNew {
+		// Preselect the first language without a translation
+		e.languageIdx = -1
+		for i, l := range youtube.Languages {
+			if e.available(l.Code) {
+				e.languageIdx = i
+				break
+			}
+		}
+		if e.languageIdx < 0 {
+			return nil
+		}
+	}
+
+	e.editing = true
+	e.editError = ""
+	l := e.localizations[lang]
+	e.titleInput.SetValue(l.Title)
+	e.descriptionInput.SetValue(l.Description)
+
+	if e.isNew {
+		e.field = LocalizationEditorFieldLanguage
+		e.titleInput.Blur()
+		return nil
+	}
+
+	e.field = LocalizationEditorFieldTitle
+	e.titleInput.Focus()
+	return textinput.Blink
+}
+
+// editLanguage returns the language of the translation being edited
+func (e *LocalizationEditorModel) editLanguage() string {
+	if e.isNew {
+		return youtube.Languages[e.languageIdx].Code
+	}
+	return e.languages[e.selected]
+}
+
+// cycleLanguage moves the language of a new translation to the previous or
+// next language without a translation
+func (e *LocalizationEditorModel) cycleLanguage(step int) {
+	n := len(youtube.Languages)
+	for i := 1; i < n; i++ {
+		idx := ((e.languageIdx+step*i)%n + n) % n
+		if e.available(youtube.Languages[idx].Code) {
+			e.languageIdx = idx
+			return
+		}
+	}
+}
+
+// focusField focuses the given field of the translation being edited
+func (e *LocalizationEditorModel) focusField(field LocalizationEditorField) tea.Cmd {
+	e.field = field
+	e.titleInput.Blur()
+	e.descriptionInput.Blur()
+	switch field {
+	case LocalizationEditorFieldTitle:
+		e.titleInput.Focus()
+		return textinput.Blink
+	case LocalizationEditorFieldDescription:
+		e.descriptionInput.Focus()
+		return textinput.Blink
+	}
+	return nil
+}
+
+// commitEdit stores the translation being edited and returns to the list
+func (e *LocalizationEditorModel) commitEdit() {
+	if e.titleInput.Value() == "" {
+		e.editError = "The translated title is required"
+		return
+	}
+	lang := e.editLanguage()
+	e.localizations[lang] = models.Localization{
+		Title:       e.titleInput.Value(),
+		Description: e.descriptionInput.Value(),
+	}
+	e.editing = false
+	e.titleInput.Blur()
+	e.descriptionInput.Blur()
+	e.sortLanguages()
+	for i, l := range e.languages {
+		if l == lang {
+			e.selected = i
+		}
+	}
+}
+
+// Update handles a message while the editor is open and reports whether it
+// is still open, canceled or saved
+func (e *LocalizationEditorModel) Update(msg tea.Msg) (LocalizationEditorResult, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		e.SetSize(msg.Width, msg.Height)
+		return LocalizationEditorEditing, nil
+
+	case tea.KeyMsg:
+		if e.editing {
+			return e.updateEdit(msg)
+		}
+
+		switch msg.String() {
+		case "esc", "q":
+			return LocalizationEditorCanceled, nil
+
+		case "up", "k":
+			if e.selected > 0 {
+				e.selected--
+			}
+
+		case "down", "j":
+			if e.selected < len(e.languages)-1 {
+				e.selected++
+			}
+
+		case "a":
+			return LocalizationEditorEditing, e.startEdit("")
+
+		case "enter":
+			if len(e.languages) > 0 {
+				return LocalizationEditorEditing, e.startEdit(e.languages[e.selected])
+			}
+
+		case "d", "delete":
+			if len(e.languages) > 0 {
+				delete(e.localizations, e.languages[e.selected])
+				e.sortLanguages()
+			}
+
+		case "ctrl+s", "s":
+			return LocalizationEditorSaved, nil
+		}
+	}
+
+	return LocalizationEditorEditing, nil
+}
+
+// updateEdit handles keys while a translation is being edited
+func (e *LocalizationEditorModel) updateEdit(msg tea.KeyMsg) (LocalizationEditorResult, tea.Cmd) {
+	first := LocalizationEditorFieldTitle
+	if e.isNew {
+		first = LocalizationEditorFieldLanguage
+	}
+
+	switch msg.String() {
+	case "esc":
+		e.editing = false
+		e.titleInput.Blur()
+		e.descriptionInput.Blur()
+		return LocalizationEditorEditing, nil
+
+	case "enter", "ctrl+s":
+		e.commitEdit()
+		return LocalizationEditorEditing, nil
+
+	case "tab", "down":
+		next := e.field + 1
+		if next > LocalizationEditorFieldDescription {
+			next = first
+		}
+		return LocalizationEditorEditing, e.focusField(next)
+
+	case "shift+tab", "up":
+		prev := e.field - 1
+		if prev < first {
+			prev = LocalizationEditorFieldDescription
+		}
+		return LocalizationEditorEditing, e.focusField(prev)
+
+	case "left", "right":
+		if e.field == LocalizationEditorFieldLanguage {
+			step := 1
+			if msg.String() == "left" {
+				step = -1
+			}
+			e.cycleLanguage(step)
+			return LocalizationEditorEditing, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	switch e.field {
+	case LocalizationEditorFieldTitle:
+		e.titleInput, cmd = e.titleInput.Update(msg)
+	case LocalizationEditorFieldDescription:
+		e.descriptionInput, cmd = e.descriptionInput.Update(msg)
+	}
+	return LocalizationEditorEditing, cmd
+}
+
+// View renders the editor with full screen layout. status is an already
+// rendered line (e.g. saving or an error) shown below the content.
+func (e *LocalizationEditorModel) View(status string) string {
+	header := RenderHeader("YouTube Translations")
+
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+
+	rows := []string{
+		lipgloss.NewStyle().Foreground(ColorOrange).Bold(true).Render(e.title),
+		grayStyle.Render("Original language: " + youtube.LanguageName(e.defaultLanguage)),
+		"",
+	}
+
+	var helpText string
+	if e.editing {
+		rows = append(rows, e.renderEdit()...)
+		helpText = "tab: next field • enter: keep translation • esc: discard changes"
+	} else {
+		if len(e.languages) == 0 {
+			rows = append(rows, grayStyle.Italic(true).Render("No translations yet, press a to add one"))
+		}
+		selectedStyle := lipgloss.NewStyle().
+			Background(ColorOrange).
+			Foreground(lipgloss.Color("#000000"))
+		for i, lang := range e.languages {
+			line := fmt.Sprintf("%-28s %s", youtube.LanguageName(lang), e.localizations[lang].Title)
+			if i == e.selected {
+				rows = append(rows, selectedStyle.Render("▶ "+line))
+			} else {
+				rows = append(rows, valueStyle.Render("  "+line))
+			}
+		}
+		helpText = "↑/↓: navigate • a: add • enter: edit • d: delete • s: save to YouTube • esc: cancel"
+	}
+
+	if status != "" {
+		rows = append(rows, "", status)
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	footer := RenderHelpFooter(helpText, e.width)
+	return LayoutWithHeaderFooter(header, content, footer, e.width, e.height)
+}
+
+// renderEdit renders the rows of the translation being edited
+func (e *LocalizationEditorModel) renderEdit() []string {
+	labelStyle := lipgloss.NewStyle().
+		Foreground(ColorGray).
+		Width(14).
+		Align(lipgloss.Right)
+	labelActiveStyle := labelStyle.
+		Foreground(ColorOrange).
+		Bold(true)
+	label := func(text string, field LocalizationEditorField) string {
+		if e.field == field {
+			return labelActiveStyle.Render(text)
+		}
+		return labelStyle.Render(text)
+	}
+
+	langName := youtube.LanguageName(e.editLanguage())
+	langValue := lipgloss.NewStyle().Foreground(ColorWhite).Bold(true).Render(" " + langName + " ")
+	if e.isNew && e.field == LocalizationEditorFieldLanguage {
+		langValue = lipgloss.NewStyle().Background(ColorOrange).Foreground(lipgloss.Color("#000000")).Render(" "+langName+" ") +
+			lipgloss.NewStyle().Foreground(ColorGray).Render(" (←/→ to change)")
+	}
+
+	rows := []string{
+		lipgloss.JoinHorizontal(lipgloss.Center, label("Language: ", LocalizationEditorFieldLanguage), langValue),
+		lipgloss.JoinHorizontal(lipgloss.Center, label("Title: ", LocalizationEditorFieldTitle), e.titleInput.View()),
+		lipgloss.JoinHorizontal(lipgloss.Center, label("Description: ", LocalizationEditorFieldDescription), e.descriptionInput.View()),
+	}
+	if e.editError != "" {
+		rows = append(rows, "", lipgloss.NewStyle().Foreground(ColorRed).Render(e.editError))
+	}
+	return rows
+}
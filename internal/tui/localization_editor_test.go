@@ -0,0 +1,52 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestLocalizationEditor_AddEditDelete(t *testing.T) {
+	e := NewLocalizationEditor("Title", "en-GB", map[string]models.Localization{
+		"fr": {Title: "Titre"},
+	})
+
+	// Adding skips the video's own language and languages with a translation
+	e.Update(bulkKey("a"))
+	if got := e.editLanguage(); got == "en-GB" || got == "fr" {
+		t.Fatalf("new translation preselected %q", got)
+	}
+	e.Update(tea.KeyMsg{Type: tea.KeyTab})
+	e.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !e.editing || e.editError == "" {
+		t.Fatal("expected an error for an empty translated title")
+	}
+	e.Update(bulkKey("Titel"))
+	e.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if e.editing || len(e.Localizations()) != 2 {
+		t.Fatalf("expected 2 translations after adding, got %v", e.Localizations())
+	}
+
+	// Editing an existing translation keeps its language
+	e.selected = 0
+	lang := e.languages[0]
+	e.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	e.Update(bulkKey("!"))
+	e.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := e.Localizations()[lang].Title; got[len(got)-1] != '!' {
+		t.Errorf("edited title of %s = %q", lang, got)
+	}
+
+	e.Update(bulkKey("d"))
+	if len(e.Localizations()) != 1 {
+		t.Errorf("expected 1 translation after deleting, got %v", e.Localizations())
+	}
+
+	if result, _ := e.Update(bulkKey("s")); result != LocalizationEditorSaved {
+		t.Errorf("s = %v, want LocalizationEditorSaved", result)
+	}
+	if result, _ := e.Update(tea.KeyMsg{Type: tea.KeyEsc}); result != LocalizationEditorCanceled {
+		t.Errorf("esc = %v, want LocalizationEditorCanceled", result)
+	}
+}
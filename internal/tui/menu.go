@@ -7,6 +7,9 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/retention"
 )
 
 // MenuItem represents a menu option
@@ -15,6 +18,7 @@ type MenuItem int
 const (
 	MenuNewRecording MenuItem = iota
 	MenuRecordingHistory
+	MenuNeedsAttention
 	MenuOptions
 	MenuQuit
 )
@@ -36,6 +40,12 @@ type MenuModel struct {
 	// External recording warning
 	externalRecordingActive bool
 	externalRecordingPIDs   []string
+
+	// Usage of the videos directory against the storage budget
+	storageBudget retention.BudgetPlan
+
+	// New recording form the previous run was quit during (nil = none)
+	setupDraft *config.SetupDraft
 }
 
 // NewMenuModel creates a new menu model
@@ -45,6 +55,7 @@ func NewMenuModel() *MenuModel {
 		menuItems: []menuItem{
 			{label: "New Recording", enabled: true, action: MenuNewRecording},
 			{label: "Recording History", enabled: true, action: MenuRecordingHistory},
+			{label: "Needs Attention", enabled: true, action: MenuNeedsAttention},
 			{label: "Options", enabled: true, action: MenuOptions},
 			{label: "Quit", enabled: true, action: MenuQuit},
 		},
@@ -95,6 +106,43 @@ func (m *MenuModel) Update(msg tea.Msg) (*MenuModel, tea.Cmd) {
 				}
 			}
 			return m, nil
+
+		// Open the details of the latest recording
+		case key.Matches(msg, key.NewBinding(key.WithKeys("r"))):
+			return m, func() tea.Msg { return openLatestRecordingMsg{} }
+
+		// Edit the latest recording that needs metadata
+		case key.Matches(msg, key.NewBinding(key.WithKeys("e"))):
+			return m, func() tea.Msg { return openLatestRecordingMsg{needsMetadata: true} }
+
+		// Review the recordings suggested to get back under the storage budget
+		case key.Matches(msg, key.NewBinding(key.WithKeys("b"))):
+			if showStorageBudgetPrompt(m.storageBudget) {
+				return m, func() tea.Msg { return openStorageBudgetMsg{} }
+			}
+			return m, nil
+
+		// Dismiss the storage budget prompt
+		case key.Matches(msg, key.NewBinding(key.WithKeys("x"))):
+			if showStorageBudgetPrompt(m.storageBudget) {
+				storageBudgetDismissed = true
+			}
+			return m, nil
+
+		// Continue the new recording form of the previous run
+		case key.Matches(msg, key.NewBinding(key.WithKeys("c"))):
+			if draft := m.setupDraft; draft != nil && m.newRecordingEnabled() {
+				return m, func() tea.Msg { return restoreSetupDraftMsg{draft: draft} }
+			}
+			return m, nil
+
+		// Discard the new recording form of the previous run
+		case key.Matches(msg, key.NewBinding(key.WithKeys("d"))):
+			if m.setupDraft != nil {
+				_ = config.ClearSetupDraft()
+				m.setupDraft = nil
+			}
+			return m, nil
 		}
 	}
 
@@ -112,6 +160,10 @@ func (m *MenuModel) handleSelection(action MenuItem) tea.Cmd {
 		return func() tea.Msg {
 			return menuActionMsg{action: MenuRecordingHistory}
 		}
+	case MenuNeedsAttention:
+		return func() tea.Msg {
+			return menuActionMsg{action: MenuNeedsAttention}
+		}
 	case MenuOptions:
 		return func() tea.Msg {
 			return menuActionMsg{action: MenuOptions}
@@ -131,7 +183,14 @@ func (m *MenuModel) View() string {
 	menu := m.renderMenuItems()
 
 	// Render help footer
-	helpText := "↑/k: up • ↓/j: down • enter/space: select • q: quit"
+	helpText := "↑/k: up • ↓/j: down • enter/space: select • r: latest recording • e: finish untitled"
+	if showStorageBudgetPrompt(m.storageBudget) {
+		helpText += " • b: storage budget • x: dismiss"
+	}
+	if m.setupDraft != nil {
+		helpText += " • c: continue setup • d: discard setup"
+	}
+	helpText += " • q: quit"
 	footer := RenderHelpFooter(helpText, m.width)
 
 	// Use standard layout
@@ -175,6 +234,52 @@ func (m *MenuModel) renderMenuItems() string {
 		sections = append(sections, "")
 	}
 
+	// Suggest freeing space when the recordings exceed the storage budget
+	if showStorageBudgetPrompt(m.storageBudget) {
+		budgetStyle := lipgloss.NewStyle().
+			Foreground(ColorOrange).
+			Bold(true)
+
+		budgetBoxStyle := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(ColorOrange).
+			Padding(0, 2).
+			MarginBottom(1)
+
+		plan := m.storageBudget
+		budgetText := fmt.Sprintf("⚠ Recordings use %s of the %s storage budget\n", models.FormatFileSize(plan.Used), models.FormatFileSize(plan.Budget))
+		if len(plan.Suggestions) > 0 {
+			budgetText += fmt.Sprintf("Archiving %d uploaded recording(s) frees %s. b: review • x: dismiss",
+				len(plan.Suggestions), models.FormatFileSize(plan.Freed))
+		} else {
+			budgetText += "No uploaded recordings to suggest. x: dismiss"
+		}
+		sections = append(sections, budgetBoxStyle.Render(budgetStyle.Render(budgetText)))
+		sections = append(sections, "")
+	}
+
+	// Offer to continue the setup the previous run was quit during
+	if draft := m.setupDraft; draft != nil {
+		draftStyle := lipgloss.NewStyle().
+			Foreground(ColorBlue).
+			Bold(true)
+
+		draftBoxStyle := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(ColorBlue).
+			Padding(0, 2).
+			MarginBottom(1)
+
+		title := draft.Metadata.Title
+		if title == "" {
+			title = "Untitled"
+		}
+		draftText := fmt.Sprintf("↺ Unfinished recording setup: %s\nSaved %s. c: continue • d: discard",
+			truncateStr(title, 40), draft.SavedAt.Format("2006-01-02 15:04"))
+		sections = append(sections, draftBoxStyle.Render(draftStyle.Render(draftText)))
+		sections = append(sections, "")
+	}
+
 	var items []string
 	for i, item := range m.menuItems {
 		prefix := "  "
@@ -220,7 +325,35 @@ func (m *MenuModel) SetExternalRecording(active bool, pids []string) {
 	}
 }
 
+// SetStorageBudget updates the usage of the videos directory against the storage budget
+func (m *MenuModel) SetStorageBudget(plan retention.BudgetPlan) {
+	m.storageBudget = plan
+}
+
+// SetSetupDraft offers to continue the new recording form of the previous
+// run, or stops offering it if draft is nil
+func (m *MenuModel) SetSetupDraft(draft *config.SetupDraft) {
+	m.setupDraft = draft
+}
+
+// newRecordingEnabled returns false while new recordings are disabled, e.g.
+// by an external recording
+func (m *MenuModel) newRecordingEnabled() bool {
+	for _, item := range m.menuItems {
+		if item.action == MenuNewRecording {
+			return item.enabled
+		}
+	}
+	return false
+}
+
 // menuActionMsg is sent when a menu item is selected
 type menuActionMsg struct {
 	action MenuItem
 }
+
+// openLatestRecordingMsg opens the history at the latest recording, or at
+// the edit form of the latest recording that needs metadata
+type openLatestRecordingMsg struct {
+	needsMetadata bool
+}
@@ -17,12 +17,12 @@ func TestNewMenuModel(t *testing.T) {
 		t.Errorf("expected selectedItem to be 0, got %d", m.selectedItem)
 	}
 
-	if len(m.menuItems) != 4 {
-		t.Errorf("expected 4 menu items, got %d", len(m.menuItems))
+	if len(m.menuItems) != 5 {
+		t.Errorf("expected 5 menu items, got %d", len(m.menuItems))
 	}
 
 	// Check menu item labels
-	expectedLabels := []string{"New Recording", "Recording History", "Options", "Quit"}
+	expectedLabels := []string{"New Recording", "Recording History", "Needs Attention", "Options", "Quit"}
 	for i, item := range m.menuItems {
 		if item.label != expectedLabels[i] {
 			t.Errorf("expected menu item %d to be %q, got %q", i, expectedLabels[i], item.label)
@@ -37,7 +37,7 @@ func TestMenuModel_NavigationDown(t *testing.T) {
 	m := NewMenuModel()
 
 	// Navigate down through all items
-	for i := 0; i < 4; i++ {
+	for i := 0; i < 5; i++ {
 		if m.selectedItem != i {
 			t.Errorf("expected selectedItem to be %d, got %d", i, m.selectedItem)
 		}
@@ -61,8 +61,8 @@ func TestMenuModel_NavigationUp(t *testing.T) {
 	newM, _ := m.Update(keyMsg)
 	m = newM
 
-	if m.selectedItem != 3 {
-		t.Errorf("expected selectedItem to wrap to 3, got %d", m.selectedItem)
+	if m.selectedItem != 4 {
+		t.Errorf("expected selectedItem to wrap to 4, got %d", m.selectedItem)
 	}
 }
 
@@ -174,8 +174,9 @@ func TestMenuModel_SelectedAction(t *testing.T) {
 	}{
 		{0, MenuNewRecording},
 		{1, MenuRecordingHistory},
-		{2, MenuOptions},
-		{3, MenuQuit},
+		{2, MenuNeedsAttention},
+		{3, MenuOptions},
+		{4, MenuQuit},
 	}
 
 	for _, tt := range tests {
@@ -227,3 +228,18 @@ func containsStringHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func TestMenuModel_LatestRecordingShortcuts(t *testing.T) {
+	m := NewMenuModel()
+
+	for key, needsMetadata := range map[string]bool{"r": false, "e": true} {
+		_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		if cmd == nil {
+			t.Fatalf("expected %q to send a command", key)
+		}
+		msg, ok := cmd().(openLatestRecordingMsg)
+		if !ok || msg.needsMetadata != needsMetadata {
+			t.Errorf("%q sent %#v, want openLatestRecordingMsg{needsMetadata: %v}", key, msg, needsMetadata)
+		}
+	}
+}
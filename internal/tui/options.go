@@ -2,25 +2,18 @@ package tui
 
 import (
 	"fmt"
-	"os"
-	"path/filepath"
-	"sort"
+	"math"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/merger"
 	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/templates"
 )
 
-// fileEntry represents a file or directory in the browser
-type fileEntry struct {
-	name  string
-	path  string
-	isDir bool
-}
-
 // OptionsField represents which field is focused in options
 type OptionsField int
 
@@ -32,6 +25,29 @@ const (
 	OptionsFieldDefaultPresenter
 	OptionsFieldLogoDirectory
 	OptionsFieldBgColor
+	OptionsFieldLogoFit
+	OptionsFieldOutputResolution
+	OptionsFieldPiPCorner
+	OptionsFieldPiPSize
+	OptionsFieldHardwareEncoding
+	OptionsFieldLoudnessTarget
+	OptionsFieldFilterPresetList
+	OptionsFieldFilterPresetName
+	OptionsFieldFilterPresetStage
+	OptionsFieldFilterPresetFilter
+	OptionsFieldAddFilterPreset
+	OptionsFieldTemplateList
+	OptionsFieldImportTemplate
+	OptionsFieldUITheme
+	OptionsFieldRetentionAction
+	OptionsFieldRetentionAge
+	OptionsFieldRetentionUploaded
+	OptionsFieldStorageBudget
+	OptionsFieldCountdown
+	OptionsFieldBreakInterval
+	OptionsFieldBreakAutoSplit
+	OptionsFieldAutoOpenFolder
+	OptionsFieldAutoPlay
 	OptionsFieldYouTubeSetup
 	OptionsFieldSyndicationSetup
 	OptionsFieldPresetRecordAudio
@@ -39,23 +55,18 @@ const (
 	OptionsFieldPresetRecordScreen
 	OptionsFieldPresetVerticalVideo
 	OptionsFieldPresetAddLogos
+	OptionsFieldFormFocus
+	OptionsFieldSkipPresetToggles
 	OptionsFieldSave
 )
 
-// FileBrowserField represents which part of the file browser is focused
-type FileBrowserField int
-
-const (
-	FileBrowserFieldList FileBrowserField = iota
-	FileBrowserFieldPathInput
-)
-
 // BrowserTarget indicates what the file browser is selecting
 type BrowserTarget int
 
 const (
 	BrowserTargetLogo BrowserTarget = iota
 	BrowserTargetOutput
+	BrowserTargetTemplate
 )
 
 // OptionsModel handles the options screen
@@ -86,16 +97,60 @@ type OptionsModel struct {
 	// Background color for vertical video lower third
 	bgColorIdx int
 
-	// Custom file browser (for selecting logo directory or output directory)
-	showFileBrowser      bool
-	selectingDirectory   bool // true when selecting directory, not file
-	browserTarget        BrowserTarget
-	browserCurrentDir    string
-	browserEntries       []fileEntry
-	browserSelected      int
-	browserScrollTop     int
-	browserPathInput     textinput.Model
-	browserField         FileBrowserField
+	// How logos are fitted into their overlay slots
+	logoFitIdx int
+
+	// Default output resolution for processed videos
+	outputResolutionIdx int
+
+	// Default landscape webcam overlay corner and size
+	pipCornerIdx int
+	pipSizeIdx   int
+
+	// Encode on the GPU when one is found
+	hardwareEncoding bool
+
+	// Integrated loudness audio is normalized to, in whole LUFS
+	loudnessTarget int
+
+	// Filter preset library and the new preset being entered
+	filterPresets        []models.FilterPreset
+	selectedFilterPreset int
+	filterNameInput      textinput.Model
+	filterStageIdx       int
+	filterInput          textinput.Model
+	testingFilterPreset  bool // A new preset is being test-run with ffmpeg
+
+	// Recording metadata templates
+	templates        []templates.Template
+	selectedTemplate int
+
+	// TUI color theme (auto, dark, light)
+	uiThemeIdx int
+
+	// Retention policy for old recordings
+	retentionActionIdx int
+	retentionAgeIdx    int
+	retentionUploaded  bool
+
+	// Storage budget for the videos directory (index into config.StorageBudgetsGB)
+	storageBudgetIdx int
+
+	// Seconds of countdown before recording starts (0 = none)
+	countdownSeconds int
+
+	// Break reminders during long recordings
+	breakIntervalIdx int
+	breakAutoSplit   bool
+
+	// Review after processing: open the folder, play a video (0 = off,
+	// otherwise config.AutoPlayOutputs[autoPlayIdx-1])
+	autoOpenFolder bool
+	autoPlayIdx    int
+
+	// File browser for selecting the logo or output directory (nil when closed)
+	fileBrowser   *FileBrowserModel
+	browserTarget BrowserTarget
 
 	// Recording presets (for systray quick-record)
 	presetRecordAudio   bool
@@ -104,6 +159,10 @@ type OptionsModel struct {
 	presetVerticalVideo bool
 	presetAddLogos      bool
 
+	// Recording form navigation
+	formFocusIdx      int
+	skipPresetToggles bool
+
 	// State
 	savedSuccess bool // set on successful save (for presets-mode auto-close)
 	message      string
@@ -135,18 +194,16 @@ func NewOptionsModel() *OptionsModel {
 		presenterInput.SetValue(cfg.DefaultPresenter)
 	}
 
-	// Path input for file browser
-	pathInput := textinput.New()
-	pathInput.Placeholder = "Enter or paste path..."
-	pathInput.CharLimit = 500
-	pathInput.Width = 50
+	// Filter preset inputs
+	filterNameInput := textinput.New()
+	filterNameInput.Placeholder = "Preset name"
+	filterNameInput.CharLimit = 50
+	filterNameInput.Width = 30
 
-	// Start in home directory or output directory if set
-	home, _ := os.UserHomeDir()
-	browserDir := home
-	if cfg.OutputDir != "" {
-		browserDir = cfg.OutputDir
-	}
+	filterInput := textinput.New()
+	filterInput.Placeholder = "ffmpeg filter, e.g. eq=gamma=1.2,hqdn3d"
+	filterInput.CharLimit = 500
+	filterInput.Width = 50
 
 	// Get output directory - use config value or default
 	outputDir := cfg.OutputDir
@@ -181,108 +238,69 @@ func NewOptionsModel() *OptionsModel {
 		outputDirectory:     outputDir,
 		logoDirectory:       cfg.LogoDirectory,
 		bgColorIdx:          bgColorIdx,
-		showFileBrowser:     false,
-		selectingDirectory:  false,
-		browserCurrentDir:   browserDir,
-		browserPathInput:    pathInput,
-		browserField:        FileBrowserFieldList,
+		logoFitIdx:          config.LogoFitIndex(cfg.LogoFit),
+		outputResolutionIdx: config.OutputResolutionIndex(cfg.OutputResolution),
+		pipCornerIdx:        config.PiPCornerIndex(cfg.PiPCorner),
+		pipSizeIdx:          config.PiPSizeIndex(cfg.PiPSize),
+		hardwareEncoding:    cfg.HardwareEncoding,
+		loudnessTarget:      int(math.Round(cfg.LoudnessTargetLUFS())),
+		filterPresets:       append([]models.FilterPreset(nil), cfg.FilterPresets...),
+		templates:           append([]templates.Template(nil), cfg.RecordingTemplates...),
+		filterNameInput:     filterNameInput,
+		filterInput:         filterInput,
+		uiThemeIdx:          uiThemeIndex(cfg.UITheme),
+		retentionActionIdx:  retentionActionIndex(cfg.Retention.Action),
+		retentionAgeIdx:     retentionAgeIndex(cfg.Retention.AgeDays()),
+		retentionUploaded:   cfg.Retention.OnlyIfUploaded,
+		storageBudgetIdx:    storageBudgetIndex(cfg.StorageBudgetGB),
+		countdownSeconds:    cfg.Countdown(),
+		breakIntervalIdx:    breakIntervalIndex(cfg.BreakReminder.IntervalMinutes),
+		breakAutoSplit:      cfg.BreakReminder.AutoSplit,
+		autoOpenFolder:      cfg.AutoOpenOutputOnComplete,
+		autoPlayIdx:         autoPlayIndex(cfg),
 		presetRecordAudio:   presets.RecordAudio,
 		presetRecordWebcam:  presets.RecordWebcam,
 		presetRecordScreen:  presets.RecordScreen,
 		presetVerticalVideo: presets.VerticalVideo,
 		presetAddLogos:      presets.AddLogos,
+		formFocusIdx:        config.FormFocusIndex(cfg.FormFocus),
+		skipPresetToggles:   cfg.SkipPresetToggles,
 	}
 }
 
-// loadBrowserEntries loads the directory contents for the file browser
-func (m *OptionsModel) loadBrowserEntries() {
-	m.browserEntries = nil
-	m.browserSelected = 0
-	m.browserScrollTop = 0
-
-	entries, err := os.ReadDir(m.browserCurrentDir)
-	if err != nil {
-		return
-	}
-
-	// Add parent directory entry if not at root
-	if m.browserCurrentDir != "/" {
-		m.browserEntries = append(m.browserEntries, fileEntry{
-			name:  "..",
-			path:  filepath.Dir(m.browserCurrentDir),
-			isDir: true,
-		})
-	}
-
-	// Collect directories (and files if not selecting directory)
-	var dirs []fileEntry
-	for _, entry := range entries {
-		// Skip hidden files
-		if strings.HasPrefix(entry.Name(), ".") {
-			continue
-		}
-
-		fullPath := filepath.Join(m.browserCurrentDir, entry.Name())
-		fe := fileEntry{
-			name:  entry.Name(),
-			path:  fullPath,
-			isDir: entry.IsDir(),
-		}
-
-		if entry.IsDir() {
-			dirs = append(dirs, fe)
-		}
-		// When selecting directory, we only show directories
-	}
-
-	// Sort alphabetically
-	sort.Slice(dirs, func(i, j int) bool {
-		return strings.ToLower(dirs[i].name) < strings.ToLower(dirs[j].name)
-	})
-
-	m.browserEntries = append(m.browserEntries, dirs...)
-}
-
 // openDirectoryBrowser opens the file browser for selecting a directory
 func (m *OptionsModel) openDirectoryBrowser(target BrowserTarget) {
-	m.showFileBrowser = true
-	m.selectingDirectory = true
 	m.browserTarget = target
-	m.browserField = FileBrowserFieldList
 
-	// Start in the appropriate directory based on target
+	// Start in the current directory of the target, or the media folder
+	var title, startDir string
 	switch target {
 	case BrowserTargetOutput:
-		if m.outputDirectory != "" {
-			m.browserCurrentDir = m.outputDirectory
-		}
+		title = "Select Media Folder"
+		startDir = m.outputDirectory
 	case BrowserTargetLogo:
-		if m.logoDirectory != "" {
-			m.browserCurrentDir = m.logoDirectory
-		}
+		title = "Select Logo Directory"
+		startDir = m.logoDirectory
+	}
+	if startDir == "" {
+		startDir = m.outputDirectory
 	}
 
-	m.browserPathInput.SetValue(m.browserCurrentDir)
-	m.browserPathInput.Blur()
-	m.loadBrowserEntries()
-}
-
-// closeFileBrowser closes the file browser
-func (m *OptionsModel) closeFileBrowser() {
-	m.showFileBrowser = false
-	m.selectingDirectory = false
+	m.fileBrowser = NewDirectoryBrowser(title, startDir)
+	m.fileBrowser.SetSize(m.width, m.height)
 }
 
 // IsFileBrowserActive returns true if the file browser is currently shown
 func (m *OptionsModel) IsFileBrowserActive() bool {
-	return m.showFileBrowser
+	return m.fileBrowser != nil
 }
 
 // RenderFileBrowser renders the file browser with full screen layout
 func (m *OptionsModel) RenderFileBrowser(width, height int) string {
 	m.width = width
 	m.height = height
-	return m.renderFileBrowser()
+	m.fileBrowser.SetSize(width, height)
+	return m.fileBrowser.View()
 }
 
 // Init initializes the model
@@ -295,7 +313,7 @@ func (m *OptionsModel) Update(msg tea.Msg) (*OptionsModel, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	// Handle file browser if active
-	if m.showFileBrowser {
+	if m.fileBrowser != nil {
 		return m.updateFileBrowser(msg)
 	}
 
@@ -304,6 +322,19 @@ func (m *OptionsModel) Update(msg tea.Msg) (*OptionsModel, tea.Cmd) {
 		m.width = msg.Width
 		m.height = msg.Height
 
+	case filterPresetTestedMsg:
+		m.testingFilterPreset = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.filterPresets = append(m.filterPresets, msg.preset)
+		m.selectedFilterPreset = len(m.filterPresets) - 1
+		m.filterNameInput.SetValue("")
+		m.filterInput.SetValue("")
+		m.message = "Filter preset added: " + msg.preset.Name + " (save to keep it)"
+		return m, nil
+
 	case tea.KeyMsg:
 		// Clear messages on any key
 		m.message = ""
@@ -326,6 +357,20 @@ func (m *OptionsModel) Update(msg tea.Msg) (*OptionsModel, tea.Cmd) {
 				}
 				return m, nil
 			}
+			if m.focusedField == OptionsFieldFilterPresetList && len(m.filterPresets) > 0 {
+				m.selectedFilterPreset++
+				if m.selectedFilterPreset >= len(m.filterPresets) {
+					m.selectedFilterPreset = 0
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldTemplateList && len(m.templates) > 0 {
+				m.selectedTemplate++
+				if m.selectedTemplate >= len(m.templates) {
+					m.selectedTemplate = 0
+				}
+				return m, nil
+			}
 
 		case "k":
 			if m.focusedField == OptionsFieldTopicList {
@@ -335,6 +380,20 @@ func (m *OptionsModel) Update(msg tea.Msg) (*OptionsModel, tea.Cmd) {
 				}
 				return m, nil
 			}
+			if m.focusedField == OptionsFieldFilterPresetList && len(m.filterPresets) > 0 {
+				m.selectedFilterPreset--
+				if m.selectedFilterPreset < 0 {
+					m.selectedFilterPreset = len(m.filterPresets) - 1
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldTemplateList && len(m.templates) > 0 {
+				m.selectedTemplate--
+				if m.selectedTemplate < 0 {
+					m.selectedTemplate = len(m.templates) - 1
+				}
+				return m, nil
+			}
 
 		case "left":
 			if m.focusedField == OptionsFieldBgColor {
@@ -344,6 +403,104 @@ func (m *OptionsModel) Update(msg tea.Msg) (*OptionsModel, tea.Cmd) {
 				}
 				return m, nil
 			}
+			if m.focusedField == OptionsFieldLogoFit {
+				m.logoFitIdx--
+				if m.logoFitIdx < 0 {
+					m.logoFitIdx = len(config.LogoFits) - 1
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldOutputResolution {
+				m.outputResolutionIdx--
+				if m.outputResolutionIdx < 0 {
+					m.outputResolutionIdx = len(config.OutputResolutions) - 1
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldPiPCorner {
+				m.pipCornerIdx--
+				if m.pipCornerIdx < 0 {
+					m.pipCornerIdx = len(config.PiPCorners) - 1
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldPiPSize {
+				m.pipSizeIdx--
+				if m.pipSizeIdx < 0 {
+					m.pipSizeIdx = len(config.PiPSizes) - 1
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldLoudnessTarget {
+				m.loudnessTarget--
+				if m.loudnessTarget < models.MinLoudnessTargetLUFS {
+					m.loudnessTarget = models.MaxLoudnessTargetLUFS
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldFilterPresetStage {
+				m.filterStageIdx--
+				if m.filterStageIdx < 0 {
+					m.filterStageIdx = len(models.FilterStages) - 1
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldUITheme {
+				m.uiThemeIdx--
+				if m.uiThemeIdx < 0 {
+					m.uiThemeIdx = len(config.UIThemes) - 1
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldRetentionAction {
+				m.retentionActionIdx--
+				if m.retentionActionIdx < 0 {
+					m.retentionActionIdx = len(config.RetentionActions) - 1
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldRetentionAge {
+				m.retentionAgeIdx--
+				if m.retentionAgeIdx < 0 {
+					m.retentionAgeIdx = len(config.RetentionAgeDays) - 1
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldStorageBudget {
+				m.storageBudgetIdx--
+				if m.storageBudgetIdx < 0 {
+					m.storageBudgetIdx = len(config.StorageBudgetsGB) - 1
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldCountdown {
+				m.countdownSeconds--
+				if m.countdownSeconds < 0 {
+					m.countdownSeconds = config.MaxCountdownSeconds
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldBreakInterval {
+				m.breakIntervalIdx--
+				if m.breakIntervalIdx < 0 {
+					m.breakIntervalIdx = len(config.BreakReminderIntervals) - 1
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldAutoPlay {
+				m.autoPlayIdx--
+				if m.autoPlayIdx < 0 {
+					m.autoPlayIdx = len(config.AutoPlayOutputs)
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldFormFocus {
+				m.formFocusIdx--
+				if m.formFocusIdx < 0 {
+					m.formFocusIdx = len(config.FormFocuses) - 1
+				}
+				return m, nil
+			}
 
 		case "right":
 			if m.focusedField == OptionsFieldBgColor {
@@ -353,8 +510,110 @@ func (m *OptionsModel) Update(msg tea.Msg) (*OptionsModel, tea.Cmd) {
 				}
 				return m, nil
 			}
+			if m.focusedField == OptionsFieldLogoFit {
+				m.logoFitIdx++
+				if m.logoFitIdx >= len(config.LogoFits) {
+					m.logoFitIdx = 0
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldOutputResolution {
+				m.outputResolutionIdx++
+				if m.outputResolutionIdx >= len(config.OutputResolutions) {
+					m.outputResolutionIdx = 0
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldPiPCorner {
+				m.pipCornerIdx++
+				if m.pipCornerIdx >= len(config.PiPCorners) {
+					m.pipCornerIdx = 0
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldPiPSize {
+				m.pipSizeIdx++
+				if m.pipSizeIdx >= len(config.PiPSizes) {
+					m.pipSizeIdx = 0
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldLoudnessTarget {
+				m.loudnessTarget++
+				if m.loudnessTarget > models.MaxLoudnessTargetLUFS {
+					m.loudnessTarget = models.MinLoudnessTargetLUFS
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldFilterPresetStage {
+				m.filterStageIdx++
+				if m.filterStageIdx >= len(models.FilterStages) {
+					m.filterStageIdx = 0
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldUITheme {
+				m.uiThemeIdx++
+				if m.uiThemeIdx >= len(config.UIThemes) {
+					m.uiThemeIdx = 0
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldRetentionAction {
+				m.retentionActionIdx++
+				if m.retentionActionIdx >= len(config.RetentionActions) {
+					m.retentionActionIdx = 0
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldRetentionAge {
+				m.retentionAgeIdx++
+				if m.retentionAgeIdx >= len(config.RetentionAgeDays) {
+					m.retentionAgeIdx = 0
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldStorageBudget {
+				m.storageBudgetIdx++
+				if m.storageBudgetIdx >= len(config.StorageBudgetsGB) {
+					m.storageBudgetIdx = 0
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldCountdown {
+				m.countdownSeconds++
+				if m.countdownSeconds > config.MaxCountdownSeconds {
+					m.countdownSeconds = 0
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldBreakInterval {
+				m.breakIntervalIdx++
+				if m.breakIntervalIdx >= len(config.BreakReminderIntervals) {
+					m.breakIntervalIdx = 0
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldAutoPlay {
+				m.autoPlayIdx++
+				if m.autoPlayIdx > len(config.AutoPlayOutputs) {
+					m.autoPlayIdx = 0
+				}
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldFormFocus {
+				m.formFocusIdx++
+				if m.formFocusIdx >= len(config.FormFocuses) {
+					m.formFocusIdx = 0
+				}
+				return m, nil
+			}
 
 		case "enter", " ":
+			// Let text inputs receive spaces
+			if msg.String() == " " && m.focusedOnTextInput() {
+				break
+			}
 			switch m.focusedField {
 			case OptionsFieldOutputDirectory:
 				m.openDirectoryBrowser(BrowserTargetOutput)
@@ -368,6 +627,9 @@ func (m *OptionsModel) Update(msg tea.Msg) (*OptionsModel, tea.Cmd) {
 			case OptionsFieldLogoDirectory:
 				m.openDirectoryBrowser(BrowserTargetLogo)
 				return m, nil
+			case OptionsFieldImportTemplate:
+				m.openTemplateBrowser()
+				return m, nil
 			case OptionsFieldBgColor:
 				// Cycle to next color on enter/space
 				m.bgColorIdx++
@@ -375,6 +637,100 @@ func (m *OptionsModel) Update(msg tea.Msg) (*OptionsModel, tea.Cmd) {
 					m.bgColorIdx = 0
 				}
 				return m, nil
+			case OptionsFieldLogoFit:
+				m.logoFitIdx++
+				if m.logoFitIdx >= len(config.LogoFits) {
+					m.logoFitIdx = 0
+				}
+				return m, nil
+			case OptionsFieldOutputResolution:
+				// Cycle to next resolution on enter/space
+				m.outputResolutionIdx++
+				if m.outputResolutionIdx >= len(config.OutputResolutions) {
+					m.outputResolutionIdx = 0
+				}
+				return m, nil
+			case OptionsFieldPiPCorner:
+				m.pipCornerIdx++
+				if m.pipCornerIdx >= len(config.PiPCorners) {
+					m.pipCornerIdx = 0
+				}
+				return m, nil
+			case OptionsFieldPiPSize:
+				m.pipSizeIdx++
+				if m.pipSizeIdx >= len(config.PiPSizes) {
+					m.pipSizeIdx = 0
+				}
+				return m, nil
+			case OptionsFieldHardwareEncoding:
+				m.hardwareEncoding = !m.hardwareEncoding
+				return m, nil
+			case OptionsFieldLoudnessTarget:
+				m.loudnessTarget++
+				if m.loudnessTarget > models.MaxLoudnessTargetLUFS {
+					m.loudnessTarget = models.MinLoudnessTargetLUFS
+				}
+				return m, nil
+			case OptionsFieldFilterPresetStage:
+				m.filterStageIdx++
+				if m.filterStageIdx >= len(models.FilterStages) {
+					m.filterStageIdx = 0
+				}
+				return m, nil
+			case OptionsFieldAddFilterPreset:
+				return m, m.addFilterPreset()
+			case OptionsFieldUITheme:
+				// Cycle to next theme on enter/space
+				m.uiThemeIdx++
+				if m.uiThemeIdx >= len(config.UIThemes) {
+					m.uiThemeIdx = 0
+				}
+				return m, nil
+			case OptionsFieldRetentionAction:
+				m.retentionActionIdx++
+				if m.retentionActionIdx >= len(config.RetentionActions) {
+					m.retentionActionIdx = 0
+				}
+				return m, nil
+			case OptionsFieldRetentionAge:
+				m.retentionAgeIdx++
+				if m.retentionAgeIdx >= len(config.RetentionAgeDays) {
+					m.retentionAgeIdx = 0
+				}
+				return m, nil
+			case OptionsFieldRetentionUploaded:
+				m.retentionUploaded = !m.retentionUploaded
+				return m, nil
+			case OptionsFieldStorageBudget:
+				m.storageBudgetIdx++
+				if m.storageBudgetIdx >= len(config.StorageBudgetsGB) {
+					m.storageBudgetIdx = 0
+				}
+				return m, nil
+			case OptionsFieldCountdown:
+				m.countdownSeconds++
+				if m.countdownSeconds > config.MaxCountdownSeconds {
+					m.countdownSeconds = 0
+				}
+				return m, nil
+			case OptionsFieldBreakInterval:
+				m.breakIntervalIdx++
+				if m.breakIntervalIdx >= len(config.BreakReminderIntervals) {
+					m.breakIntervalIdx = 0
+				}
+				return m, nil
+			case OptionsFieldBreakAutoSplit:
+				m.breakAutoSplit = !m.breakAutoSplit
+				return m, nil
+			case OptionsFieldAutoOpenFolder:
+				m.autoOpenFolder = !m.autoOpenFolder
+				return m, nil
+			case OptionsFieldAutoPlay:
+				m.autoPlayIdx++
+				if m.autoPlayIdx > len(config.AutoPlayOutputs) {
+					m.autoPlayIdx = 0
+				}
+				return m, nil
 			case OptionsFieldYouTubeSetup:
 				return m, func() tea.Msg { return goToYouTubeSetupMsg{} }
 			case OptionsFieldSyndicationSetup:
@@ -384,27 +740,29 @@ func (m *OptionsModel) Update(msg tea.Msg) (*OptionsModel, tea.Cmd) {
 				return m, nil
 			case OptionsFieldPresetRecordWebcam:
 				m.presetRecordWebcam = !m.presetRecordWebcam
-				// Auto-disable vertical video if both webcam and screen are off
-				if !m.presetRecordWebcam && !m.presetRecordScreen {
-					m.presetVerticalVideo = false
-				}
 				return m, nil
 			case OptionsFieldPresetRecordScreen:
 				m.presetRecordScreen = !m.presetRecordScreen
-				// Auto-disable vertical video if both webcam and screen are off
-				if !m.presetRecordWebcam && !m.presetRecordScreen {
-					m.presetVerticalVideo = false
-				}
 				return m, nil
 			case OptionsFieldPresetVerticalVideo:
-				// Only allow if webcam or screen is enabled
-				if m.presetRecordWebcam || m.presetRecordScreen {
+				// Only allow if both webcam and screen are enabled. The value is
+				// kept while they are off, so it comes back with them.
+				if config.VerticalVideoSupported(m.presetRecordWebcam, m.presetRecordScreen) {
 					m.presetVerticalVideo = !m.presetVerticalVideo
 				}
 				return m, nil
 			case OptionsFieldPresetAddLogos:
 				m.presetAddLogos = !m.presetAddLogos
 				return m, nil
+			case OptionsFieldFormFocus:
+				m.formFocusIdx++
+				if m.formFocusIdx >= len(config.FormFocuses) {
+					m.formFocusIdx = 0
+				}
+				return m, nil
+			case OptionsFieldSkipPresetToggles:
+				m.skipPresetToggles = !m.skipPresetToggles
+				return m, nil
 			case OptionsFieldSave:
 				m.save()
 				return m, nil
@@ -436,11 +794,25 @@ func (m *OptionsModel) Update(msg tea.Msg) (*OptionsModel, tea.Cmd) {
 				return m, nil
 			}
 
+		case "s":
+			if m.focusedField == OptionsFieldTopicList && m.selectedTopic < len(m.topics) {
+				m.toggleSensitiveTopic()
+				return m, nil
+			}
+
 		case "d", "delete", "backspace":
 			if m.focusedField == OptionsFieldTopicList && len(m.topics) > 1 {
 				m.removeTopic()
 				return m, nil
 			}
+			if m.focusedField == OptionsFieldFilterPresetList {
+				m.removeFilterPreset()
+				return m, nil
+			}
+			if m.focusedField == OptionsFieldTemplateList {
+				m.removeTemplate()
+				return m, nil
+			}
 		}
 	}
 
@@ -455,6 +827,16 @@ func (m *OptionsModel) Update(msg tea.Msg) (*OptionsModel, tea.Cmd) {
 		var cmd tea.Cmd
 		m.presenterInput, cmd = m.presenterInput.Update(msg)
 		cmds = append(cmds, cmd)
+
+	case OptionsFieldFilterPresetName:
+		var cmd tea.Cmd
+		m.filterNameInput, cmd = m.filterNameInput.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case OptionsFieldFilterPresetFilter:
+		var cmd tea.Cmd
+		m.filterInput, cmd = m.filterInput.Update(msg)
+		cmds = append(cmds, cmd)
 	}
 
 	return m, tea.Batch(cmds...)
@@ -484,6 +866,8 @@ func (m *OptionsModel) prevField() {
 func (m *OptionsModel) unfocusAll() {
 	m.newTopicInput.Blur()
 	m.presenterInput.Blur()
+	m.filterNameInput.Blur()
+	m.filterInput.Blur()
 }
 
 // focusCurrent focuses the current field
@@ -493,7 +877,21 @@ func (m *OptionsModel) focusCurrent() {
 		m.newTopicInput.Focus()
 	case OptionsFieldDefaultPresenter:
 		m.presenterInput.Focus()
+	case OptionsFieldFilterPresetName:
+		m.filterNameInput.Focus()
+	case OptionsFieldFilterPresetFilter:
+		m.filterInput.Focus()
+	}
+}
+
+// focusedOnTextInput returns true if the focused field is a text input
+func (m *OptionsModel) focusedOnTextInput() bool {
+	switch m.focusedField {
+	case OptionsFieldAddTopic, OptionsFieldDefaultPresenter,
+		OptionsFieldFilterPresetName, OptionsFieldFilterPresetFilter:
+		return true
 	}
+	return false
 }
 
 // addTopic adds a new topic
@@ -524,6 +922,18 @@ func (m *OptionsModel) addTopic() {
 	m.message = "Topic added: " + name
 }
 
+// toggleSensitiveTopic marks the selected topic sensitive, or no longer
+// sensitive
+func (m *OptionsModel) toggleSensitiveTopic() {
+	topic := &m.topics[m.selectedTopic]
+	topic.Sensitive = !topic.Sensitive
+	if topic.Sensitive {
+		m.message = topic.Name + " is sensitive: uploads default to private"
+	} else {
+		m.message = topic.Name + " is no longer sensitive"
+	}
+}
+
 // removeTopic removes the selected topic
 func (m *OptionsModel) removeTopic() {
 	if len(m.topics) <= 1 {
@@ -541,13 +951,137 @@ func (m *OptionsModel) removeTopic() {
 	}
 }
 
+// filterPresetTestedMsg reports the result of test-running a new filter preset
+type filterPresetTestedMsg struct {
+	preset models.FilterPreset
+	err    error
+}
+
+// addFilterPreset validates the new filter preset and test-runs it with
+// ffmpeg in the background; it is added to the library once the test passes
+func (m *OptionsModel) addFilterPreset() tea.Cmd {
+	if m.testingFilterPreset {
+		return nil
+	}
+	preset := models.FilterPreset{
+		Name:   strings.TrimSpace(m.filterNameInput.Value()),
+		Stage:  models.FilterStages[m.filterStageIdx],
+		Filter: strings.TrimSpace(m.filterInput.Value()),
+	}
+	if err := preset.Validate(); err != nil {
+		m.err = err
+		return nil
+	}
+	for _, p := range m.filterPresets {
+		if strings.EqualFold(p.Name, preset.Name) {
+			m.message = "Filter preset already exists"
+			return nil
+		}
+	}
+
+	m.testingFilterPreset = true
+	m.message = "Testing filter preset with ffmpeg..."
+	return func() tea.Msg {
+		return filterPresetTestedMsg{preset: preset, err: merger.ValidateFilterPreset(preset)}
+	}
+}
+
+// removeFilterPreset removes the selected filter preset from the library
+func (m *OptionsModel) removeFilterPreset() {
+	if m.selectedFilterPreset < 0 || m.selectedFilterPreset >= len(m.filterPresets) {
+		return
+	}
+	name := m.filterPresets[m.selectedFilterPreset].Name
+	m.filterPresets = append(m.filterPresets[:m.selectedFilterPreset], m.filterPresets[m.selectedFilterPreset+1:]...)
+	if m.selectedFilterPreset >= len(m.filterPresets) && m.selectedFilterPreset > 0 {
+		m.selectedFilterPreset = len(m.filterPresets) - 1
+	}
+	m.message = "Filter preset removed: " + name
+}
+
+// openTemplateBrowser opens the file browser for importing recording templates
+func (m *OptionsModel) openTemplateBrowser() {
+	m.browserTarget = BrowserTargetTemplate
+	m.fileBrowser = NewFileBrowser("Import Recording Templates", "", ".yaml", ".yml", ".json")
+	m.fileBrowser.SetSize(m.width, m.height)
+}
+
+// importTemplates adds the recording templates in a YAML or JSON file,
+// replacing templates with the same name
+func (m *OptionsModel) importTemplates(path string) {
+	imported, err := templates.LoadFile(path)
+	if err != nil {
+		m.err = err
+		return
+	}
+	m.templates = templates.Merge(m.templates, imported)
+	m.selectedTemplate = len(m.templates) - 1
+	for i, t := range m.templates {
+		if t.Name == imported[0].Name {
+			m.selectedTemplate = i
+			break
+		}
+	}
+	m.message = fmt.Sprintf("Imported %d template(s) (save to keep them)", len(imported))
+}
+
+// removeTemplate removes the selected recording template
+func (m *OptionsModel) removeTemplate() {
+	if m.selectedTemplate < 0 || m.selectedTemplate >= len(m.templates) {
+		return
+	}
+	name := m.templates[m.selectedTemplate].Name
+	m.templates = append(m.templates[:m.selectedTemplate], m.templates[m.selectedTemplate+1:]...)
+	if m.selectedTemplate >= len(m.templates) && m.selectedTemplate > 0 {
+		m.selectedTemplate = len(m.templates) - 1
+	}
+	m.message = "Template removed: " + name
+}
+
 // save saves the configuration
 func (m *OptionsModel) save() {
 	m.config.Topics = m.topics
+	m.config.FilterPresets = m.filterPresets
+	m.config.RecordingTemplates = m.templates
 	m.config.DefaultPresenter = strings.TrimSpace(m.presenterInput.Value())
 	m.config.OutputDir = m.outputDirectory
 	m.config.LogoDirectory = m.logoDirectory
 	m.config.BgColor = config.BgColors[m.bgColorIdx]
+	m.config.LogoFit = config.LogoFits[m.logoFitIdx]
+	m.config.OutputResolution = config.OutputResolutions[m.outputResolutionIdx]
+	m.config.PiPCorner = config.PiPCorners[m.pipCornerIdx]
+	m.config.PiPSize = config.PiPSizes[m.pipSizeIdx]
+	m.config.HardwareEncoding = m.hardwareEncoding
+	m.config.AudioProcessing.TargetLoudness = float64(m.loudnessTarget)
+	m.config.UITheme = config.UIThemes[m.uiThemeIdx]
+	ApplyTheme(m.config.UITheme)
+
+	// A changed retention policy must be reviewed again (dry run) before it acts
+	retentionPolicy := config.RetentionPolicy{
+		Action:         config.RetentionActions[m.retentionActionIdx],
+		MaxAgeDays:     config.RetentionAgeDays[m.retentionAgeIdx],
+		OnlyIfUploaded: m.retentionUploaded,
+	}
+	if retentionPolicy.Action == m.config.Retention.Action &&
+		retentionPolicy.MaxAgeDays == m.config.Retention.AgeDays() &&
+		retentionPolicy.OnlyIfUploaded == m.config.Retention.OnlyIfUploaded {
+		retentionPolicy.Reviewed = m.config.Retention.Reviewed
+	}
+	m.config.Retention = retentionPolicy
+	m.config.StorageBudgetGB = config.StorageBudgetsGB[m.storageBudgetIdx]
+	if m.countdownSeconds != m.config.Countdown() {
+		m.config.CountdownSeconds = m.countdownSeconds
+		m.config.CountdownConfigured = true
+	}
+	m.config.BreakReminder = config.BreakReminder{
+		IntervalMinutes: config.BreakReminderIntervals[m.breakIntervalIdx],
+		AutoSplit:       m.breakAutoSplit,
+	}
+	m.config.AutoOpenOutputOnComplete = m.autoOpenFolder
+	m.config.AutoPlayOnComplete = m.autoPlayIdx > 0
+	if m.autoPlayIdx > 0 {
+		m.config.AutoPlayOutput = config.AutoPlayOutputs[m.autoPlayIdx-1]
+	}
 
 	// Save recording presets
 	m.config.RecordingPresets = config.RecordingPresets{
@@ -558,6 +1092,8 @@ func (m *OptionsModel) save() {
 		AddLogos:      m.presetAddLogos,
 	}
 	m.config.PresetsConfigured = true
+	m.config.FormFocus = config.FormFocuses[m.formFocusIdx]
+	m.config.SkipPresetToggles = m.skipPresetToggles
 
 	if err := config.Save(m.config); err != nil {
 		m.err = err
@@ -571,8 +1107,8 @@ func (m *OptionsModel) save() {
 // View renders the options screen
 func (m *OptionsModel) View() string {
 	// If file browser is shown, render it instead
-	if m.showFileBrowser {
-		return m.renderFileBrowser()
+	if m.fileBrowser != nil {
+		return m.fileBrowser.View()
 	}
 
 	// Styles
@@ -643,7 +1179,11 @@ func (m *OptionsModel) View() string {
 				style = lipgloss.NewStyle().Foreground(ColorWhite)
 			}
 		}
-		topicItems = append(topicItems, style.Render(" "+topic.Name+" "))
+		name := topic.Name
+		if topic.Sensitive {
+			name = "🔒 " + name
+		}
+		topicItems = append(topicItems, style.Render(" "+name+" "))
 	}
 	topicListStr := lipgloss.JoinHorizontal(lipgloss.Center, topicItems...)
 
@@ -652,6 +1192,7 @@ func (m *OptionsModel) View() string {
 		topicLabel = labelActiveStyle.Render("Topics: ")
 	}
 	topicRow := lipgloss.JoinHorizontal(lipgloss.Center, topicLabel, topicListStr)
+	topicHint := hintStyle.Render("                    ←/→: select • s: sensitive (private uploads) • d: remove")
 
 	// Add topic input
 	addLabel := labelStyle.Render("Add: ")
@@ -723,21 +1264,426 @@ func (m *OptionsModel) View() string {
 	bgColorRow := lipgloss.JoinHorizontal(lipgloss.Center, bgLabel, strings.Join(bgColorPills, " "))
 	bgColorHint := hintStyle.Render("                    ←/→: change • lower third background")
 
-	// YouTube Section
-	youtubeSection := sectionStyle.Render("YouTube")
-	youtubeLabel := labelStyle.Render("Status: ")
-	if m.focusedField == OptionsFieldYouTubeSetup {
-		youtubeLabel = labelActiveStyle.Render("Status: ")
+	// Logo Fit
+	logoFitLabel := labelStyle.Render("Logo fit: ")
+	if m.focusedField == OptionsFieldLogoFit {
+		logoFitLabel = labelActiveStyle.Render("Logo fit: ")
 	}
-
-	// Get YouTube status
-	cfg, _ := config.Load()
-	youtubeStatus := cfg.GetYouTubeAuthStatus()
-	var youtubeStatusText string
-	var youtubeStatusColor lipgloss.Color
-	switch youtubeStatus {
-	case 3: // AuthStatusAuthenticated
-		youtubeStatusText = "Connected"
+	var logoFitPills []string
+	for i, fit := range config.LogoFits {
+		pillStyle := lipgloss.NewStyle().Padding(0, 1)
+		if i == m.logoFitIdx {
+			if m.focusedField == OptionsFieldLogoFit {
+				pillStyle = pillStyle.Background(ColorOrange).Foreground(lipgloss.Color("#000")).Bold(true)
+			} else {
+				pillStyle = pillStyle.Background(ColorGreen).Foreground(ColorWhite)
+			}
+		} else {
+			pillStyle = pillStyle.Foreground(ColorGray)
+		}
+		logoFitPills = append(logoFitPills, pillStyle.Render(config.LogoFitLabels[fit]))
+	}
+	logoFitRow := lipgloss.JoinHorizontal(lipgloss.Center, logoFitLabel, strings.Join(logoFitPills, " "))
+	logoFitHint := hintStyle.Render("                    ←/→: change • contain pads, cover crops (never stretched)")
+
+	// Processing Section
+	processingSection := sectionStyle.Render("Processing")
+	resolutionLabel := labelStyle.Render("Resolution: ")
+	if m.focusedField == OptionsFieldOutputResolution {
+		resolutionLabel = labelActiveStyle.Render("Resolution: ")
+	}
+	var resolutionPills []string
+	for i, r := range config.OutputResolutions {
+		pillStyle := lipgloss.NewStyle().Padding(0, 1)
+		if i == m.outputResolutionIdx {
+			if m.focusedField == OptionsFieldOutputResolution {
+				pillStyle = pillStyle.Background(ColorOrange).Foreground(lipgloss.Color("#000")).Bold(true)
+			} else {
+				pillStyle = pillStyle.Background(ColorGreen).Foreground(ColorWhite)
+			}
+		} else {
+			pillStyle = pillStyle.Foreground(ColorGray)
+		}
+		resolutionPills = append(resolutionPills, pillStyle.Render(config.OutputResolutionLabels[r]))
+	}
+	resolutionRow := lipgloss.JoinHorizontal(lipgloss.Center, resolutionLabel, strings.Join(resolutionPills, " "))
+	resolutionHint := hintStyle.Render("                    ←/→: change • default for new recordings (never upscales)")
+
+	pipCornerLabel := labelStyle.Render("Webcam corner: ")
+	if m.focusedField == OptionsFieldPiPCorner {
+		pipCornerLabel = labelActiveStyle.Render("Webcam corner: ")
+	}
+	var pipCornerPills []string
+	for i, c := range config.PiPCorners {
+		pillStyle := lipgloss.NewStyle().Padding(0, 1)
+		if i == m.pipCornerIdx {
+			if m.focusedField == OptionsFieldPiPCorner {
+				pillStyle = pillStyle.Background(ColorOrange).Foreground(lipgloss.Color("#000")).Bold(true)
+			} else {
+				pillStyle = pillStyle.Background(ColorGreen).Foreground(ColorWhite)
+			}
+		} else {
+			pillStyle = pillStyle.Foreground(ColorGray)
+		}
+		pipCornerPills = append(pipCornerPills, pillStyle.Render(config.PiPCornerLabels[c]))
+	}
+	pipCornerRow := lipgloss.JoinHorizontal(lipgloss.Center, pipCornerLabel, strings.Join(pipCornerPills, " "))
+
+	pipSizeLabel := labelStyle.Render("Webcam size: ")
+	if m.focusedField == OptionsFieldPiPSize {
+		pipSizeLabel = labelActiveStyle.Render("Webcam size: ")
+	}
+	var pipSizePills []string
+	for i, size := range config.PiPSizes {
+		pillStyle := lipgloss.NewStyle().Padding(0, 1)
+		if i == m.pipSizeIdx {
+			if m.focusedField == OptionsFieldPiPSize {
+				pillStyle = pillStyle.Background(ColorOrange).Foreground(lipgloss.Color("#000")).Bold(true)
+			} else {
+				pillStyle = pillStyle.Background(ColorGreen).Foreground(ColorWhite)
+			}
+		} else {
+			pillStyle = pillStyle.Foreground(ColorGray)
+		}
+		pipSizePills = append(pipSizePills, pillStyle.Render(config.PiPSizeLabels[size]))
+	}
+	pipSizeRow := lipgloss.JoinHorizontal(lipgloss.Center, pipSizeLabel, strings.Join(pipSizePills, " "))
+	pipHint := hintStyle.Render("                    ←/→: change • webcam overlay on the landscape video")
+
+	hardwareEncodingLabel := labelStyle.Render("HW encoding: ")
+	if m.focusedField == OptionsFieldHardwareEncoding {
+		hardwareEncodingLabel = labelActiveStyle.Render("HW encoding: ")
+	}
+	hardwareEncodingRow := lipgloss.JoinHorizontal(lipgloss.Center,
+		hardwareEncodingLabel, m.renderPresetToggle(m.hardwareEncoding, m.focusedField == OptionsFieldHardwareEncoding))
+	hardwareEncodingHint := hintStyle.Render("                    space: toggle • VAAPI/NVENC when found, else software (x264)")
+
+	loudnessLabel := labelStyle.Render("Loudness: ")
+	loudnessValue := lipgloss.NewStyle().Padding(0, 1).Background(ColorGreen).Foreground(ColorWhite).
+		Render(fmt.Sprintf("%d LUFS", m.loudnessTarget))
+	if m.focusedField == OptionsFieldLoudnessTarget {
+		loudnessLabel = labelActiveStyle.Render("Loudness: ")
+		loudnessValue = lipgloss.NewStyle().Padding(0, 1).Background(ColorOrange).Foreground(lipgloss.Color("#000")).Bold(true).
+			Render(fmt.Sprintf("◀ %d LUFS ▶", m.loudnessTarget))
+	}
+	loudnessRow := lipgloss.JoinHorizontal(lipgloss.Center, loudnessLabel, loudnessValue)
+	loudnessHint := hintStyle.Render(fmt.Sprintf("                    ←/→: change (%d to %d, default %d) • applies when recordings are (re)processed",
+		int(models.MinLoudnessTargetLUFS), int(models.MaxLoudnessTargetLUFS), int(models.DefaultLoudnessTargetLUFS)))
+
+	// Filter Presets Section
+	filterSection := sectionStyle.Render("Filter Presets")
+	filterListLabel := labelStyle.Render("Library: ")
+	if m.focusedField == OptionsFieldFilterPresetList {
+		filterListLabel = labelActiveStyle.Render("Library: ")
+	}
+	var filterListLines []string
+	if len(m.filterPresets) == 0 {
+		filterListLines = append(filterListLines, hintStyle.Render("(no presets)"))
+	}
+	for i, preset := range m.filterPresets {
+		style := lipgloss.NewStyle().Foreground(ColorGray)
+		if i == m.selectedFilterPreset {
+			if m.focusedField == OptionsFieldFilterPresetList {
+				style = lipgloss.NewStyle().Background(ColorOrange).Foreground(lipgloss.Color("#000000"))
+			} else {
+				style = lipgloss.NewStyle().Foreground(ColorWhite)
+			}
+		}
+		line := fmt.Sprintf(" %s [%s] ", preset.Name, models.FilterStageLabels[preset.Stage])
+		filterListLines = append(filterListLines, style.Render(line)+" "+hintStyle.Render(preset.Filter))
+	}
+	filterListRow := lipgloss.JoinHorizontal(lipgloss.Top, filterListLabel, lipgloss.JoinVertical(lipgloss.Left, filterListLines...))
+	filterListHint := hintStyle.Render("                    j/k: select • d: delete")
+
+	filterNameLabel := labelStyle.Render("Name: ")
+	if m.focusedField == OptionsFieldFilterPresetName {
+		filterNameLabel = labelActiveStyle.Render("Name: ")
+	}
+	filterNameRow := lipgloss.JoinHorizontal(lipgloss.Center, filterNameLabel, m.filterNameInput.View())
+
+	filterStageLabel := labelStyle.Render("Stage: ")
+	if m.focusedField == OptionsFieldFilterPresetStage {
+		filterStageLabel = labelActiveStyle.Render("Stage: ")
+	}
+	var filterStagePills []string
+	for i, stage := range models.FilterStages {
+		pillStyle := lipgloss.NewStyle().Padding(0, 1)
+		if i == m.filterStageIdx {
+			if m.focusedField == OptionsFieldFilterPresetStage {
+				pillStyle = pillStyle.Background(ColorOrange).Foreground(lipgloss.Color("#000")).Bold(true)
+			} else {
+				pillStyle = pillStyle.Background(ColorGreen).Foreground(ColorWhite)
+			}
+		} else {
+			pillStyle = pillStyle.Foreground(ColorGray)
+		}
+		filterStagePills = append(filterStagePills, pillStyle.Render(models.FilterStageLabels[stage]))
+	}
+	filterStageRow := lipgloss.JoinHorizontal(lipgloss.Center, filterStageLabel, strings.Join(filterStagePills, " "))
+	filterStageHint := hintStyle.Render("                    ←/→: change • audio after normalizing, video before merging, merge on the final video")
+
+	filterLabel := labelStyle.Render("Filter: ")
+	if m.focusedField == OptionsFieldFilterPresetFilter {
+		filterLabel = labelActiveStyle.Render("Filter: ")
+	}
+	filterRow := lipgloss.JoinHorizontal(lipgloss.Center, filterLabel, m.filterInput.View())
+
+	addFilterText := "Add Preset"
+	if m.testingFilterPreset {
+		addFilterText = "Testing..."
+	}
+	addFilterBtn := inactiveButtonStyle.Render(addFilterText)
+	if m.focusedField == OptionsFieldAddFilterPreset {
+		addFilterBtn = activeButtonStyle.Render(addFilterText)
+	}
+	addFilterRow := lipgloss.JoinHorizontal(lipgloss.Center, labelStyle.Render(""), "  ", addFilterBtn)
+	addFilterHint := hintStyle.Render("                    the filter is test-run on a short clip before it is added")
+
+	// Recording Templates Section
+	templateSection := sectionStyle.Render("Recording Templates")
+	templateListLabel := labelStyle.Render("Templates: ")
+	if m.focusedField == OptionsFieldTemplateList {
+		templateListLabel = labelActiveStyle.Render("Templates: ")
+	}
+	var templateListLines []string
+	if len(m.templates) == 0 {
+		templateListLines = append(templateListLines, hintStyle.Render("(no templates)"))
+	}
+	for i, t := range m.templates {
+		style := lipgloss.NewStyle().Foreground(ColorGray)
+		if i == m.selectedTemplate {
+			if m.focusedField == OptionsFieldTemplateList {
+				style = lipgloss.NewStyle().Background(ColorOrange).Foreground(lipgloss.Color("#000000"))
+			} else {
+				style = lipgloss.NewStyle().Foreground(ColorWhite)
+			}
+		}
+		var details []string
+		for _, d := range []string{t.TitlePattern, t.Topic, t.Privacy, t.Playlist} {
+			if d != "" {
+				details = append(details, d)
+			}
+		}
+		templateListLines = append(templateListLines, style.Render(" "+t.Name+" ")+" "+hintStyle.Render(strings.Join(details, " • ")))
+	}
+	templateListRow := lipgloss.JoinHorizontal(lipgloss.Top, templateListLabel, lipgloss.JoinVertical(lipgloss.Left, templateListLines...))
+	templateListHint := hintStyle.Render("                    j/k: select • d: delete • ctrl+t in the recording form applies one")
+
+	importTemplateBtn := inactiveButtonStyle.Render("Import...")
+	if m.focusedField == OptionsFieldImportTemplate {
+		importTemplateBtn = activeButtonStyle.Render("Import...")
+	}
+	importTemplateRow := lipgloss.JoinHorizontal(lipgloss.Center, labelStyle.Render(""), "  ", importTemplateBtn)
+	importTemplateHint := hintStyle.Render("                    a YAML or JSON file with one or more templates")
+
+	// Appearance Section
+	appearanceSection := sectionStyle.Render("Appearance")
+	themeLabel := labelStyle.Render("Theme: ")
+	if m.focusedField == OptionsFieldUITheme {
+		themeLabel = labelActiveStyle.Render("Theme: ")
+	}
+	var themePills []string
+	for i, theme := range config.UIThemes {
+		pillStyle := lipgloss.NewStyle().Padding(0, 1)
+		if i == m.uiThemeIdx {
+			if m.focusedField == OptionsFieldUITheme {
+				pillStyle = pillStyle.Background(ColorOrange).Foreground(lipgloss.Color("#000")).Bold(true)
+			} else {
+				pillStyle = pillStyle.Background(ColorGreen).Foreground(ColorWhite)
+			}
+		} else {
+			pillStyle = pillStyle.Foreground(ColorGray)
+		}
+		themePills = append(themePills, pillStyle.Render(config.UIThemeLabels[theme]))
+	}
+	themeRow := lipgloss.JoinHorizontal(lipgloss.Center, themeLabel, strings.Join(themePills, " "))
+	themeHint := hintStyle.Render("                    ←/→: change • auto detects the terminal background")
+
+	// Retention Section
+	retentionSection := sectionStyle.Render("Retention")
+	retentionActionLabel := labelStyle.Render("Old recordings: ")
+	if m.focusedField == OptionsFieldRetentionAction {
+		retentionActionLabel = labelActiveStyle.Render("Old recordings: ")
+	}
+	var retentionActionPills []string
+	for i, action := range config.RetentionActions {
+		pillStyle := lipgloss.NewStyle().Padding(0, 1)
+		if i == m.retentionActionIdx {
+			if m.focusedField == OptionsFieldRetentionAction {
+				pillStyle = pillStyle.Background(ColorOrange).Foreground(lipgloss.Color("#000")).Bold(true)
+			} else {
+				pillStyle = pillStyle.Background(ColorGreen).Foreground(ColorWhite)
+			}
+		} else {
+			pillStyle = pillStyle.Foreground(ColorGray)
+		}
+		retentionActionPills = append(retentionActionPills, pillStyle.Render(config.RetentionActionLabels[action]))
+	}
+	retentionActionRow := lipgloss.JoinHorizontal(lipgloss.Center, retentionActionLabel, strings.Join(retentionActionPills, " "))
+	retentionActionHint := hintStyle.Render("                    ←/→: change • runs at startup, first run is a dry run")
+
+	retentionAgeLabel := labelStyle.Render("Older than: ")
+	if m.focusedField == OptionsFieldRetentionAge {
+		retentionAgeLabel = labelActiveStyle.Render("Older than: ")
+	}
+	var retentionAgePills []string
+	for i, days := range config.RetentionAgeDays {
+		pillStyle := lipgloss.NewStyle().Padding(0, 1)
+		if i == m.retentionAgeIdx {
+			if m.focusedField == OptionsFieldRetentionAge {
+				pillStyle = pillStyle.Background(ColorOrange).Foreground(lipgloss.Color("#000")).Bold(true)
+			} else {
+				pillStyle = pillStyle.Background(ColorGreen).Foreground(ColorWhite)
+			}
+		} else {
+			pillStyle = pillStyle.Foreground(ColorGray)
+		}
+		retentionAgePills = append(retentionAgePills, pillStyle.Render(fmt.Sprintf("%dd", days)))
+	}
+	retentionAgeRow := lipgloss.JoinHorizontal(lipgloss.Center, retentionAgeLabel, strings.Join(retentionAgePills, " "))
+
+	retentionUploadedLabel := labelStyle.Render("Uploaded only: ")
+	if m.focusedField == OptionsFieldRetentionUploaded {
+		retentionUploadedLabel = labelActiveStyle.Render("Uploaded only: ")
+	}
+	retentionUploadedRow := lipgloss.JoinHorizontal(lipgloss.Center,
+		retentionUploadedLabel, m.renderPresetToggle(m.retentionUploaded, m.focusedField == OptionsFieldRetentionUploaded))
+
+	storageBudgetLabel := labelStyle.Render("Storage budget: ")
+	if m.focusedField == OptionsFieldStorageBudget {
+		storageBudgetLabel = labelActiveStyle.Render("Storage budget: ")
+	}
+	var storageBudgetPills []string
+	for i, gb := range config.StorageBudgetsGB {
+		pillStyle := lipgloss.NewStyle().Padding(0, 1)
+		if i == m.storageBudgetIdx {
+			if m.focusedField == OptionsFieldStorageBudget {
+				pillStyle = pillStyle.Background(ColorOrange).Foreground(lipgloss.Color("#000")).Bold(true)
+			} else {
+				pillStyle = pillStyle.Background(ColorGreen).Foreground(ColorWhite)
+			}
+		} else {
+			pillStyle = pillStyle.Foreground(ColorGray)
+		}
+		label := "Off"
+		if gb > 0 {
+			label = fmt.Sprintf("%dGB", gb)
+		}
+		storageBudgetPills = append(storageBudgetPills, pillStyle.Render(label))
+	}
+	storageBudgetRow := lipgloss.JoinHorizontal(lipgloss.Center, storageBudgetLabel, strings.Join(storageBudgetPills, " "))
+	storageBudgetHint := hintStyle.Render("                    ←/→: change • suggests uploaded recordings to archive when exceeded")
+
+	// Countdown Section
+	countdownSection := sectionStyle.Render("Countdown")
+	countdownLabel := labelStyle.Render("Seconds: ")
+	if m.focusedField == OptionsFieldCountdown {
+		countdownLabel = labelActiveStyle.Render("Seconds: ")
+	}
+	var countdownPills []string
+	for seconds := 0; seconds <= config.MaxCountdownSeconds; seconds++ {
+		pillStyle := lipgloss.NewStyle().Padding(0, 1)
+		if seconds == m.countdownSeconds {
+			if m.focusedField == OptionsFieldCountdown {
+				pillStyle = pillStyle.Background(ColorOrange).Foreground(lipgloss.Color("#000")).Bold(true)
+			} else {
+				pillStyle = pillStyle.Background(ColorGreen).Foreground(ColorWhite)
+			}
+		} else {
+			pillStyle = pillStyle.Foreground(ColorGray)
+		}
+		label := "Off"
+		if seconds > 0 {
+			label = fmt.Sprintf("%ds", seconds)
+		}
+		countdownPills = append(countdownPills, pillStyle.Render(label))
+	}
+	countdownRow := lipgloss.JoinHorizontal(lipgloss.Center, countdownLabel, strings.Join(countdownPills, " "))
+	countdownHint := hintStyle.Render("                    ←/→: change • with Off, recording starts right away")
+
+	// Break Reminders Section
+	breakSection := sectionStyle.Render("Break Reminders")
+	breakIntervalLabel := labelStyle.Render("Remind after: ")
+	if m.focusedField == OptionsFieldBreakInterval {
+		breakIntervalLabel = labelActiveStyle.Render("Remind after: ")
+	}
+	var breakIntervalPills []string
+	for i, minutes := range config.BreakReminderIntervals {
+		pillStyle := lipgloss.NewStyle().Padding(0, 1)
+		if i == m.breakIntervalIdx {
+			if m.focusedField == OptionsFieldBreakInterval {
+				pillStyle = pillStyle.Background(ColorOrange).Foreground(lipgloss.Color("#000")).Bold(true)
+			} else {
+				pillStyle = pillStyle.Background(ColorGreen).Foreground(ColorWhite)
+			}
+		} else {
+			pillStyle = pillStyle.Foreground(ColorGray)
+		}
+		label := "Off"
+		if minutes > 0 {
+			label = fmt.Sprintf("%dm", minutes)
+		}
+		breakIntervalPills = append(breakIntervalPills, pillStyle.Render(label))
+	}
+	breakIntervalRow := lipgloss.JoinHorizontal(lipgloss.Center, breakIntervalLabel, strings.Join(breakIntervalPills, " "))
+	breakIntervalHint := hintStyle.Render("                    ←/→: change • continuous recording time, pausing resets it")
+
+	breakAutoSplitLabel := labelStyle.Render("Start new part: ")
+	if m.focusedField == OptionsFieldBreakAutoSplit {
+		breakAutoSplitLabel = labelActiveStyle.Render("Start new part: ")
+	}
+	breakAutoSplitRow := lipgloss.JoinHorizontal(lipgloss.Center,
+		breakAutoSplitLabel, m.renderPresetToggle(m.breakAutoSplit, m.focusedField == OptionsFieldBreakAutoSplit))
+
+	// After Processing Section
+	reviewSection := sectionStyle.Render("After Processing")
+	autoOpenLabel := labelStyle.Render("Open folder: ")
+	if m.focusedField == OptionsFieldAutoOpenFolder {
+		autoOpenLabel = labelActiveStyle.Render("Open folder: ")
+	}
+	autoOpenRow := lipgloss.JoinHorizontal(lipgloss.Center,
+		autoOpenLabel, m.renderPresetToggle(m.autoOpenFolder, m.focusedField == OptionsFieldAutoOpenFolder))
+
+	autoPlayLabel := labelStyle.Render("Play video: ")
+	if m.focusedField == OptionsFieldAutoPlay {
+		autoPlayLabel = labelActiveStyle.Render("Play video: ")
+	}
+	autoPlayLabels := []string{"Off"}
+	for _, output := range config.AutoPlayOutputs {
+		autoPlayLabels = append(autoPlayLabels, config.AutoPlayOutputLabels[output])
+	}
+	var autoPlayPills []string
+	for i, label := range autoPlayLabels {
+		pillStyle := lipgloss.NewStyle().Padding(0, 1)
+		if i == m.autoPlayIdx {
+			if m.focusedField == OptionsFieldAutoPlay {
+				pillStyle = pillStyle.Background(ColorOrange).Foreground(lipgloss.Color("#000")).Bold(true)
+			} else {
+				pillStyle = pillStyle.Background(ColorGreen).Foreground(ColorWhite)
+			}
+		} else {
+			pillStyle = pillStyle.Foreground(ColorGray)
+		}
+		autoPlayPills = append(autoPlayPills, pillStyle.Render(label))
+	}
+	autoPlayRow := lipgloss.JoinHorizontal(lipgloss.Center, autoPlayLabel, strings.Join(autoPlayPills, " "))
+	autoPlayHint := hintStyle.Render("                    ←/→: change • skipped when the YouTube upload prompt is on")
+
+	// YouTube Section
+	youtubeSection := sectionStyle.Render("YouTube")
+	youtubeLabel := labelStyle.Render("Status: ")
+	if m.focusedField == OptionsFieldYouTubeSetup {
+		youtubeLabel = labelActiveStyle.Render("Status: ")
+	}
+
+	// Get YouTube status
+	cfg, _ := config.Load()
+	youtubeStatus := cfg.GetYouTubeAuthStatus()
+	var youtubeStatusText string
+	var youtubeStatusColor lipgloss.Color
+	switch youtubeStatus {
+	case 3: // AuthStatusAuthenticated
+		youtubeStatusText = "Connected"
 		youtubeStatusColor = ColorGreen
 		if cfg.YouTube.ChannelName != "" {
 			youtubeStatusText = "Connected: " + cfg.YouTube.ChannelName
@@ -809,7 +1755,7 @@ func (m *OptionsModel) View() string {
 	screenPresetRow := lipgloss.JoinHorizontal(lipgloss.Center,
 		screenPresetLabel, m.renderPresetToggle(m.presetRecordScreen, m.focusedField == OptionsFieldPresetRecordScreen))
 
-	verticalDisabled := !m.presetRecordWebcam && !m.presetRecordScreen
+	verticalDisabled := !config.VerticalVideoSupported(m.presetRecordWebcam, m.presetRecordScreen)
 	verticalPresetLabel := labelStyle.Render("Vertical: ")
 	if m.focusedField == OptionsFieldPresetVerticalVideo {
 		verticalPresetLabel = labelActiveStyle.Render("Vertical: ")
@@ -824,6 +1770,37 @@ func (m *OptionsModel) View() string {
 	logosPresetRow := lipgloss.JoinHorizontal(lipgloss.Center,
 		logosPresetLabel, m.renderPresetToggle(m.presetAddLogos, m.focusedField == OptionsFieldPresetAddLogos))
 
+	// Recording Form Section
+	formSection := sectionStyle.Render("Recording Form")
+	formFocusLabel := labelStyle.Render("Start on: ")
+	if m.focusedField == OptionsFieldFormFocus {
+		formFocusLabel = labelActiveStyle.Render("Start on: ")
+	}
+	var formFocusPills []string
+	for i, focus := range config.FormFocuses {
+		pillStyle := lipgloss.NewStyle().Padding(0, 1)
+		if i == m.formFocusIdx {
+			if m.focusedField == OptionsFieldFormFocus {
+				pillStyle = pillStyle.Background(ColorOrange).Foreground(lipgloss.Color("#000")).Bold(true)
+			} else {
+				pillStyle = pillStyle.Background(ColorGreen).Foreground(ColorWhite)
+			}
+		} else {
+			pillStyle = pillStyle.Foreground(ColorGray)
+		}
+		formFocusPills = append(formFocusPills, pillStyle.Render(config.FormFocusLabels[focus]))
+	}
+	formFocusRow := lipgloss.JoinHorizontal(lipgloss.Center, formFocusLabel, strings.Join(formFocusPills, " "))
+	formFocusHint := hintStyle.Render("                    ←/→: change • field focused when the form opens")
+
+	skipPresetsLabel := labelStyle.Render("Skip presets: ")
+	if m.focusedField == OptionsFieldSkipPresetToggles {
+		skipPresetsLabel = labelActiveStyle.Render("Skip presets: ")
+	}
+	skipPresetsRow := lipgloss.JoinHorizontal(lipgloss.Center,
+		skipPresetsLabel, m.renderPresetToggle(m.skipPresetToggles, m.focusedField == OptionsFieldSkipPresetToggles))
+	skipPresetsHint := hintStyle.Render("                    tab passes toggles left at their preset • ↑/↓ visit every field")
+
 	// Save button
 	saveLabel := labelStyle.Render("")
 	saveBtn := inactiveButtonStyle.Render("Save")
@@ -853,6 +1830,7 @@ func (m *OptionsModel) View() string {
 		outputHint,
 		topicSection,
 		topicRow,
+		topicHint,
 		addTopicRow,
 		removeRow,
 		presenterSection,
@@ -862,6 +1840,53 @@ func (m *OptionsModel) View() string {
 		logoDirHint,
 		bgColorRow,
 		bgColorHint,
+		logoFitRow,
+		logoFitHint,
+		processingSection,
+		resolutionRow,
+		resolutionHint,
+		pipCornerRow,
+		pipSizeRow,
+		pipHint,
+		hardwareEncodingRow,
+		hardwareEncodingHint,
+		loudnessRow,
+		loudnessHint,
+		filterSection,
+		filterListRow,
+		filterListHint,
+		filterNameRow,
+		filterStageRow,
+		filterStageHint,
+		filterRow,
+		addFilterRow,
+		addFilterHint,
+		templateSection,
+		templateListRow,
+		templateListHint,
+		importTemplateRow,
+		importTemplateHint,
+		appearanceSection,
+		themeRow,
+		themeHint,
+		retentionSection,
+		retentionActionRow,
+		retentionActionHint,
+		retentionAgeRow,
+		retentionUploadedRow,
+		storageBudgetRow,
+		storageBudgetHint,
+		countdownSection,
+		countdownRow,
+		countdownHint,
+		breakSection,
+		breakIntervalRow,
+		breakIntervalHint,
+		breakAutoSplitRow,
+		reviewSection,
+		autoOpenRow,
+		autoPlayRow,
+		autoPlayHint,
 		youtubeSection,
 		youtubeRow,
 		syndicationSection,
@@ -873,6 +1898,11 @@ func (m *OptionsModel) View() string {
 		screenPresetRow,
 		verticalPresetRow,
 		logosPresetRow,
+		formSection,
+		formFocusRow,
+		formFocusHint,
+		skipPresetsRow,
+		skipPresetsHint,
 		"",
 		saveRow,
 		"",
@@ -880,6 +1910,71 @@ func (m *OptionsModel) View() string {
 	)
 }
 
+// autoPlayIndex returns the auto-play selector index for cfg (0 = off)
+func autoPlayIndex(cfg *config.Config) int {
+	if !cfg.AutoPlayOnComplete {
+		return 0
+	}
+	return config.AutoPlayOutputIndex(cfg.AutoPlayOutput) + 1
+}
+
+// uiThemeIndex returns the index of theme in config.UIThemes (0 = auto if not found)
+func uiThemeIndex(theme config.UITheme) int {
+	for i, t := range config.UIThemes {
+		if t == theme {
+			return i
+		}
+	}
+	return 0
+}
+
+// retentionActionIndex returns the index of action in config.RetentionActions (0 = off if not found)
+func retentionActionIndex(action config.RetentionAction) int {
+	for i, a := range config.RetentionActions {
+		if a == action {
+			return i
+		}
+	}
+	return 0
+}
+
+// retentionAgeIndex returns the index of days in config.RetentionAgeDays,
+// falling back to the default threshold
+func retentionAgeIndex(days int) int {
+	fallback := 0
+	for i, d := range config.RetentionAgeDays {
+		if d == days {
+			return i
+		}
+		if d == config.DefaultRetentionAgeDays {
+			fallback = i
+		}
+	}
+	return fallback
+}
+
+// storageBudgetIndex returns the index of gb in config.StorageBudgetsGB
+// (0 = no budget if not found)
+func storageBudgetIndex(gb int) int {
+	for i, b := range config.StorageBudgetsGB {
+		if b == gb {
+			return i
+		}
+	}
+	return 0
+}
+
+// breakIntervalIndex returns the index of minutes in
+// config.BreakReminderIntervals (0 = off if not found)
+func breakIntervalIndex(minutes int) int {
+	for i, m := range config.BreakReminderIntervals {
+		if m == minutes {
+			return i
+		}
+	}
+	return 0
+}
+
 // renderPresetToggle renders a Yes/No toggle pill for preset fields
 func (m *OptionsModel) renderPresetToggle(value bool, focused bool) string {
 	yesStyle := lipgloss.NewStyle().Padding(0, 1)
@@ -908,256 +2003,47 @@ func (m *OptionsModel) renderPresetToggle(value bool, focused bool) string {
 func (m *OptionsModel) renderPresetToggleWithDisabled(value bool, focused bool, disabled bool) string {
 	if disabled {
 		disabledStyle := lipgloss.NewStyle().Foreground(ColorGray).Italic(true)
-		return disabledStyle.Render("(requires webcam or screen)")
+		return disabledStyle.Render("(requires webcam and screen)")
 	}
 	return m.renderPresetToggle(value, focused)
 }
 
 // updateFileBrowser handles messages when the file browser is active
 func (m *OptionsModel) updateFileBrowser(msg tea.Msg) (*OptionsModel, tea.Cmd) {
-	switch msg := msg.(type) {
-	case tea.WindowSizeMsg:
-		m.width = msg.Width
-		m.height = msg.Height
-		return m, nil
-
-	case tea.KeyMsg:
-		// Handle path input mode
-		if m.browserField == FileBrowserFieldPathInput {
-			switch msg.String() {
-			case "esc":
-				m.browserField = FileBrowserFieldList
-				m.browserPathInput.Blur()
-				return m, nil
-			case "enter":
-				// Try to navigate to the entered path
-				path := m.browserPathInput.Value()
-				if info, err := os.Stat(path); err == nil {
-					if info.IsDir() {
-						m.browserCurrentDir = path
-						m.loadBrowserEntries()
-					}
-				}
-				m.browserField = FileBrowserFieldList
-				m.browserPathInput.Blur()
-				return m, nil
-			default:
-				var cmd tea.Cmd
-				m.browserPathInput, cmd = m.browserPathInput.Update(msg)
-				return m, cmd
-			}
-		}
-
-		// Handle file list mode
-		switch msg.String() {
-		case "esc", "q":
-			m.closeFileBrowser()
-			return m, nil
-
-		case "up", "k":
-			if m.browserSelected > 0 {
-				m.browserSelected--
-				// Scroll up if needed
-				if m.browserSelected < m.browserScrollTop {
-					m.browserScrollTop = m.browserSelected
-				}
-			}
-			return m, nil
-
-		case "down", "j":
-			if m.browserSelected < len(m.browserEntries)-1 {
-				m.browserSelected++
-				// Scroll down if needed
-				visibleHeight := m.height - 12 // Account for header, footer, path input
-				if m.browserSelected >= m.browserScrollTop+visibleHeight {
-					m.browserScrollTop = m.browserSelected - visibleHeight + 1
-				}
-			}
-			return m, nil
-
-		case "enter", " ":
-			if len(m.browserEntries) > 0 && m.browserSelected < len(m.browserEntries) {
-				entry := m.browserEntries[m.browserSelected]
-				if entry.isDir {
-					// Navigate into directory
-					m.browserCurrentDir = entry.path
-					m.browserPathInput.SetValue(entry.path)
-					m.loadBrowserEntries()
-				}
-			}
-			return m, nil
-
-		case "s":
-			// Select current directory (when selecting directory)
-			if m.selectingDirectory {
-				switch m.browserTarget {
-				case BrowserTargetOutput:
-					m.outputDirectory = m.browserCurrentDir
-					m.config.OutputDir = m.browserCurrentDir
-					if err := config.Save(m.config); err != nil {
-						m.message = "Error saving: " + err.Error()
-					} else {
-						m.message = "Output directory saved: " + m.browserCurrentDir
-					}
-				case BrowserTargetLogo:
-					m.logoDirectory = m.browserCurrentDir
-					m.config.LogoDirectory = m.browserCurrentDir
-					if err := config.Save(m.config); err != nil {
-						m.message = "Error saving: " + err.Error()
-					} else {
-						m.message = "Logo directory saved: " + m.browserCurrentDir
-					}
-				}
-				m.closeFileBrowser()
-			}
-			return m, nil
-
-		case "backspace":
-			// Go to parent directory
-			if m.browserCurrentDir != "/" {
-				m.browserCurrentDir = filepath.Dir(m.browserCurrentDir)
-				m.browserPathInput.SetValue(m.browserCurrentDir)
-				m.loadBrowserEntries()
-			}
-			return m, nil
-
-		case "tab", "/":
-			// Switch to path input
-			m.browserField = FileBrowserFieldPathInput
-			m.browserPathInput.Focus()
-			return m, textinput.Blink
-
-		case "~":
-			// Go to home directory
-			if home, err := os.UserHomeDir(); err == nil {
-				m.browserCurrentDir = home
-				m.browserPathInput.SetValue(home)
-				m.loadBrowserEntries()
-			}
-			return m, nil
-		}
-	}
-
-	return m, nil
-}
-
-// renderFileBrowser renders the custom file browser
-func (m *OptionsModel) renderFileBrowser() string {
-	// Page title based on target
-	var pageTitle string
-	switch m.browserTarget {
-	case BrowserTargetOutput:
-		pageTitle = "Select Media Folder"
-	case BrowserTargetLogo:
-		pageTitle = "Select Logo Directory"
-	default:
-		pageTitle = "Select Directory"
-	}
-
-	header := RenderHeader(pageTitle)
-
-	// Styles
-	labelStyle := lipgloss.NewStyle().
-		Foreground(ColorGray).
-		Width(10).
-		Align(lipgloss.Right)
-
-	labelActiveStyle := lipgloss.NewStyle().
-		Foreground(ColorOrange).
-		Bold(true).
-		Width(10).
-		Align(lipgloss.Right)
-
-	dirStyle := lipgloss.NewStyle().
-		Foreground(ColorBlue)
-
-	selectedStyle := lipgloss.NewStyle().
-		Background(ColorOrange).
-		Foreground(lipgloss.Color("#000000"))
-
-	// Path input row
-	pathLabel := labelStyle.Render("Path: ")
-	if m.browserField == FileBrowserFieldPathInput {
-		pathLabel = labelActiveStyle.Render("Path: ")
-	}
-	pathRow := lipgloss.JoinHorizontal(lipgloss.Center, pathLabel, m.browserPathInput.View())
-
-	// Current directory display
-	dirLabel := labelStyle.Render("In: ")
-	dirRow := lipgloss.JoinHorizontal(lipgloss.Center, dirLabel, lipgloss.NewStyle().Foreground(ColorGray).Render(m.browserCurrentDir))
-
-	// File list - calculate available height more precisely
-	// Header: ~6 lines, path row: 1, dir row: 1, empty line: 1, footer: 2, margins: 3
-	visibleHeight := m.height - 14
-	if visibleHeight < 3 {
-		visibleHeight = 3
-	}
-
-	// Ensure scroll position keeps selected item visible
-	if m.browserSelected < m.browserScrollTop {
-		m.browserScrollTop = m.browserSelected
-	} else if m.browserSelected >= m.browserScrollTop+visibleHeight {
-		m.browserScrollTop = m.browserSelected - visibleHeight + 1
-	}
-
-	// Clamp scroll position
-	if m.browserScrollTop < 0 {
-		m.browserScrollTop = 0
-	}
-	maxScroll := len(m.browserEntries) - visibleHeight
-	if maxScroll < 0 {
-		maxScroll = 0
-	}
-	if m.browserScrollTop > maxScroll {
-		m.browserScrollTop = maxScroll
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		m.width = size.Width
+		m.height = size.Height
 	}
 
-	var fileLines []string
-	endIdx := m.browserScrollTop + visibleHeight
-	if endIdx > len(m.browserEntries) {
-		endIdx = len(m.browserEntries)
-	}
-
-	for i := m.browserScrollTop; i < endIdx; i++ {
-		entry := m.browserEntries[i]
-		var line string
-		if entry.isDir {
-			if i == m.browserSelected && m.browserField == FileBrowserFieldList {
-				line = selectedStyle.Render("▶ 📁 " + entry.name)
+	result, cmd := m.fileBrowser.Update(msg)
+	switch result {
+	case FileBrowserCanceled:
+		m.fileBrowser = nil
+	case FileBrowserSelected:
+		dir := m.fileBrowser.SelectedPath()
+		switch m.browserTarget {
+		case BrowserTargetOutput:
+			m.outputDirectory = dir
+			m.config.OutputDir = dir
+			if err := config.Save(m.config); err != nil {
+				m.message = "Error saving: " + err.Error()
 			} else {
-				line = dirStyle.Render("  📁 " + entry.name)
+				m.message = "Output directory saved: " + dir
 			}
+		case BrowserTargetLogo:
+			m.logoDirectory = dir
+			m.config.LogoDirectory = dir
+			if err := config.Save(m.config); err != nil {
+				m.message = "Error saving: " + err.Error()
+			} else {
+				m.message = "Logo directory saved: " + dir
+			}
+		case BrowserTargetTemplate:
+			m.importTemplates(dir)
 		}
-		if line != "" {
-			fileLines = append(fileLines, line)
-		}
+		m.fileBrowser = nil
 	}
-
-	// Add scroll indicators if needed
-	scrollInfo := ""
-	if len(m.browserEntries) > visibleHeight {
-		scrollInfo = lipgloss.NewStyle().Foreground(ColorGray).Render(
-			fmt.Sprintf(" [%d-%d of %d]", m.browserScrollTop+1, endIdx, len(m.browserEntries)))
-	}
-
-	fileList := lipgloss.JoinVertical(lipgloss.Left, fileLines...)
-	if len(m.browserEntries) == 0 {
-		fileList = lipgloss.NewStyle().Foreground(ColorGray).Italic(true).Render("(no subdirectories)")
-	}
-
-	// Content - use a fixed height box for the file list to prevent overflow
-	content := lipgloss.JoinVertical(lipgloss.Left,
-		pathRow,
-		dirRow+scrollInfo,
-		"",
-		fileList,
-	)
-
-	// Help footer
-	helpText := "↑/k ↓/j: navigate • enter: open dir • s: select this dir • backspace: parent • ~: home • esc: cancel"
-	footer := RenderHelpFooter(helpText, m.width)
-
-	return LayoutWithHeaderFooter(header, content, footer, m.width, m.height)
+	return m, cmd
 }
 
 // goToYouTubeSetupMsg signals navigation to YouTube setup screen
@@ -0,0 +1,54 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+func TestOptionsLoudnessTarget(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	m := NewOptionsModel()
+	if m.loudnessTarget != -16 {
+		t.Fatalf("loudnessTarget = %d, want the default -16", m.loudnessTarget)
+	}
+
+	m.focusedField = OptionsFieldLoudnessTarget
+	m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	m.save()
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.LoudnessTargetLUFS() != -18 {
+		t.Errorf("saved loudness target = %v, want -18", cfg.LoudnessTargetLUFS())
+	}
+
+	// Wraps around at the ends of the range
+	m.loudnessTarget = int(models.MaxLoudnessTargetLUFS)
+	m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if m.loudnessTarget != int(models.MinLoudnessTargetLUFS) {
+		t.Errorf("loudnessTarget = %d, want %v", m.loudnessTarget, models.MinLoudnessTargetLUFS)
+	}
+}
+
+func TestNormalizationLabel(t *testing.T) {
+	tests := []struct {
+		processing models.ProcessingInfo
+		want       string
+	}{
+		{models.ProcessingInfo{}, "Not normalized"},
+		{models.ProcessingInfo{NormalizeApplied: true}, "Normalized"},
+		{models.ProcessingInfo{NormalizeApplied: true, NormalizedLUFS: -16}, "Normalized to -16 LUFS"},
+		{models.ProcessingInfo{NormalizeApplied: true, NormalizedLUFS: -14.5}, "Normalized to -14.5 LUFS"},
+	}
+	for _, tt := range tests {
+		if got := normalizationLabel(tt.processing); got != tt.want {
+			t.Errorf("normalizationLabel(%+v) = %q, want %q", tt.processing, got, tt.want)
+		}
+	}
+}
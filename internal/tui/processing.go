@@ -10,6 +10,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
 	"github.com/kartoza/kartoza-screencaster/internal/models"
 )
 
@@ -20,6 +21,7 @@ type ProcessingStep struct {
 	StartTime time.Time
 	EndTime   time.Time
 	Progress  float64 // Progress percentage (0-100), -1 means indeterminate
+	Encoder   string  // Video encoder the step encodes with, e.g. "VAAPI" ("" = none)
 }
 
 // StepStatus represents the status of a processing step
@@ -41,6 +43,7 @@ type ProcessingState struct {
 	StartTime    time.Time
 	EndTime      time.Time
 	Error        error
+	Notice       string // Shown while processing, e.g. why the recording stopped
 }
 
 // Processing step indices (must match order in NewProcessingState)
@@ -50,6 +53,7 @@ const (
 	ProcessStepNormalizing
 	ProcessStepMerging
 	ProcessStepVertical
+	ProcessStepWebcamOnly
 )
 
 // NewProcessingState creates a new processing state with default steps
@@ -61,6 +65,7 @@ func NewProcessingState() *ProcessingState {
 			{Name: "Normalizing audio", Status: StepPending},
 			{Name: "Merging video & audio", Status: StepPending},
 			{Name: "Creating vertical video", Status: StepPending},
+			{Name: "Creating webcam-only video", Status: StepPending},
 		},
 		CurrentStep:  -1,
 		IsProcessing: false,
@@ -68,7 +73,7 @@ func NewProcessingState() *ProcessingState {
 }
 
 // ConfigureSteps marks steps as skipped based on recording settings
-func (p *ProcessingState) ConfigureSteps(hasAudio, hasScreen, hasWebcam, createVertical bool) {
+func (p *ProcessingState) ConfigureSteps(hasAudio, hasScreen, hasWebcam, createVertical, createWebcamOnly bool) {
 	// Audio steps skipped if no audio
 	if !hasAudio {
 		p.Steps[ProcessStepAnalyzing].Status = StepSkipped
@@ -84,6 +89,11 @@ func (p *ProcessingState) ConfigureSteps(hasAudio, hasScreen, hasWebcam, createV
 	if !createVertical {
 		p.Steps[ProcessStepVertical].Status = StepSkipped
 	}
+
+	// Webcam-only video step skipped if not requested or no webcam
+	if !createWebcamOnly || !hasWebcam {
+		p.Steps[ProcessStepWebcamOnly].Status = StepSkipped
+	}
 }
 
 // SetStepByIndex directly sets a step's status by index
@@ -109,6 +119,13 @@ func (p *ProcessingState) SetStepProgress(index int, progress float64) {
 	}
 }
 
+// SetStepEncoder sets the video encoder a step encodes with
+func (p *ProcessingState) SetStepEncoder(index int, encoder string) {
+	if index >= 0 && index < len(p.Steps) {
+		p.Steps[index].Encoder = encoder
+	}
+}
+
 // Start begins the processing
 func (p *ProcessingState) Start() {
 	p.IsProcessing = true
@@ -190,6 +207,7 @@ func (p *ProcessingState) Reset() {
 	p.StartTime = time.Time{}
 	p.EndTime = time.Time{}
 	p.Error = nil
+	p.Notice = ""
 }
 
 // Messages for processing updates
@@ -204,6 +222,10 @@ type processingPercentMsg struct {
 	Step    int
 	Percent float64
 }
+type processingEncoderMsg struct {
+	Step    int
+	Encoder string
+}
 type processingCompleteMsg struct{}
 type processingErrorMsg struct {
 	Error error
@@ -216,11 +238,6 @@ func processingTickCmd() tea.Cmd {
 	})
 }
 
-// Donut animation frames (Unicode block characters for spinning effect)
-var donutFrames = []string{
-	"◐", "◓", "◑", "◒",
-}
-
 // ProcessingButton represents a button option on the processing complete screen
 type ProcessingButton int
 
@@ -283,6 +300,8 @@ func RenderProcessingView(state *ProcessingState, width, height int, frame int,
 	} else if !state.IsProcessing {
 		statusStyle = statusStyle.Foreground(ColorGreen)
 		statusMsg = statusStyle.Render("Processing complete!")
+	} else if state.Notice != "" {
+		statusMsg = statusStyle.Foreground(ColorOrange).Render(state.Notice)
 	} else {
 		statusMsg = statusStyle.Render("Please wait...")
 	}
@@ -361,6 +380,7 @@ func buildProcessingCompleteFooter(info *models.RecordingInfo) string {
 
 	if info != nil {
 		hasVertical := info.Files.VerticalFile != ""
+		hasWebcamOnly := info.Files.WebcamOnlyFile != ""
 		hasMerged := info.Files.MergedFile != ""
 		hasAudio := info.Files.AudioFile != ""
 		hasFolder := info.Files.FolderPath != ""
@@ -368,6 +388,9 @@ func buildProcessingCompleteFooter(info *models.RecordingInfo) string {
 		if hasVertical {
 			parts = append(parts, "v: vertical")
 		}
+		if hasWebcamOnly {
+			parts = append(parts, "w: webcam only")
+		}
 		if hasMerged {
 			parts = append(parts, "m: merged")
 		}
@@ -424,8 +447,7 @@ func renderStepLine(step ProcessingStep, isCurrent bool, frame int) string {
 
 	case StepRunning:
 		// Animated donut
-		donutStyle := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true)
-		indicator = donutStyle.Render(donutFrames[frame%len(donutFrames)])
+		indicator = spinnerStyle().Render(loadingFrame(frame))
 		nameStyle = lipgloss.NewStyle().Foreground(ColorWhite).Bold(true)
 
 	case StepComplete:
@@ -457,7 +479,12 @@ func renderStepLine(step ProcessingStep, isCurrent bool, frame int) string {
 		suffix = skippedStyle.Render(" (skipped)")
 	}
 
-	return fmt.Sprintf("  %s %s%s", indicator, nameStyle.Render(step.Name), suffix)
+	name := nameStyle.Render(step.Name)
+	if step.Encoder != "" && step.Status != StepSkipped {
+		name += lipgloss.NewStyle().Foreground(ColorGray).Render(fmt.Sprintf(" · Encoding (%s)", step.Encoder))
+	}
+
+	return fmt.Sprintf("  %s %s%s", indicator, name, suffix)
 }
 
 // openFileCmd opens a file with the system default application
@@ -486,7 +513,26 @@ func openFolderCmd(folderPath string) tea.Cmd {
 	}
 }
 
-// HandleProcessingMediaKey handles v/m/a/o key presses on the processing complete screen.
+// autoReviewCmd opens the recording folder and plays the processed video as
+// configured, so the output can be reviewed as soon as processing finishes.
+// It returns nil if neither is enabled.
+func autoReviewCmd(cfg *config.Config, info *models.RecordingInfo) tea.Cmd {
+	if info == nil {
+		return nil
+	}
+	var cmds []tea.Cmd
+	if cfg.AutoOpenOutputOnComplete && info.Files.FolderPath != "" {
+		cmds = append(cmds, openFolderCmd(info.Files.FolderPath))
+	}
+	if cfg.AutoPlayOnComplete {
+		if videoPath := cfg.AutoPlayOutput.File(info.Files); videoPath != "" {
+			cmds = append(cmds, openFileCmd(videoPath))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// HandleProcessingMediaKey handles v/w/m/a/o key presses on the processing complete screen.
 // Returns a tea.Cmd if the key was handled, nil otherwise.
 func HandleProcessingMediaKey(key string, info *models.RecordingInfo) tea.Cmd {
 	if info == nil {
@@ -501,6 +547,10 @@ func HandleProcessingMediaKey(key string, info *models.RecordingInfo) tea.Cmd {
 		if info.Files.MergedFile != "" {
 			return openFileCmd(info.Files.MergedFile)
 		}
+	case "w":
+		if info.Files.WebcamOnlyFile != "" {
+			return openFileCmd(info.Files.WebcamOnlyFile)
+		}
 	case "m":
 		if info.Files.MergedFile != "" {
 			return openFileCmd(info.Files.MergedFile)
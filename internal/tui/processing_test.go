@@ -13,8 +13,8 @@ func TestNewProcessingState(t *testing.T) {
 		t.Fatal("NewProcessingState returned nil")
 	}
 
-	if len(p.Steps) != 5 {
-		t.Errorf("expected 5 steps, got %d", len(p.Steps))
+	if len(p.Steps) != 6 {
+		t.Errorf("expected 6 steps, got %d", len(p.Steps))
 	}
 
 	if p.CurrentStep != -1 {
@@ -33,6 +33,20 @@ func TestNewProcessingState(t *testing.T) {
 	}
 }
 
+func TestProcessingState_ConfigureSteps(t *testing.T) {
+	p := NewProcessingState()
+	p.ConfigureSteps(true, true, false, false, true)
+	if p.Steps[ProcessStepWebcamOnly].Status != StepSkipped {
+		t.Error("expected the webcam-only video to be skipped without a webcam")
+	}
+
+	p = NewProcessingState()
+	p.ConfigureSteps(true, true, true, false, true)
+	if p.Steps[ProcessStepVertical].Status != StepSkipped || p.Steps[ProcessStepWebcamOnly].Status != StepPending {
+		t.Error("expected only the webcam-only video to be created")
+	}
+}
+
 func TestProcessingState_Start(t *testing.T) {
 	p := NewProcessingState()
 
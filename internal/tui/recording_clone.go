@@ -0,0 +1,208 @@
+package tui
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/retention"
+)
+
+// maxCloneSources is the number of recent recordings offered to clone the
+// settings of
+const maxCloneSources = 9
+
+// cloneSourcesLoadedMsg carries the recordings settings can be cloned from
+type cloneSourcesLoadedMsg struct {
+	recordings []models.RecordingInfo
+	err        error
+}
+
+// loadCloneSources loads the most recent recordings in videosDir, newest
+// first. Recordings that are still being recorded are left out.
+func loadCloneSources(videosDir string) tea.Cmd {
+	return func() tea.Msg {
+		recordings, err := retention.LoadRecordings(videosDir)
+		if err != nil {
+			return cloneSourcesLoadedMsg{err: err}
+		}
+		var sources []models.RecordingInfo
+		for _, rec := range recordings {
+			if rec.Status != models.StatusRecording && rec.Status != models.StatusPaused {
+				sources = append(sources, rec)
+			}
+		}
+		sort.SliceStable(sources, func(i, j int) bool {
+			return recordingTime(sources[i]).After(recordingTime(sources[j]))
+		})
+		if len(sources) > maxCloneSources {
+			sources = sources[:maxCloneSources]
+		}
+		return cloneSourcesLoadedMsg{recordings: sources}
+	}
+}
+
+// recordingTime returns the best known time a recording was made
+func recordingTime(rec models.RecordingInfo) time.Time {
+	if !rec.StartTime.IsZero() {
+		return rec.StartTime
+	}
+	return rec.CreatedAt
+}
+
+// IsCloning returns true while the picker of recordings to clone the
+// settings of is shown
+func (m *RecordingSetupModel) IsCloning() bool {
+	return m.cloning
+}
+
+// openClonePicker shows the picker and loads the recent recordings
+func (m *RecordingSetupModel) openClonePicker() tea.Cmd {
+	m.cloning = true
+	m.cloneLoading = true
+	m.cloneSources = nil
+	m.cloneCursor = 0
+	m.cloneErr = ""
+
+	videosDir := m.config.OutputDir
+	if videosDir == "" {
+		videosDir = config.GetDefaultVideosDir()
+	}
+	return loadCloneSources(videosDir)
+}
+
+// handleCloneSourcesLoaded shows the loaded recordings in the picker
+func (m *RecordingSetupModel) handleCloneSourcesLoaded(msg cloneSourcesLoadedMsg) {
+	m.cloneLoading = false
+	m.cloneSources = msg.recordings
+	if msg.err != nil {
+		m.cloneErr = msg.err.Error()
+	}
+}
+
+// updateClonePicker handles keys while the picker is shown
+func (m *RecordingSetupModel) updateClonePicker(msg tea.KeyMsg) {
+	switch msg.String() {
+	case "esc", "q", "ctrl+r":
+		m.cloning = false
+
+	case "up", "k":
+		if m.cloneCursor > 0 {
+			m.cloneCursor--
+		}
+
+	case "down", "j":
+		if m.cloneCursor < len(m.cloneSources)-1 {
+			m.cloneCursor++
+		}
+
+	case "enter":
+		if m.cloneCursor < len(m.cloneSources) {
+			m.cloneFrom(&m.cloneSources[m.cloneCursor])
+		}
+		m.cloning = false
+
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		// Number keys clone one of the recordings directly
+		if n := int(msg.String()[0] - '1'); n < len(m.cloneSources) {
+			m.cloneFrom(&m.cloneSources[n])
+			m.cloning = false
+		}
+	}
+}
+
+// cloneFrom copies the settings, topic, presenter and logos of a recording
+// into the form. The title, number and description are left for the new
+// recording. Recordings saved before their settings were stored keep the
+// form's recording sources.
+func (m *RecordingSetupModel) cloneFrom(rec *models.RecordingInfo) {
+	m.applySettings(rec.Settings)
+
+	if rec.Metadata.Topic != "" {
+		m.form.SetSelectedTopic(rec.Metadata.Topic)
+	}
+	if rec.Metadata.Presenter != "" {
+		m.form.SetPresenter(rec.Metadata.Presenter)
+	}
+
+	m.form.State.ErrorMsg = ""
+	m.form.State.SuccessMsg = fmt.Sprintf("Settings cloned from %s", cloneSourceTitle(*rec))
+}
+
+// applySettings copies recording settings into the form. Settings without
+// any recording source keep the form's sources and devices.
+func (m *RecordingSetupModel) applySettings(s models.RecordingSettings) {
+	state := m.form.State
+
+	if s.AudioEnabled || s.WebcamEnabled || s.ScreenEnabled {
+		state.RecordAudio = s.AudioEnabled
+		state.RecordWebcam = s.WebcamEnabled
+		state.RecordScreen = s.ScreenEnabled
+		state.VerticalVideo = s.VerticalEnabled
+		state.WebcamOnly = s.WebcamOnlyEnabled
+		m.form.SetAudioDevice(s.AudioDevice)
+		m.form.SetWebcamDevice(s.WebcamDevice)
+	}
+	state.AddLogos = s.LogosEnabled
+	state.MaxDuration = s.MaxDuration
+	state.SelectedResolutionIdx = config.OutputResolutionIndex(config.OutputResolution(s.OutputResolution))
+	state.SelectedFrameRateIdx = config.FrameRateIndex(s.FrameRate)
+	state.SelectedPiPCornerIdx = config.PiPCornerIndex(config.PiPCorner(s.PiPCorner))
+	state.SelectedPiPSizeIdx = config.PiPSizeIndex(config.PiPSize(s.PiPSize))
+	m.form.SetVerticalWebcam(s.VerticalWebcamPosition, s.VerticalWebcamSize)
+	m.form.SetFilterPresets(s.FilterPresets)
+	if s.LogosEnabled {
+		m.setLogoIndices(config.LogoSelection{
+			LeftLogo:    s.LeftLogo,
+			RightLogo:   s.RightLogo,
+			BottomLogo:  s.BottomLogo,
+			TitleColor:  s.TitleColor,
+			GifLoopMode: config.GifLoopMode(s.GifLoopMode),
+		})
+	}
+}
+
+// cloneSourceTitle returns the title of a recording, or its folder name
+func cloneSourceTitle(rec models.RecordingInfo) string {
+	if rec.Metadata.Title != "" {
+		return rec.Metadata.Title
+	}
+	return filepath.Base(rec.Files.FolderPath)
+}
+
+// renderClonePicker renders the list of recent recordings to clone the
+// settings of
+func (m *RecordingSetupModel) renderClonePicker() string {
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+	selectedStyle := lipgloss.NewStyle().
+		Background(ColorOrange).
+		Foreground(lipgloss.Color("#000000"))
+	titleStyle := lipgloss.NewStyle().Foreground(ColorOrange).Bold(true)
+
+	rows := []string{titleStyle.Render("Clone settings from a recent recording"), ""}
+	switch {
+	case m.cloneLoading:
+		rows = append(rows, grayStyle.Italic(true).Render("Loading recordings..."))
+	case m.cloneErr != "":
+		rows = append(rows, lipgloss.NewStyle().Foreground(ColorRed).Render("Error: "+m.cloneErr))
+	case len(m.cloneSources) == 0:
+		rows = append(rows, grayStyle.Italic(true).Render("No recordings to clone from"))
+	}
+	for i, rec := range m.cloneSources {
+		line := fmt.Sprintf("%d  %-40s %-12s %s", i+1, truncateStr(cloneSourceTitle(rec), 40),
+			truncateStr(rec.Metadata.Topic, 12), recordingTime(rec).Format("2006-01-02"))
+		if i == m.cloneCursor {
+			rows = append(rows, selectedStyle.Render("▶ "+line))
+		} else {
+			rows = append(rows, valueStyle.Render("  "+line))
+		}
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
@@ -0,0 +1,125 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// setupDraftInterval is how long after a change the new recording form is
+// saved as a draft, so typing does not write the file on every key
+const setupDraftInterval = 2 * time.Second
+
+// setupDraftSaveMsg asks to save the new recording form as a draft
+type setupDraftSaveMsg struct{}
+
+// setupDraftLoadedMsg carries the draft of the new recording form left by
+// a previous run
+type setupDraftLoadedMsg struct {
+	draft *config.SetupDraft
+}
+
+// restoreSetupDraftMsg asks the app to open the new recording form filled in
+// with a draft
+type restoreSetupDraftMsg struct {
+	draft *config.SetupDraft
+}
+
+// checkSetupDraft loads the draft of the new recording form, if the
+// application was quit while it was filled in
+func checkSetupDraft() tea.Cmd {
+	return func() tea.Msg {
+		draft, err := config.LoadSetupDraft()
+		if err != nil || draft == nil || draft.IsEmpty() {
+			return nil
+		}
+		return setupDraftLoadedMsg{draft: draft}
+	}
+}
+
+// scheduleDraftSave marks the form as changed and saves it as a draft a
+// little later. Only one save is waiting at a time.
+func (m *RecordingSetupModel) scheduleDraftSave() tea.Cmd {
+	if m.draftDirty {
+		return nil
+	}
+	m.draftDirty = true
+	return tea.Tick(setupDraftInterval, func(time.Time) tea.Msg {
+		return setupDraftSaveMsg{}
+	})
+}
+
+// formDraft returns the form as a draft: the title, description, topic,
+// presenter, screen and settings. The number is left out, the form of the
+// next run picks the next free one.
+func (m *RecordingSetupModel) formDraft() *config.SetupDraft {
+	draft := &config.SetupDraft{
+		SavedAt: time.Now(),
+		Metadata: models.RecordingMetadata{
+			Title:       m.form.GetTitle(),
+			Description: m.form.GetDescription(),
+			Topic:       m.form.GetSelectedTopic().Name,
+			Presenter:   m.form.GetPresenter(),
+		},
+		Settings: m.GetRecordingSettings(),
+	}
+	if idx := m.form.State.SelectedMonitor; idx >= 0 && idx < len(m.monitors) {
+		draft.Monitor = m.monitors[idx].Name
+	}
+	return draft
+}
+
+// saveDraft saves the form as a draft if it changed. A form without a title
+// or description is not saved; it removes the draft this form saved before,
+// but leaves the one of a previous run alone.
+func (m *RecordingSetupModel) saveDraft() {
+	if !m.draftDirty {
+		return
+	}
+	m.draftDirty = false
+
+	draft := m.formDraft()
+	if draft.IsEmpty() {
+		if m.draftSaved {
+			_ = config.ClearSetupDraft()
+			m.draftSaved = false
+		}
+		return
+	}
+	if err := config.SaveSetupDraft(draft); err == nil {
+		m.draftSaved = true
+	}
+}
+
+// discardDraft removes the draft once the recording started, as its
+// recording.json now holds what was typed
+func (m *RecordingSetupModel) discardDraft() {
+	m.draftDirty = false
+	m.draftSaved = false
+	_ = config.ClearSetupDraft()
+}
+
+// restoreDraft fills in the form with a draft left by a previous run
+func (m *RecordingSetupModel) restoreDraft(draft *config.SetupDraft) {
+	m.applySettings(draft.Settings)
+	if draft.Metadata.Topic != "" {
+		m.form.SetSelectedTopic(draft.Metadata.Topic)
+	}
+	m.form.SetPresenter(draft.Metadata.Presenter)
+	m.form.SetTitle(draft.Metadata.Title)
+	m.form.SetDescription(draft.Metadata.Description)
+	for i, mon := range m.monitors {
+		if mon.Name == draft.Monitor {
+			m.form.State.SelectedMonitor = i
+			break
+		}
+	}
+
+	// The draft stays on disk until the recording starts
+	m.draftSaved = true
+	m.form.State.ErrorMsg = ""
+	m.form.State.SuccessMsg = fmt.Sprintf("Restored the setup saved %s", draft.SavedAt.Format("2006-01-02 15:04"))
+}
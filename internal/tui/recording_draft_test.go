@@ -0,0 +1,88 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+)
+
+func TestRecordingSetup_SavesAndRestoresDraft(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+
+	// Moving around an empty form leaves the draft of a previous run alone
+	previous := &config.SetupDraft{}
+	previous.Metadata.Title = "Earlier"
+	if err := config.SaveSetupDraft(previous); err != nil {
+		t.Fatal(err)
+	}
+	m := NewRecordingSetupModel()
+	m.form.SetTitle("")
+	app := AppModel{screen: ScreenRecordingSetup, recordingSetup: m, menu: NewMenuModel()}
+	if _, cmd := app.Update(tea.KeyMsg{Type: tea.KeyTab}); cmd == nil {
+		t.Fatal("expected a change to schedule saving the draft")
+	}
+	app.Update(setupDraftSaveMsg{})
+	if draft, _ := config.LoadSetupDraft(); draft == nil || draft.Metadata.Title != "Earlier" {
+		t.Fatalf("draft = %+v, want the previous one kept", draft)
+	}
+
+	m.form.SetTitle("QGIS intro")
+	m.form.SetDescription("A long description typed before recording")
+	m.form.State.RecordWebcam = false
+	app.Update(tea.KeyMsg{Type: tea.KeyTab})
+	app.Update(setupDraftSaveMsg{})
+	draft, err := config.LoadSetupDraft()
+	if err != nil || draft == nil || draft.Metadata.Title != "QGIS intro" {
+		t.Fatalf("draft = %+v, %v; want the form saved", draft, err)
+	}
+
+	// The next run offers it on the menu and restores it into a new form
+	app = AppModel{screen: ScreenMenu, menu: NewMenuModel()}
+	model, _ := app.Update(checkSetupDraft()())
+	app = model.(AppModel)
+	if !strings.Contains(app.menu.View(), "Unfinished recording setup: QGIS intro") {
+		t.Error("expected the menu to offer the unfinished setup")
+	}
+	_, cmd := app.menu.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	if cmd == nil {
+		t.Fatal("expected c to continue the setup")
+	}
+	model, _ = app.Update(cmd())
+	app = model.(AppModel)
+	form := app.recordingSetup.form
+	if app.screen != ScreenRecordingSetup || form.GetTitle() != "QGIS intro" || form.GetDescription() != "A long description typed before recording" {
+		t.Fatalf("screen %v with title %q, want the restored form", app.screen, form.GetTitle())
+	}
+	if form.State.RecordWebcam || app.menu.setupDraft != nil {
+		t.Error("expected the settings restored and the offer gone")
+	}
+
+	// Starting the recording discards the draft
+	app.recordingSetup.discardDraft()
+	if draft, _ := config.LoadSetupDraft(); draft != nil {
+		t.Error("expected the draft removed once the recording started")
+	}
+}
+
+func TestMenuModel_DiscardSetupDraft(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+
+	draft := &config.SetupDraft{}
+	draft.Metadata.Description = "Notes"
+	if err := config.SaveSetupDraft(draft); err != nil {
+		t.Fatal(err)
+	}
+	m := NewMenuModel()
+	m.SetSetupDraft(draft)
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	if m.setupDraft != nil || strings.Contains(m.View(), "Unfinished recording setup") {
+		t.Error("expected the offer to be gone")
+	}
+	if draft, _ := config.LoadSetupDraft(); draft != nil {
+		t.Error("expected the draft to be removed")
+	}
+}
@@ -2,7 +2,9 @@ package tui
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
@@ -12,6 +14,8 @@ import (
 	"github.com/kartoza/kartoza-screencaster/internal/config"
 	"github.com/kartoza/kartoza-screencaster/internal/models"
 	"github.com/kartoza/kartoza-screencaster/internal/spellcheck"
+	"github.com/kartoza/kartoza-screencaster/internal/templates"
+	"github.com/kartoza/kartoza-screencaster/internal/webcam"
 )
 
 // RecordingFormMode indicates whether the form is for new recording or editing existing
@@ -30,10 +34,23 @@ const (
 	FormFieldNumber
 	FormFieldTopic
 	FormFieldRecordAudio
+	FormFieldAudioDevice
 	FormFieldRecordWebcam
+	FormFieldWebcamDevice
 	FormFieldRecordScreen
 	FormFieldMonitor
+	FormFieldMaxDuration
+	FormFieldWebcamOnly
 	FormFieldVerticalVideo
+	FormFieldVerticalWebcamPosition
+	FormFieldVerticalWebcamSize
+	FormFieldTrimStart
+	FormFieldTrimEnd
+	FormFieldOutputResolution
+	FormFieldFrameRate
+	FormFieldPiPCorner
+	FormFieldPiPSize
+	FormFieldFilterPresets
 	FormFieldAddLogos
 	FormFieldLeftLogo
 	FormFieldRightLogo
@@ -54,10 +71,19 @@ type RecordingFormConfig struct {
 	Date       string
 	Duration   string
 
+	// Screen resolution the recording was made at, WxH (edit mode)
+	SourceResolution string
+
+	// Length of the recorded video before trimming (edit mode, 0 = unknown)
+	SourceDuration time.Duration
+
 	// Available options
-	Topics   []models.Topic
-	Monitors []models.Monitor
-	Logos    []string
+	Topics        []models.Topic
+	Monitors      []models.Monitor
+	AudioDevices  []models.AudioDevice // Microphones to record from (new recording only)
+	WebcamDevices []webcam.Device      // Webcams to record from (new recording only)
+	Logos         []string
+	Templates     []templates.Template // Applied with ctrl+t (new recording only)
 
 	// Callbacks
 	OnConfirm func()
@@ -72,15 +98,25 @@ type RecordingFormState struct {
 	PresenterInput textinput.Model
 	DescInput      textarea.Model
 
+	// Trim of the recording, in seconds or mm:ss (edit mode only)
+	TrimStartInput textinput.Model
+	TrimEndInput   textinput.Model
+
 	// Selections
-	SelectedTopic   int
-	SelectedMonitor int
+	SelectedTopic        int
+	SelectedMonitor      int
+	SelectedAudioDevice  int // 0 = default device, else index+1 into AudioDevices
+	SelectedWebcamDevice int // 0 = first detected webcam, else index+1 into WebcamDevices
+
+	// Minutes after which the recording stops by itself (0 = unlimited)
+	MaxDuration int
 
 	// Toggles (new recording only)
 	RecordAudio   bool
 	RecordWebcam  bool
 	RecordScreen  bool
 	VerticalVideo bool
+	WebcamOnly    bool
 	AddLogos      bool
 
 	// Logo selection
@@ -90,10 +126,34 @@ type RecordingFormState struct {
 	SelectedColorIdx   int
 	SelectedGifLoopIdx int
 
+	// Processing options
+	SelectedResolutionIdx int
+	SelectedFrameRateIdx  int // Output frame rate
+	SelectedPiPCornerIdx  int // Landscape webcam overlay corner
+	SelectedPiPSizeIdx    int // Landscape webcam overlay size
+
+	// Webcam placement on the vertical video
+	SelectedVerticalPositionIdx int
+	SelectedVerticalSizeIdx     int
+
+	// Filter presets: the library from the config, the one under the cursor
+	// and the names of those attached to the recording
+	FilterPresets         []models.FilterPreset
+	FilterPresetCursor    int
+	AttachedFilterPresets map[string]bool
+
+	// Index of the applied recording template (-1 = none)
+	TemplateIdx int
+
 	// Focus state
 	FocusedField RecordingFormField
 	InputMode    bool // When true, text input captures all keys
 
+	// Navigation preferences
+	InitialFocus      config.FormFocus        // Field focused when the form opens
+	SkipPresetToggles bool                    // Tab skips toggles that still match Presets
+	Presets           config.RecordingPresets // Presets the toggles were initialised from
+
 	// Confirm button state
 	ConfirmSelected bool // true = confirm, false = cancel
 
@@ -108,15 +168,44 @@ type RecordingFormState struct {
 	IsSaving   bool
 }
 
+// newTitleInput returns the title input of the recording form, also used
+// wherever else a recording's title is edited
+func newTitleInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "Enter recording title..."
+	input.CharLimit = 100
+	input.Width = 40
+	return input
+}
+
+// newDescriptionInput returns the description input of the recording form,
+// also used wherever else a recording's description is edited
+func newDescriptionInput() textarea.Model {
+	input := textarea.New()
+	input.Placeholder = "Enter description..."
+	input.CharLimit = 2000
+	input.SetWidth(58)
+	input.SetHeight(4)
+	input.ShowLineNumbers = false
+	return input
+}
+
+// newTagsInput returns an input for comma separated tags, as parsed by
+// youtube.ParseTags
+func newTagsInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "Tags (comma separated)"
+	input.CharLimit = 500
+	input.Width = 50
+	return input
+}
+
 // NewRecordingFormState creates a new form state with default values
 func NewRecordingFormState(mode RecordingFormMode) *RecordingFormState {
 	cfg, _ := config.Load()
 
 	// Title input
-	titleInput := textinput.New()
-	titleInput.Placeholder = "Enter recording title..."
-	titleInput.CharLimit = 100
-	titleInput.Width = 40
+	titleInput := newTitleInput()
 
 	// Number input (for new recordings)
 	numberInput := textinput.New()
@@ -137,13 +226,18 @@ func NewRecordingFormState(mode RecordingFormMode) *RecordingFormState {
 		presenterInput.SetValue(cfg.DefaultPresenter)
 	}
 
+	// Trim inputs (for existing recordings)
+	trimStartInput := textinput.New()
+	trimStartInput.Placeholder = "0:00"
+	trimStartInput.CharLimit = 12
+	trimStartInput.Width = 12
+	trimEndInput := textinput.New()
+	trimEndInput.Placeholder = "end"
+	trimEndInput.CharLimit = 12
+	trimEndInput.Width = 12
+
 	// Description input
-	descInput := textarea.New()
-	descInput.Placeholder = "Enter description..."
-	descInput.CharLimit = 2000
-	descInput.SetWidth(58)
-	descInput.SetHeight(4)
-	descInput.ShowLineNumbers = false
+	descInput := newDescriptionInput()
 
 	// Get recording presets
 	presets := cfg.RecordingPresets
@@ -158,9 +252,27 @@ func NewRecordingFormState(mode RecordingFormMode) *RecordingFormState {
 		NumberInput:     numberInput,
 		PresenterInput:  presenterInput,
 		DescInput:       descInput,
+		TrimStartInput:  trimStartInput,
+		TrimEndInput:    trimEndInput,
 		FocusedField:    FormFieldTitle,
 		ConfirmSelected: true,
 		SpellChecker:    spellcheck.NewSpellChecker(),
+
+		SelectedResolutionIdx: config.OutputResolutionIndex(cfg.OutputResolution),
+		SelectedFrameRateIdx:  config.FrameRateIndex(config.DefaultFrameRate),
+		SelectedPiPCornerIdx:  config.PiPCornerIndex(cfg.PiPCorner),
+		SelectedPiPSizeIdx:    config.PiPSizeIndex(cfg.PiPSize),
+
+		SelectedVerticalPositionIdx: config.VerticalWebcamPositionIndex(config.VerticalWebcamBottomCenter),
+		SelectedVerticalSizeIdx:     config.VerticalWebcamSizeIndex(config.VerticalWebcamLarge),
+
+		FilterPresets:         cfg.FilterPresets,
+		AttachedFilterPresets: make(map[string]bool),
+		TemplateIdx:           -1,
+
+		InitialFocus:      cfg.FormFocus,
+		SkipPresetToggles: cfg.SkipPresetToggles,
+		Presets:           presets,
 	}
 
 	if mode == FormModeNewRecording {
@@ -168,6 +280,7 @@ func NewRecordingFormState(mode RecordingFormMode) *RecordingFormState {
 		state.RecordWebcam = presets.RecordWebcam
 		state.RecordScreen = presets.RecordScreen
 		state.VerticalVideo = presets.VerticalVideo
+		state.WebcamOnly = presets.WebcamOnlyVideo
 		state.AddLogos = presets.AddLogos
 	}
 
@@ -185,6 +298,7 @@ type RecordingForm struct {
 
 	// Track line positions for auto-scroll
 	fieldLinePositions map[RecordingFormField]int
+	scrollOnRender     bool // Scroll to the focused field once positions are known
 }
 
 // NewRecordingForm creates a new recording form
@@ -217,10 +331,27 @@ func (f *RecordingForm) SetSize(width, height int) {
 	f.ready = true
 }
 
-// Focus focuses the title input
+// Focus focuses the configured initial field (the title input by default)
 func (f *RecordingForm) Focus() {
-	f.State.TitleInput.Focus()
-	f.State.FocusedField = FormFieldTitle
+	f.State.FocusedField = f.initialField()
+	if f.State.FocusedField == FormFieldTitle {
+		f.State.TitleInput.Focus()
+	}
+	f.scrollOnRender = true
+}
+
+// initialField returns the field to focus when the form opens. The confirm
+// button only exists for new recordings; edit mode falls back to the title.
+func (f *RecordingForm) initialField() RecordingFormField {
+	switch f.State.InitialFocus {
+	case config.FormFocusTopic:
+		return FormFieldTopic
+	case config.FormFocusConfirm:
+		if f.Config.Mode == FormModeNewRecording {
+			return FormFieldConfirm
+		}
+	}
+	return FormFieldTitle
 }
 
 // Blur removes focus from all inputs
@@ -229,6 +360,8 @@ func (f *RecordingForm) Blur() {
 	f.State.NumberInput.Blur()
 	f.State.PresenterInput.Blur()
 	f.State.DescInput.Blur()
+	f.State.TrimStartInput.Blur()
+	f.State.TrimEndInput.Blur()
 	f.State.InputMode = false
 }
 
@@ -239,6 +372,12 @@ func (f *RecordingForm) Update(msg tea.Msg) (*RecordingForm, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// Apply the next recording template, also while typing
+		if msg.String() == "ctrl+t" && f.canApplyTemplate() {
+			f.applyTemplate((f.State.TemplateIdx + 1) % len(f.Config.Templates))
+			return f, nil
+		}
+
 		// Handle input mode (when typing in a text field)
 		if f.State.InputMode {
 			switch msg.String() {
@@ -272,12 +411,19 @@ func (f *RecordingForm) Update(msg tea.Msg) (*RecordingForm, tea.Cmd) {
 			}
 		}
 
-		// Normal mode navigation
+		// Normal mode navigation. Tab may skip toggles still at their
+		// preset; the arrow keys always visit every field.
 		switch msg.String() {
-		case "tab", "down", "j":
+		case "tab":
+			f.nextFieldSkipping(f.State.SkipPresetToggles)
+			f.scrollToFocusedField()
+		case "down", "j":
 			f.nextField()
 			f.scrollToFocusedField()
-		case "shift+tab", "up", "k":
+		case "shift+tab":
+			f.prevFieldSkipping(f.State.SkipPresetToggles)
+			f.scrollToFocusedField()
+		case "up", "k":
 			f.prevField()
 			f.scrollToFocusedField()
 		case "left", "h":
@@ -348,6 +494,10 @@ func (f *RecordingForm) updateFocusedInput(msg tea.Msg) tea.Cmd {
 		f.State.NumberInput, cmd = f.State.NumberInput.Update(msg)
 	case FormFieldPresenter:
 		f.State.PresenterInput, cmd = f.State.PresenterInput.Update(msg)
+	case FormFieldTrimStart:
+		f.State.TrimStartInput, cmd = f.State.TrimStartInput.Update(msg)
+	case FormFieldTrimEnd:
+		f.State.TrimEndInput, cmd = f.State.TrimEndInput.Update(msg)
 	case FormFieldDescription:
 		f.State.DescInput, cmd = f.State.DescInput.Update(msg)
 		f.State.DescIssues = f.State.SpellChecker.Check(f.State.DescInput.Value())
@@ -363,16 +513,26 @@ func (f *RecordingForm) blurCurrentInput() {
 		f.State.NumberInput.Blur()
 	case FormFieldPresenter:
 		f.State.PresenterInput.Blur()
+	case FormFieldTrimStart:
+		f.State.TrimStartInput.Blur()
+	case FormFieldTrimEnd:
+		f.State.TrimEndInput.Blur()
 	case FormFieldDescription:
 		f.State.DescInput.Blur()
 	}
 }
 
 func (f *RecordingForm) nextField() {
+	f.nextFieldSkipping(false)
+}
+
+// nextFieldSkipping moves to the next field; with skipPresets, toggles that
+// match the recording presets are passed over (new recordings only)
+func (f *RecordingForm) nextFieldSkipping(skipPresets bool) {
 	if f.Config.Mode == FormModeEditExisting {
 		f.nextFieldEditMode()
 	} else {
-		f.nextFieldNewMode()
+		f.nextFieldNewMode(skipPresets)
 	}
 }
 
@@ -394,11 +554,31 @@ func (f *RecordingForm) nextFieldEditMode() {
 			if f.State.RecordScreen && len(f.Config.Monitors) > 0 {
 				f.State.FocusedField = FormFieldMonitor
 			} else {
-				f.State.FocusedField = FormFieldVerticalVideo
+				f.State.FocusedField = FormFieldWebcamOnly
 			}
 		case FormFieldMonitor:
+			f.State.FocusedField = FormFieldWebcamOnly
+		case FormFieldWebcamOnly:
 			f.State.FocusedField = FormFieldVerticalVideo
 		case FormFieldVerticalVideo:
+			f.State.FocusedField = FormFieldVerticalWebcamPosition
+		case FormFieldVerticalWebcamPosition:
+			f.State.FocusedField = FormFieldVerticalWebcamSize
+		case FormFieldVerticalWebcamSize:
+			f.State.FocusedField = FormFieldTrimStart
+		case FormFieldTrimStart:
+			f.State.FocusedField = FormFieldTrimEnd
+		case FormFieldTrimEnd:
+			f.State.FocusedField = FormFieldOutputResolution
+		case FormFieldOutputResolution:
+			f.State.FocusedField = FormFieldFrameRate
+		case FormFieldFrameRate:
+			f.State.FocusedField = FormFieldPiPCorner
+		case FormFieldPiPCorner:
+			f.State.FocusedField = FormFieldPiPSize
+		case FormFieldPiPSize:
+			f.State.FocusedField = FormFieldFilterPresets
+		case FormFieldFilterPresets:
 			f.State.FocusedField = FormFieldAddLogos
 		case FormFieldAddLogos:
 			if f.State.AddLogos {
@@ -432,7 +612,7 @@ func (f *RecordingForm) nextFieldEditMode() {
 	}
 }
 
-func (f *RecordingForm) nextFieldNewMode() {
+func (f *RecordingForm) nextFieldNewMode(skipPresets bool) {
 	// New recording mode has more fields
 	for {
 		switch f.State.FocusedField {
@@ -443,18 +623,40 @@ func (f *RecordingForm) nextFieldNewMode() {
 		case FormFieldTopic:
 			f.State.FocusedField = FormFieldRecordAudio
 		case FormFieldRecordAudio:
+			f.State.FocusedField = FormFieldAudioDevice
+		case FormFieldAudioDevice:
 			f.State.FocusedField = FormFieldRecordWebcam
 		case FormFieldRecordWebcam:
+			f.State.FocusedField = FormFieldWebcamDevice
+		case FormFieldWebcamDevice:
 			f.State.FocusedField = FormFieldRecordScreen
 		case FormFieldRecordScreen:
 			if f.State.RecordScreen && len(f.Config.Monitors) > 0 {
 				f.State.FocusedField = FormFieldMonitor
 			} else {
-				f.State.FocusedField = FormFieldVerticalVideo
+				f.State.FocusedField = FormFieldMaxDuration
 			}
 		case FormFieldMonitor:
+			f.State.FocusedField = FormFieldMaxDuration
+		case FormFieldMaxDuration:
+			f.State.FocusedField = FormFieldWebcamOnly
+		case FormFieldWebcamOnly:
 			f.State.FocusedField = FormFieldVerticalVideo
 		case FormFieldVerticalVideo:
+			f.State.FocusedField = FormFieldVerticalWebcamPosition
+		case FormFieldVerticalWebcamPosition:
+			f.State.FocusedField = FormFieldVerticalWebcamSize
+		case FormFieldVerticalWebcamSize:
+			f.State.FocusedField = FormFieldOutputResolution
+		case FormFieldOutputResolution:
+			f.State.FocusedField = FormFieldFrameRate
+		case FormFieldFrameRate:
+			f.State.FocusedField = FormFieldPiPCorner
+		case FormFieldPiPCorner:
+			f.State.FocusedField = FormFieldPiPSize
+		case FormFieldPiPSize:
+			f.State.FocusedField = FormFieldFilterPresets
+		case FormFieldFilterPresets:
 			f.State.FocusedField = FormFieldAddLogos
 		case FormFieldAddLogos:
 			if f.State.AddLogos {
@@ -486,17 +688,23 @@ func (f *RecordingForm) nextFieldNewMode() {
 		}
 
 		// Check if we should skip this field
-		if !f.shouldSkipField(f.State.FocusedField) {
+		if !f.shouldSkipField(f.State.FocusedField) &&
+			!(skipPresets && f.matchesPreset(f.State.FocusedField)) {
 			break
 		}
 	}
 }
 
 func (f *RecordingForm) prevField() {
+	f.prevFieldSkipping(false)
+}
+
+// prevFieldSkipping is the reverse of nextFieldSkipping
+func (f *RecordingForm) prevFieldSkipping(skipPresets bool) {
 	if f.Config.Mode == FormModeEditExisting {
 		f.prevFieldEditMode()
 	} else {
-		f.prevFieldNewMode()
+		f.prevFieldNewMode(skipPresets)
 	}
 }
 
@@ -519,13 +727,33 @@ func (f *RecordingForm) prevFieldEditMode() {
 		case FormFieldMonitor:
 			f.State.FocusedField = FormFieldRecordScreen
 		case FormFieldVerticalVideo:
+			f.State.FocusedField = FormFieldWebcamOnly
+		case FormFieldVerticalWebcamPosition:
+			f.State.FocusedField = FormFieldVerticalVideo
+		case FormFieldVerticalWebcamSize:
+			f.State.FocusedField = FormFieldVerticalWebcamPosition
+		case FormFieldWebcamOnly:
 			if f.State.RecordScreen && len(f.Config.Monitors) > 0 {
 				f.State.FocusedField = FormFieldMonitor
 			} else {
 				f.State.FocusedField = FormFieldRecordScreen
 			}
+		case FormFieldTrimStart:
+			f.State.FocusedField = FormFieldVerticalWebcamSize
+		case FormFieldTrimEnd:
+			f.State.FocusedField = FormFieldTrimStart
+		case FormFieldOutputResolution:
+			f.State.FocusedField = FormFieldTrimEnd
+		case FormFieldFrameRate:
+			f.State.FocusedField = FormFieldOutputResolution
+		case FormFieldPiPCorner:
+			f.State.FocusedField = FormFieldFrameRate
+		case FormFieldPiPSize:
+			f.State.FocusedField = FormFieldPiPCorner
+		case FormFieldFilterPresets:
+			f.State.FocusedField = FormFieldPiPSize
 		case FormFieldAddLogos:
-			f.State.FocusedField = FormFieldVerticalVideo
+			f.State.FocusedField = FormFieldFilterPresets
 		case FormFieldLeftLogo:
 			f.State.FocusedField = FormFieldAddLogos
 		case FormFieldRightLogo:
@@ -556,7 +784,7 @@ func (f *RecordingForm) prevFieldEditMode() {
 	}
 }
 
-func (f *RecordingForm) prevFieldNewMode() {
+func (f *RecordingForm) prevFieldNewMode(skipPresets bool) {
 	for {
 		switch f.State.FocusedField {
 		case FormFieldTitle:
@@ -567,20 +795,42 @@ func (f *RecordingForm) prevFieldNewMode() {
 			f.State.FocusedField = FormFieldNumber
 		case FormFieldRecordAudio:
 			f.State.FocusedField = FormFieldTopic
-		case FormFieldRecordWebcam:
+		case FormFieldAudioDevice:
 			f.State.FocusedField = FormFieldRecordAudio
-		case FormFieldRecordScreen:
+		case FormFieldRecordWebcam:
+			f.State.FocusedField = FormFieldAudioDevice
+		case FormFieldWebcamDevice:
 			f.State.FocusedField = FormFieldRecordWebcam
+		case FormFieldRecordScreen:
+			f.State.FocusedField = FormFieldWebcamDevice
 		case FormFieldMonitor:
 			f.State.FocusedField = FormFieldRecordScreen
-		case FormFieldVerticalVideo:
+		case FormFieldMaxDuration:
 			if f.State.RecordScreen && len(f.Config.Monitors) > 0 {
 				f.State.FocusedField = FormFieldMonitor
 			} else {
 				f.State.FocusedField = FormFieldRecordScreen
 			}
-		case FormFieldAddLogos:
+		case FormFieldVerticalVideo:
+			f.State.FocusedField = FormFieldWebcamOnly
+		case FormFieldVerticalWebcamPosition:
 			f.State.FocusedField = FormFieldVerticalVideo
+		case FormFieldVerticalWebcamSize:
+			f.State.FocusedField = FormFieldVerticalWebcamPosition
+		case FormFieldWebcamOnly:
+			f.State.FocusedField = FormFieldMaxDuration
+		case FormFieldOutputResolution:
+			f.State.FocusedField = FormFieldVerticalWebcamSize
+		case FormFieldFrameRate:
+			f.State.FocusedField = FormFieldOutputResolution
+		case FormFieldPiPCorner:
+			f.State.FocusedField = FormFieldFrameRate
+		case FormFieldPiPSize:
+			f.State.FocusedField = FormFieldPiPCorner
+		case FormFieldFilterPresets:
+			f.State.FocusedField = FormFieldPiPSize
+		case FormFieldAddLogos:
+			f.State.FocusedField = FormFieldFilterPresets
 		case FormFieldLeftLogo:
 			f.State.FocusedField = FormFieldAddLogos
 		case FormFieldRightLogo:
@@ -607,7 +857,8 @@ func (f *RecordingForm) prevFieldNewMode() {
 			f.State.FocusedField = FormFieldTitle
 		}
 
-		if !f.shouldSkipField(f.State.FocusedField) {
+		if !f.shouldSkipField(f.State.FocusedField) &&
+			!(skipPresets && f.matchesPreset(f.State.FocusedField)) {
 			break
 		}
 	}
@@ -621,12 +872,32 @@ func (f *RecordingForm) shouldSkipField(field RecordingFormField) bool {
 	case FormFieldMonitor:
 		// Only show monitor if recording screen and monitors available
 		return !f.State.RecordScreen || len(f.Config.Monitors) == 0
+	case FormFieldAudioDevice:
+		// Only show the microphone for new recordings with audio, when
+		// devices could be listed
+		return f.Config.Mode == FormModeEditExisting || !f.State.RecordAudio || len(f.Config.AudioDevices) == 0
+	case FormFieldWebcamDevice:
+		// Only show the webcam for new recordings with the webcam, when
+		// devices could be listed
+		return f.Config.Mode == FormModeEditExisting || !f.State.RecordWebcam || len(f.Config.WebcamDevices) == 0
+	case FormFieldMaxDuration:
+		// Only show the limit for new recordings
+		return f.Config.Mode == FormModeEditExisting
+	case FormFieldVerticalWebcamPosition, FormFieldVerticalWebcamSize:
+		// Only show the vertical webcam placement if a vertical video is made
+		return !f.VerticalVideoEnabled()
+	case FormFieldTrimStart, FormFieldTrimEnd:
+		// Only recordings that exist can be trimmed
+		return f.Config.Mode == FormModeNewRecording
 	case FormFieldLeftLogo, FormFieldRightLogo, FormFieldBottomLogo, FormFieldTitleColor:
 		// Only show logo fields if logos enabled
 		return !f.State.AddLogos
 	case FormFieldGifLoopMode:
 		// Only show GIF loop mode if logos enabled and bottom logo is GIF
 		return !f.State.AddLogos || !f.isBottomLogoGif()
+	case FormFieldFilterPresets:
+		// Only show filter presets if the library has any
+		return len(f.State.FilterPresets) == 0
 	case FormFieldConfirm:
 		// Only show confirm button for new recordings
 		return f.Config.Mode == FormModeEditExisting
@@ -634,6 +905,27 @@ func (f *RecordingForm) shouldSkipField(field RecordingFormField) bool {
 	return false
 }
 
+// matchesPreset returns true if field is a recording toggle whose value is
+// unchanged from the recording presets
+func (f *RecordingForm) matchesPreset(field RecordingFormField) bool {
+	presets := f.State.Presets
+	switch field {
+	case FormFieldRecordAudio:
+		return f.State.RecordAudio == presets.RecordAudio
+	case FormFieldRecordWebcam:
+		return f.State.RecordWebcam == presets.RecordWebcam
+	case FormFieldRecordScreen:
+		return f.State.RecordScreen == presets.RecordScreen
+	case FormFieldWebcamOnly:
+		return f.State.WebcamOnly == presets.WebcamOnlyVideo
+	case FormFieldVerticalVideo:
+		return f.State.VerticalVideo == presets.VerticalVideo
+	case FormFieldAddLogos:
+		return f.State.AddLogos == presets.AddLogos
+	}
+	return false
+}
+
 func (f *RecordingForm) handleLeftRight(dir int) {
 	switch f.State.FocusedField {
 	case FormFieldTopic:
@@ -652,15 +944,88 @@ func (f *RecordingForm) handleLeftRight(dir int) {
 		if f.State.SelectedMonitor >= len(f.Config.Monitors) {
 			f.State.SelectedMonitor = 0
 		}
+	case FormFieldAudioDevice:
+		// The default device comes first
+		n := len(f.Config.AudioDevices) + 1
+		f.State.SelectedAudioDevice = (f.State.SelectedAudioDevice + dir + n) % n
+	case FormFieldWebcamDevice:
+		// The detected webcam comes first
+		n := len(f.Config.WebcamDevices) + 1
+		f.State.SelectedWebcamDevice = (f.State.SelectedWebcamDevice + dir + n) % n
+	case FormFieldMaxDuration:
+		f.State.MaxDuration = nextMaxDuration(f.State.MaxDuration, dir)
 	case FormFieldRecordAudio:
 		f.State.RecordAudio = !f.State.RecordAudio
 	case FormFieldRecordWebcam:
 		f.State.RecordWebcam = !f.State.RecordWebcam
+		f.clearVerticalVideoError()
+		if f.State.ErrorMsg == webcamOnlyUnsupportedMsg && f.State.RecordWebcam {
+			f.State.ErrorMsg = ""
+		}
 	case FormFieldRecordScreen:
 		f.State.RecordScreen = !f.State.RecordScreen
+		f.clearVerticalVideoError()
+	case FormFieldWebcamOnly:
+		if f.State.RecordWebcam {
+			f.State.WebcamOnly = !f.State.WebcamOnly
+		} else {
+			f.State.ErrorMsg = webcamOnlyUnsupportedMsg
+		}
 	case FormFieldVerticalVideo:
 		if f.canEnableVerticalVideo() {
 			f.State.VerticalVideo = !f.State.VerticalVideo
+		} else {
+			f.State.ErrorMsg = verticalVideoUnsupportedMsg
+		}
+	case FormFieldVerticalWebcamPosition:
+		n := len(config.VerticalWebcamPositions)
+		f.State.SelectedVerticalPositionIdx = (f.State.SelectedVerticalPositionIdx + dir + n) % n
+	case FormFieldVerticalWebcamSize:
+		n := len(config.VerticalWebcamSizes)
+		f.State.SelectedVerticalSizeIdx = (f.State.SelectedVerticalSizeIdx + dir + n) % n
+	case FormFieldOutputResolution:
+		// The error about the previous resolution no longer applies
+		if f.State.ErrorMsg != "" && f.State.ErrorMsg == f.resolutionError() {
+			f.State.ErrorMsg = ""
+		}
+		f.State.SelectedResolutionIdx += dir
+		if f.State.SelectedResolutionIdx < 0 {
+			f.State.SelectedResolutionIdx = len(config.OutputResolutions) - 1
+		}
+		if f.State.SelectedResolutionIdx >= len(config.OutputResolutions) {
+			f.State.SelectedResolutionIdx = 0
+		}
+	case FormFieldFrameRate:
+		f.State.SelectedFrameRateIdx += dir
+		if f.State.SelectedFrameRateIdx < 0 {
+			f.State.SelectedFrameRateIdx = len(config.FrameRates) - 1
+		}
+		if f.State.SelectedFrameRateIdx >= len(config.FrameRates) {
+			f.State.SelectedFrameRateIdx = 0
+		}
+	case FormFieldPiPCorner:
+		f.State.SelectedPiPCornerIdx += dir
+		if f.State.SelectedPiPCornerIdx < 0 {
+			f.State.SelectedPiPCornerIdx = len(config.PiPCorners) - 1
+		}
+		if f.State.SelectedPiPCornerIdx >= len(config.PiPCorners) {
+			f.State.SelectedPiPCornerIdx = 0
+		}
+	case FormFieldPiPSize:
+		f.State.SelectedPiPSizeIdx += dir
+		if f.State.SelectedPiPSizeIdx < 0 {
+			f.State.SelectedPiPSizeIdx = len(config.PiPSizes) - 1
+		}
+		if f.State.SelectedPiPSizeIdx >= len(config.PiPSizes) {
+			f.State.SelectedPiPSizeIdx = 0
+		}
+	case FormFieldFilterPresets:
+		f.State.FilterPresetCursor += dir
+		if f.State.FilterPresetCursor < 0 {
+			f.State.FilterPresetCursor = len(f.State.FilterPresets) - 1
+		}
+		if f.State.FilterPresetCursor >= len(f.State.FilterPresets) {
+			f.State.FilterPresetCursor = 0
 		}
 	case FormFieldAddLogos:
 		f.State.AddLogos = !f.State.AddLogos
@@ -711,7 +1076,7 @@ func (f *RecordingForm) handleLeftRight(dir int) {
 
 func (f *RecordingForm) handleEnter() (*RecordingForm, tea.Cmd) {
 	switch f.State.FocusedField {
-	case FormFieldTitle, FormFieldNumber, FormFieldPresenter:
+	case FormFieldTitle, FormFieldNumber, FormFieldPresenter, FormFieldTrimStart, FormFieldTrimEnd:
 		f.State.InputMode = true
 		f.focusCurrentInput()
 		return f, textinput.Blink
@@ -719,6 +1084,8 @@ func (f *RecordingForm) handleEnter() (*RecordingForm, tea.Cmd) {
 		f.State.InputMode = true
 		f.State.DescInput.Focus()
 		return f, textarea.Blink
+	case FormFieldFilterPresets:
+		f.toggleFilterPreset()
 	case FormFieldConfirm:
 		if f.State.ConfirmSelected {
 			if f.Config.OnConfirm != nil {
@@ -736,6 +1103,50 @@ func (f *RecordingForm) handleEnter() (*RecordingForm, tea.Cmd) {
 	return f, nil
 }
 
+// canApplyTemplate returns true if recording templates can be applied,
+// which only seed new recordings
+func (f *RecordingForm) canApplyTemplate() bool {
+	return f.Config.Mode == FormModeNewRecording && len(f.Config.Templates) > 0
+}
+
+// applyTemplate fills in the title, topic and description from the
+// recording template at idx. Fields the template leaves empty are kept.
+func (f *RecordingForm) applyTemplate(idx int) {
+	t := f.Config.Templates[idx]
+	f.State.TemplateIdx = idx
+	if t.TitlePattern != "" {
+		number, _ := strconv.Atoi(f.GetNumber())
+		f.SetTitle(t.Title(number, time.Now()))
+	}
+	if t.Topic != "" {
+		f.SetSelectedTopic(t.Topic)
+	}
+	if t.Description != "" {
+		f.SetDescription(strings.TrimSpace(t.Description))
+	}
+}
+
+// AppliedTemplate returns the recording template applied to the form, or nil
+func (f *RecordingForm) AppliedTemplate() *templates.Template {
+	if f.State.TemplateIdx < 0 || f.State.TemplateIdx >= len(f.Config.Templates) {
+		return nil
+	}
+	return &f.Config.Templates[f.State.TemplateIdx]
+}
+
+// toggleFilterPreset attaches or detaches the preset under the cursor
+func (f *RecordingForm) toggleFilterPreset() {
+	if f.State.FilterPresetCursor < 0 || f.State.FilterPresetCursor >= len(f.State.FilterPresets) {
+		return
+	}
+	name := f.State.FilterPresets[f.State.FilterPresetCursor].Name
+	if f.State.AttachedFilterPresets[name] {
+		delete(f.State.AttachedFilterPresets, name)
+	} else {
+		f.State.AttachedFilterPresets[name] = true
+	}
+}
+
 func (f *RecordingForm) focusCurrentInput() {
 	switch f.State.FocusedField {
 	case FormFieldTitle:
@@ -744,11 +1155,140 @@ func (f *RecordingForm) focusCurrentInput() {
 		f.State.NumberInput.Focus()
 	case FormFieldPresenter:
 		f.State.PresenterInput.Focus()
+	case FormFieldTrimStart:
+		f.State.TrimStartInput.Focus()
+	case FormFieldTrimEnd:
+		f.State.TrimEndInput.Focus()
 	}
 }
 
 func (f *RecordingForm) canEnableVerticalVideo() bool {
-	return f.State.RecordWebcam || f.State.RecordScreen
+	return config.VerticalVideoSupported(f.State.RecordWebcam, f.State.RecordScreen)
+}
+
+// GetFrameRate returns the selected output frame rate
+func (f *RecordingForm) GetFrameRate() int {
+	return config.FrameRates[f.State.SelectedFrameRateIdx]
+}
+
+// GetVerticalWebcamPosition returns where the webcam is placed on the
+// vertical video
+func (f *RecordingForm) GetVerticalWebcamPosition() config.VerticalWebcamPosition {
+	return config.VerticalWebcamPositions[f.State.SelectedVerticalPositionIdx]
+}
+
+// GetVerticalWebcamSize returns the size of the webcam on the vertical video
+func (f *RecordingForm) GetVerticalWebcamSize() config.VerticalWebcamSize {
+	return config.VerticalWebcamSizes[f.State.SelectedVerticalSizeIdx]
+}
+
+// SetVerticalWebcam selects the webcam placement on the vertical video
+// stored in a recording (empty = the default)
+func (f *RecordingForm) SetVerticalWebcam(position, size string) {
+	f.State.SelectedVerticalPositionIdx = config.VerticalWebcamPositionIndex(config.VerticalWebcamPosition(position))
+	f.State.SelectedVerticalSizeIdx = config.VerticalWebcamSizeIndex(config.VerticalWebcamSize(size))
+}
+
+// sourceResolution returns the size of the recorded screen: the one the
+// recording was made at when editing, else the selected monitor. Both are 0
+// if unknown or no screen is recorded.
+func (f *RecordingForm) sourceResolution() (int, int) {
+	if !f.State.RecordScreen {
+		return 0, 0
+	}
+	if f.Config.Mode == FormModeEditExisting {
+		var w, h int
+		if _, err := fmt.Sscanf(f.Config.SourceResolution, "%dx%d", &w, &h); err != nil {
+			return 0, 0
+		}
+		return w, h
+	}
+	if f.State.SelectedMonitor < len(f.Config.Monitors) {
+		mon := f.Config.Monitors[f.State.SelectedMonitor]
+		return mon.Width, mon.Height
+	}
+	return 0, 0
+}
+
+// resolutionError returns why the output resolution cannot be used, or ""
+// if it can. Videos are never upscaled, so a resolution larger than the
+// recorded screen is refused.
+func (f *RecordingForm) resolutionError() string {
+	resolution := config.OutputResolutions[f.State.SelectedResolutionIdx]
+	w, h := f.sourceResolution()
+	if resolution.Height() == 0 || h == 0 || resolution.Height() <= h {
+		return ""
+	}
+	return fmt.Sprintf("%s is larger than the %dx%d screen, choose a lower resolution or Native",
+		config.OutputResolutionLabels[resolution], w, h)
+}
+
+// GetTrim returns the trim entered for the recording. end 0 keeps everything
+// after start. It returns an error if a time cannot be read or the trim
+// would not keep part of the recording.
+func (f *RecordingForm) GetTrim() (start, end time.Duration, err error) {
+	if start, err = models.ParseTrimTime(f.State.TrimStartInput.Value()); err != nil {
+		return 0, 0, fmt.Errorf("trim start: %w", err)
+	}
+	if end, err = models.ParseTrimTime(f.State.TrimEndInput.Value()); err != nil {
+		return 0, 0, fmt.Errorf("trim end: %w", err)
+	}
+	if err = models.ValidateTrim(start, end, f.Config.SourceDuration); err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+// SetTrim sets the trim of the recording (0 = no trim at that end)
+func (f *RecordingForm) SetTrim(start, end time.Duration) {
+	f.State.TrimStartInput.SetValue(models.FormatTrimTime(start))
+	f.State.TrimEndInput.SetValue(models.FormatTrimTime(end))
+}
+
+// trimSummary returns how much of the recording the trim keeps, or why it
+// cannot be used
+func (f *RecordingForm) trimSummary() (string, bool) {
+	start, end, err := f.GetTrim()
+	if err != nil {
+		return err.Error(), false
+	}
+	source := f.Config.SourceDuration
+	if end <= 0 {
+		end = source
+	}
+	if source <= 0 || (start == 0 && end == source) {
+		return "", true
+	}
+	return fmt.Sprintf("Keeps %s of %s", models.FormatDuration(end-start), models.FormatDuration(source)), true
+}
+
+// verticalVideoUnsupportedMsg explains why vertical video cannot be turned on
+const verticalVideoUnsupportedMsg = "Vertical video needs both the webcam and the screen"
+
+// clearVerticalVideoError clears the vertical video error once both sources
+// are recorded
+func (f *RecordingForm) clearVerticalVideoError() {
+	if f.State.ErrorMsg == verticalVideoUnsupportedMsg && f.canEnableVerticalVideo() {
+		f.State.ErrorMsg = ""
+	}
+}
+
+// VerticalVideoEnabled returns true if a vertical video will be created. The
+// toggle keeps its value while the webcam or screen is off, so it comes back
+// when both are recorded again.
+func (f *RecordingForm) VerticalVideoEnabled() bool {
+	return f.State.VerticalVideo && f.canEnableVerticalVideo()
+}
+
+// webcamOnlyUnsupportedMsg explains why the webcam-only video cannot be
+// turned on
+const webcamOnlyUnsupportedMsg = "Webcam-only video needs the webcam"
+
+// WebcamOnlyEnabled returns true if a square video of the webcam alone will
+// be created. Like vertical video, the toggle keeps its value while the
+// webcam is off.
+func (f *RecordingForm) WebcamOnlyEnabled() bool {
+	return f.State.WebcamOnly && f.State.RecordWebcam
 }
 
 func (f *RecordingForm) isBottomLogoGif() bool {
@@ -821,6 +1361,20 @@ func (f *RecordingForm) View() string {
 	rows = append(rows, metadataRow)
 	rows = append(rows, "")
 
+	// Applied recording template (not a field, ctrl+t works anywhere)
+	if f.canApplyTemplate() {
+		name := lipgloss.NewStyle().Foreground(ColorGray).Render("(none)")
+		if t := f.AppliedTemplate(); t != nil {
+			name = lipgloss.NewStyle().Foreground(ColorOrange).Bold(true).Render(t.Name)
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+			labelStyle.Render("Template:"),
+			"  ",
+			name,
+			lipgloss.NewStyle().Foreground(ColorGray).Italic(true).Render("  ctrl+t: apply next"),
+		))
+	}
+
 	// Title field
 	f.fieldLinePositions[FormFieldTitle] = len(rows)
 	titleLabel := labelStyle.Render("Title:")
@@ -895,6 +1449,10 @@ func (f *RecordingForm) View() string {
 		"  ",
 		lipgloss.JoinHorizontal(lipgloss.Center, topicOptions...),
 	))
+	if f.GetSelectedTopic().Sensitive {
+		reminderStyle := lipgloss.NewStyle().Foreground(ColorOrange).Italic(true)
+		rows = append(rows, strings.Repeat(" ", 18)+reminderStyle.Render("🔒 Sensitive topic: uploads default to private"))
+	}
 
 	// Presenter field
 	f.fieldLinePositions[FormFieldPresenter] = len(rows)
@@ -933,6 +1491,20 @@ func (f *RecordingForm) View() string {
 		f.renderToggle(f.State.RecordAudio, f.State.FocusedField == FormFieldRecordAudio),
 	))
 
+	// Microphone selector
+	if !f.shouldSkipField(FormFieldAudioDevice) {
+		f.fieldLinePositions[FormFieldAudioDevice] = len(rows)
+		deviceLabel := labelStyle.Render("Microphone:")
+		if f.State.FocusedField == FormFieldAudioDevice {
+			deviceLabel = focusedLabelStyle.Render("Microphone:")
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+			deviceLabel,
+			"  ",
+			f.renderCycleSelector(f.audioDeviceLabel(), f.State.FocusedField == FormFieldAudioDevice),
+		))
+	}
+
 	// Webcam toggle
 	f.fieldLinePositions[FormFieldRecordWebcam] = len(rows)
 	webcamLabel := labelStyle.Render("Record Webcam:")
@@ -945,6 +1517,23 @@ func (f *RecordingForm) View() string {
 		f.renderToggle(f.State.RecordWebcam, f.State.FocusedField == FormFieldRecordWebcam),
 	))
 
+	// Webcam selector
+	if !f.shouldSkipField(FormFieldWebcamDevice) {
+		f.fieldLinePositions[FormFieldWebcamDevice] = len(rows)
+		cameraLabel := labelStyle.Render("Camera:")
+		hint := ""
+		if f.State.FocusedField == FormFieldWebcamDevice {
+			cameraLabel = focusedLabelStyle.Render("Camera:")
+			hint = lipgloss.NewStyle().Foreground(ColorGray).Italic(true).Render("  p: preview")
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+			cameraLabel,
+			"  ",
+			f.renderCycleSelector(f.webcamDeviceLabel(), f.State.FocusedField == FormFieldWebcamDevice),
+			hint,
+		))
+	}
+
 	// Screen toggle
 	f.fieldLinePositions[FormFieldRecordScreen] = len(rows)
 	screenLabel := labelStyle.Render("Record Screen:")
@@ -971,6 +1560,20 @@ func (f *RecordingForm) View() string {
 		))
 	}
 
+	// Maximum duration selector
+	if !f.shouldSkipField(FormFieldMaxDuration) {
+		f.fieldLinePositions[FormFieldMaxDuration] = len(rows)
+		limitLabel := labelStyle.Render("Max Duration:")
+		if f.State.FocusedField == FormFieldMaxDuration {
+			limitLabel = focusedLabelStyle.Render("Max Duration:")
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+			limitLabel,
+			"  ",
+			f.renderCycleSelector(maxDurationLabel(f.State.MaxDuration), f.State.FocusedField == FormFieldMaxDuration),
+		))
+	}
+
 	// Output Options section
 	rows = append(rows, "")
 	rows = append(rows, dividerStyle.Render(strings.Repeat("─", 62)))
@@ -981,19 +1584,169 @@ func (f *RecordingForm) View() string {
 	rows = append(rows, outputRow)
 	rows = append(rows, "")
 
+	// Webcam-only video toggle
+	f.fieldLinePositions[FormFieldWebcamOnly] = len(rows)
+	webcamOnlyLabel := labelStyle.Render("Webcam Only:")
+	if f.State.FocusedField == FormFieldWebcamOnly {
+		webcamOnlyLabel = focusedLabelStyle.Render("Webcam Only:")
+	}
+	webcamOnlyDisabled := ""
+	if !f.State.RecordWebcam {
+		webcamOnlyDisabled = "(requires webcam)"
+	}
+	rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+		webcamOnlyLabel,
+		"  ",
+		f.renderToggleWithDisabled(f.State.WebcamOnly, f.State.FocusedField == FormFieldWebcamOnly, webcamOnlyDisabled),
+	))
+
 	// Vertical Video toggle
 	f.fieldLinePositions[FormFieldVerticalVideo] = len(rows)
 	verticalLabel := labelStyle.Render("Vertical Video:")
 	if f.State.FocusedField == FormFieldVerticalVideo {
 		verticalLabel = focusedLabelStyle.Render("Vertical Video:")
 	}
-	verticalDisabled := !f.canEnableVerticalVideo()
+	verticalDisabled := ""
+	if !f.canEnableVerticalVideo() {
+		verticalDisabled = "(requires webcam and screen)"
+	}
 	rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
 		verticalLabel,
 		"  ",
 		f.renderToggleWithDisabled(f.State.VerticalVideo, f.State.FocusedField == FormFieldVerticalVideo, verticalDisabled),
 	))
 
+	// Webcam placement on the vertical video (only when one is made)
+	if f.VerticalVideoEnabled() {
+		for _, sel := range []struct {
+			field RecordingFormField
+			label string
+			value string
+		}{
+			{FormFieldVerticalWebcamPosition, "Vertical Webcam:", config.VerticalWebcamPositionLabels[f.GetVerticalWebcamPosition()]},
+			{FormFieldVerticalWebcamSize, "Vertical Size:", config.VerticalWebcamSizeLabels[f.GetVerticalWebcamSize()]},
+		} {
+			f.fieldLinePositions[sel.field] = len(rows)
+			selLabel := labelStyle.Render(sel.label)
+			if f.State.FocusedField == sel.field {
+				selLabel = focusedLabelStyle.Render(sel.label)
+			}
+			rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+				selLabel,
+				"  ",
+				f.renderCycleSelector(sel.value, f.State.FocusedField == sel.field),
+			))
+		}
+	}
+
+	// Trim of an existing recording
+	if !f.shouldSkipField(FormFieldTrimStart) {
+		for _, trim := range []struct {
+			field RecordingFormField
+			label string
+			input textinput.Model
+		}{
+			{FormFieldTrimStart, "Trim Start:", f.State.TrimStartInput},
+			{FormFieldTrimEnd, "Trim End:", f.State.TrimEndInput},
+		} {
+			f.fieldLinePositions[trim.field] = len(rows)
+			trimLabel := labelStyle.Render(trim.label)
+			if f.State.FocusedField == trim.field {
+				trimLabel = focusedLabelStyle.Render(trim.label)
+				if f.State.InputMode {
+					trimLabel = focusedLabelStyle.Render("» " + trim.label)
+				}
+			}
+			rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+				trimLabel,
+				"  ",
+				trim.input.View(),
+			))
+		}
+		if summary, ok := f.trimSummary(); !ok {
+			warningStyle := lipgloss.NewStyle().Foreground(ColorRed).Italic(true)
+			rows = append(rows, strings.Repeat(" ", 18)+warningStyle.Render("⚠ "+summary))
+		} else if summary != "" {
+			hintStyle := lipgloss.NewStyle().Foreground(ColorGray).Italic(true)
+			rows = append(rows, strings.Repeat(" ", 18)+hintStyle.Render(summary))
+		}
+	}
+
+	// Output resolution selector
+	f.fieldLinePositions[FormFieldOutputResolution] = len(rows)
+	resolutionLabel := labelStyle.Render("Resolution:")
+	if f.State.FocusedField == FormFieldOutputResolution {
+		resolutionLabel = focusedLabelStyle.Render("Resolution:")
+	}
+	rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+		resolutionLabel,
+		"  ",
+		f.renderResolutionSelector(f.State.FocusedField == FormFieldOutputResolution),
+	))
+	if w, h := f.sourceResolution(); f.resolutionError() != "" {
+		warningStyle := lipgloss.NewStyle().Foreground(ColorRed).Italic(true)
+		rows = append(rows, strings.Repeat(" ", 18)+warningStyle.Render(fmt.Sprintf("⚠ Larger than the %dx%d screen, videos are not upscaled", w, h)))
+	}
+
+	// Output frame rate selector
+	f.fieldLinePositions[FormFieldFrameRate] = len(rows)
+	frameRateLabel := labelStyle.Render("Frame Rate:")
+	if f.State.FocusedField == FormFieldFrameRate {
+		frameRateLabel = focusedLabelStyle.Render("Frame Rate:")
+	}
+	rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+		frameRateLabel,
+		"  ",
+		f.renderCycleSelector(fmt.Sprintf("%d fps", f.GetFrameRate()), f.State.FocusedField == FormFieldFrameRate),
+	))
+
+	// Landscape webcam overlay corner and size
+	f.fieldLinePositions[FormFieldPiPCorner] = len(rows)
+	pipCornerLabel := labelStyle.Render("Webcam Corner:")
+	if f.State.FocusedField == FormFieldPiPCorner {
+		pipCornerLabel = focusedLabelStyle.Render("Webcam Corner:")
+	}
+	rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+		pipCornerLabel,
+		"  ",
+		f.renderCycleSelector(config.PiPCornerLabels[config.PiPCorners[f.State.SelectedPiPCornerIdx]], f.State.FocusedField == FormFieldPiPCorner),
+	))
+
+	f.fieldLinePositions[FormFieldPiPSize] = len(rows)
+	pipSizeLabel := labelStyle.Render("Webcam Size:")
+	if f.State.FocusedField == FormFieldPiPSize {
+		pipSizeLabel = focusedLabelStyle.Render("Webcam Size:")
+	}
+	rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+		pipSizeLabel,
+		"  ",
+		f.renderCycleSelector(config.PiPSizeLabels[config.PiPSizes[f.State.SelectedPiPSizeIdx]], f.State.FocusedField == FormFieldPiPSize),
+	))
+
+	// Filter presets (only when the library has any)
+	if len(f.State.FilterPresets) > 0 {
+		f.fieldLinePositions[FormFieldFilterPresets] = len(rows)
+		filtersLabel := labelStyle.Render("Filter Presets:")
+		if f.State.FocusedField == FormFieldFilterPresets {
+			filtersLabel = focusedLabelStyle.Render("Filter Presets:")
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+			filtersLabel,
+			"  ",
+			f.renderFilterPresetSelector(f.State.FocusedField == FormFieldFilterPresets),
+		))
+		hintStyle := lipgloss.NewStyle().Foreground(ColorGray).Italic(true).MarginLeft(18)
+		if attached := f.GetFilterPresets(); len(attached) > 0 {
+			names := make([]string, len(attached))
+			for i, p := range attached {
+				names[i] = p.Name
+			}
+			rows = append(rows, hintStyle.Render("Attached: "+strings.Join(names, ", ")))
+		} else if f.State.FocusedField == FormFieldFilterPresets {
+			rows = append(rows, hintStyle.Render("space: attach/detach • ←/→: browse presets"))
+		}
+	}
+
 	// Add Logos toggle
 	f.fieldLinePositions[FormFieldAddLogos] = len(rows)
 	logosLabel := labelStyle.Render("Add Logos:")
@@ -1165,6 +1918,10 @@ func (f *RecordingForm) View() string {
 		// Check if scrolling is needed
 		if totalLines > f.viewport.Height {
 			f.viewport.SetContent(content)
+			if f.scrollOnRender {
+				f.scrollOnRender = false
+				f.scrollToFocusedField()
+			}
 
 			// Build output with scroll indicators
 			var output strings.Builder
@@ -1224,10 +1981,10 @@ func (f *RecordingForm) renderToggle(value bool, focused bool) string {
 	return yesStyle.Render("Yes") + " " + noStyle.Render("No")
 }
 
-func (f *RecordingForm) renderToggleWithDisabled(value bool, focused bool, disabled bool) string {
-	if disabled {
+func (f *RecordingForm) renderToggleWithDisabled(value bool, focused bool, disabledReason string) string {
+	if disabledReason != "" {
 		disabledStyle := lipgloss.NewStyle().Foreground(ColorGray).Italic(true)
-		return disabledStyle.Render("(requires webcam or screen)")
+		return disabledStyle.Render(disabledReason)
 	}
 	return f.renderToggle(value, focused)
 }
@@ -1329,6 +2086,53 @@ func (f *RecordingForm) renderGifLoopSelector(focused bool) string {
 	return style.Render(arrows + string(mode) + suffix)
 }
 
+func (f *RecordingForm) renderResolutionSelector(focused bool) string {
+	style := lipgloss.NewStyle()
+	if focused {
+		style = style.Foreground(ColorOrange).Bold(true)
+	} else {
+		style = style.Foreground(ColorWhite)
+	}
+
+	resolution := config.OutputResolutions[f.State.SelectedResolutionIdx]
+
+	arrows := ""
+	if focused {
+		arrows = "◀ "
+	}
+	suffix := ""
+	if focused {
+		suffix = " ▶"
+	}
+
+	return style.Render(arrows + config.OutputResolutionLabels[resolution] + suffix)
+}
+
+// renderCycleSelector renders a value cycled with left/right, with arrows
+// when focused
+func (f *RecordingForm) renderCycleSelector(label string, focused bool) string {
+	if !focused {
+		return lipgloss.NewStyle().Foreground(ColorWhite).Render(label)
+	}
+	return lipgloss.NewStyle().Foreground(ColorOrange).Bold(true).Render("◀ " + label + " ▶")
+}
+
+// renderFilterPresetSelector renders the preset under the cursor with its
+// stage and whether it is attached
+func (f *RecordingForm) renderFilterPresetSelector(focused bool) string {
+	idx := f.State.FilterPresetCursor
+	if idx < 0 || idx >= len(f.State.FilterPresets) {
+		idx = 0
+	}
+	preset := f.State.FilterPresets[idx]
+	check := "[ ] "
+	if f.State.AttachedFilterPresets[preset.Name] {
+		check = "[✓] "
+	}
+	label := fmt.Sprintf("%s%s (%s)", check, preset.Name, models.FilterStageLabels[preset.Stage])
+	return f.renderCycleSelector(label, focused)
+}
+
 func (f *RecordingForm) renderConfirmButtons() string {
 	hasSource := f.State.RecordAudio || f.State.RecordWebcam || f.State.RecordScreen
 	hasTitle := strings.TrimSpace(f.State.TitleInput.Value()) != ""
@@ -1415,9 +2219,29 @@ func (f *RecordingForm) renderConfirmButtons() string {
 		return lipgloss.JoinVertical(lipgloss.Center, buttonRow, warningText)
 	}
 
+	// The recording can start, but may not make what the user expects
+	if notice := sourcesNotice(f.State.RecordAudio, f.State.RecordWebcam, f.State.RecordScreen); notice != "" {
+		noticeStyle := lipgloss.NewStyle().
+			Foreground(ColorOrange).
+			Italic(true).
+			Align(lipgloss.Center).
+			Width(62)
+		return lipgloss.JoinVertical(lipgloss.Center, buttonRow, noticeStyle.Render(notice))
+	}
+
 	return buttonRow
 }
 
+// sourcesNotice warns about recording sources that make no video, or returns
+// an empty string. Without the screen and webcam there is nothing to merge or
+// upload to YouTube, only the (normalized) audio is kept.
+func sourcesNotice(audio, webcam, screen bool) string {
+	if audio && !webcam && !screen {
+		return "Audio only: no video will be made, only the audio. Play it with a in the recording history."
+	}
+	return ""
+}
+
 // GetTitle returns the current title value
 func (f *RecordingForm) GetTitle() string {
 	return strings.TrimSpace(f.State.TitleInput.Value())
@@ -1446,6 +2270,39 @@ func (f *RecordingForm) GetSelectedTopic() models.Topic {
 	return models.Topic{}
 }
 
+// GetFilterPresets returns the attached filter presets in library order
+func (f *RecordingForm) GetFilterPresets() []models.FilterPreset {
+	var presets []models.FilterPreset
+	for _, p := range f.State.FilterPresets {
+		if f.State.AttachedFilterPresets[p.Name] {
+			presets = append(presets, p)
+		}
+	}
+	return presets
+}
+
+// SetFilterPresets attaches the given presets. Presets that are no longer in
+// the library are added to the form's list so they are kept when saving.
+func (f *RecordingForm) SetFilterPresets(presets []models.FilterPreset) {
+	f.State.FilterPresets = append([]models.FilterPreset(nil), f.State.FilterPresets...)
+	f.State.AttachedFilterPresets = make(map[string]bool)
+	for _, p := range presets {
+		found := false
+		for i, lib := range f.State.FilterPresets {
+			if lib.Name == p.Name {
+				// Keep the recording's copy, which is what it was processed with
+				f.State.FilterPresets[i] = p
+				found = true
+				break
+			}
+		}
+		if !found {
+			f.State.FilterPresets = append(f.State.FilterPresets, p)
+		}
+		f.State.AttachedFilterPresets[p.Name] = true
+	}
+}
+
 // SetTitle sets the title value
 func (f *RecordingForm) SetTitle(title string) {
 	f.State.TitleInput.SetValue(title)
@@ -1463,6 +2320,101 @@ func (f *RecordingForm) SetPresenter(presenter string) {
 	f.State.PresenterInput.SetValue(presenter)
 }
 
+// GetAudioDevice returns the name of the selected microphone, or "" for the
+// default device
+func (f *RecordingForm) GetAudioDevice() string {
+	idx := f.State.SelectedAudioDevice - 1
+	if idx < 0 || idx >= len(f.Config.AudioDevices) {
+		return ""
+	}
+	return f.Config.AudioDevices[idx].Name
+}
+
+// SetAudioDevice selects a microphone by name. Devices that are not
+// available select the default device.
+func (f *RecordingForm) SetAudioDevice(name string) {
+	f.State.SelectedAudioDevice = 0
+	for i, d := range f.Config.AudioDevices {
+		if d.Name == name {
+			f.State.SelectedAudioDevice = i + 1
+			return
+		}
+	}
+}
+
+// GetWebcamDevice returns the selected webcam, e.g. video0, or "" to record
+// from the first detected webcam
+func (f *RecordingForm) GetWebcamDevice() string {
+	idx := f.State.SelectedWebcamDevice - 1
+	if idx < 0 || idx >= len(f.Config.WebcamDevices) {
+		return ""
+	}
+	return f.Config.WebcamDevices[idx].Name
+}
+
+// SetWebcamDevice selects a webcam by name. Webcams that are not available
+// select the detected webcam.
+func (f *RecordingForm) SetWebcamDevice(name string) {
+	f.State.SelectedWebcamDevice = 0
+	for i, d := range f.Config.WebcamDevices {
+		if d.Name == name {
+			f.State.SelectedWebcamDevice = i + 1
+			return
+		}
+	}
+}
+
+// maxDurationChoices are the recording limits in minutes offered by the
+// form, 0 being unlimited
+var maxDurationChoices = []int{0, 1, 2, 3, 5, 10, 15, 20, 30, 45, 60, 90, 120}
+
+// nextMaxDuration returns the limit after (dir 1) or before (dir -1) the given
+// one, wrapping around. Limits that are not a choice, e.g. cloned from an
+// older recording, move to the nearest choice in that direction.
+func nextMaxDuration(minutes, dir int) int {
+	n := len(maxDurationChoices)
+	if dir > 0 {
+		for _, choice := range maxDurationChoices {
+			if choice > minutes {
+				return choice
+			}
+		}
+		return maxDurationChoices[0]
+	}
+	for i := n - 1; i >= 0; i-- {
+		if maxDurationChoices[i] < minutes {
+			return maxDurationChoices[i]
+		}
+	}
+	return maxDurationChoices[n-1]
+}
+
+// maxDurationLabel returns the display name of a recording limit
+func maxDurationLabel(minutes int) string {
+	if minutes <= 0 {
+		return "Unlimited"
+	}
+	return fmt.Sprintf("%d min", minutes)
+}
+
+// webcamDeviceLabel returns the display name of the selected webcam
+func (f *RecordingForm) webcamDeviceLabel() string {
+	idx := f.State.SelectedWebcamDevice - 1
+	if idx < 0 || idx >= len(f.Config.WebcamDevices) {
+		return "Auto-detect"
+	}
+	return truncateStr(f.Config.WebcamDevices[idx].DisplayName(), 40)
+}
+
+// audioDeviceLabel returns the display name of the selected microphone
+func (f *RecordingForm) audioDeviceLabel() string {
+	idx := f.State.SelectedAudioDevice - 1
+	if idx < 0 || idx >= len(f.Config.AudioDevices) {
+		return "Default"
+	}
+	return truncateStr(f.Config.AudioDevices[idx].Label(), 40)
+}
+
 // SetSelectedTopic sets the selected topic by name
 func (f *RecordingForm) SetSelectedTopic(topicName string) {
 	for i, t := range f.Config.Topics {
@@ -0,0 +1,82 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// isQuit reports whether cmd quits the program
+func isQuit(cmd tea.Cmd) bool {
+	if cmd == nil {
+		return false
+	}
+	_, ok := cmd().(tea.QuitMsg)
+	return ok
+}
+
+func TestRecordingQuit_AsksWhileRecording(t *testing.T) {
+	m := AppModel{
+		screen:     ScreenRecording,
+		status:     models.RecordingStatus{IsRecording: true},
+		processing: NewProcessingState(),
+		width:      120,
+		height:     40,
+	}
+
+	for _, k := range []tea.KeyMsg{bulkKey("q"), {Type: tea.KeyCtrlC}} {
+		model, cmd := m.handleKeyMsg(k)
+		got := model.(AppModel)
+		if !got.quitConfirm || isQuit(cmd) {
+			t.Fatalf("%s quit straight away, want a confirmation while recording", k)
+		}
+		if !strings.Contains(got.View(), "Quit while recording?") {
+			t.Errorf("expected the confirmation to be shown after %s", k)
+		}
+	}
+
+	m.quitConfirm = true
+	model, _ := m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEsc})
+	if got := model.(AppModel); got.quitConfirm || got.screen != ScreenRecording {
+		t.Error("expected esc to keep recording")
+	}
+
+	if _, cmd := m.handleKeyMsg(bulkKey("y")); !isQuit(cmd) {
+		t.Error("expected y to quit anyway")
+	}
+
+	// Paused recordings are asked about too
+	paused := AppModel{screen: ScreenRecording, isPaused: true}
+	if model, cmd := paused.handleKeyMsg(bulkKey("q")); !model.(AppModel).quitConfirm || isQuit(cmd) {
+		t.Error("expected a confirmation while paused")
+	}
+
+	idle := AppModel{screen: ScreenRecording}
+	if _, cmd := idle.handleKeyMsg(bulkKey("q")); !isQuit(cmd) {
+		t.Error("expected q to quit when not recording")
+	}
+}
+
+func TestRecordingQuit_StopsAndProcessesFirst(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+
+	m := AppModel{
+		screen:      ScreenRecording,
+		status:      models.RecordingStatus{IsRecording: true},
+		processing:  NewProcessingState(),
+		quitConfirm: true,
+	}
+	model, cmd := m.handleKeyMsg(bulkKey("s"))
+	m = model.(AppModel)
+	if m.state != stateProcessing || cmd == nil || m.quitConfirm {
+		t.Fatalf("state %v, want the recording stopped and processed", m.state)
+	}
+
+	_, cmd = m.Update(processingCompleteMsg{})
+	if !isQuit(cmd) {
+		t.Error("expected to quit once the recording is processed")
+	}
+}
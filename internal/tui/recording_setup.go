@@ -1,16 +1,20 @@
 package tui
 
 import (
+	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/audio"
 	"github.com/kartoza/kartoza-screencaster/internal/config"
 	"github.com/kartoza/kartoza-screencaster/internal/models"
 	"github.com/kartoza/kartoza-screencaster/internal/monitor"
+	"github.com/kartoza/kartoza-screencaster/internal/webcam"
 )
 
 // RecordingSetupModel handles the recording setup form
@@ -27,6 +31,21 @@ type RecordingSetupModel struct {
 
 	// Monitors for screen recording
 	monitors []models.Monitor
+
+	// Live view of the selected webcam (p on the camera field)
+	preview     *exec.Cmd
+	previewDone chan struct{} // Closed when the preview window is closed
+
+	// Picker of recent recordings to clone the settings of (ctrl+r)
+	cloning      bool
+	cloneLoading bool
+	cloneSources []models.RecordingInfo
+	cloneCursor  int
+	cloneErr     string
+
+	// Draft of the form saved to disk, to restore it after a restart
+	draftDirty bool // Changed since the draft was last saved
+	draftSaved bool // A draft of this form is on disk
 }
 
 // NewRecordingSetupModel creates a new recording setup model
@@ -38,8 +57,10 @@ func NewRecordingSetupModel() *RecordingSetupModel {
 		topics = models.DefaultTopics()
 	}
 
-	// Get available monitors
+	// Get available monitors, microphones and webcams
 	monitors, _ := monitor.ListMonitors()
+	audioDevices, _ := audio.ListSources()
+	webcamDevices, _ := webcam.ListCameras()
 
 	m := &RecordingSetupModel{
 		config:        cfg,
@@ -52,10 +73,13 @@ func NewRecordingSetupModel() *RecordingSetupModel {
 
 	// Create the shared form
 	m.form = NewRecordingForm(&RecordingFormConfig{
-		Mode:     FormModeNewRecording,
-		Topics:   topics,
-		Monitors: monitors,
-		Logos:    m.availableLogos[1:], // Skip the "(none)" entry, form handles that
+		Mode:          FormModeNewRecording,
+		Topics:        topics,
+		Monitors:      monitors,
+		AudioDevices:  audioDevices,
+		WebcamDevices: webcamDevices,
+		Logos:         m.availableLogos[1:], // Skip the "(none)" entry, form handles that
+		Templates:     cfg.RecordingTemplates,
 		OnConfirm: func() {
 			// Will be handled by the parent via message
 		},
@@ -76,8 +100,10 @@ func NewRecordingSetupModel() *RecordingSetupModel {
 	if presets.Topic != "" {
 		m.form.SetSelectedTopic(presets.Topic)
 	}
+	m.form.SetAudioDevice(presets.AudioDevice)
+	m.form.SetWebcamDevice(presets.WebcamDevice)
 
-	// Focus the title field
+	// Focus the configured initial field
 	m.form.Focus()
 
 	return m
@@ -116,18 +142,23 @@ func (m *RecordingSetupModel) setLogoIndicesFromConfig() {
 	if m.form == nil {
 		return
 	}
+	m.setLogoIndices(m.config.LastUsedLogos)
+}
 
+// setLogoIndices selects the logos, title color and GIF loop mode of a logo
+// selection. Logos that are not in the logo directory are set to (none).
+func (m *RecordingSetupModel) setLogoIndices(sel config.LogoSelection) {
 	// Find indices for current selections
-	leftIdx := m.findLogoIndex(m.config.LastUsedLogos.LeftLogo)
-	rightIdx := m.findLogoIndex(m.config.LastUsedLogos.RightLogo)
-	bottomIdx := m.findLogoIndex(m.config.LastUsedLogos.BottomLogo)
+	leftIdx := m.findLogoIndex(sel.LeftLogo)
+	rightIdx := m.findLogoIndex(sel.RightLogo)
+	bottomIdx := m.findLogoIndex(sel.BottomLogo)
 
 	m.form.State.SelectedLeftIdx = leftIdx
 	m.form.State.SelectedRightIdx = rightIdx
 	m.form.State.SelectedBottomIdx = bottomIdx
 
 	// Set color index
-	titleColor := m.config.LastUsedLogos.TitleColor
+	titleColor := sel.TitleColor
 	if titleColor == "" {
 		titleColor = config.DefaultTitleColor
 	}
@@ -139,7 +170,7 @@ func (m *RecordingSetupModel) setLogoIndicesFromConfig() {
 	}
 
 	// Set GIF loop mode index
-	gifLoopMode := m.config.LastUsedLogos.GifLoopMode
+	gifLoopMode := sel.GifLoopMode
 	if gifLoopMode == "" {
 		gifLoopMode = config.GifLoopContinuous
 	}
@@ -191,7 +222,22 @@ func (m *RecordingSetupModel) Update(msg tea.Msg) (*RecordingSetupModel, tea.Cmd
 		}
 		m.form.SetSize(msg.Width, contentHeight)
 
+	case cloneSourcesLoadedMsg:
+		m.handleCloneSourcesLoaded(msg)
+
 	case tea.KeyMsg:
+		if m.cloning {
+			m.updateClonePicker(msg)
+			return m, nil
+		}
+		if msg.String() == "ctrl+r" {
+			return m, m.openClonePicker()
+		}
+		if msg.String() == "p" && m.form.State.FocusedField == FormFieldWebcamDevice && !m.form.State.InputMode {
+			m.togglePreview()
+			return m, nil
+		}
+
 		// Check for confirm/cancel actions
 		if m.form.State.FocusedField == FormFieldConfirm && !m.form.State.InputMode {
 			switch msg.String() {
@@ -199,10 +245,14 @@ func (m *RecordingSetupModel) Update(msg tea.Msg) (*RecordingSetupModel, tea.Cmd
 				if m.form.State.ConfirmSelected {
 					// Go Live selected
 					if m.Validate() {
+						// The webcam cannot be recorded while it is previewed
+						m.StopPreview()
 						return m, func() tea.Msg { return recordingSetupCompleteMsg{} }
 					}
 				} else {
 					// Cancel selected
+					m.StopPreview()
+					m.saveDraft()
 					return m, func() tea.Msg { return backToMenuMsg{} }
 				}
 				return m, nil
@@ -217,6 +267,51 @@ func (m *RecordingSetupModel) Update(msg tea.Msg) (*RecordingSetupModel, tea.Cmd
 	return m, cmd
 }
 
+// togglePreview opens a live view of the selected webcam, or closes it
+func (m *RecordingSetupModel) togglePreview() {
+	if m.isPreviewing() {
+		m.StopPreview()
+		m.form.State.SuccessMsg = ""
+		return
+	}
+
+	cmd, err := webcam.Preview(m.form.GetWebcamDevice())
+	if err != nil {
+		m.form.State.ErrorMsg = err.Error()
+		return
+	}
+	done := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		close(done)
+	}()
+	m.preview, m.previewDone = cmd, done
+	m.form.State.ErrorMsg = ""
+	m.form.State.SuccessMsg = fmt.Sprintf("Previewing %s, press p again to close it", m.form.webcamDeviceLabel())
+}
+
+// isPreviewing returns true while the webcam preview window is open
+func (m *RecordingSetupModel) isPreviewing() bool {
+	if m.preview == nil {
+		return false
+	}
+	select {
+	case <-m.previewDone:
+		return false
+	default:
+		return true
+	}
+}
+
+// StopPreview closes the webcam preview window, if open
+func (m *RecordingSetupModel) StopPreview() {
+	if m.isPreviewing() {
+		_ = m.preview.Process.Kill()
+		<-m.previewDone
+	}
+	m.preview, m.previewDone = nil, nil
+}
+
 func (m *RecordingSetupModel) Validate() bool {
 	// Title is required
 	if m.form.GetTitle() == "" {
@@ -226,6 +321,11 @@ func (m *RecordingSetupModel) Validate() bool {
 	if !m.form.State.RecordAudio && !m.form.State.RecordWebcam && !m.form.State.RecordScreen {
 		return false
 	}
+	// The output cannot be larger than the screen
+	if err := m.form.resolutionError(); err != "" {
+		m.form.State.ErrorMsg = err
+		return false
+	}
 	return true
 }
 
@@ -242,13 +342,53 @@ func (m *RecordingSetupModel) GetMetadata() models.RecordingMetadata {
 		Title:       m.form.GetTitle(),
 		Description: m.form.GetDescription(),
 		Topic:       topic,
-		Presenter:   m.config.DefaultPresenter,
+		Presenter:   m.form.GetPresenter(),
 	}
 	metadata.GenerateFolderName()
 
+	// Upload settings of the applied template, for the YouTube upload form
+	if t := m.form.AppliedTemplate(); t != nil && (len(t.Tags) > 0 || t.Privacy != "" || t.Playlist != "") {
+		metadata.UploadDefaults = &models.UploadDefaults{
+			Template: t.Name,
+			Tags:     t.Tags,
+			Privacy:  t.Privacy,
+			Playlist: t.Playlist,
+		}
+	}
+
 	return metadata
 }
 
+// GetRecordingSettings returns the settings of the form stored in
+// recording.json
+func (m *RecordingSetupModel) GetRecordingSettings() models.RecordingSettings {
+	logos := m.GetLogoSelection()
+	return models.RecordingSettings{
+		ScreenEnabled:     m.form.State.RecordScreen,
+		AudioEnabled:      m.form.State.RecordAudio,
+		WebcamEnabled:     m.form.State.RecordWebcam,
+		AudioDevice:       m.form.GetAudioDevice(),
+		WebcamDevice:      m.form.GetWebcamDevice(),
+		MaxDuration:       m.form.State.MaxDuration,
+		VerticalEnabled:   m.form.VerticalVideoEnabled(),
+		WebcamOnlyEnabled: m.form.WebcamOnlyEnabled(),
+		LogosEnabled:      m.form.State.AddLogos,
+		OutputResolution:  string(m.GetOutputResolution()),
+		FrameRate:         m.form.GetFrameRate(),
+		PiPCorner:         string(m.GetPiPCorner()),
+		PiPSize:           string(m.GetPiPSize()),
+		FilterPresets:     m.GetFilterPresets(),
+		LeftLogo:          logos.LeftLogo,
+		RightLogo:         logos.RightLogo,
+		BottomLogo:        logos.BottomLogo,
+		TitleColor:        logos.TitleColor,
+		GifLoopMode:       string(logos.GifLoopMode),
+
+		VerticalWebcamPosition: string(m.form.GetVerticalWebcamPosition()),
+		VerticalWebcamSize:     string(m.form.GetVerticalWebcamSize()),
+	}
+}
+
 func (m *RecordingSetupModel) GetRecordingOptions() models.RecordingOptions {
 	monitorName := ""
 	if m.form.State.RecordScreen && m.form.State.SelectedMonitor >= 0 && m.form.State.SelectedMonitor < len(m.monitors) {
@@ -257,10 +397,12 @@ func (m *RecordingSetupModel) GetRecordingOptions() models.RecordingOptions {
 
 	return models.RecordingOptions{
 		Monitor:        monitorName,
+		AudioDevice:    m.form.GetAudioDevice(),
+		WebcamDevice:   m.form.GetWebcamDevice(),
 		NoAudio:        !m.form.State.RecordAudio,
 		NoWebcam:       !m.form.State.RecordWebcam,
 		NoScreen:       !m.form.State.RecordScreen,
-		CreateVertical: m.form.State.VerticalVideo && m.form.State.RecordWebcam && m.form.State.RecordScreen,
+		CreateVertical: m.form.VerticalVideoEnabled(),
 	}
 }
 
@@ -278,6 +420,26 @@ func (m *RecordingSetupModel) GetLogoSelection() config.LogoSelection {
 	}
 }
 
+// GetOutputResolution returns the selected processing output resolution
+func (m *RecordingSetupModel) GetOutputResolution() config.OutputResolution {
+	return config.OutputResolutions[m.form.State.SelectedResolutionIdx]
+}
+
+// GetPiPCorner returns the selected landscape webcam overlay corner
+func (m *RecordingSetupModel) GetPiPCorner() config.PiPCorner {
+	return config.PiPCorners[m.form.State.SelectedPiPCornerIdx]
+}
+
+// GetPiPSize returns the selected landscape webcam overlay size
+func (m *RecordingSetupModel) GetPiPSize() config.PiPSize {
+	return config.PiPSizes[m.form.State.SelectedPiPSizeIdx]
+}
+
+// GetFilterPresets returns the filter presets attached to the recording
+func (m *RecordingSetupModel) GetFilterPresets() []models.FilterPreset {
+	return m.form.GetFilterPresets()
+}
+
 // SaveLogoSelection saves the current logo selection to config for next time
 func (m *RecordingSetupModel) SaveLogoSelection() error {
 	cfg, err := config.Load()
@@ -291,16 +453,19 @@ func (m *RecordingSetupModel) SaveLogoSelection() error {
 // GetRecordingPresets returns the current recording presets
 func (m *RecordingSetupModel) GetRecordingPresets() config.RecordingPresets {
 	return config.RecordingPresets{
-		RecordAudio:   m.form.State.RecordAudio,
-		RecordWebcam:  m.form.State.RecordWebcam,
-		RecordScreen:  m.form.State.RecordScreen,
-		VerticalVideo: m.form.State.VerticalVideo,
-		AddLogos:      m.form.State.AddLogos,
-		Topic:         m.form.GetSelectedTopic().Name,
+		RecordAudio:     m.form.State.RecordAudio,
+		RecordWebcam:    m.form.State.RecordWebcam,
+		RecordScreen:    m.form.State.RecordScreen,
+		VerticalVideo:   m.form.State.VerticalVideo,
+		WebcamOnlyVideo: m.form.State.WebcamOnly,
+		AddLogos:        m.form.State.AddLogos,
+		Topic:           m.form.GetSelectedTopic().Name,
+		AudioDevice:     m.form.GetAudioDevice(),
+		WebcamDevice:    m.form.GetWebcamDevice(),
 	}
 }
 
-// SaveAllPresets saves all recording presets (toggles, topic, logos) to config
+// SaveAllPresets saves all recording presets (toggles, topic, devices, logos) to config
 // This should be called when starting a recording to remember settings for next time
 func (m *RecordingSetupModel) SaveAllPresets() error {
 	cfg, err := config.Load()
@@ -320,6 +485,9 @@ func (m *RecordingSetupModel) SaveAllPresets() error {
 
 // View renders the recording setup form content (layout is handled by app.go)
 func (m *RecordingSetupModel) View() string {
+	if m.cloning {
+		return m.renderClonePicker()
+	}
 	return m.form.View()
 }
 
@@ -0,0 +1,689 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/templates"
+	"github.com/kartoza/kartoza-screencaster/internal/webcam"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
+)
+
+func TestRecordingSetup_AppliesTemplate(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	cfg, _ := config.Load()
+	cfg.Topics = []models.Topic{{ID: "general", Name: "General"}, {ID: "qgis", Name: "QGIS"}}
+	cfg.RecordingTemplates = []templates.Template{
+		{Name: "QGIS Tips", TitlePattern: "QGIS Tips #{number}", Topic: "QGIS", Description: "Short tips.\n",
+			Tags: []string{"qgis"}, Privacy: "public", Playlist: "Tips"},
+		{Name: "Notes", Description: "Meeting notes"},
+	}
+	if err := config.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewRecordingSetupModel()
+	m.form.State.NumberInput.SetValue("007")
+	if m.form.AppliedTemplate() != nil {
+		t.Fatal("expected no template applied when the form opens")
+	}
+	if !strings.Contains(m.View(), "ctrl+t: apply next") {
+		t.Error("expected the form to offer the templates")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	if got := m.form.GetTitle(); got != "QGIS Tips #7" {
+		t.Errorf("title = %q, want the expanded pattern", got)
+	}
+	if got := m.form.GetSelectedTopic().Name; got != "QGIS" {
+		t.Errorf("topic = %q, want QGIS", got)
+	}
+	metadata := m.GetMetadata()
+	if metadata.Description != "Short tips." {
+		t.Errorf("description = %q", metadata.Description)
+	}
+	d := metadata.UploadDefaults
+	if d == nil || d.Template != "QGIS Tips" || d.Privacy != "public" || d.Playlist != "Tips" || len(d.Tags) != 1 {
+		t.Errorf("UploadDefaults = %+v, want the upload settings of the template", d)
+	}
+
+	// The next template keeps the fields it leaves empty
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	if m.form.GetTitle() != "QGIS Tips #7" || m.form.GetDescription() != "Meeting notes" {
+		t.Errorf("title %q, description %q after the second template", m.form.GetTitle(), m.form.GetDescription())
+	}
+	if m.GetMetadata().UploadDefaults != nil {
+		t.Error("expected no upload settings from a template without any")
+	}
+
+	// Wraps around to the first template
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	if m.form.AppliedTemplate().Name != "QGIS Tips" {
+		t.Errorf("applied template = %q, want QGIS Tips", m.form.AppliedTemplate().Name)
+	}
+}
+
+func TestYouTubeUpload_TemplateDefaults(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	rec := &models.RecordingInfo{}
+	rec.Metadata.Title = "QGIS Tips #7"
+	rec.Metadata.Topic = "QGIS"
+	rec.Metadata.UploadDefaults = &models.UploadDefaults{Tags: []string{"qgis", "gis"}, Privacy: "public", Playlist: "tips"}
+
+	m := NewYouTubeUploadModelWithRecording("", rec)
+	if got := m.tagsInput.Value(); got != "qgis, gis" {
+		t.Errorf("tags = %q, want the template tags", got)
+	}
+	if got := m.privacyOptions[m.selectedPrivacy]; got != youtube.PrivacyPublic {
+		t.Errorf("privacy = %q, want public", got)
+	}
+
+	m.Update(playlistsLoadedMsg{playlists: []youtube.Playlist{{ID: "a", Title: "Sprints"}, {ID: "b", Title: "Tips"}}})
+	if m.selectedPlaylist != 1 {
+		t.Errorf("selectedPlaylist = %d, want the playlist of the template", m.selectedPlaylist)
+	}
+}
+
+func TestOptionsImportTemplates(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	path := filepath.Join(t.TempDir(), "series.yaml")
+	if err := os.WriteFile(path, []byte("name: QGIS Tips\ntopic: QGIS\n---\nname: Notes\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewOptionsModel()
+	m.importTemplates(path)
+	if m.err != nil || len(m.templates) != 2 || m.templates[m.selectedTemplate].Name != "QGIS Tips" {
+		t.Fatalf("templates = %+v, err %v", m.templates, m.err)
+	}
+
+	m.focusedField = OptionsFieldTemplateList
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	if len(m.templates) != 1 || m.templates[0].Name != "Notes" {
+		t.Errorf("templates after delete = %+v", m.templates)
+	}
+	m.save()
+
+	cfg, _ := config.Load()
+	if len(cfg.RecordingTemplates) != 1 || cfg.RecordingTemplates[0].Name != "Notes" {
+		t.Errorf("saved templates = %+v", cfg.RecordingTemplates)
+	}
+
+	m.importTemplates(filepath.Join(t.TempDir(), "missing.yaml"))
+	if m.err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestRecordingSetup_ClonesSettings(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	videosDir := t.TempDir()
+	cfg, _ := config.Load()
+	cfg.OutputDir = videosDir
+	cfg.Topics = []models.Topic{{ID: "general", Name: "General"}, {ID: "qgis", Name: "QGIS"}}
+	if err := config.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	older := &models.RecordingInfo{StartTime: time.Now().Add(-48 * time.Hour)}
+	older.Metadata.Title = "Older"
+	older.Files.FolderPath = filepath.Join(videosDir, "older")
+	newer := &models.RecordingInfo{StartTime: time.Now().Add(-time.Hour)}
+	newer.Metadata = models.RecordingMetadata{Title: "Newer", Topic: "QGIS", Presenter: "Ada"}
+	newer.Settings = models.RecordingSettings{
+		AudioEnabled:     true,
+		ScreenEnabled:    true,
+		OutputResolution: "720p",
+		PiPSize:          "large",
+		FilterPresets:    []models.FilterPreset{{Name: "Denoise", Stage: models.FilterStageVideo, Filter: "hqdn3d"}},
+	}
+	newer.Files.FolderPath = filepath.Join(videosDir, "newer")
+	for _, rec := range []*models.RecordingInfo{older, newer} {
+		if err := os.MkdirAll(rec.Files.FolderPath, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := rec.Save(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m := NewRecordingSetupModel()
+	m.form.SetTitle("Next episode")
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if !m.IsCloning() || cmd == nil {
+		t.Fatal("expected ctrl+r to open the picker and load the recordings")
+	}
+	m.Update(cmd())
+	if len(m.cloneSources) != 2 || m.cloneSources[0].Metadata.Title != "Newer" {
+		t.Fatalf("clone sources = %+v, want the newest first", m.cloneSources)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.IsCloning() {
+		t.Error("expected the picker to close after cloning")
+	}
+	state := m.form.State
+	if !state.RecordAudio || state.RecordWebcam || !state.RecordScreen {
+		t.Errorf("sources = audio %v, webcam %v, screen %v, want those of the recording", state.RecordAudio, state.RecordWebcam, state.RecordScreen)
+	}
+	if m.GetOutputResolution() != config.OutputResolution720p || m.GetPiPSize() != config.PiPSizeLarge {
+		t.Errorf("resolution %q, webcam size %q", m.GetOutputResolution(), m.GetPiPSize())
+	}
+	if presets := m.GetFilterPresets(); len(presets) != 1 || presets[0].Name != "Denoise" {
+		t.Errorf("filter presets = %+v", presets)
+	}
+	metadata := m.GetMetadata()
+	if metadata.Topic != "QGIS" || metadata.Presenter != "Ada" || metadata.Title != "Next episode" {
+		t.Errorf("metadata = %+v, want the topic and presenter cloned and the title kept", metadata)
+	}
+}
+
+func TestRecordingForm_AudioDevice(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	devices := []models.AudioDevice{
+		{Name: "alsa_input.builtin", Description: "Built-in Audio"},
+		{Name: "alsa_input.usb-mic"},
+	}
+	f := NewRecordingForm(&RecordingFormConfig{Mode: FormModeNewRecording, AudioDevices: devices})
+	f.State.RecordAudio = true
+	f.State.FocusedField = FormFieldRecordAudio
+
+	f.nextField()
+	if f.State.FocusedField != FormFieldAudioDevice {
+		t.Fatalf("focused %v after Record Audio, want the microphone", f.State.FocusedField)
+	}
+	if f.GetAudioDevice() != "" || !strings.Contains(f.View(), "Default") {
+		t.Errorf("expected the default device to be selected first, got %q", f.GetAudioDevice())
+	}
+	f.handleLeftRight(-1)
+	if got := f.GetAudioDevice(); got != "alsa_input.usb-mic" {
+		t.Errorf("device = %q after wrapping backwards, want the last device", got)
+	}
+	f.SetAudioDevice("alsa_input.builtin")
+	if !strings.Contains(f.View(), "Built-in Audio") {
+		t.Error("expected the description of the device to be shown")
+	}
+	f.SetAudioDevice("unplugged")
+	if f.GetAudioDevice() != "" {
+		t.Errorf("device = %q, want the default for a device that is gone", f.GetAudioDevice())
+	}
+
+	// Skipped without audio, without devices and when editing
+	f.State.RecordAudio = false
+	f.State.FocusedField = FormFieldRecordAudio
+	f.nextField()
+	if f.State.FocusedField != FormFieldRecordWebcam {
+		t.Errorf("focused %v, want the microphone skipped without audio", f.State.FocusedField)
+	}
+	none := NewRecordingForm(&RecordingFormConfig{Mode: FormModeNewRecording})
+	none.State.RecordAudio = true
+	if !none.shouldSkipField(FormFieldAudioDevice) {
+		t.Error("expected the microphone to be skipped when no devices are listed")
+	}
+	edit := NewRecordingForm(&RecordingFormConfig{Mode: FormModeEditExisting, AudioDevices: devices})
+	edit.State.RecordAudio = true
+	if !edit.shouldSkipField(FormFieldAudioDevice) {
+		t.Error("expected the microphone to be skipped when editing a recording")
+	}
+}
+
+func TestRecordingForm_WebcamDevice(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	devices := []webcam.Device{
+		{Name: "video0", Label: "Integrated Camera"},
+		{Name: "video2", Label: "USB Camera"},
+	}
+	f := NewRecordingForm(&RecordingFormConfig{Mode: FormModeNewRecording, WebcamDevices: devices})
+	f.State.RecordWebcam = true
+	f.State.FocusedField = FormFieldRecordWebcam
+
+	f.nextField()
+	if f.State.FocusedField != FormFieldWebcamDevice {
+		t.Fatalf("focused %v after Record Webcam, want the camera", f.State.FocusedField)
+	}
+	if f.GetWebcamDevice() != "" || !strings.Contains(f.View(), "Auto-detect") {
+		t.Errorf("expected the camera to be detected by default, got %q", f.GetWebcamDevice())
+	}
+	f.handleLeftRight(1)
+	if got := f.GetWebcamDevice(); got != "video0" {
+		t.Errorf("device = %q, want the first camera", got)
+	}
+	f.SetWebcamDevice("video2")
+	if !strings.Contains(f.View(), "USB Camera (video2)") {
+		t.Error("expected the name of the camera to be shown")
+	}
+	f.SetWebcamDevice("video9")
+	if f.GetWebcamDevice() != "" {
+		t.Errorf("device = %q, want auto-detect for a camera that is gone", f.GetWebcamDevice())
+	}
+	f.State.FocusedField = FormFieldRecordScreen
+	f.prevField()
+	if f.State.FocusedField != FormFieldWebcamDevice {
+		t.Errorf("focused %v before Record Screen, want the camera", f.State.FocusedField)
+	}
+
+	// Skipped without the webcam and when no cameras are listed
+	f.State.RecordWebcam = false
+	if !f.shouldSkipField(FormFieldWebcamDevice) {
+		t.Error("expected the camera to be skipped without the webcam")
+	}
+	none := NewRecordingForm(&RecordingFormConfig{Mode: FormModeNewRecording})
+	none.State.RecordWebcam = true
+	if !none.shouldSkipField(FormFieldWebcamDevice) {
+		t.Error("expected the camera to be skipped when no cameras are listed")
+	}
+}
+
+func TestRecordingForm_VerticalVideoNeedsWebcamAndScreen(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	f := NewRecordingForm(&RecordingFormConfig{Mode: FormModeNewRecording})
+	f.State.RecordWebcam, f.State.RecordScreen, f.State.VerticalVideo = true, true, true
+	if !f.VerticalVideoEnabled() {
+		t.Fatal("expected vertical video with the webcam and the screen")
+	}
+
+	// Screen only: the toggle is disabled and keeps its value
+	f.State.FocusedField = FormFieldRecordWebcam
+	f.handleLeftRight(1)
+	if f.VerticalVideoEnabled() {
+		t.Error("expected no vertical video without the webcam")
+	}
+	if !strings.Contains(f.View(), "(requires webcam and screen)") {
+		t.Error("expected the form to say why vertical video is off")
+	}
+	f.State.FocusedField = FormFieldVerticalVideo
+	f.handleLeftRight(1)
+	if !f.State.VerticalVideo || f.State.ErrorMsg != verticalVideoUnsupportedMsg {
+		t.Errorf("vertical = %v, error = %q: want the value kept and an explanation", f.State.VerticalVideo, f.State.ErrorMsg)
+	}
+
+	f.State.FocusedField = FormFieldRecordWebcam
+	f.handleLeftRight(1)
+	if !f.VerticalVideoEnabled() || f.State.ErrorMsg != "" {
+		t.Errorf("expected vertical video and no error once the webcam is back, got error %q", f.State.ErrorMsg)
+	}
+}
+
+func TestRecordingForm_VerticalWebcamPlacement(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	f := NewRecordingForm(&RecordingFormConfig{Mode: FormModeNewRecording})
+	f.State.RecordWebcam, f.State.RecordScreen, f.State.VerticalVideo = true, true, false
+
+	// Only shown with a vertical video
+	if strings.Contains(f.View(), "Vertical Webcam:") || !f.shouldSkipField(FormFieldVerticalWebcamPosition) {
+		t.Error("expected no vertical webcam placement without a vertical video")
+	}
+	f.State.VerticalVideo = true
+	if !strings.Contains(f.View(), "Vertical Webcam:") {
+		t.Error("expected the vertical webcam placement with a vertical video")
+	}
+	f.State.FocusedField = FormFieldVerticalVideo
+	f.nextField()
+	if f.State.FocusedField != FormFieldVerticalWebcamPosition {
+		t.Fatalf("focused %v, want the vertical webcam position after the toggle", f.State.FocusedField)
+	}
+
+	if f.GetVerticalWebcamPosition() != config.VerticalWebcamBottomCenter || f.GetVerticalWebcamSize() != config.VerticalWebcamLarge {
+		t.Errorf("placement = %s %s, want the default layout", f.GetVerticalWebcamPosition(), f.GetVerticalWebcamSize())
+	}
+	f.handleLeftRight(-1)
+	f.nextField()
+	f.handleLeftRight(1)
+	if f.GetVerticalWebcamPosition() != config.VerticalWebcamTopRight || f.GetVerticalWebcamSize() != config.VerticalWebcamSmall {
+		t.Errorf("placement = %s %s, want top-right small", f.GetVerticalWebcamPosition(), f.GetVerticalWebcamSize())
+	}
+
+	// Stored with the recording and restored when editing it
+	m := NewRecordingSetupModel()
+	m.form = f
+	settings := m.GetRecordingSettings()
+	if settings.VerticalWebcamPosition != "top-right" || settings.VerticalWebcamSize != "small" {
+		t.Errorf("settings = %q %q, want top-right small", settings.VerticalWebcamPosition, settings.VerticalWebcamSize)
+	}
+	edit := NewRecordingForm(&RecordingFormConfig{Mode: FormModeEditExisting})
+	edit.SetVerticalWebcam(settings.VerticalWebcamPosition, settings.VerticalWebcamSize)
+	if edit.GetVerticalWebcamPosition() != config.VerticalWebcamTopRight || edit.GetVerticalWebcamSize() != config.VerticalWebcamSmall {
+		t.Error("expected the stored placement restored")
+	}
+}
+
+func TestRecordingForm_WebcamOnlyNeedsWebcam(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	f := NewRecordingForm(&RecordingFormConfig{Mode: FormModeNewRecording})
+	f.State.RecordWebcam, f.State.RecordScreen = true, true
+
+	// The toggle comes right before vertical video
+	f.State.FocusedField = FormFieldMaxDuration
+	f.nextField()
+	if f.State.FocusedField != FormFieldWebcamOnly {
+		t.Fatalf("focused %v, want the webcam-only toggle after the max duration", f.State.FocusedField)
+	}
+	f.handleLeftRight(1)
+	if !f.WebcamOnlyEnabled() {
+		t.Fatal("expected the webcam-only video with the webcam")
+	}
+	f.nextField()
+	if f.State.FocusedField != FormFieldVerticalVideo {
+		t.Errorf("focused %v, want vertical video next", f.State.FocusedField)
+	}
+
+	f.State.FocusedField = FormFieldRecordWebcam
+	f.handleLeftRight(1)
+	if f.WebcamOnlyEnabled() || !strings.Contains(f.View(), "(requires webcam)") {
+		t.Error("expected no webcam-only video without the webcam")
+	}
+	f.State.FocusedField = FormFieldWebcamOnly
+	f.handleLeftRight(1)
+	if !f.State.WebcamOnly || f.State.ErrorMsg != webcamOnlyUnsupportedMsg {
+		t.Errorf("webcam only = %v, error = %q: want the value kept and an explanation", f.State.WebcamOnly, f.State.ErrorMsg)
+	}
+	f.State.FocusedField = FormFieldRecordWebcam
+	f.handleLeftRight(1)
+	if !f.WebcamOnlyEnabled() || f.State.ErrorMsg != "" {
+		t.Errorf("expected the webcam-only video and no error once the webcam is back, got error %q", f.State.ErrorMsg)
+	}
+}
+
+func TestRecordingForm_FrameRateAndResolution(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	f := NewRecordingForm(&RecordingFormConfig{
+		Mode:     FormModeNewRecording,
+		Monitors: []models.Monitor{{Name: "HDMI-1", Width: 1280, Height: 720}},
+	})
+	f.State.RecordScreen = true
+	if f.GetFrameRate() != 30 {
+		t.Errorf("frame rate = %d, want 30 by default", f.GetFrameRate())
+	}
+	f.State.FocusedField = FormFieldFrameRate
+	f.handleLeftRight(1)
+	if f.GetFrameRate() != 60 {
+		t.Errorf("frame rate = %d, want 60", f.GetFrameRate())
+	}
+
+	// 1080p would upscale the 720p screen
+	f.State.SelectedResolutionIdx = config.OutputResolutionIndex(config.OutputResolution1080p)
+	if err := f.resolutionError(); !strings.Contains(err, "1280x720") {
+		t.Errorf("resolutionError() = %q, want the screen size", err)
+	}
+	m := NewRecordingSetupModel()
+	m.form = f
+	f.SetTitle("Demo")
+	if m.Validate() || f.State.ErrorMsg == "" {
+		t.Error("expected a resolution larger than the screen to be refused")
+	}
+	f.State.FocusedField = FormFieldOutputResolution
+	f.handleLeftRight(1)
+	if f.resolutionError() != "" || f.State.ErrorMsg != "" {
+		t.Errorf("expected 720p to be accepted, got %q", f.State.ErrorMsg)
+	}
+
+	// Editing checks the resolution the recording was made at
+	f = NewRecordingForm(&RecordingFormConfig{Mode: FormModeEditExisting, SourceResolution: "2560x1440"})
+	f.State.RecordScreen = true
+	f.State.SelectedResolutionIdx = config.OutputResolutionIndex(config.OutputResolution1080p)
+	if err := f.resolutionError(); err != "" {
+		t.Errorf("resolutionError() = %q, want 1080p accepted for a 1440p recording", err)
+	}
+}
+
+func TestHistoryDetail_NewRecordingLike(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	h := historyWithRecordings("Episode 1")
+	rec := &h.recordings[0]
+	rec.Metadata.Number = 1
+	rec.Metadata.Presenter = "Ada"
+	rec.Settings = models.RecordingSettings{AudioEnabled: true, WebcamEnabled: true, OutputResolution: "720p"}
+	h.mode = HistoryDetailMode
+	h.selectedRecording = rec
+
+	_, cmd := h.Update(bulkKey("N"))
+	if cmd == nil {
+		t.Fatal("expected N to start a new recording")
+	}
+	msg, ok := cmd().(newRecordingLikeMsg)
+	if !ok || msg.recording != rec {
+		t.Fatalf("got %T, want a new recording like the selected one", msg)
+	}
+
+	model, _ := AppModel{screen: ScreenHistory, history: h}.Update(msg)
+	app := model.(AppModel)
+	if app.screen != ScreenRecordingSetup {
+		t.Fatalf("screen = %v, want the recording setup", app.screen)
+	}
+	form := app.recordingSetup.form
+	if !form.State.RecordAudio || !form.State.RecordWebcam || form.State.RecordScreen {
+		t.Errorf("sources = audio %v, webcam %v, screen %v, want those of the recording",
+			form.State.RecordAudio, form.State.RecordWebcam, form.State.RecordScreen)
+	}
+	if form.GetPresenter() != "Ada" || app.recordingSetup.GetOutputResolution() != "720p" {
+		t.Errorf("presenter %q, resolution %q: want those of the recording", form.GetPresenter(), app.recordingSetup.GetOutputResolution())
+	}
+	if form.GetTitle() != "" {
+		t.Errorf("title = %q, want a fresh one", form.GetTitle())
+	}
+}
+
+func TestSourcesNotice(t *testing.T) {
+	tests := []struct {
+		audio, webcam, screen bool
+		warn                  bool
+	}{
+		{true, false, false, true},
+		{true, true, false, false},
+		{true, false, true, false},
+		{false, true, false, false},
+		{false, false, false, false}, // Blocked by validation instead
+	}
+	for _, tt := range tests {
+		if got := sourcesNotice(tt.audio, tt.webcam, tt.screen) != ""; got != tt.warn {
+			t.Errorf("sourcesNotice(audio %v, webcam %v, screen %v) warns = %v, want %v",
+				tt.audio, tt.webcam, tt.screen, got, tt.warn)
+		}
+	}
+
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	f := NewRecordingForm(&RecordingFormConfig{Mode: FormModeNewRecording})
+	f.SetTitle("Voice over")
+	f.State.RecordAudio, f.State.RecordWebcam, f.State.RecordScreen = true, false, false
+	if !strings.Contains(f.View(), "Audio only") {
+		t.Error("expected the form to warn that no video will be made")
+	}
+}
+
+func TestNextMaxDuration(t *testing.T) {
+	tests := []struct {
+		minutes, dir, want int
+	}{
+		{0, 1, 1},
+		{5, 1, 10},
+		{120, 1, 0},
+		{0, -1, 120},
+		{10, -1, 5},
+		{7, 1, 10}, // Not a choice, e.g. cloned
+		{7, -1, 5},
+	}
+	for _, tt := range tests {
+		if got := nextMaxDuration(tt.minutes, tt.dir); got != tt.want {
+			t.Errorf("nextMaxDuration(%d, %d) = %d, want %d", tt.minutes, tt.dir, got, tt.want)
+		}
+	}
+}
+
+func TestAppModel_MaxDurationReached(t *testing.T) {
+	info := &models.RecordingInfo{}
+	info.Settings.MaxDuration = 5
+	m := AppModel{state: stateRecording, recordingInfo: info}
+
+	// Four minutes recorded before a pause. However long the pause lasts,
+	// only the recorded time counts.
+	m.status = models.RecordingStatus{IsPaused: true, RecordedBefore: 4 * time.Minute}
+	if m.maxDurationReached() {
+		t.Error("expected paused time not to count toward the limit")
+	}
+	m.status = models.RecordingStatus{IsRecording: true, StartTime: time.Now().Add(-30 * time.Second), RecordedBefore: 4 * time.Minute}
+	if m.maxDurationReached() {
+		t.Error("expected 4m30s recorded to be under the limit")
+	}
+	m.status.StartTime = time.Now().Add(-time.Minute)
+	if !m.maxDurationReached() {
+		t.Error("expected the limit to be reached after 5 minutes recorded")
+	}
+
+	info.Settings.MaxDuration = 0
+	if m.maxDurationReached() {
+		t.Error("expected no limit with a maximum duration of 0")
+	}
+}
+
+func TestRecordingForm_InitialField(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	cfg, _ := config.Load()
+	cfg.FormFocus = config.FormFocusTopic
+	if err := config.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		mode  RecordingFormMode
+		focus config.FormFocus
+		want  RecordingFormField
+	}{
+		{"default", FormModeNewRecording, "", FormFieldTitle},
+		{"title", FormModeNewRecording, config.FormFocusTitle, FormFieldTitle},
+		{"topic", FormModeNewRecording, config.FormFocusTopic, FormFieldTopic},
+		{"confirm", FormModeNewRecording, config.FormFocusConfirm, FormFieldConfirm},
+		{"topic when editing", FormModeEditExisting, config.FormFocusTopic, FormFieldTopic},
+		{"confirm when editing", FormModeEditExisting, config.FormFocusConfirm, FormFieldTitle},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewRecordingForm(&RecordingFormConfig{Mode: tt.mode})
+			if f.State.InitialFocus != config.FormFocusTopic {
+				t.Fatalf("InitialFocus = %q, want the configured field", f.State.InitialFocus)
+			}
+			f.State.InitialFocus = tt.focus
+			f.Focus()
+			if f.State.FocusedField != tt.want {
+				t.Errorf("focused %v, want %v", f.State.FocusedField, tt.want)
+			}
+			if f.State.TitleInput.Focused() != (tt.want == FormFieldTitle) {
+				t.Errorf("title input focused = %v, want it focused only on the title", f.State.TitleInput.Focused())
+			}
+		})
+	}
+}
+
+func TestRecordingForm_MatchesPreset(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	f := NewRecordingForm(&RecordingFormConfig{Mode: FormModeNewRecording})
+	f.State.Presets = config.RecordingPresets{RecordAudio: true, RecordScreen: true}
+	f.State.RecordAudio, f.State.RecordWebcam, f.State.RecordScreen = true, false, true
+	f.State.WebcamOnly, f.State.VerticalVideo, f.State.AddLogos = false, false, false
+
+	toggles := map[RecordingFormField]*bool{
+		FormFieldRecordAudio:   &f.State.RecordAudio,
+		FormFieldRecordWebcam:  &f.State.RecordWebcam,
+		FormFieldRecordScreen:  &f.State.RecordScreen,
+		FormFieldWebcamOnly:    &f.State.WebcamOnly,
+		FormFieldVerticalVideo: &f.State.VerticalVideo,
+		FormFieldAddLogos:      &f.State.AddLogos,
+	}
+	for field, value := range toggles {
+		if !f.matchesPreset(field) {
+			t.Errorf("field %v does not match its preset", field)
+		}
+		*value = !*value
+		if f.matchesPreset(field) {
+			t.Errorf("field %v matches its preset after toggling", field)
+		}
+		*value = !*value
+	}
+
+	// Only toggles have presets
+	for _, field := range []RecordingFormField{FormFieldTitle, FormFieldTopic, FormFieldMaxDuration, FormFieldConfirm} {
+		if f.matchesPreset(field) {
+			t.Errorf("field %v matches a preset, want only toggles to", field)
+		}
+	}
+}
+
+func TestRecordingForm_TabSkipsPresetToggles(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	f := NewRecordingForm(&RecordingFormConfig{Mode: FormModeNewRecording})
+	f.State.Presets = config.DefaultRecordingPresets()
+	f.State.RecordAudio, f.State.RecordWebcam, f.State.RecordScreen = true, true, true
+	f.State.VerticalVideo, f.State.AddLogos, f.State.WebcamOnly = true, true, false
+	f.State.SkipPresetToggles = true
+
+	// Tab passes over the toggles still at their preset
+	f.State.FocusedField = FormFieldTopic
+	f.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if f.State.FocusedField != FormFieldMaxDuration {
+		t.Fatalf("focused %v after tab from the topic, want the max duration", f.State.FocusedField)
+	}
+	f.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if f.State.FocusedField != FormFieldVerticalWebcamPosition {
+		t.Errorf("focused %v after tab from the max duration, want the webcam position", f.State.FocusedField)
+	}
+	f.State.FocusedField = FormFieldMaxDuration
+	f.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	if f.State.FocusedField != FormFieldTopic {
+		t.Errorf("focused %v after shift+tab from the max duration, want the topic", f.State.FocusedField)
+	}
+
+	// The arrow keys visit every toggle
+	f.State.FocusedField = FormFieldTopic
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if f.State.FocusedField != FormFieldRecordAudio {
+		t.Errorf("focused %v after down from the topic, want Record Audio", f.State.FocusedField)
+	}
+
+	// A toggle that differs from its preset is not skipped
+	f.State.RecordWebcam = false
+	f.State.FocusedField = FormFieldTopic
+	f.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if f.State.FocusedField != FormFieldRecordWebcam {
+		t.Errorf("focused %v after tab, want the changed Record Webcam toggle", f.State.FocusedField)
+	}
+
+	// Without the preference tab visits every toggle
+	f.State.SkipPresetToggles = false
+	f.State.FocusedField = FormFieldTopic
+	f.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if f.State.FocusedField != FormFieldRecordAudio {
+		t.Errorf("focused %v after tab without skipping, want Record Audio", f.State.FocusedField)
+	}
+
+	// Editing a recording never skips toggles
+	edit := NewRecordingForm(&RecordingFormConfig{Mode: FormModeEditExisting})
+	edit.State.Presets = config.DefaultRecordingPresets()
+	edit.State.RecordAudio = true
+	edit.State.FocusedField = FormFieldPresenter
+	edit.nextFieldSkipping(true)
+	if edit.State.FocusedField != FormFieldRecordAudio {
+		t.Errorf("focused %v when editing, want Record Audio", edit.State.FocusedField)
+	}
+}
@@ -0,0 +1,424 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/search"
+)
+
+// SavedFilterField represents which field of a saved filter is focused while
+// editing it
+type SavedFilterField int
+
+const (
+	SavedFilterFieldName SavedFilterField = iota
+	SavedFilterFieldText
+	SavedFilterFieldStatus
+	SavedFilterFieldTopic
+	SavedFilterFieldPeriod
+)
+
+// SavedFiltersResult reports the state of the saved filters screen after a
+// message
+type SavedFiltersResult int
+
+const (
+	SavedFiltersOpen    SavedFiltersResult = iota // Still open
+	SavedFiltersChanged                           // Still open, Filters changed and should be saved
+	SavedFiltersClosed                            // Closed without applying a filter
+	SavedFiltersApplied                           // Closed, Selected holds the filter to apply
+)
+
+// savedFilterStatuses are the statuses a saved filter can select ("" = any)
+var savedFilterStatuses = []string{
+	"",
+	models.StatusCompleted,
+	models.StatusFailed,
+	models.StatusNeedsMetadata,
+	models.StatusProcessing,
+}
+
+// savedFilterStatusLabel returns the display name of a status of a saved
+// filter
+func savedFilterStatusLabel(status string) string {
+	switch status {
+	case "":
+		return "Any"
+	case models.StatusNeedsMetadata:
+		return "Needs metadata"
+	}
+	return strings.ToUpper(status[:1]) + status[1:]
+}
+
+// SavedFiltersModel is a full screen list of the saved history filters
+// ("smart lists"), where they are applied, added, edited and deleted. The
+// owning screen forwards messages to Update while it is open and renders
+// View instead of its own content.
+type SavedFiltersModel struct {
+	width  int
+	height int
+
+	filters  []config.SavedFilter
+	topics   []string // Selectable topic names, "" = any
+	current  config.SavedFilter
+	selected int
+
+	// Filter being edited; editing is false in the list
+	editing   bool
+	editIndex int // Index into filters, -1 for a new filter
+	field     SavedFilterField
+	nameInput textinput.Model
+	textInput textinput.Model
+	statusIdx int
+	topicIdx  int
+	periodIdx int
+	editError string
+}
+
+// NewSavedFiltersModel creates the saved filters screen. current is the
+// filter state of the history, used to prefill new saved filters.
+func NewSavedFiltersModel(filters []config.SavedFilter, topics []models.Topic, current config.SavedFilter) *SavedFiltersModel {
+	nameInput := textinput.New()
+	nameInput.Placeholder = "e.g. This month's tutorials"
+	nameInput.CharLimit = 50
+	nameInput.Width = 40
+
+	textInput := textinput.New()
+	textInput.Placeholder = "Words in the title, topic, presenter or folder"
+	textInput.CharLimit = 100
+	textInput.Width = 40
+
+	m := &SavedFiltersModel{
+		filters:   append([]config.SavedFilter(nil), filters...),
+		topics:    []string{""},
+		current:   current,
+		nameInput: nameInput,
+		textInput: textInput,
+	}
+	for _, t := range topics {
+		m.topics = append(m.topics, t.Name)
+	}
+	return m
+}
+
+// SetSize sets the size of the screen the list is rendered in
+func (m *SavedFiltersModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// Filters returns the saved filters, including changes
+func (m *SavedFiltersModel) Filters() []config.SavedFilter {
+	return m.filters
+}
+
+// Selected returns the filter chosen to be applied
+func (m *SavedFiltersModel) Selected() config.SavedFilter {
+	if m.selected < 0 || m.selected >= len(m.filters) {
+		return config.SavedFilter{}
+	}
+	return m.filters[m.selected]
+}
+
+// startEdit opens the filter at index, or a new filter prefilled from the
+// current history filter if index is -1
+func (m *SavedFiltersModel) startEdit(index int) tea.Cmd {
+	f := m.current
+	f.Name = ""
+	if index >= 0 {
+		f = m.filters[index]
+	}
+
+	m.editing = true
+	m.editIndex = index
+	m.editError = ""
+	m.nameInput.SetValue(f.Name)
+	m.textInput.SetValue(f.Text)
+	m.statusIdx = 0
+	for i, s := range savedFilterStatuses {
+		if s == f.Status {
+			m.statusIdx = i
+		}
+	}
+	m.topicIdx = m.topicIndex(f.Topic)
+	m.periodIdx = 0
+	for i, p := range search.Periods {
+		if string(p) == f.Period {
+			m.periodIdx = i
+		}
+	}
+	return m.focusField(SavedFilterFieldName)
+}
+
+// topicIndex returns the index of a topic name, adding topics that are no
+// longer configured so editing a filter keeps them
+func (m *SavedFiltersModel) topicIndex(topic string) int {
+	for i, t := range m.topics {
+		if strings.EqualFold(t, topic) {
+			return i
+		}
+	}
+	m.topics = append(m.topics, topic)
+	return len(m.topics) - 1
+}
+
+// focusField focuses the given field of the filter being edited
+func (m *SavedFiltersModel) focusField(field SavedFilterField) tea.Cmd {
+	m.field = field
+	m.nameInput.Blur()
+	m.textInput.Blur()
+	switch field {
+	case SavedFilterFieldName:
+		m.nameInput.Focus()
+		return textinput.Blink
+	case SavedFilterFieldText:
+		m.textInput.Focus()
+		return textinput.Blink
+	}
+	return nil
+}
+
+// commitEdit stores the filter being edited and returns to the list. It
+// reports whether the filter was stored.
+func (m *SavedFiltersModel) commitEdit() bool {
+	name := strings.TrimSpace(m.nameInput.Value())
+	if name == "" {
+		m.editError = "A name is required"
+		return false
+	}
+	f := config.SavedFilter{
+		Name:   name,
+		Text:   strings.TrimSpace(m.textInput.Value()),
+		Status: savedFilterStatuses[m.statusIdx],
+		Topic:  m.topics[m.topicIdx],
+		Period: string(search.Periods[m.periodIdx]),
+	}
+	if m.editIndex < 0 {
+		m.filters = append(m.filters, f)
+		m.selected = len(m.filters) - 1
+	} else {
+		m.filters[m.editIndex] = f
+		m.selected = m.editIndex
+	}
+	m.editing = false
+	m.nameInput.Blur()
+	m.textInput.Blur()
+	return true
+}
+
+// Update handles a message while the screen is open and reports whether it
+// is still open, changed, closed or a filter was applied
+func (m *SavedFiltersModel) Update(msg tea.Msg) (SavedFiltersResult, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.SetSize(msg.Width, msg.Height)
+		return SavedFiltersOpen, nil
+
+	case tea.KeyMsg:
+		if m.editing {
+			return m.updateEdit(msg)
+		}
+
+		switch msg.String() {
+		case "esc", "q", "f":
+			return SavedFiltersClosed, nil
+
+		case "up", "k":
+			if m.selected > 0 {
+				m.selected--
+			}
+
+		case "down", "j":
+			if m.selected < len(m.filters)-1 {
+				m.selected++
+			}
+
+		case "enter":
+			if len(m.filters) > 0 {
+				return SavedFiltersApplied, nil
+			}
+
+		case "n", "a":
+			return SavedFiltersOpen, m.startEdit(-1)
+
+		case "e":
+			if len(m.filters) > 0 {
+				return SavedFiltersOpen, m.startEdit(m.selected)
+			}
+
+		case "d", "delete":
+			if len(m.filters) > 0 {
+				m.filters = append(m.filters[:m.selected], m.filters[m.selected+1:]...)
+				if m.selected >= len(m.filters) && m.selected > 0 {
+					m.selected--
+				}
+				return SavedFiltersChanged, nil
+			}
+		}
+	}
+
+	return SavedFiltersOpen, nil
+}
+
+// updateEdit handles keys while a filter is being edited
+func (m *SavedFiltersModel) updateEdit(msg tea.KeyMsg) (SavedFiltersResult, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.editing = false
+		m.nameInput.Blur()
+		m.textInput.Blur()
+		return SavedFiltersOpen, nil
+
+	case "enter", "ctrl+s":
+		if m.commitEdit() {
+			return SavedFiltersChanged, nil
+		}
+		return SavedFiltersOpen, nil
+
+	case "tab", "down":
+		next := m.field + 1
+		if next > SavedFilterFieldPeriod {
+			next = SavedFilterFieldName
+		}
+		return SavedFiltersOpen, m.focusField(next)
+
+	case "shift+tab", "up":
+		prev := m.field - 1
+		if prev < SavedFilterFieldName {
+			prev = SavedFilterFieldPeriod
+		}
+		return SavedFiltersOpen, m.focusField(prev)
+
+	case "left", "right":
+		step := 1
+		if msg.String() == "left" {
+			step = -1
+		}
+		switch m.field {
+		case SavedFilterFieldStatus:
+			n := len(savedFilterStatuses)
+			m.statusIdx = ((m.statusIdx+step)%n + n) % n
+			return SavedFiltersOpen, nil
+		case SavedFilterFieldTopic:
+			n := len(m.topics)
+			m.topicIdx = ((m.topicIdx+step)%n + n) % n
+			return SavedFiltersOpen, nil
+		case SavedFilterFieldPeriod:
+			n := len(search.Periods)
+			m.periodIdx = ((m.periodIdx+step)%n + n) % n
+			return SavedFiltersOpen, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	switch m.field {
+	case SavedFilterFieldName:
+		m.nameInput, cmd = m.nameInput.Update(msg)
+	case SavedFilterFieldText:
+		m.textInput, cmd = m.textInput.Update(msg)
+	}
+	return SavedFiltersOpen, cmd
+}
+
+// describeSavedFilter returns a short summary of what a saved filter matches
+func describeSavedFilter(f config.SavedFilter) string {
+	var parts []string
+	if f.Status != "" {
+		parts = append(parts, savedFilterStatusLabel(f.Status))
+	}
+	if f.Topic != "" {
+		parts = append(parts, f.Topic)
+	}
+	if f.Period != "" {
+		parts = append(parts, search.Period(f.Period).Label())
+	}
+	if f.Text != "" {
+		parts = append(parts, fmt.Sprintf("%q", f.Text))
+	}
+	if len(parts) == 0 {
+		return "All recordings"
+	}
+	return strings.Join(parts, " · ")
+}
+
+// View renders the screen with full screen layout
+func (m *SavedFiltersModel) View() string {
+	header := RenderHeader("Saved Filters")
+
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+
+	var rows []string
+	var helpText string
+	if m.editing {
+		rows = m.renderEdit()
+		helpText = "tab: next field • ←/→: change • enter: keep filter • esc: discard changes"
+	} else {
+		if len(m.filters) == 0 {
+			rows = append(rows, grayStyle.Italic(true).Render("No saved filters yet, press n to save the current filter"))
+		}
+		selectedStyle := lipgloss.NewStyle().
+			Background(ColorOrange).
+			Foreground(lipgloss.Color("#000000"))
+		for i, f := range m.filters {
+			line := fmt.Sprintf("%-28s %s", truncateStr(f.Name, 28), describeSavedFilter(f))
+			if i == m.selected {
+				rows = append(rows, selectedStyle.Render("▶ "+line))
+			} else {
+				rows = append(rows, valueStyle.Render("  "+line))
+			}
+		}
+		helpText = "↑/↓: navigate • enter: apply • n: new from current filter • e: edit • d: delete • esc: back"
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	footer := RenderHelpFooter(helpText, m.width)
+	return LayoutWithHeaderFooter(header, content, footer, m.width, m.height)
+}
+
+// renderEdit renders the rows of the filter being edited
+func (m *SavedFiltersModel) renderEdit() []string {
+	labelStyle := lipgloss.NewStyle().
+		Foreground(ColorGray).
+		Width(10).
+		Align(lipgloss.Right)
+	labelActiveStyle := labelStyle.
+		Foreground(ColorOrange).
+		Bold(true)
+	label := func(text string, field SavedFilterField) string {
+		if m.field == field {
+			return labelActiveStyle.Render(text)
+		}
+		return labelStyle.Render(text)
+	}
+	choice := func(text string, field SavedFilterField) string {
+		if m.field == field {
+			return lipgloss.NewStyle().Background(ColorOrange).Foreground(lipgloss.Color("#000000")).Render(" "+text+" ") +
+				lipgloss.NewStyle().Foreground(ColorGray).Render(" (←/→ to change)")
+		}
+		return lipgloss.NewStyle().Foreground(ColorWhite).Bold(true).Render(" " + text + " ")
+	}
+
+	topic := m.topics[m.topicIdx]
+	if topic == "" {
+		topic = "Any"
+	}
+
+	rows := []string{
+		lipgloss.JoinHorizontal(lipgloss.Center, label("Name: ", SavedFilterFieldName), m.nameInput.View()),
+		lipgloss.JoinHorizontal(lipgloss.Center, label("Text: ", SavedFilterFieldText), m.textInput.View()),
+		lipgloss.JoinHorizontal(lipgloss.Center, label("Status: ", SavedFilterFieldStatus), choice(savedFilterStatusLabel(savedFilterStatuses[m.statusIdx]), SavedFilterFieldStatus)),
+		lipgloss.JoinHorizontal(lipgloss.Center, label("Topic: ", SavedFilterFieldTopic), choice(topic, SavedFilterFieldTopic)),
+		lipgloss.JoinHorizontal(lipgloss.Center, label("Date: ", SavedFilterFieldPeriod), choice(search.Periods[m.periodIdx].Label(), SavedFilterFieldPeriod)),
+	}
+	if m.editError != "" {
+		rows = append(rows, "", lipgloss.NewStyle().Foreground(ColorRed).Render(m.editError))
+	}
+	return rows
+}
@@ -0,0 +1,80 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// loadingFrames are the frames of every spinner shown while waiting, so all
+// screens animate the same way
+var loadingFrames = []string{"◐", "◓", "◑", "◒"}
+
+// loadingSpinner animates loadingFrames five times a second
+var loadingSpinner = spinner.Spinner{
+	Frames: loadingFrames,
+	FPS:    time.Second / 5,
+}
+
+// ReduceMotion stops all spinners on their first frame, for users who find
+// the animation distracting (e.g. an accessibility mode)
+var ReduceMotion bool
+
+// loadingFrame returns the spinner frame for an animation counter
+func loadingFrame(frame int) string {
+	if ReduceMotion {
+		frame = 0
+	}
+	return loadingFrames[frame%len(loadingFrames)]
+}
+
+// spinnerStyle is the style of the spinners in the active palette
+func spinnerStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(ColorOrange).Bold(true)
+}
+
+// tickingSpinner is a spinner animated by its own tick messages while its
+// screen is busy. The frame is advanced in Update, so View only renders the
+// current state and the animation does not depend on other messages arriving.
+type tickingSpinner struct {
+	spinner.Model
+	ticking bool // A tick is pending
+}
+
+// newTickingSpinner returns a stopped loading spinner
+func newTickingSpinner() tickingSpinner {
+	return tickingSpinner{Model: spinner.New(spinner.WithSpinner(loadingSpinner))}
+}
+
+// start returns the command that starts the ticks when busy, or nil if they
+// are already running or motion is reduced
+func (s *tickingSpinner) start(busy bool) tea.Cmd {
+	if !busy || s.ticking || ReduceMotion {
+		return nil
+	}
+	s.ticking = true
+	return s.Tick
+}
+
+// update advances the frame on a tick of this spinner and schedules the next
+// one while busy. Once idle the ticks stop until start is called again.
+func (s *tickingSpinner) update(msg spinner.TickMsg, busy bool) tea.Cmd {
+	if msg.ID != s.ID() {
+		return nil
+	}
+	if !busy {
+		s.ticking = false
+		return nil
+	}
+	var cmd tea.Cmd
+	s.Model, cmd = s.Model.Update(msg)
+	return cmd
+}
+
+// View renders the current frame in the spinner style of the active palette
+func (s tickingSpinner) View() string {
+	s.Style = spinnerStyle()
+	return s.Model.View()
+}
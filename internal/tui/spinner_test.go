@@ -0,0 +1,157 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+)
+
+// verifyingSetup returns a YouTube setup model waiting for a verify call
+func verifyingSetup(t *testing.T) *YouTubeSetupModel {
+	t.Helper()
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	m := NewYouTubeSetupModel()
+	m.width, m.height = 100, 30
+	m.step = YouTubeStepVerifying
+	m.isVerifying = true
+	m.networkStarted = time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	m.now = m.networkStarted.Add(5 * time.Second)
+	return m
+}
+
+func TestYouTubeSetupSpinner_ViewIsDeterministic(t *testing.T) {
+	m := verifyingSetup(t)
+	first := m.View()
+	time.Sleep(250 * time.Millisecond)
+	if m.View() != first {
+		t.Error("expected View to render the same frame until the spinner ticks")
+	}
+	if !strings.Contains(first, "(5s)") {
+		t.Error("expected the elapsed time to be rendered from the model's time")
+	}
+}
+
+func TestYouTubeSetupSpinner_TicksWhileWaiting(t *testing.T) {
+	m := verifyingSetup(t)
+	frame := m.spinner.View()
+
+	// Any update while waiting starts the ticks, once
+	_, cmd := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	if cmd == nil {
+		t.Fatal("expected the spinner to start ticking")
+	}
+	if _, again := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30}); again != nil {
+		t.Error("expected no second tick loop while one is running")
+	}
+
+	tick, ok := cmd().(spinner.TickMsg)
+	if !ok {
+		t.Fatalf("expected a spinner tick, got %T", cmd())
+	}
+	_, next := m.Update(tick)
+	if next == nil {
+		t.Error("expected the next tick to be scheduled while waiting")
+	}
+	if m.spinner.View() == frame {
+		t.Error("expected a tick to advance the frame")
+	}
+	if !m.now.Equal(tick.Time) {
+		t.Errorf("expected the model time to follow the tick, got %v", m.now)
+	}
+
+	// Ticks stop once the call has finished
+	m.isVerifying = false
+	if _, next := m.Update(tick); next != nil {
+		t.Error("expected the ticks to stop when no longer waiting")
+	}
+	if m.spinner.ticking {
+		t.Error("expected the spinner to be stopped")
+	}
+}
+
+func TestYouTubeSetupSpinner_IgnoresOtherSpinners(t *testing.T) {
+	m := verifyingSetup(t)
+	other := spinner.New()
+	frame, now := m.spinner.View(), m.now
+	if _, cmd := m.Update(other.Tick()); cmd != nil {
+		t.Error("expected a tick of another spinner to be ignored")
+	}
+	if m.spinner.View() != frame || !m.now.Equal(now) {
+		t.Error("expected a tick of another spinner to leave the model unchanged")
+	}
+}
+
+func TestHistorySpinner_TicksWhileLoading(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	h := NewHistoryModel()
+	h.width, h.height = 100, 30
+	if !strings.Contains(h.View(), loadingFrames[0]+" Loading recordings...") {
+		t.Error("expected the loading spinner next to the loading message")
+	}
+
+	h.Init()
+	if !h.spinner.ticking {
+		t.Fatal("expected the spinner to tick while loading")
+	}
+	_, next := h.Update(h.spinner.Tick())
+	if next == nil || !strings.Contains(h.View(), loadingFrames[1]) {
+		t.Error("expected a tick to advance the frame and schedule the next one")
+	}
+
+	h.Update(recordingsLoadedMsg{})
+	if _, next := h.Update(h.spinner.Tick()); next != nil || h.spinner.ticking {
+		t.Error("expected the ticks to stop once the recordings are loaded")
+	}
+}
+
+func TestReduceMotion(t *testing.T) {
+	ReduceMotion = true
+	t.Cleanup(func() { ReduceMotion = false })
+
+	s := newTickingSpinner()
+	if cmd := s.start(true); cmd != nil {
+		t.Error("expected no ticks with reduced motion")
+	}
+	for frame := 0; frame < len(loadingFrames); frame++ {
+		if got := loadingFrame(frame); got != loadingFrames[0] {
+			t.Errorf("loadingFrame(%d) = %q, want the first frame", frame, got)
+		}
+	}
+}
+
+func TestHistoryLoadProgress(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	h := NewHistoryModel()
+	h.width, h.height = 100, 30
+	h.Init()
+	if h.renderLoadProgress() != "" {
+		t.Error("expected no progress bar before the first sample")
+	}
+
+	progress := h.loadCounter
+	progress.scanned.Store(2)
+	progress.total.Store(4)
+	at := h.loadStarted.Add(4 * time.Second)
+	if next := h.handleLoadProgress(loadProgressMsg{progress: progress, at: at}); next == nil {
+		t.Error("expected the next sample to be scheduled while loading")
+	}
+	view := h.View()
+	if !strings.Contains(view, "2/4 folders") || !strings.Contains(view, "about 4s left") {
+		t.Errorf("expected the folders scanned and time left, got:\n%s", view)
+	}
+
+	// Samples of an earlier load are dropped
+	h.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if h.handleLoadProgress(loadProgressMsg{progress: progress, at: at}) != nil || h.loadTotal != 0 {
+		t.Error("expected a sample of an earlier load to be dropped")
+	}
+
+	h.Update(recordingsLoadedMsg{})
+	if h.handleLoadProgress(loadProgressMsg{progress: h.loadCounter, at: at}) != nil {
+		t.Error("expected the samples to stop once the recordings are loaded")
+	}
+}
@@ -291,8 +291,8 @@ func (m *SyndicationPostModel) renderSelect() string {
 
 	b.WriteString(titleStyle.Render("Syndicate: " + m.metadata.Title))
 	b.WriteString("\n")
-	if m.metadata.YouTube != nil {
-		b.WriteString(subtitleStyle.Render(m.metadata.YouTube.VideoURL))
+	if yt := m.metadata.PrimaryYouTube(); yt != nil {
+		b.WriteString(subtitleStyle.Render(yt.VideoURL))
 	}
 	b.WriteString("\n\n")
 
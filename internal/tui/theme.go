@@ -0,0 +1,115 @@
+package tui
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+)
+
+// ========================================
+// Theme - dark/light palette variants
+// ========================================
+
+// Palette holds the colors used across all screens
+type Palette struct {
+	Orange   lipgloss.Color // Primary/Active
+	Blue     lipgloss.Color // Secondary/Links
+	Gray     lipgloss.Color // Inactive/Subtle
+	White    lipgloss.Color // Text
+	DarkGray lipgloss.Color // Background
+	Red      lipgloss.Color // Error/Recording
+	Green    lipgloss.Color // Success
+}
+
+// DarkPalette is the Kartoza palette tuned for dark terminal backgrounds
+var DarkPalette = Palette{
+	Orange:   lipgloss.Color("#DDA036"),
+	Blue:     lipgloss.Color("#569FC6"),
+	Gray:     lipgloss.Color("#9A9EA0"),
+	White:    lipgloss.Color("#FFFFFF"),
+	DarkGray: lipgloss.Color("#3A3A3A"),
+	Red:      lipgloss.Color("#E95420"),
+	Green:    lipgloss.Color("#4CAF50"),
+}
+
+// LightPalette is the Kartoza palette tuned for light terminal backgrounds.
+// Colors are darkened so they keep their contrast on white.
+var LightPalette = Palette{
+	Orange:   lipgloss.Color("#A86F0C"),
+	Blue:     lipgloss.Color("#1F6A96"),
+	Gray:     lipgloss.Color("#5F6366"),
+	White:    lipgloss.Color("#1A1A1A"),
+	DarkGray: lipgloss.Color("#E4E4E4"),
+	Red:      lipgloss.Color("#C13F0E"),
+	Green:    lipgloss.Color("#2E7D32"),
+}
+
+// ApplyPalette makes p the active palette and rebuilds the common styles
+func ApplyPalette(p Palette) {
+	ColorOrange = p.Orange
+	ColorBlue = p.Blue
+	ColorGray = p.Gray
+	ColorWhite = p.White
+	ColorDarkGray = p.DarkGray
+	ColorRed = p.Red
+	ColorGreen = p.Green
+	buildStyles()
+}
+
+// ApplyTheme selects the palette for the given theme, detecting the
+// terminal background when the theme is auto (or unset)
+func ApplyTheme(theme config.UITheme) {
+	if IsDarkTheme(theme) {
+		ApplyPalette(DarkPalette)
+	} else {
+		ApplyPalette(LightPalette)
+	}
+}
+
+// ApplyConfiguredTheme applies the theme from the saved configuration
+func ApplyConfiguredTheme() {
+	cfg, _ := config.Load()
+	if cfg == nil {
+		ApplyTheme(config.UIThemeAuto)
+		return
+	}
+	ApplyTheme(cfg.UITheme)
+}
+
+// IsDarkTheme reports whether the dark palette should be used for theme
+func IsDarkTheme(theme config.UITheme) bool {
+	switch theme {
+	case config.UIThemeDark:
+		return true
+	case config.UIThemeLight:
+		return false
+	}
+	return detectDarkBackground()
+}
+
+// detectDarkBackground checks COLORFGBG first (cheap, set by many terminals)
+// and falls back to querying the terminal background color via OSC 11.
+func detectDarkBackground() bool {
+	if dark, ok := parseColorFgBg(os.Getenv("COLORFGBG")); ok {
+		return dark
+	}
+	return lipgloss.HasDarkBackground()
+}
+
+// parseColorFgBg parses a COLORFGBG value such as "15;0" or "0;default;15".
+// The last field is the background ANSI color index: 0-6 and 8 are dark,
+// 7 and 9-15 are light. ok is false if the value cannot be interpreted.
+func parseColorFgBg(value string) (dark bool, ok bool) {
+	if value == "" {
+		return false, false
+	}
+	fields := strings.Split(value, ";")
+	bg, err := strconv.Atoi(strings.TrimSpace(fields[len(fields)-1]))
+	if err != nil || bg < 0 || bg > 15 {
+		return false, false
+	}
+	return bg < 7 || bg == 8, true
+}
@@ -0,0 +1,39 @@
+package tui
+
+import "testing"
+
+func TestParseColorFgBg(t *testing.T) {
+	tests := []struct {
+		value    string
+		wantDark bool
+		wantOK   bool
+	}{
+		{"15;0", true, true},
+		{"0;15", false, true},
+		{"0;7", false, true},
+		{"15;8", true, true},
+		{"0;default;15", false, true},
+		{"", false, false},
+		{"15;default", false, false},
+		{"15;42", false, false},
+	}
+
+	for _, tt := range tests {
+		dark, ok := parseColorFgBg(tt.value)
+		if ok != tt.wantOK || dark != tt.wantDark {
+			t.Errorf("parseColorFgBg(%q) = (%v, %v), want (%v, %v)", tt.value, dark, ok, tt.wantDark, tt.wantOK)
+		}
+	}
+}
+
+func TestApplyPalette(t *testing.T) {
+	defer ApplyPalette(DarkPalette)
+
+	ApplyPalette(LightPalette)
+	if ColorWhite != LightPalette.White {
+		t.Errorf("expected text color %q, got %q", LightPalette.White, ColorWhite)
+	}
+	if TitleStyle.GetForeground() != LightPalette.Orange {
+		t.Error("expected common styles to be rebuilt from the light palette")
+	}
+}
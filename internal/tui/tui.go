@@ -60,6 +60,7 @@ type monitorsUpdateMsg []models.Monitor
 type blinkMsg struct{}
 type countdownTickMsg struct{}
 type pauseCompleteMsg struct{ err error }
+type breakSplitCompleteMsg struct{ err error }
 type resumeCompleteMsg struct{ err error }
 
 // Model is the main TUI model
@@ -102,7 +103,7 @@ func NewModel() Model {
 		blinkOn:         true,
 		state:           initialState,
 		status:          status,
-		countdownNum:    5,
+		countdownNum:    countdownSeconds(),
 		processing:      NewProcessingState(),
 		processingFrame: 0,
 	}
@@ -138,7 +139,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.state == stateCountdown {
 			if key.Matches(msg, keys.Cancel) || msg.String() == "q" {
 				m.state = stateReady
-				m.countdownNum = 5
+				m.countdownNum = countdownSeconds()
 				return m, nil
 			}
 			// Ignore other keys during countdown
@@ -163,11 +164,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.stopAndProcess(),
 				)
 			} else {
-				// Start countdown
+				// Start countdown, or recording straight away without one
 				m.state = stateCountdown
-				m.countdownNum = 5
+				m.countdownNum = countdownSeconds()
+				if m.countdownNum == 0 {
+					return m, func() tea.Msg { return countdownTickMsg{} }
+				}
 				// Play first beep
-				go beep.Play(5)
+				go beep.Play(m.countdownNum)
 				return m, tea.Tick(time.Second, func(t time.Time) tea.Msg {
 					return countdownTickMsg{}
 				})
@@ -214,7 +218,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, updateStatus(m.recorder)
 		}
 
-		// Play beep for counts 5-1 (not for 0/GO)
+		// Play beep for the counts down to 1 (not for 0/GO)
 		if m.countdownNum > 0 {
 			go beep.Play(m.countdownNum)
 		}
@@ -596,6 +600,9 @@ func (m Model) stopAndProcess() tea.Cmd {
 
 // Run starts the TUI application with optional splash screens
 func Run(noSplash bool, presetsMode bool, editRecordingMode bool) error {
+	// Pick the dark or light palette before anything is rendered
+	ApplyConfiguredTheme()
+
 	// Check for required dependencies before starting
 	missing := deps.MissingRequired()
 	if len(missing) > 0 {
@@ -624,7 +631,12 @@ func Run(noSplash bool, presetsMode bool, editRecordingMode bool) error {
 		model = NewAppModel()
 	}
 	p := tea.NewProgram(model, tea.WithAltScreen())
-	_, err := p.Run()
+	final, err := p.Run()
+
+	// Cancel a queued upload still running rather than leaving it behind
+	if app, ok := final.(AppModel); ok && app.uploadQueue != nil {
+		app.uploadQueue.Close()
+	}
 
 	// Show exit splash screen (2 seconds, skippable with any key)
 	if !skipSplash {
@@ -0,0 +1,190 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
+)
+
+// queueUploadsMsg asks the app to add videos to the upload queue
+type queueUploadsMsg struct {
+	items []youtube.QueueItem
+	batch *uploadBatch // Set when uploading all pending recordings
+}
+
+// uploadQueueEventMsg carries progress or a completion from the upload queue
+type uploadQueueEventMsg struct {
+	event youtube.QueueEvent
+}
+
+// queueItems returns an upload queue item for each account the form uploads
+// to, with the settings of the form
+func (m *YouTubeUploadModel) queueItems() []youtube.QueueItem {
+	var items []youtube.QueueItem
+	for _, target := range m.buildUploadTargets() {
+		if target.playlistID == "" && m.templatePlaylist != "" {
+			// The playlists are not loaded yet, the one of the recording
+			// template is looked up by title when uploading
+			target.playlistName = m.templatePlaylist
+		} else if target.playlistID == "" {
+			target.playlistID = target.account.DefaultPlaylistID
+			target.playlistName = target.account.DefaultPlaylistName
+		}
+		items = append(items, youtube.QueueItem{
+			Recording:    m.recordingInfo,
+			VideoPath:    m.videoPath,
+			Options:      m.uploadOptions(target),
+			Account:      target.account,
+			PlaylistName: target.playlistName,
+		})
+	}
+	return items
+}
+
+// uploadQueued uploads one item of the upload queue with its account. It
+// runs in the queue's worker goroutine.
+func uploadQueued(ctx context.Context, item youtube.QueueItem, progress func(read, total int64)) (*youtube.UploadResult, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	acc := item.Account
+	if acc.ID != "legacy" && !youtube.IsAccountAuthenticated(&cfg.YouTube, config.GetConfigDir(), acc.ID) {
+		return nil, fmt.Errorf("account not connected, authenticate it in Options > YouTube")
+	}
+	auth := youtube.NewAuthForAccount(acc.ClientID, acc.ClientSecret, config.GetConfigDir(), acc.ID)
+	uploader, err := youtube.NewUploader(ctx, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := item.Options
+	if opts.PlaylistID == "" && item.PlaylistName != "" {
+		// A playlist that cannot be found leaves the video out of it
+		if playlists, err := uploader.ListPlaylists(ctx); err == nil {
+			for _, pl := range playlists {
+				if strings.EqualFold(pl.Title, item.PlaylistName) {
+					opts.PlaylistID = pl.ID
+					break
+				}
+			}
+		}
+	}
+	opts.ThumbnailPath = youtube.GetThumbnailPath(config.GetCacheDir(), item.VideoPath)
+	if err := youtube.ExtractThumbnailForYouTube(item.VideoPath, opts.ThumbnailPath); err != nil {
+		opts.ThumbnailPath = ""
+	}
+	return uploader.Upload(ctx, opts, progress)
+}
+
+// waitForQueueEvent waits for the next progress or completion of the upload
+// queue
+func waitForQueueEvent(q *youtube.UploadQueue) tea.Cmd {
+	return func() tea.Msg {
+		return uploadQueueEventMsg{event: <-q.Events()}
+	}
+}
+
+// queueUploads adds videos to the upload queue, which keeps uploading in the
+// background whatever screen is shown
+func (m AppModel) queueUploads(items []youtube.QueueItem, batch *uploadBatch) (AppModel, tea.Cmd) {
+	if m.uploadQueue == nil {
+		m.uploadQueue = youtube.NewUploadQueue(uploadQueued)
+	}
+	waiting := m.uploadQueueWaiting
+	for _, item := range items {
+		if m.uploadQueue.Add(item) {
+			m.uploadQueueWaiting++
+		} else if batch != nil {
+			batch.drop(item)
+		}
+	}
+	if batch != nil && batch.total > 0 {
+		m.uploadBatch = batch
+	}
+	m.updateQueueBadge()
+
+	// One command at a time waits for the queue's events, from the first
+	// upload queued until the last completion is handled
+	if waiting > 0 || m.uploadQueueWaiting == 0 {
+		return m, nil
+	}
+	return m, waitForQueueEvent(m.uploadQueue)
+}
+
+// handleQueueEvent records the progress of the queued upload and saves a
+// completed upload to its recording. Waiting stops once the queue is empty.
+func (m AppModel) handleQueueEvent(event youtube.QueueEvent) (AppModel, tea.Cmd) {
+	m.uploadQueuePct = event.Percent
+	if event.Done {
+		m.uploadQueuePct = 0
+		m.uploadQueueWaiting--
+		if event.Err != nil {
+			m.uploadQueueFailed++
+		} else if event.Result != nil && event.Item.Recording != nil {
+			m.saveQueuedUpload(event.Item, event.Result)
+		}
+	}
+	m.updateQueueBadge()
+
+	// The summary of a batch is shown once its last upload is done
+	var notice tea.Cmd
+	if b := m.uploadBatch; b != nil && b.includes(event.Item) {
+		b.handle(event)
+		if b.finished() {
+			m.uploadBatch = nil
+			if m.history != nil {
+				notice = m.history.showNotice(b.summary(), len(b.failed) > 0)
+			}
+		}
+	}
+
+	if m.uploadQueueWaiting == 0 {
+		// Refresh YouTube status
+		updateGlobalAppState(GlobalAppState.IsRecording, GlobalAppState.BlinkOn, GlobalAppState.Status)
+		return m, notice
+	}
+	if notice != nil {
+		return m, tea.Batch(notice, waitForQueueEvent(m.uploadQueue))
+	}
+	return m, waitForQueueEvent(m.uploadQueue)
+}
+
+// saveQueuedUpload adds a completed upload to the recording metadata. The
+// recording is read again first so changes made while it was queued are kept.
+func (m AppModel) saveQueuedUpload(item youtube.QueueItem, result *youtube.UploadResult) {
+	rec := item.Recording
+	if fresh, err := models.LoadRecordingInfo(rec.Files.FolderPath); err == nil {
+		rec = fresh
+	}
+	target := uploadTarget{account: item.Account, playlistID: item.Options.PlaylistID, playlistName: item.PlaylistName}
+	rec.Metadata.AddYouTubeUpload(uploadMetadata(item.Options, target, result))
+	_ = rec.Save()
+	if m.history != nil {
+		m.history.replaceRecording(*rec)
+	}
+}
+
+// updateQueueBadge shows the number of queued uploads in the status footer
+func (m AppModel) updateQueueBadge() {
+	GlobalAppState.UploadQueue = m.uploadQueueWaiting
+	GlobalAppState.UploadQueueFailed = m.uploadQueueFailed
+}
+
+// queueJobLabel describes the queued upload in progress for the status
+// footer, or returns "" if the queue is idle. Uploads of a batch show their
+// place in it.
+func (m AppModel) queueJobLabel() string {
+	if m.uploadQueue == nil || m.uploadQueue.Active() == nil {
+		return ""
+	}
+	if b := m.uploadBatch; b != nil && b.includes(*m.uploadQueue.Active()) {
+		return b.label()
+	}
+	return fmt.Sprintf("Uploading %d%%", int(m.uploadQueuePct*100))
+}
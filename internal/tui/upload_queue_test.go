@@ -0,0 +1,118 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
+)
+
+// queuedUploads runs cmd and returns the uploads it queues
+func queuedUploads(cmd tea.Cmd) []youtube.QueueItem {
+	if cmd == nil {
+		return nil
+	}
+	switch msg := cmd().(type) {
+	case queueUploadsMsg:
+		return msg.items
+	case tea.BatchMsg:
+		var items []youtube.QueueItem
+		for _, c := range msg {
+			items = append(items, queuedUploads(c)...)
+		}
+		return items
+	}
+	return nil
+}
+
+// connectYouTubeForTest isolates the config directory and stores a
+// connected YouTube client and token in it
+func connectYouTubeForTest(t *testing.T) {
+	t.Helper()
+	configDir := t.TempDir()
+	t.Setenv(config.ConfigDirEnvVar, configDir)
+	cfg := config.DefaultConfig()
+	cfg.YouTube.ClientID = "123-abc.apps.googleusercontent.com"
+	cfg.YouTube.ClientSecret = "GOCSPX-abc123"
+	if err := config.Save(&cfg); err != nil {
+		t.Fatal(err)
+	}
+	token := &youtube.Token{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer", Expiry: time.Now().Add(time.Hour).Format(time.RFC3339)}
+	if err := youtube.SaveToken(configDir, token); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUploadQueue_QueuesMarkedRecordingsAndSavesUploads(t *testing.T) {
+	connectYouTubeForTest(t)
+	defer func(badge, failed int) {
+		GlobalAppState.UploadQueue, GlobalAppState.UploadQueueFailed = badge, failed
+	}(GlobalAppState.UploadQueue, GlobalAppState.UploadQueueFailed)
+
+	h := historyWithRecordings("QGIS intro", "GeoServer", "Published", "Still processing")
+	for i := range h.recordings {
+		rec := &h.recordings[i]
+		rec.Files.FolderPath = t.TempDir()
+		rec.Files.MergedFile = filepath.Join(rec.Files.FolderPath, "merged.mp4")
+		if err := os.WriteFile(rec.Files.MergedFile, []byte("video"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		rec.Status = models.StatusCompleted
+		if err := rec.Save(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	h.recordings[2].Metadata.YouTubeUploads = []models.YouTubeUpload{{VideoID: "abc123"}}
+	h.recordings[3].Status = models.StatusProcessing
+	h.rebuildSearchIndex()
+	for range h.recordings {
+		h.Update(bulkKey(" "))
+	}
+
+	_, cmd := h.Update(bulkKey("u"))
+	items := queuedUploads(cmd)
+	if len(items) != 2 || items[0].Recording.Metadata.Title != "QGIS intro" || items[0].Options.Title != "QGIS intro" {
+		t.Fatalf("queued %d uploads, want the 2 completed recordings not on YouTube", len(items))
+	}
+	if !strings.Contains(h.copyNotice, "skipped 2") || len(h.selected) != 2 {
+		t.Errorf("notice %q with %d still marked, want the skipped recordings reported and left marked", h.copyNotice, len(h.selected))
+	}
+
+	m := AppModel{screen: ScreenMenu, history: h}
+	m.uploadQueue = youtube.NewUploadQueue(func(ctx context.Context, item youtube.QueueItem, progress func(read, total int64)) (*youtube.UploadResult, error) {
+		progress(5, 10)
+		if item.Recording.Metadata.Title == "GeoServer" {
+			return nil, errors.New("quota exceeded")
+		}
+		return &youtube.UploadResult{VideoID: "new123", VideoURL: "https://youtu.be/new123"}, nil
+	})
+	model, cmd := m.Update(queueUploadsMsg{items: items})
+	if GlobalAppState.UploadQueue != 2 || cmd == nil {
+		t.Fatalf("badge = %d, want both uploads counted and their events awaited", GlobalAppState.UploadQueue)
+	}
+	for cmd != nil {
+		model, cmd = model.Update(cmd())
+	}
+
+	if GlobalAppState.UploadQueue != 0 || GlobalAppState.UploadQueueFailed != 1 {
+		t.Errorf("badge = %d queued, %d failed; want the queue empty with one failure", GlobalAppState.UploadQueue, GlobalAppState.UploadQueueFailed)
+	}
+	saved, err := models.LoadRecordingInfo(h.recordings[0].Files.FolderPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Metadata.PrimaryYouTube() == nil || saved.Metadata.PrimaryYouTube().VideoID != "new123" {
+		t.Errorf("saved YouTube metadata = %+v, want the queued upload", saved.Metadata.PrimaryYouTube())
+	}
+	if !h.recordings[0].Metadata.IsPublishedToYouTube() || h.recordings[1].Metadata.IsPublishedToYouTube() {
+		t.Error("expected the history to show only the successful upload as published")
+	}
+}
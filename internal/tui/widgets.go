@@ -2,6 +2,7 @@ package tui
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 )
@@ -10,24 +11,30 @@ import (
 // Brand Colors - Kartoza standard palette
 // ========================================
 
+// Active palette. Defaults to the dark-terminal variant; see ApplyTheme in theme.go.
 var (
-	ColorOrange   = lipgloss.Color("#DDA036") // Primary/Active
-	ColorBlue     = lipgloss.Color("#569FC6") // Secondary/Links
-	ColorGray     = lipgloss.Color("#9A9EA0") // Inactive/Subtle
-	ColorWhite    = lipgloss.Color("#FFFFFF") // Text
-	ColorDarkGray = lipgloss.Color("#3A3A3A") // Background
-	ColorRed      = lipgloss.Color("#E95420") // Error/Recording
-	ColorGreen    = lipgloss.Color("#4CAF50") // Success
+	ColorOrange   = DarkPalette.Orange   // Primary/Active
+	ColorBlue     = DarkPalette.Blue     // Secondary/Links
+	ColorGray     = DarkPalette.Gray     // Inactive/Subtle
+	ColorWhite    = DarkPalette.White    // Text
+	ColorDarkGray = DarkPalette.DarkGray // Background
+	ColorRed      = DarkPalette.Red      // Error/Recording
+	ColorGreen    = DarkPalette.Green    // Success
 )
 
 // HeaderWidth is the standard width for the header
 const HeaderWidth = 60
 
+// StatusFooterHeight is the number of lines the status footer takes below
+// every screen
+const StatusFooterHeight = 1
+
 // ========================================
 // Global Application State for Header
 // ========================================
 
-// AppState contains the global application state shown in all headers
+// AppState contains the global application state shown in all headers and
+// the status footer
 type AppState struct {
 	IsRecording      bool
 	TotalRecordings  int
@@ -35,6 +42,10 @@ type AppState struct {
 	BlinkOn          bool   // For blinking recording indicator
 	YouTubeConnected bool   // Whether YouTube API is connected
 	Version          string // Application version
+	Job              string // Active background job, e.g. "Uploading 42%" (empty = none)
+
+	UploadQueue       int // Uploads queued or in progress, shown as a badge (0 = none)
+	UploadQueueFailed int // Queued uploads that failed
 }
 
 // Global app state - updated by the main app model
@@ -58,8 +69,8 @@ var GlobalAppState = &AppState{
 //	Kartoza Video Processor - Page Title
 //	Serva Momentum
 //	────────────────────────────────────────────────────────────
-//	Recording: Off | Total Recordings: 10 | Status: Ready
-//	────────────────────────────────────────────────────────────
+//
+// The global status is shown in the status footer, see RenderStatusFooter.
 func RenderHeader(pageTitle string) string {
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
@@ -78,11 +89,6 @@ func RenderHeader(pageTitle string) string {
 		Align(lipgloss.Center).
 		Width(HeaderWidth)
 
-	statusStyle := lipgloss.NewStyle().
-		Foreground(ColorWhite).
-		Align(lipgloss.Center).
-		Width(HeaderWidth)
-
 	// Line 1: Application Name - Page Title - Version
 	title := titleStyle.Render(fmt.Sprintf("Kartoza Video Processor v%s - %s", GlobalAppState.Version, pageTitle))
 
@@ -92,7 +98,27 @@ func RenderHeader(pageTitle string) string {
 	// Line 3: Divider
 	divider := dividerStyle.Render("────────────────────────────────────────────────────────────")
 
-	// Line 4: Status bar
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		title,
+		motto,
+		divider,
+	)
+}
+
+// RenderStatusFooter renders the one-line status footer shown below every
+// screen: recording state, YouTube connectivity, total recordings, status
+// and the active background job, followed by the upload queue badge.
+//
+// Format:
+//
+//	Rec: ● On | YT: ✓ | #10 | Recording | ⟳ Uploading 42% | ⇪ 3 queued
+func RenderStatusFooter(width int) string {
+	footerStyle := lipgloss.NewStyle().
+		Foreground(ColorWhite).
+		Align(lipgloss.Center).
+		Width(width)
+
 	recordingStatus := "Off"
 	recordingColor := ColorGray
 	if GlobalAppState.IsRecording {
@@ -120,22 +146,44 @@ func RenderHeader(pageTitle string) string {
 		Foreground(youtubeColor).
 		Render(youtubeStatus)
 
+	// Paused recordings stand out from the other states
+	statusStyled := GlobalAppState.Status
+	if statusStyled == "Paused" {
+		statusStyled = lipgloss.NewStyle().
+			Foreground(ColorOrange).
+			Bold(true).
+			Render("❚❚ Paused")
+	}
+
 	statusLine := fmt.Sprintf("Rec: %s | %s | #%d | %s",
 		recordingStyled,
 		youtubeStyled,
 		GlobalAppState.TotalRecordings,
-		GlobalAppState.Status,
+		statusStyled,
 	)
-	status := statusStyle.Render(statusLine)
+	if GlobalAppState.Job != "" {
+		statusLine += " | " + lipgloss.NewStyle().
+			Foreground(ColorOrange).
+			Render("⟳ "+GlobalAppState.Job)
+	}
+	if badge := uploadQueueBadge(); badge != "" {
+		statusLine += " | " + badge
+	}
 
-	return lipgloss.JoinVertical(
-		lipgloss.Center,
-		title,
-		motto,
-		divider,
-		status,
-		divider,
-	)
+	return footerStyle.Render(statusLine)
+}
+
+// uploadQueueBadge returns the number of queued and failed uploads for the
+// status footer, or "" if there are none
+func uploadQueueBadge() string {
+	var parts []string
+	if n := GlobalAppState.UploadQueue; n > 0 {
+		parts = append(parts, lipgloss.NewStyle().Foreground(ColorOrange).Render(fmt.Sprintf("⇪ %d queued", n)))
+	}
+	if n := GlobalAppState.UploadQueueFailed; n > 0 {
+		parts = append(parts, lipgloss.NewStyle().Foreground(ColorRed).Render(fmt.Sprintf("✗ %d failed", n)))
+	}
+	return strings.Join(parts, " ")
 }
 
 // ========================================
@@ -200,50 +248,62 @@ func CenterContent(content string, width, height int) string {
 // Common Styles
 // ========================================
 
-// Box style for content areas
-var BoxStyle = lipgloss.NewStyle().
-	Border(lipgloss.RoundedBorder()).
-	BorderForeground(ColorOrange).
-	Padding(1, 2)
-
-// Title style for section headings
-var TitleStyle = lipgloss.NewStyle().
-	Bold(true).
-	Foreground(ColorOrange)
-
-// Subtitle style
-var SubtitleStyle = lipgloss.NewStyle().
-	Foreground(ColorBlue)
-
-// Label style for form labels
-var LabelStyle = lipgloss.NewStyle().
-	Foreground(ColorGray)
-
-// Value style for displaying values
-var ValueStyle = lipgloss.NewStyle().
-	Foreground(ColorWhite)
-
-// Active style for active/selected items
-var ActiveStyle = lipgloss.NewStyle().
-	Foreground(ColorOrange).
-	Bold(true)
-
-// Inactive style for inactive items
-var InactiveStyle = lipgloss.NewStyle().
-	Foreground(ColorGray)
-
-// Error style for error messages
-var ErrorStyle = lipgloss.NewStyle().
-	Foreground(ColorRed).
-	Bold(true)
-
-// Success style for success messages
-var SuccessStyle = lipgloss.NewStyle().
-	Foreground(ColorGreen).
-	Bold(true)
-
-// Recording style for recording indicator (blinking red)
-var RecordingStyle = lipgloss.NewStyle().
-	Foreground(ColorRed).
-	Bold(true).
-	Blink(true)
+// Common styles, built from the current palette by buildStyles
+var (
+	BoxStyle       lipgloss.Style // Box style for content areas
+	TitleStyle     lipgloss.Style // Title style for section headings
+	SubtitleStyle  lipgloss.Style // Subtitle style
+	LabelStyle     lipgloss.Style // Label style for form labels
+	ValueStyle     lipgloss.Style // Value style for displaying values
+	ActiveStyle    lipgloss.Style // Active style for active/selected items
+	InactiveStyle  lipgloss.Style // Inactive style for inactive items
+	ErrorStyle     lipgloss.Style // Error style for error messages
+	SuccessStyle   lipgloss.Style // Success style for success messages
+	RecordingStyle lipgloss.Style // Recording style for recording indicator (blinking red)
+)
+
+func init() {
+	buildStyles()
+}
+
+// buildStyles (re)creates the common styles from the current palette.
+// It is called again whenever the theme changes.
+func buildStyles() {
+	BoxStyle = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorOrange).
+		Padding(1, 2)
+
+	TitleStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(ColorOrange)
+
+	SubtitleStyle = lipgloss.NewStyle().
+		Foreground(ColorBlue)
+
+	LabelStyle = lipgloss.NewStyle().
+		Foreground(ColorGray)
+
+	ValueStyle = lipgloss.NewStyle().
+		Foreground(ColorWhite)
+
+	ActiveStyle = lipgloss.NewStyle().
+		Foreground(ColorOrange).
+		Bold(true)
+
+	InactiveStyle = lipgloss.NewStyle().
+		Foreground(ColorGray)
+
+	ErrorStyle = lipgloss.NewStyle().
+		Foreground(ColorRed).
+		Bold(true)
+
+	SuccessStyle = lipgloss.NewStyle().
+		Foreground(ColorGreen).
+		Bold(true)
+
+	RecordingStyle = lipgloss.NewStyle().
+		Foreground(ColorRed).
+		Bold(true).
+		Blink(true)
+}
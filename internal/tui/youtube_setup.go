@@ -2,10 +2,15 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -30,6 +35,7 @@ const (
 	YouTubeStepAccountAdd    // Add new account
 	YouTubeStepAccountEdit   // Edit existing account
 	YouTubeStepAccountDelete // Delete confirmation
+	YouTubeStepDisconnect    // Disconnect confirmation
 	YouTubeStepError
 )
 
@@ -46,6 +52,7 @@ type YouTubeSetupModel struct {
 	// Status
 	authStatus       youtube.AuthStatus
 	channelName      string
+	accountEmail     string // Google account email, if known
 	errorMessage     string
 	isAuthenticating bool
 	authURL          string // URL for manual browser opening
@@ -66,16 +73,42 @@ type YouTubeSetupModel struct {
 	createPlaylistFocus int // 0=title, 1=desc, 2=privacy
 	isCreatingPlaylist  bool
 
+	// In-flight verify/playlist call, cancelable with esc
+	networkCancel     context.CancelFunc
+	networkStarted    time.Time
+	networkReturnStep YouTubeSetupStep // Step to return to when canceled
+	networkRetry      *retryNotice     // Rate limit/retry notice of the call
+
+	// Spinner shown while waiting for Google, and the time of the latest
+	// update, which the elapsed and remaining times are rendered from
+	spinner tickingSpinner
+	now     time.Time
+
+	// Disconnect/delete confirmation: also revoke the grant at Google
+	revokeAtGoogle bool
+	isRevoking     bool
+
+	// File browser for importing a client_secret.json (nil when closed)
+	fileBrowser *FileBrowserModel
+
 	// Account management
 	accounts             []youtube.Account
 	selectedAccountIndex int
 	accountName          textinput.Model
 	accountClientID      textinput.Model
 	accountClientSecret  textinput.Model
-	accountFormFocus     int  // 0=name, 1=clientID, 2=clientSecret
+	accountRedirectURI   textinput.Model
+	accountFormFocus     int  // 0=name, 1=clientID, 2=clientSecret, 3=redirectURI
 	editingAccountID     string
 	isAuthenticatingAccount bool
 	accountAuthURL       string
+	accountTests         map[string]accountTestResult // Connection test results by account ID
+
+	// Manual auth-code paste fallback (headless/remote machines)
+	authCodeInput    textinput.Model
+	authCodeError    string
+	isExchangingCode bool
+	authDeadline     time.Time // When the pending auth times out
 
 	// Config
 	cfg *config.Config
@@ -124,6 +157,18 @@ func NewYouTubeSetupModel() *YouTubeSetupModel {
 	accountClientSecretInput.Width = 50
 	accountClientSecretInput.EchoMode = textinput.EchoPassword
 
+	// Account redirect URI input (empty = automatic loopback port)
+	accountRedirectURIInput := textinput.New()
+	accountRedirectURIInput.Placeholder = "automatic (e.g. http://127.0.0.1:8085/callback)"
+	accountRedirectURIInput.CharLimit = 200
+	accountRedirectURIInput.Width = 50
+
+	// Pasted authorization code (or redirect URL) input
+	authCodeInput := textinput.New()
+	authCodeInput.Placeholder = "Paste code or redirect URL"
+	authCodeInput.CharLimit = 2048
+	authCodeInput.Width = 50
+
 	// Load existing config
 	cfg, _ := config.Load()
 
@@ -144,6 +189,10 @@ func NewYouTubeSetupModel() *YouTubeSetupModel {
 		accountName:         accountNameInput,
 		accountClientID:     accountClientIDInput,
 		accountClientSecret: accountClientSecretInput,
+		accountRedirectURI:  accountRedirectURIInput,
+		authCodeInput:       authCodeInput,
+		spinner:             newTickingSpinner(),
+		now:                 time.Now(),
 		accounts:            cfg.YouTube.GetAccounts(),
 		cfg:                 cfg,
 		authStatus:          cfg.GetYouTubeAuthStatus(),
@@ -154,6 +203,7 @@ func NewYouTubeSetupModel() *YouTubeSetupModel {
 	case youtube.AuthStatusAuthenticated:
 		m.step = YouTubeStepConnected
 		m.channelName = cfg.YouTube.ChannelName
+		m.accountEmail = cfg.YouTube.Email
 	case youtube.AuthStatusConfigured:
 		m.step = YouTubeStepCredentials
 	default:
@@ -168,10 +218,36 @@ func (m *YouTubeSetupModel) Init() tea.Cmd {
 	return textinput.Blink
 }
 
-// Update handles messages
+// waiting returns true while a screen with a spinner waits for Google
+func (m *YouTubeSetupModel) waiting() bool {
+	return m.isAuthenticating || m.isAuthenticatingAccount || m.isVerifying || m.isLoadingPlaylists || m.isCreatingPlaylist
+}
+
+// Update handles messages, animating the spinner while waiting for Google
 func (m *YouTubeSetupModel) Update(msg tea.Msg) (*YouTubeSetupModel, tea.Cmd) {
+	if tick, ok := msg.(spinner.TickMsg); ok {
+		if tick.ID == m.spinner.ID() {
+			m.now = tick.Time
+		}
+		return m, m.spinner.update(tick, m.waiting())
+	}
+	m.now = time.Now()
+	m, cmd := m.update(msg)
+	return m, tea.Batch(cmd, m.spinner.start(m.waiting()))
+}
+
+// update handles messages other than spinner ticks
+func (m *YouTubeSetupModel) update(msg tea.Msg) (*YouTubeSetupModel, tea.Cmd) {
 	var cmd tea.Cmd
 
+	// Keys go to the file browser while it is open
+	if m.fileBrowser != nil {
+		switch msg.(type) {
+		case tea.KeyMsg, tea.WindowSizeMsg:
+			return m.updateFileBrowser(msg)
+		}
+	}
+
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
@@ -181,56 +257,86 @@ func (m *YouTubeSetupModel) Update(msg tea.Msg) (*YouTubeSetupModel, tea.Cmd) {
 		return m.handleKeyMsg(msg)
 
 	case youtubeAuthStartedMsg:
+		if msg.state != currentAuthState {
+			// The flow was aborted before its URL arrived
+			return m, nil
+		}
 		m.step = YouTubeStepAuthenticating
 		m.isAuthenticating = true
 		m.authURL = msg.authURL
+		m.resetAuthCodeInput()
+		m.authDeadline = time.Now().Add(authTimeout)
 		// Start waiting for the auth result
 		return m, m.waitForAuthResult()
 
 	case youtubeAuthCompleteMsg:
+		if errors.Is(msg.err, context.Canceled) {
+			// Aborted by the user; abortAuth already left the auth screen
+			return m, nil
+		}
 		m.isAuthenticating = false
+		m.authCodeInput.Blur()
 		if msg.err != nil {
 			m.step = YouTubeStepError
-			m.errorMessage = msg.err.Error()
+			m.errorMessage = youtube.FriendlyError(msg.err)
 		} else {
 			m.step = YouTubeStepConnected
 			m.channelName = msg.channelName
-			// Save channel name to config
+			m.accountEmail = msg.email
+			// Save channel name and account email to config
 			m.cfg.YouTube.ChannelName = msg.channelName
+			m.cfg.YouTube.Email = msg.email
 			_ = config.Save(m.cfg)
 		}
 		return m, nil
 
 	case youtubeDisconnectMsg:
+		m.isRevoking = false
+		if msg.revokeErr != nil {
+			m.errorMessage = revokeFailedMessage(msg.revokeErr)
+		}
 		m.authStatus = youtube.AuthStatusConfigured
 		m.channelName = ""
+		m.accountEmail = ""
 		m.cfg.YouTube.ChannelName = ""
+		m.cfg.YouTube.Email = ""
 		_ = config.Save(m.cfg)
 		m.step = YouTubeStepCredentials
 		return m, nil
 
 	case youtubeVerifyCompleteMsg:
+		if m.networkCallCanceled(msg.err) {
+			// Canceled by the user; cancelNetworkCall already left the step
+			return m, nil
+		}
+		m.finishNetworkCall()
 		m.isVerifying = false
 		if msg.err != nil {
-			m.verifyError = msg.err.Error()
+			m.verifyError = youtube.FriendlyError(msg.err)
 			m.step = YouTubeStepVerified
 		} else {
 			m.channelName = msg.channelName
 			m.channelID = msg.channelID
+			m.accountEmail = msg.email
 			m.playlists = msg.playlists
 			m.verifyError = ""
 			m.step = YouTubeStepVerified
 			// Save channel info to config
 			m.cfg.YouTube.ChannelName = msg.channelName
 			m.cfg.YouTube.ChannelID = msg.channelID
+			m.cfg.YouTube.Email = msg.email
 			_ = config.Save(m.cfg)
 		}
 		return m, nil
 
 	case youtubePlaylistsLoadedMsg:
+		if m.networkCallCanceled(msg.err) {
+			return m, nil
+		}
+		m.finishNetworkCall()
 		m.isLoadingPlaylists = false
 		if msg.err != nil {
-			m.playlistsError = msg.err.Error()
+			m.playlistsError = youtube.FriendlyError(msg.err)
 		} else {
 			m.playlists = msg.playlists
 			m.playlistsError = ""
@@ -238,9 +344,13 @@ func (m *YouTubeSetupModel) Update(msg tea.Msg) (*YouTubeSetupModel, tea.Cmd) {
 		return m, nil
 
 	case youtubePlaylistCreatedMsg:
+		if m.networkCallCanceled(msg.err) {
+			return m, nil
+		}
+		m.finishNetworkCall()
 		m.isCreatingPlaylist = false
 		if msg.err != nil {
-			m.playlistsError = msg.err.Error()
+			m.playlistsError = youtube.FriendlyError(msg.err)
 		} else {
 			// Add the new playlist to our list
 			if msg.playlist != nil {
@@ -256,21 +366,55 @@ func (m *YouTubeSetupModel) Update(msg tea.Msg) (*YouTubeSetupModel, tea.Cmd) {
 		return m, nil
 
 	case youtubeAccountAuthStartedMsg:
+		if msg.state != currentAccountAuthState {
+			return m, nil
+		}
 		m.isAuthenticatingAccount = true
 		m.accountAuthURL = msg.authURL
+		m.resetAuthCodeInput()
+		m.authDeadline = time.Now().Add(authTimeout)
 		return m, m.waitForAccountAuthResult()
 
+	case youtubeAuthCodeExchangedMsg:
+		m.isExchangingCode = false
+		if msg.err != nil {
+			m.authCodeError = youtube.FriendlyError(msg.err)
+		} else {
+			// The waiting auth flow now completes and reports the result
+			m.authCodeInput.SetValue("")
+		}
+		return m, nil
+
+	case youtubeAccountDeletedMsg:
+		m.isRevoking = false
+		m.removeAccount(msg.accountID)
+		if msg.revokeErr != nil {
+			m.errorMessage = revokeFailedMessage(msg.revokeErr)
+		}
+		m.step = YouTubeStepAccounts
+		return m, nil
+
+	case youtubeAccountTestMsg:
+		m.accountTests[msg.accountID] = accountTestResult{err: msg.err, testedAt: time.Now()}
+		return m, nil
+
 	case youtubeAccountAuthCompleteMsg:
+		if errors.Is(msg.err, context.Canceled) {
+			return m, nil
+		}
 		m.isAuthenticatingAccount = false
 		m.accountAuthURL = ""
+		m.authCodeInput.Blur()
 		if msg.err != nil {
-			m.errorMessage = msg.err.Error()
+			m.errorMessage = youtube.FriendlyError(msg.err)
 		} else {
 			// Update account with channel info
 			if m.selectedAccountIndex < len(m.accounts) {
 				acc := m.accounts[m.selectedAccountIndex]
 				acc.ChannelName = msg.channelName
 				acc.ChannelID = msg.channelID
+				acc.Email = msg.email
+				delete(m.accountTests, acc.ID)
 				m.cfg.YouTube.UpdateAccount(acc)
 				_ = config.Save(m.cfg)
 				m.accounts = m.cfg.YouTube.GetAccounts()
@@ -287,8 +431,10 @@ func (m *YouTubeSetupModel) Update(msg tea.Msg) (*YouTubeSetupModel, tea.Cmd) {
 		switch m.focusedInput {
 		case 0:
 			m.clientID, cmd = m.clientID.Update(msg)
+			sanitizeCredentialInput(&m.clientID)
 		default:
 			m.clientSecret, cmd = m.clientSecret.Update(msg)
+			sanitizeCredentialInput(&m.clientSecret)
 		}
 	case YouTubeStepCreatePlaylist:
 		switch m.createPlaylistFocus {
@@ -310,13 +456,38 @@ func (m *YouTubeSetupModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeSetupModel, te
 		return m, tea.Quit
 
 	case "esc":
+		if m.isRevoking {
+			return m, nil
+		}
 		if m.step == YouTubeStepAuthenticating {
-			// Can't cancel during authentication
+			return m.abortAuth()
+		}
+		if m.isAuthenticatingAccount {
+			return m.abortAccountAuth()
+		}
+		if m.networkCancel != nil {
+			m.cancelNetworkCall()
+			return m, nil
+		}
+		switch m.step {
+		case YouTubeStepDisconnect:
+			m.step = YouTubeStepConnected
+			return m, nil
+		case YouTubeStepAccountDelete:
+			m.step = YouTubeStepAccounts
 			return m, nil
 		}
 		return m, func() tea.Msg { return backToMenuMsg{} }
 	}
 
+	// While waiting for authorization, keys go to the paste-code input
+	if m.step == YouTubeStepAuthenticating {
+		return m.handleAuthCodeKey(msg, currentAuthState)
+	}
+	if m.isAuthenticatingAccount {
+		return m.handleAuthCodeKey(msg, currentAccountAuthState)
+	}
+
 	// Handle step-specific keys
 	switch m.step {
 	case YouTubeStepCredentials:
@@ -335,13 +506,19 @@ func (m *YouTubeSetupModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeSetupModel, te
 		case "enter":
 			return m.handleEnter()
 
+		case "ctrl+o":
+			m.openClientSecretBrowser()
+			return m, nil
+
 		default:
 			// Forward all other keys to the focused text input
 			var cmd tea.Cmd
 			if m.focusedInput == 0 {
 				m.clientID, cmd = m.clientID.Update(msg)
+				sanitizeCredentialInput(&m.clientID)
 			} else {
 				m.clientSecret, cmd = m.clientSecret.Update(msg)
+				sanitizeCredentialInput(&m.clientSecret)
 			}
 			return m, cmd
 		}
@@ -368,18 +545,20 @@ func (m *YouTubeSetupModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeSetupModel, te
 		case "enter":
 			return m, func() tea.Msg { return backToMenuMsg{} }
 		case "d":
-			return m, m.disconnect()
+			m.revokeAtGoogle = false
+			m.step = YouTubeStepDisconnect
+			return m, nil
 		case "v", "t":
 			// Verify/Test credentials
 			m.step = YouTubeStepVerifying
 			m.isVerifying = true
-			return m, m.verifyCredentials()
+			return m, m.verifyCredentials(m.startNetworkCall(YouTubeStepConnected))
 		case "p":
 			// Manage playlists
 			m.step = YouTubeStepPlaylists
 			m.isLoadingPlaylists = true
 			m.playlistPage = 0
-			return m, m.loadPlaylists()
+			return m, m.loadPlaylists(m.startNetworkCall(YouTubeStepConnected))
 		case "a":
 			// Manage accounts
 			m.accounts = m.cfg.YouTube.GetAccounts()
@@ -426,7 +605,7 @@ func (m *YouTubeSetupModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeSetupModel, te
 		case "r":
 			// Refresh playlists
 			m.isLoadingPlaylists = true
-			return m, m.loadPlaylists()
+			return m, m.loadPlaylists(m.startNetworkCall(YouTubeStepPlaylists))
 		case "up", "k":
 			if m.playlistPage > 0 {
 				m.playlistPage--
@@ -483,7 +662,7 @@ func (m *YouTubeSetupModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeSetupModel, te
 				}
 				m.isCreatingPlaylist = true
 				m.playlistsError = ""
-				return m, m.createPlaylist()
+				return m, m.createPlaylist(m.startNetworkCall(YouTubeStepCreatePlaylist))
 			}
 			// Move to next field
 			m.createPlaylistFocus = (m.createPlaylistFocus + 1) % 3
@@ -524,15 +703,35 @@ func (m *YouTubeSetupModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeSetupModel, te
 			if m.selectedAccountIndex < len(m.accounts)-1 {
 				m.selectedAccountIndex++
 			}
+		case "shift+up", "K":
+			m.moveSelectedAccount(-1)
+			return m, nil
+		case "shift+down", "J":
+			m.moveSelectedAccount(1)
+			return m, nil
+		case "s":
+			// Mark the selected account as default, or clear it if it already is
+			if len(m.accounts) > 0 && m.selectedAccountIndex < len(m.accounts) {
+				acc := m.accounts[m.selectedAccountIndex]
+				if m.cfg.YouTube.IsDefaultAccount(acc.ID) {
+					m.cfg.YouTube.SetDefaultAccount("")
+				} else {
+					m.cfg.YouTube.SetDefaultAccount(acc.ID)
+				}
+				_ = config.Save(m.cfg)
+			}
+			return m, nil
 		case "n", "a":
 			// Add new account
 			m.accountName.SetValue("")
 			m.accountClientID.SetValue("")
 			m.accountClientSecret.SetValue("")
+			m.accountRedirectURI.SetValue("")
 			m.accountFormFocus = 0
 			m.accountName.Focus()
 			m.accountClientID.Blur()
 			m.accountClientSecret.Blur()
+			m.accountRedirectURI.Blur()
 			m.editingAccountID = ""
 			m.step = YouTubeStepAccountAdd
 			return m, textinput.Blink
@@ -543,10 +742,12 @@ func (m *YouTubeSetupModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeSetupModel, te
 				m.accountName.SetValue(acc.Name)
 				m.accountClientID.SetValue(acc.ClientID)
 				m.accountClientSecret.SetValue(acc.ClientSecret)
+				m.accountRedirectURI.SetValue(acc.RedirectURI)
 				m.accountFormFocus = 0
 				m.accountName.Focus()
 				m.accountClientID.Blur()
 				m.accountClientSecret.Blur()
+				m.accountRedirectURI.Blur()
 				m.editingAccountID = acc.ID
 				m.step = YouTubeStepAccountEdit
 				return m, textinput.Blink
@@ -554,6 +755,7 @@ func (m *YouTubeSetupModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeSetupModel, te
 		case "d":
 			// Delete selected account
 			if len(m.accounts) > 0 && m.selectedAccountIndex < len(m.accounts) {
+				m.revokeAtGoogle = false
 				m.step = YouTubeStepAccountDelete
 				return m, nil
 			}
@@ -562,7 +764,24 @@ func (m *YouTubeSetupModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeSetupModel, te
 			if len(m.accounts) > 0 && m.selectedAccountIndex < len(m.accounts) {
 				acc := m.accounts[m.selectedAccountIndex]
 				if acc.IsConfigured() {
-					return m, m.startAccountAuth(acc)
+					return m, m.startAccountAuth(acc, false)
+				}
+			}
+		case "r":
+			// Re-authenticate selected account, keeping its name and defaults
+			if len(m.accounts) > 0 && m.selectedAccountIndex < len(m.accounts) {
+				acc := m.accounts[m.selectedAccountIndex]
+				if acc.IsConfigured() {
+					return m, m.startAccountAuth(acc, true)
+				}
+			}
+		case "t":
+			// Test the selected account's connection
+			if len(m.accounts) > 0 && m.selectedAccountIndex < len(m.accounts) {
+				acc := m.accounts[m.selectedAccountIndex]
+				if youtube.IsAccountAuthenticated(&m.cfg.YouTube, config.GetConfigDir(), acc.ID) &&
+					!m.accountTests[acc.ID].testing {
+					return m, m.testAccountConnection(acc)
 				}
 			}
 		}
@@ -575,29 +794,33 @@ func (m *YouTubeSetupModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeSetupModel, te
 		case "esc":
 			m.step = YouTubeStepAccounts
 			return m, nil
+		case "ctrl+o":
+			m.openClientSecretBrowser()
+			return m, nil
 		case "tab", "shift+tab":
-			// Cycle through fields: name -> clientID -> clientSecret -> name
-			m.accountFormFocus = (m.accountFormFocus + 1) % 3
+			// Cycle through fields: name -> clientID -> clientSecret -> redirectURI -> name
+			m.accountFormFocus = (m.accountFormFocus + 1) % 4
+			m.accountName.Blur()
+			m.accountClientID.Blur()
+			m.accountClientSecret.Blur()
+			m.accountRedirectURI.Blur()
 			switch m.accountFormFocus {
 			case 0:
 				m.accountName.Focus()
-				m.accountClientID.Blur()
-				m.accountClientSecret.Blur()
 			case 1:
-				m.accountName.Blur()
 				m.accountClientID.Focus()
-				m.accountClientSecret.Blur()
 			case 2:
-				m.accountName.Blur()
-				m.accountClientID.Blur()
 				m.accountClientSecret.Focus()
+			case 3:
+				m.accountRedirectURI.Focus()
 			}
 			return m, textinput.Blink
 		case "enter":
 			// Save account
 			name := strings.TrimSpace(m.accountName.Value())
-			clientID := strings.TrimSpace(m.accountClientID.Value())
-			clientSecret := strings.TrimSpace(m.accountClientSecret.Value())
+			clientID := youtube.SanitizeCredential(m.accountClientID.Value())
+			clientSecret := youtube.SanitizeCredential(m.accountClientSecret.Value())
+			redirectURI := strings.TrimSpace(m.accountRedirectURI.Value())
 
 			if name == "" {
 				m.errorMessage = "Account name is required"
@@ -607,6 +830,12 @@ func (m *YouTubeSetupModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeSetupModel, te
 				m.errorMessage = err.Error()
 				return m, nil
 			}
+			if redirectURI != "" {
+				if _, err := youtube.ParseRedirectURI(redirectURI); err != nil {
+					m.errorMessage = err.Error()
+					return m, nil
+				}
+			}
 
 			if m.step == YouTubeStepAccountAdd {
 				// Add new account
@@ -614,6 +843,7 @@ func (m *YouTubeSetupModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeSetupModel, te
 					Name:         name,
 					ClientID:     clientID,
 					ClientSecret: clientSecret,
+					RedirectURI:  redirectURI,
 				}
 				m.cfg.YouTube.AddAccount(newAccount)
 			} else {
@@ -623,6 +853,7 @@ func (m *YouTubeSetupModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeSetupModel, te
 					acc.Name = name
 					acc.ClientID = clientID
 					acc.ClientSecret = clientSecret
+					acc.RedirectURI = redirectURI
 					m.cfg.YouTube.UpdateAccount(*acc)
 				}
 			}
@@ -644,36 +875,60 @@ func (m *YouTubeSetupModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeSetupModel, te
 				m.accountName, cmd = m.accountName.Update(msg)
 			case 1:
 				m.accountClientID, cmd = m.accountClientID.Update(msg)
+				sanitizeCredentialInput(&m.accountClientID)
 			case 2:
 				m.accountClientSecret, cmd = m.accountClientSecret.Update(msg)
+				sanitizeCredentialInput(&m.accountClientSecret)
+			case 3:
+				m.accountRedirectURI, cmd = m.accountRedirectURI.Update(msg)
 			}
 			return m, cmd
 		}
 
 	case YouTubeStepAccountDelete:
+		if m.isRevoking {
+			return m, nil
+		}
 		switch msg.String() {
 		case "y", "Y":
 			// Confirm delete
 			if len(m.accounts) > 0 && m.selectedAccountIndex < len(m.accounts) {
 				acc := m.accounts[m.selectedAccountIndex]
-				m.cfg.YouTube.RemoveAccount(acc.ID)
+				if m.revokeAtGoogle {
+					// The account is removed once the revocation has finished
+					m.isRevoking = true
+					return m, m.revokeAccount(acc)
+				}
 				// Also delete the token file
 				_ = youtube.DeleteTokenForAccount(config.GetConfigDir(), acc.ID)
-				if err := config.Save(m.cfg); err != nil {
-					m.errorMessage = "Failed to save: " + err.Error()
-				}
-				m.accounts = m.cfg.YouTube.GetAccounts()
-				if m.selectedAccountIndex >= len(m.accounts) && m.selectedAccountIndex > 0 {
-					m.selectedAccountIndex--
-				}
+				m.removeAccount(acc.ID)
 			}
 			m.step = YouTubeStepAccounts
 			return m, nil
+		case "r", " ":
+			m.revokeAtGoogle = !m.revokeAtGoogle
+			return m, nil
 		case "n", "N", "esc":
 			m.step = YouTubeStepAccounts
 			return m, nil
 		}
 
+	case YouTubeStepDisconnect:
+		if m.isRevoking {
+			return m, nil
+		}
+		switch msg.String() {
+		case "y", "Y":
+			m.isRevoking = m.revokeAtGoogle
+			return m, m.disconnect(m.revokeAtGoogle)
+		case "r", " ":
+			m.revokeAtGoogle = !m.revokeAtGoogle
+			return m, nil
+		case "n", "N":
+			m.step = YouTubeStepConnected
+			return m, nil
+		}
+
 	case YouTubeStepError:
 		switch msg.String() {
 		case "enter", "r":
@@ -703,8 +958,8 @@ func (m *YouTubeSetupModel) handleEnter() (*YouTubeSetupModel, tea.Cmd) {
 
 	case YouTubeStepCredentials:
 		// Validate and save credentials
-		clientID := strings.TrimSpace(m.clientID.Value())
-		clientSecret := strings.TrimSpace(m.clientSecret.Value())
+		clientID := youtube.SanitizeCredential(m.clientID.Value())
+		clientSecret := youtube.SanitizeCredential(m.clientSecret.Value())
 
 		if err := youtube.ValidateCredentials(context.Background(), clientID, clientSecret); err != nil {
 			m.errorMessage = err.Error()
@@ -735,10 +990,84 @@ func (m *YouTubeSetupModel) handleEnter() (*YouTubeSetupModel, tea.Cmd) {
 	return m, nil
 }
 
+// authTimeout is how long the browser authorization may take
+const authTimeout = 5 * time.Minute
+
 // authState holds the state for async authentication
 type authState struct {
 	urlChan    chan string
 	resultChan chan tea.Msg
+	auth       *youtube.Auth      // In-progress flow, for exchanging a pasted code
+	cancel     context.CancelFunc // Aborts the in-progress flow
+}
+
+// abortAuth cancels the pending authentication and returns to the
+// credentials step, e.g. when the browser never opened or the wrong Google
+// account was picked
+func (m *YouTubeSetupModel) abortAuth() (*YouTubeSetupModel, tea.Cmd) {
+	if currentAuthState != nil {
+		currentAuthState.cancel()
+		currentAuthState = nil
+	}
+	m.isAuthenticating = false
+	m.authURL = ""
+	m.authCodeInput.Blur()
+	m.step = YouTubeStepCredentials
+	return m, nil
+}
+
+// abortAccountAuth cancels the pending authentication of an account and
+// returns to the account list
+func (m *YouTubeSetupModel) abortAccountAuth() (*YouTubeSetupModel, tea.Cmd) {
+	if currentAccountAuthState != nil {
+		currentAccountAuthState.cancel()
+		currentAccountAuthState = nil
+	}
+	m.isAuthenticatingAccount = false
+	m.accountAuthURL = ""
+	m.authCodeInput.Blur()
+	m.step = YouTubeStepAccounts
+	return m, nil
+}
+
+// authTimeRemaining describes how long the pending auth has left
+func (m *YouTubeSetupModel) authTimeRemaining() string {
+	remaining := m.authDeadline.Sub(m.now).Round(time.Second)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return fmt.Sprintf("times out in %d:%02d", int(remaining.Minutes()), int(remaining.Seconds())%60)
+}
+
+// resetAuthCodeInput clears and focuses the paste-code input for a new auth flow
+func (m *YouTubeSetupModel) resetAuthCodeInput() {
+	m.authCodeInput.SetValue("")
+	m.authCodeInput.Focus()
+	m.authCodeError = ""
+	m.isExchangingCode = false
+}
+
+// handleAuthCodeKey handles keys while waiting for authorization. Enter
+// exchanges a pasted code for the in-progress flow; other keys edit the input.
+func (m *YouTubeSetupModel) handleAuthCodeKey(msg tea.KeyMsg, state *authState) (*YouTubeSetupModel, tea.Cmd) {
+	if msg.String() == "enter" {
+		code := strings.TrimSpace(m.authCodeInput.Value())
+		if code == "" || m.isExchangingCode || state == nil || state.auth == nil {
+			return m, nil
+		}
+		m.isExchangingCode = true
+		m.authCodeError = ""
+		auth := state.auth
+		return m, func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			return youtubeAuthCodeExchangedMsg{err: auth.ExchangeCode(ctx, code)}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.authCodeInput, cmd = m.authCodeInput.Update(msg)
+	return m, cmd
 }
 
 var currentAuthState *authState
@@ -750,29 +1079,32 @@ func (m *YouTubeSetupModel) startAuth() tea.Cmd {
 	configDir := config.GetConfigDir()
 
 	// Create channels for communication
-	currentAuthState = &authState{
+	auth := youtube.NewAuth(clientID, clientSecret, configDir)
+	auth.SetRedirectURI(m.cfg.YouTube.RedirectURI)
+	ctx, cancel := context.WithTimeout(context.Background(), authTimeout)
+	state := &authState{
 		urlChan:    make(chan string, 1),
 		resultChan: make(chan tea.Msg, 1),
+		auth:       auth,
+		cancel:     cancel,
 	}
+	currentAuthState = state
 
 	// Start authentication in background goroutine
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
 		defer cancel()
 
-		auth := youtube.NewAuth(clientID, clientSecret, configDir)
-
 		// Use the callback to capture and send the URL
 		err := auth.AuthenticateWithCallback(ctx, func(url string) {
 			// Send the URL through the channel
 			select {
-			case currentAuthState.urlChan <- url:
+			case state.urlChan <- url:
 			default:
 			}
 		})
 
 		if err != nil {
-			currentAuthState.resultChan <- youtubeAuthCompleteMsg{err: err}
+			state.resultChan <- youtubeAuthCompleteMsg{err: err}
 			return
 		}
 
@@ -782,70 +1114,249 @@ func (m *YouTubeSetupModel) startAuth() tea.Cmd {
 			channelName = "Unknown Channel"
 		}
 
-		currentAuthState.resultChan <- youtubeAuthCompleteMsg{channelName: channelName}
+		// The email is informational; it is empty if it cannot be read
+		email, _ := auth.GetAccountEmail(ctx)
+
+		state.resultChan <- youtubeAuthCompleteMsg{channelName: channelName, email: email}
 	}()
 
 	// Return a command that waits for the URL and signals auth started
 	return func() tea.Msg {
 		// Wait for the URL from the auth flow (with timeout)
 		select {
-		case url := <-currentAuthState.urlChan:
-			return youtubeAuthStartedMsg{authURL: url}
+		case url := <-state.urlChan:
+			return youtubeAuthStartedMsg{authURL: url, state: state}
 		case <-time.After(5 * time.Second):
 			// If we don't get a URL in 5 seconds, start anyway without it
-			return youtubeAuthStartedMsg{authURL: ""}
+			return youtubeAuthStartedMsg{authURL: "", state: state}
 		}
 	}
 }
 
 // waitForAuthResult returns a command that waits for auth to complete
 func (m *YouTubeSetupModel) waitForAuthResult() tea.Cmd {
+	state := currentAuthState
 	return func() tea.Msg {
-		if currentAuthState == nil {
+		if state == nil {
 			return youtubeAuthCompleteMsg{err: nil}
 		}
 		// Wait for result with timeout
 		select {
-		case msg := <-currentAuthState.resultChan:
+		case msg := <-state.resultChan:
 			return msg
-		case <-time.After(5 * time.Minute):
+		case <-time.After(authTimeout):
 			return youtubeAuthCompleteMsg{err: context.DeadlineExceeded}
 		}
 	}
 }
 
-// disconnect disconnects the YouTube account
-func (m *YouTubeSetupModel) disconnect() tea.Cmd {
+// disconnect disconnects the YouTube account, revoking its access at
+// Google first if revoke is set. The local token is deleted either way.
+func (m *YouTubeSetupModel) disconnect(revoke bool) tea.Cmd {
+	clientID := m.cfg.YouTube.ClientID
+	clientSecret := m.cfg.YouTube.ClientSecret
 	configDir := config.GetConfigDir()
 
 	return func() tea.Msg {
+		var revokeErr error
+		if revoke {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			revokeErr = youtube.NewAuth(clientID, clientSecret, configDir).RevokeToken(ctx)
+		}
+
 		// Delete token
 		_ = youtube.DeleteToken(configDir)
-		return youtubeDisconnectMsg{}
+		return youtubeDisconnectMsg{revokeErr: revokeErr}
 	}
 }
 
-// verifyCredentials tests the credentials and fetches channel/playlist info
-func (m *YouTubeSetupModel) verifyCredentials() tea.Cmd {
-	clientID := m.cfg.YouTube.ClientID
-	clientSecret := m.cfg.YouTube.ClientSecret
+// revokeAccount revokes an account's access at Google and deletes its
+// local token, before the account itself is removed
+func (m *YouTubeSetupModel) revokeAccount(acc youtube.Account) tea.Cmd {
 	configDir := config.GetConfigDir()
 
 	return func() tea.Msg {
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
+		auth := youtube.NewAuthForAccount(acc.ClientID, acc.ClientSecret, configDir, acc.ID)
+		revokeErr := auth.RevokeToken(ctx)
+		_ = youtube.DeleteTokenForAccount(configDir, acc.ID)
+		return youtubeAccountDeletedMsg{accountID: acc.ID, revokeErr: revokeErr}
+	}
+}
+
+// removeAccount removes an account from the config and keeps the selection
+// within the list
+func (m *YouTubeSetupModel) removeAccount(accountID string) {
+	m.cfg.YouTube.RemoveAccount(accountID)
+	if err := config.Save(m.cfg); err != nil {
+		m.errorMessage = "Failed to save: " + err.Error()
+	}
+	m.accounts = m.cfg.YouTube.GetAccounts()
+	if m.selectedAccountIndex >= len(m.accounts) && m.selectedAccountIndex > 0 {
+		m.selectedAccountIndex--
+	}
+}
+
+// revokeFailedMessage explains a failed revocation; the local token is gone
+// by then, so access can only be removed from the Google account
+func revokeFailedMessage(err error) string {
+	return "Removed locally, but revoking access at Google failed (" + err.Error() +
+		"). Remove the app at myaccount.google.com/permissions"
+}
+
+// moveSelectedAccount moves the selected account up or down in the list and
+// keeps it selected
+func (m *YouTubeSetupModel) moveSelectedAccount(delta int) {
+	if len(m.accounts) == 0 || m.selectedAccountIndex >= len(m.accounts) {
+		return
+	}
+	if !m.cfg.YouTube.MoveAccount(m.accounts[m.selectedAccountIndex].ID, delta) {
+		return
+	}
+	_ = config.Save(m.cfg)
+	m.accounts = m.cfg.YouTube.GetAccounts()
+	m.selectedAccountIndex += delta
+}
+
+// testAccountConnection checks that an account's stored token actually works
+// by making an API call, rather than only checking that a token exists
+func (m *YouTubeSetupModel) testAccountConnection(acc youtube.Account) tea.Cmd {
+	if m.accountTests == nil {
+		m.accountTests = make(map[string]accountTestResult)
+	}
+	m.accountTests[acc.ID] = accountTestResult{testing: true}
+	configDir := config.GetConfigDir()
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		auth := youtube.NewAuthForAccount(acc.ClientID, acc.ClientSecret, configDir, acc.ID)
+		return youtubeAccountTestMsg{accountID: acc.ID, err: auth.TestConnection(ctx)}
+	}
+}
+
+// startNetworkCall starts tracking a verify or playlist call and returns
+// the context it must run with. Esc cancels the context and goes back to
+// returnStep; the call also times out after 30 seconds.
+func (m *YouTubeSetupModel) startNetworkCall(returnStep YouTubeSetupStep) context.Context {
+	m.finishNetworkCall()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	m.networkCancel = cancel
+	m.networkStarted = time.Now()
+	m.networkReturnStep = returnStep
+	m.networkRetry = &retryNotice{}
+	return ctx
+}
+
+// finishNetworkCall releases the context of the tracked call once its result
+// has arrived
+func (m *YouTubeSetupModel) finishNetworkCall() {
+	if m.networkCancel != nil {
+		m.networkCancel()
+		m.networkCancel = nil
+	}
+}
+
+// cancelNetworkCall cancels the tracked call and returns to the step it was
+// started from. The canceled call's result is ignored when it arrives.
+func (m *YouTubeSetupModel) cancelNetworkCall() {
+	m.finishNetworkCall()
+	m.isVerifying = false
+	m.isLoadingPlaylists = false
+	m.isCreatingPlaylist = false
+	m.step = m.networkReturnStep
+}
+
+// networkCallCanceled returns true if a verify or playlist result belongs to
+// a canceled call. A call that finished just before esc was pressed reports
+// success, so a result without a tracked call is ignored too.
+func (m *YouTubeSetupModel) networkCallCanceled(err error) bool {
+	return m.networkCancel == nil || errors.Is(err, context.Canceled)
+}
+
+// canceledOr returns ctx's error if ctx was canceled, otherwise err. API
+// errors do not always wrap context.Canceled, and some failures below are
+// tolerated, so the context is checked directly.
+func canceledOr(ctx context.Context, err error) error {
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return ctx.Err()
+	}
+	return err
+}
+
+// renderNetworkSpinner renders the spinner line for the tracked call with
+// the time elapsed so far
+func (m *YouTubeSetupModel) renderNetworkSpinner(message string) string {
+	messageStyle := lipgloss.NewStyle().
+		Foreground(ColorWhite).
+		Bold(true)
+
+	elapsedStyle := lipgloss.NewStyle().
+		Foreground(ColorGray)
+
+	elapsed := max(m.now.Sub(m.networkStarted), 0).Truncate(time.Second)
+	line := m.spinner.View() + " " + messageStyle.Render(message) +
+		elapsedStyle.Render(fmt.Sprintf(" (%s)", elapsed))
+	if retry := m.networkRetry.String(); retry != "" {
+		line += "\n" + renderRetryNotice(retry)
+	}
+	return line
+}
+
+// retryNotice holds the latest retry of a YouTube call running in a command,
+// so the screen waiting for it can show that it is rate limited
+type retryNotice struct {
+	mu   sync.Mutex
+	text string
+}
+
+// set records a retry; pass it to youtube.Uploader.SetRetryNotify
+func (n *retryNotice) set(info youtube.RetryInfo) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.text = info.String()
+}
+
+// String returns the latest retry, or "" if the call was not retried
+func (n *retryNotice) String() string {
+	if n == nil {
+		return ""
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.text
+}
+
+// renderRetryNotice renders a "rate limited, retrying" line
+func renderRetryNotice(text string) string {
+	return lipgloss.NewStyle().
+		Foreground(ColorOrange).
+		Render("⚠ " + text)
+}
+
+// verifyCredentials tests the credentials and fetches channel/playlist info
+func (m *YouTubeSetupModel) verifyCredentials(ctx context.Context) tea.Cmd {
+	clientID := m.cfg.YouTube.ClientID
+	clientSecret := m.cfg.YouTube.ClientSecret
+	configDir := config.GetConfigDir()
+	retry := m.networkRetry
+
+	return func() tea.Msg {
 		auth := youtube.NewAuth(clientID, clientSecret, configDir)
 
 		// Test the connection
 		if err := auth.TestConnection(ctx); err != nil {
-			return youtubeVerifyCompleteMsg{err: err}
+			return youtubeVerifyCompleteMsg{err: canceledOr(ctx, err)}
 		}
 
 		// Get channel info
 		channelName, err := auth.GetChannelName(ctx)
 		if err != nil {
-			return youtubeVerifyCompleteMsg{err: err}
+			return youtubeVerifyCompleteMsg{err: canceledOr(ctx, err)}
 		}
 
 		// Get channel ID
@@ -854,15 +1365,20 @@ func (m *YouTubeSetupModel) verifyCredentials() tea.Cmd {
 			channelID = ""
 		}
 
+		// Get the Google account email (empty for tokens without the email scope)
+		email, _ := auth.GetAccountEmail(ctx)
+
 		// Get playlists
 		uploader, err := youtube.NewUploader(ctx, auth)
 		if err != nil {
 			return youtubeVerifyCompleteMsg{
 				channelName: channelName,
 				channelID:   channelID,
-				err:         nil,
+				email:       email,
+				err:         canceledOr(ctx, nil),
 			}
 		}
+		uploader.SetRetryNotify(retry.set)
 
 		playlists, err := uploader.ListPlaylists(ctx)
 		if err != nil {
@@ -872,30 +1388,31 @@ func (m *YouTubeSetupModel) verifyCredentials() tea.Cmd {
 		return youtubeVerifyCompleteMsg{
 			channelName: channelName,
 			channelID:   channelID,
+			email:       email,
 			playlists:   playlists,
+			err:         canceledOr(ctx, nil),
 		}
 	}
 }
 
 // loadPlaylists fetches playlists from YouTube
-func (m *YouTubeSetupModel) loadPlaylists() tea.Cmd {
+func (m *YouTubeSetupModel) loadPlaylists(ctx context.Context) tea.Cmd {
 	clientID := m.cfg.YouTube.ClientID
 	clientSecret := m.cfg.YouTube.ClientSecret
 	configDir := config.GetConfigDir()
+	retry := m.networkRetry
 
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
-
 		auth := youtube.NewAuth(clientID, clientSecret, configDir)
 		uploader, err := youtube.NewUploader(ctx, auth)
 		if err != nil {
-			return youtubePlaylistsLoadedMsg{err: err}
+			return youtubePlaylistsLoadedMsg{err: canceledOr(ctx, err)}
 		}
+		uploader.SetRetryNotify(retry.set)
 
 		playlists, err := uploader.ListPlaylists(ctx)
 		if err != nil {
-			return youtubePlaylistsLoadedMsg{err: err}
+			return youtubePlaylistsLoadedMsg{err: canceledOr(ctx, err)}
 		}
 
 		return youtubePlaylistsLoadedMsg{playlists: playlists}
@@ -903,27 +1420,26 @@ func (m *YouTubeSetupModel) loadPlaylists() tea.Cmd {
 }
 
 // createPlaylist creates a new playlist on YouTube
-func (m *YouTubeSetupModel) createPlaylist() tea.Cmd {
+func (m *YouTubeSetupModel) createPlaylist(ctx context.Context) tea.Cmd {
 	clientID := m.cfg.YouTube.ClientID
 	clientSecret := m.cfg.YouTube.ClientSecret
 	configDir := config.GetConfigDir()
 	title := strings.TrimSpace(m.newPlaylistTitle.Value())
 	desc := strings.TrimSpace(m.newPlaylistDesc.Value())
 	privacy := m.newPlaylistPrivacy
+	retry := m.networkRetry
 
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
-
 		auth := youtube.NewAuth(clientID, clientSecret, configDir)
 		uploader, err := youtube.NewUploader(ctx, auth)
 		if err != nil {
-			return youtubePlaylistCreatedMsg{err: err}
+			return youtubePlaylistCreatedMsg{err: canceledOr(ctx, err)}
 		}
+		uploader.SetRetryNotify(retry.set)
 
 		playlist, err := uploader.CreatePlaylist(ctx, title, desc, privacy)
 		if err != nil {
-			return youtubePlaylistCreatedMsg{err: err}
+			return youtubePlaylistCreatedMsg{err: canceledOr(ctx, err)}
 		}
 
 		return youtubePlaylistCreatedMsg{playlist: playlist}
@@ -933,70 +1449,79 @@ func (m *YouTubeSetupModel) createPlaylist() tea.Cmd {
 // accountAuthState holds the state for async account authentication
 var currentAccountAuthState *authState
 
-// startAccountAuth starts OAuth authentication for a specific account
-func (m *YouTubeSetupModel) startAccountAuth(acc youtube.Account) tea.Cmd {
+// startAccountAuth starts OAuth authentication for a specific account. With
+// reauth the consent screen is forced so a fresh refresh token replaces the
+// existing one.
+func (m *YouTubeSetupModel) startAccountAuth(acc youtube.Account, reauth bool) tea.Cmd {
 	clientID := acc.ClientID
 	clientSecret := acc.ClientSecret
 	accountID := acc.ID
 	configDir := config.GetConfigDir()
 
 	// Create channels for communication
-	currentAccountAuthState = &authState{
+	auth := youtube.NewAuthForAccount(clientID, clientSecret, configDir, accountID)
+	auth.SetForceConsent(reauth)
+	auth.SetRedirectURI(acc.RedirectURI)
+	ctx, cancel := context.WithTimeout(context.Background(), authTimeout)
+	state := &authState{
 		urlChan:    make(chan string, 1),
 		resultChan: make(chan tea.Msg, 1),
+		auth:       auth,
+		cancel:     cancel,
 	}
+	currentAccountAuthState = state
 
 	// Start authentication in background goroutine
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
 		defer cancel()
 
-		auth := youtube.NewAuthForAccount(clientID, clientSecret, configDir, accountID)
-
 		// Use the callback to capture and send the URL
 		err := auth.AuthenticateWithCallback(ctx, func(url string) {
 			select {
-			case currentAccountAuthState.urlChan <- url:
+			case state.urlChan <- url:
 			default:
 			}
 		})
 
 		if err != nil {
-			currentAccountAuthState.resultChan <- youtubeAccountAuthCompleteMsg{err: err}
+			state.resultChan <- youtubeAccountAuthCompleteMsg{err: err}
 			return
 		}
 
 		// Get channel info
 		channelName, _ := auth.GetChannelName(ctx)
 		channelID, _ := auth.GetChannelID(ctx)
+		email, _ := auth.GetAccountEmail(ctx)
 
-		currentAccountAuthState.resultChan <- youtubeAccountAuthCompleteMsg{
+		state.resultChan <- youtubeAccountAuthCompleteMsg{
 			channelName: channelName,
 			channelID:   channelID,
+			email:       email,
 		}
 	}()
 
 	// Return a command that waits for the URL and signals auth started
 	return func() tea.Msg {
 		select {
-		case url := <-currentAccountAuthState.urlChan:
-			return youtubeAccountAuthStartedMsg{authURL: url}
+		case url := <-state.urlChan:
+			return youtubeAccountAuthStartedMsg{authURL: url, state: state}
 		case <-time.After(5 * time.Second):
-			return youtubeAccountAuthStartedMsg{authURL: ""}
+			return youtubeAccountAuthStartedMsg{authURL: "", state: state}
 		}
 	}
 }
 
 // waitForAccountAuthResult returns a command that waits for account auth to complete
 func (m *YouTubeSetupModel) waitForAccountAuthResult() tea.Cmd {
+	state := currentAccountAuthState
 	return func() tea.Msg {
-		if currentAccountAuthState == nil {
+		if state == nil {
 			return youtubeAccountAuthCompleteMsg{err: nil}
 		}
 		select {
-		case msg := <-currentAccountAuthState.resultChan:
+		case msg := <-state.resultChan:
 			return msg
-		case <-time.After(5 * time.Minute):
+		case <-time.After(authTimeout):
 			return youtubeAccountAuthCompleteMsg{err: context.DeadlineExceeded}
 		}
 	}
@@ -1008,6 +1533,11 @@ func (m *YouTubeSetupModel) View() string {
 		return "Loading..."
 	}
 
+	if m.fileBrowser != nil {
+		m.fileBrowser.SetSize(m.width, m.height)
+		return m.fileBrowser.View()
+	}
+
 	var content string
 
 	switch m.step {
@@ -1035,6 +1565,8 @@ func (m *YouTubeSetupModel) View() string {
 		content = m.renderAccountForm()
 	case YouTubeStepAccountDelete:
 		content = m.renderAccountDelete()
+	case YouTubeStepDisconnect:
+		content = m.renderDisconnect()
 	case YouTubeStepError:
 		content = m.renderError()
 	}
@@ -1212,10 +1744,6 @@ func (m *YouTubeSetupModel) renderCredentials() string {
 		Foreground(ColorOrange).
 		Bold(true)
 
-	hintStyle := lipgloss.NewStyle().
-		Foreground(ColorGray).
-		Italic(true)
-
 	var rows []string
 
 	rows = append(rows, titleStyle.Render("Enter your Google OAuth credentials:"))
@@ -1228,7 +1756,7 @@ func (m *YouTubeSetupModel) renderCredentials() string {
 		rows = append(rows, labelStyle.Render("  Client ID:"))
 	}
 	rows = append(rows, "  "+m.clientID.View())
-	rows = append(rows, hintStyle.Render("  (ends with .apps.googleusercontent.com)"))
+	rows = append(rows, renderCredentialHint(m.clientID.Value(), youtube.CheckClientIDFormat, "ends with .apps.googleusercontent.com"))
 	rows = append(rows, "")
 
 	// Client Secret
@@ -1238,7 +1766,7 @@ func (m *YouTubeSetupModel) renderCredentials() string {
 		rows = append(rows, labelStyle.Render("  Client Secret:"))
 	}
 	rows = append(rows, "  "+m.clientSecret.View())
-	rows = append(rows, hintStyle.Render("  (starts with GOCSPX-)"))
+	rows = append(rows, renderCredentialHint(m.clientSecret.Value(), youtube.CheckClientSecretFormat, "usually starts with GOCSPX-"))
 
 	// Error message
 	if m.errorMessage != "" {
@@ -1255,24 +1783,113 @@ func (m *YouTubeSetupModel) renderCredentials() string {
 		Foreground(ColorGray).
 		Italic(true)
 
-	helpText := helpStyle.Render("tab: switch field • enter: connect • esc: cancel")
+	helpText := helpStyle.Render("tab: switch field • ctrl+o: import client_secret.json • enter: connect • esc: cancel")
 
 	footer := RenderHelpFooter(helpText, m.width)
 
 	return LayoutWithHeaderFooter(header, content, footer, m.width, m.height)
 }
 
+// openClientSecretBrowser opens the file browser to import the credentials
+// of the credentials or account form from a downloaded client_secret.json
+func (m *YouTubeSetupModel) openClientSecretBrowser() {
+	// Downloads is where the Google Cloud Console saves the file
+	startDir := ""
+	if home, err := os.UserHomeDir(); err == nil {
+		if info, err := os.Stat(filepath.Join(home, "Downloads")); err == nil && info.IsDir() {
+			startDir = filepath.Join(home, "Downloads")
+		}
+	}
+	m.errorMessage = ""
+	m.fileBrowser = NewFileBrowser("Import client_secret.json", startDir, ".json")
+	m.fileBrowser.SetSize(m.width, m.height)
+}
+
+// updateFileBrowser handles messages while the client_secret.json browser
+// is open and fills the credential inputs from the selected file
+func (m *YouTubeSetupModel) updateFileBrowser(msg tea.Msg) (*YouTubeSetupModel, tea.Cmd) {
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		m.width = size.Width
+		m.height = size.Height
+	}
+
+	result, cmd := m.fileBrowser.Update(msg)
+	switch result {
+	case FileBrowserCanceled:
+		m.fileBrowser = nil
+	case FileBrowserSelected:
+		path := m.fileBrowser.SelectedPath()
+		m.fileBrowser = nil
+		clientID, clientSecret, err := youtube.LoadClientSecretFile(path)
+		if err != nil {
+			m.errorMessage = "Import failed: " + err.Error()
+			return m, nil
+		}
+		if m.step == YouTubeStepCredentials {
+			m.clientID.SetValue(clientID)
+			m.clientSecret.SetValue(clientSecret)
+		} else {
+			m.accountClientID.SetValue(clientID)
+			m.accountClientSecret.SetValue(clientSecret)
+		}
+	}
+	return m, cmd
+}
+
+// sanitizeCredentialInput cleans up a credential input as it is typed or
+// pasted, so stray whitespace, quotes or labels never reach the config
+func sanitizeCredentialInput(input *textinput.Model) {
+	value := input.Value()
+	if clean := youtube.SanitizeCredential(value); clean != value {
+		input.SetValue(clean)
+		input.CursorEnd()
+	}
+}
+
+// renderCredentialHint renders the line under a credential input: the
+// expected format while it is empty, then a check mark or the format problem
+// as the user types, so mistakes show up before anything is sent to Google
+func renderCredentialHint(value string, check func(string) error, format string) string {
+	if strings.TrimSpace(value) == "" {
+		return lipgloss.NewStyle().
+			Foreground(ColorGray).
+			Italic(true).
+			Render("  (" + format + ")")
+	}
+	if err := check(value); err != nil {
+		return lipgloss.NewStyle().
+			Foreground(ColorRed).
+			Render("  ✗ " + err.Error())
+	}
+	return lipgloss.NewStyle().
+		Foreground(ColorGreen).
+		Render("  ✓ Looks good")
+}
+
+// renderRedirectURIHint shows the redirect URI that has to be registered for
+// the OAuth client in Google Cloud, or why the entered one can't be used
+func renderRedirectURIHint(value string) string {
+	uri := strings.TrimSpace(value)
+	if uri == "" {
+		return lipgloss.NewStyle().
+			Foreground(ColorGray).
+			Italic(true).
+			Render("  (automatic: http://127.0.0.1:<any port>/callback, works with Desktop app clients)")
+	}
+	if _, err := youtube.ParseRedirectURI(uri); err != nil {
+		return lipgloss.NewStyle().
+			Foreground(ColorRed).
+			Render("  ✗ " + err.Error())
+	}
+	return lipgloss.NewStyle().
+		Foreground(ColorGreen).
+		Render("  ✓ Register exactly " + uri + " as an authorized redirect URI")
+}
+
 // renderAuthenticating renders the authenticating screen
 func (m *YouTubeSetupModel) renderAuthenticating() string {
 	header := RenderHeader("YouTube Setup - Authenticating")
 
-	spinnerFrames := []string{"◐", "◓", "◑", "◒"}
-	frame := spinnerFrames[int(time.Now().UnixMilli()/200)%len(spinnerFrames)]
-
-	spinnerStyle := lipgloss.NewStyle().
-		Foreground(ColorOrange).
-		Bold(true)
-
 	messageStyle := lipgloss.NewStyle().
 		Foreground(ColorWhite).
 		Bold(true)
@@ -1287,12 +1904,11 @@ func (m *YouTubeSetupModel) renderAuthenticating() string {
 		Foreground(ColorGray).
 		Bold(true)
 
-	spinner := spinnerStyle.Render(frame)
 	message := messageStyle.Render("Waiting for authorization...")
 	subMessage := subMessageStyle.Render("A browser window should have opened.\nPlease sign in to your Google account and grant access.")
 
 	var rows []string
-	rows = append(rows, spinner+" "+message)
+	rows = append(rows, m.spinner.View()+" "+message)
 	rows = append(rows, "")
 	rows = append(rows, subMessage)
 	rows = append(rows, "")
@@ -1301,27 +1917,55 @@ func (m *YouTubeSetupModel) renderAuthenticating() string {
 	if m.authURL != "" {
 		rows = append(rows, labelStyle.Render("If browser didn't open, visit this URL:"))
 		rows = append(rows, "")
-		// Wrap the URL if it's too long
-		url := m.authURL
-		if len(url) > 70 {
-			// Show truncated URL with indication it's truncated
-			rows = append(rows, linkStyle.Render(url[:70]+"..."))
-		} else {
-			rows = append(rows, linkStyle.Render(url))
-		}
+		rows = append(rows, m.renderAuthCodeFallback(m.authURL, linkStyle, subMessageStyle)...)
 		rows = append(rows, "")
 	}
 
-	rows = append(rows, subMessageStyle.Render("This may take a moment..."))
+	rows = append(rows, subMessageStyle.Render("This may take a moment... ("+m.authTimeRemaining()+")"))
 
 	content := lipgloss.JoinVertical(lipgloss.Center, rows...)
 
-	helpText := "Waiting for browser authentication..."
+	helpText := "Waiting for browser authentication... • enter: submit pasted code • esc: abort"
 	footer := RenderHelpFooter(helpText, m.width)
 
 	return LayoutWithHeaderFooter(header, content, footer, m.width, m.height)
 }
 
+// authURLWrapWidth is the line length used to show the full auth URL
+const authURLWrapWidth = 70
+
+// renderAuthCodeFallback renders the full auth URL (wrapped, browsers drop the
+// line breaks when it is pasted) and the input for pasting the resulting code
+func (m *YouTubeSetupModel) renderAuthCodeFallback(authURL string, linkStyle, hintStyle lipgloss.Style) []string {
+	var rows []string
+	for len(authURL) > authURLWrapWidth {
+		rows = append(rows, linkStyle.Render(authURL[:authURLWrapWidth]))
+		authURL = authURL[authURLWrapWidth:]
+	}
+	rows = append(rows, linkStyle.Render(authURL))
+
+	rows = append(rows, "")
+	rows = append(rows, hintStyle.Render("No browser on this machine? Open the URL on another device and approve access."))
+	rows = append(rows, hintStyle.Render("Then paste the code, or the whole URL the browser was redirected to:"))
+	rows = append(rows, m.authCodeInput.View())
+
+	if m.isExchangingCode {
+		rows = append(rows, lipgloss.NewStyle().Foreground(ColorOrange).Render("Exchanging code..."))
+	} else if m.authCodeError != "" {
+		rows = append(rows, lipgloss.NewStyle().Foreground(ColorRed).Render(m.authCodeError))
+	}
+	return rows
+}
+
+// accountEmailOrUnknown returns email, or a hint when it is not known (tokens
+// from before the email scope was requested)
+func accountEmailOrUnknown(email string) string {
+	if email == "" {
+		return "unknown (reconnect to show)"
+	}
+	return email
+}
+
 // renderConnected renders the connected screen
 func (m *YouTubeSetupModel) renderConnected() string {
 	header := RenderHeader("YouTube Connected")
@@ -1361,6 +2005,10 @@ func (m *YouTubeSetupModel) renderConnected() string {
 			labelStyle.Render("Channel: "),
 			valueStyle.Render(channelName),
 		),
+		lipgloss.JoinHorizontal(lipgloss.Top,
+			labelStyle.Render("Account: "),
+			valueStyle.Render(accountEmailOrUnknown(m.accountEmail)),
+		),
 		"",
 		lipgloss.JoinHorizontal(lipgloss.Top,
 			labelStyle.Render("Status: "),
@@ -1473,16 +2121,25 @@ func (m *YouTubeSetupModel) renderError() string {
 // Message types for YouTube setup
 type youtubeAuthStartedMsg struct {
 	authURL string
+	state   *authState
 }
 type youtubeAuthCompleteMsg struct {
 	err         error
 	channelName string
+	email       string
+}
+type youtubeDisconnectMsg struct {
+	revokeErr error // Set if revoking access at Google failed
+}
+type youtubeAccountDeletedMsg struct {
+	accountID string
+	revokeErr error
 }
-type youtubeDisconnectMsg struct{}
 type youtubeVerifyCompleteMsg struct {
 	err         error
 	channelName string
 	channelID   string
+	email       string
 	playlists   []youtube.Playlist
 }
 type youtubePlaylistsLoadedMsg struct {
@@ -1495,43 +2152,46 @@ type youtubePlaylistCreatedMsg struct {
 }
 type youtubeAccountAuthStartedMsg struct {
 	authURL string
+	state   *authState
 }
 type youtubeAccountAuthCompleteMsg struct {
 	err         error
 	channelName string
 	channelID   string
+	email       string
+}
+type youtubeAuthCodeExchangedMsg struct {
+	err error
+}
+type youtubeAccountTestMsg struct {
+	accountID string
+	err       error
+}
+
+// accountTestResult is the outcome of the last connection test of an account
+type accountTestResult struct {
+	testing  bool
+	err      error
+	testedAt time.Time
 }
 
 // renderVerifying renders the verification in progress screen
 func (m *YouTubeSetupModel) renderVerifying() string {
 	header := RenderHeader("YouTube - Verifying Credentials")
 
-	spinnerFrames := []string{"◐", "◓", "◑", "◒"}
-	frame := spinnerFrames[int(time.Now().UnixMilli()/200)%len(spinnerFrames)]
-
-	spinnerStyle := lipgloss.NewStyle().
-		Foreground(ColorOrange).
-		Bold(true)
-
-	messageStyle := lipgloss.NewStyle().
-		Foreground(ColorWhite).
-		Bold(true)
-
 	subMessageStyle := lipgloss.NewStyle().
 		Foreground(ColorGray)
 
-	spinner := spinnerStyle.Render(frame)
-	message := messageStyle.Render("Verifying credentials...")
 	subMessage := subMessageStyle.Render("Testing connection and fetching channel information...")
 
 	content := lipgloss.JoinVertical(
 		lipgloss.Center,
-		spinner+" "+message,
+		m.renderNetworkSpinner("Verifying credentials..."),
 		"",
 		subMessage,
 	)
 
-	helpText := "Please wait..."
+	helpText := "Please wait... • esc: cancel"
 	footer := RenderHelpFooter(helpText, m.width)
 
 	return LayoutWithHeaderFooter(header, content, footer, m.width, m.height)
@@ -1611,6 +2271,7 @@ func (m *YouTubeSetupModel) renderVerified() string {
 		"",
 		labelStyle.Render("Channel Name: ")+valueStyle.Render(m.channelName),
 		labelStyle.Render("Channel ID:   ")+valueStyle.Render(m.channelID),
+		labelStyle.Render("Account:      ")+valueStyle.Render(accountEmailOrUnknown(m.accountEmail)),
 	)
 
 	// Playlists section
@@ -1685,23 +2346,12 @@ func (m *YouTubeSetupModel) renderPlaylists() string {
 
 	// Show loading spinner if still loading
 	if m.isLoadingPlaylists {
-		spinnerFrames := []string{"◐", "◓", "◑", "◒"}
-		frame := spinnerFrames[int(time.Now().UnixMilli()/200)%len(spinnerFrames)]
-
-		spinnerStyle := lipgloss.NewStyle().
-			Foreground(ColorOrange).
-			Bold(true)
-
-		messageStyle := lipgloss.NewStyle().
-			Foreground(ColorWhite).
-			Bold(true)
-
 		content := lipgloss.JoinVertical(
 			lipgloss.Center,
-			spinnerStyle.Render(frame)+" "+messageStyle.Render("Loading playlists..."),
+			m.renderNetworkSpinner("Loading playlists..."),
 		)
 
-		helpText := "Please wait..."
+		helpText := "Please wait... • esc: cancel"
 		footer := RenderHelpFooter(helpText, m.width)
 		return LayoutWithHeaderFooter(header, content, footer, m.width, m.height)
 	}
@@ -1811,23 +2461,12 @@ func (m *YouTubeSetupModel) renderCreatePlaylist() string {
 
 	// Show creating spinner if in progress
 	if m.isCreatingPlaylist {
-		spinnerFrames := []string{"◐", "◓", "◑", "◒"}
-		frame := spinnerFrames[int(time.Now().UnixMilli()/200)%len(spinnerFrames)]
-
-		spinnerStyle := lipgloss.NewStyle().
-			Foreground(ColorOrange).
-			Bold(true)
-
-		messageStyle := lipgloss.NewStyle().
-			Foreground(ColorWhite).
-			Bold(true)
-
 		content := lipgloss.JoinVertical(
 			lipgloss.Center,
-			spinnerStyle.Render(frame)+" "+messageStyle.Render("Creating playlist..."),
+			m.renderNetworkSpinner("Creating playlist..."),
 		)
 
-		helpText := "Please wait..."
+		helpText := "Please wait... • esc: cancel"
 		footer := RenderHelpFooter(helpText, m.width)
 		return LayoutWithHeaderFooter(header, content, footer, m.width, m.height)
 	}
@@ -1922,13 +2561,6 @@ func (m *YouTubeSetupModel) renderAccounts() string {
 
 	// Show authenticating spinner if in progress
 	if m.isAuthenticatingAccount {
-		spinnerFrames := []string{"◐", "◓", "◑", "◒"}
-		frame := spinnerFrames[int(time.Now().UnixMilli()/200)%len(spinnerFrames)]
-
-		spinnerStyle := lipgloss.NewStyle().
-			Foreground(ColorOrange).
-			Bold(true)
-
 		messageStyle := lipgloss.NewStyle().
 			Foreground(ColorWhite).
 			Bold(true)
@@ -1940,24 +2572,20 @@ func (m *YouTubeSetupModel) renderAccounts() string {
 			Foreground(ColorBlue)
 
 		var rows []string
-		rows = append(rows, spinnerStyle.Render(frame)+" "+messageStyle.Render("Authenticating account..."))
+		rows = append(rows, m.spinner.View()+" "+messageStyle.Render("Authenticating account..."))
 		rows = append(rows, "")
 		rows = append(rows, subMessageStyle.Render("A browser window should have opened."))
-		rows = append(rows, subMessageStyle.Render("Please sign in and grant access."))
+		rows = append(rows, subMessageStyle.Render("Please sign in and grant access ("+m.authTimeRemaining()+")."))
 
 		if m.accountAuthURL != "" {
 			rows = append(rows, "")
 			rows = append(rows, subMessageStyle.Render("If browser didn't open, visit:"))
-			url := m.accountAuthURL
-			if len(url) > 60 {
-				url = url[:60] + "..."
-			}
-			rows = append(rows, linkStyle.Render(url))
+			rows = append(rows, m.renderAuthCodeFallback(m.accountAuthURL, linkStyle, subMessageStyle)...)
 		}
 
 		content := lipgloss.JoinVertical(lipgloss.Center, rows...)
 
-		helpText := "Waiting for browser authentication..."
+		helpText := "Waiting for browser authentication... • enter: submit pasted code • esc: abort"
 		footer := RenderHelpFooter(helpText, m.width)
 		return LayoutWithHeaderFooter(header, content, footer, m.width, m.height)
 	}
@@ -1985,6 +2613,12 @@ func (m *YouTubeSetupModel) renderAccounts() string {
 	notConnectedStyle := lipgloss.NewStyle().
 		Foreground(ColorGray)
 
+	testingStyle := lipgloss.NewStyle().
+		Foreground(ColorOrange)
+
+	failedStyle := lipgloss.NewStyle().
+		Foreground(ColorRed)
+
 	sectionStyle := lipgloss.NewStyle().
 		Foreground(ColorOrange).
 		Bold(true)
@@ -2024,19 +2658,51 @@ func (m *YouTubeSetupModel) renderAccounts() string {
 			// Check connection status
 			var statusText string
 			if youtube.IsAccountAuthenticated(&m.cfg.YouTube, configDir, acc.ID) {
-				channelInfo := ""
+				var details []string
 				if acc.ChannelName != "" {
-					channelInfo = " (" + acc.ChannelName + ")"
+					details = append(details, acc.ChannelName)
+				}
+				if acc.Email != "" {
+					details = append(details, acc.Email)
+				}
+				channelInfo := ""
+				if len(details) > 0 {
+					channelInfo = " (" + strings.Join(details, " · ") + ")"
+				}
+				status := connectedStyle.Render("✓ Connected")
+				test, tested := m.accountTests[acc.ID]
+				switch {
+				case !tested:
+				case test.testing:
+					status = testingStyle.Render("⟳ Testing...")
+				case test.err != nil:
+					status = failedStyle.Render("✗ Not working")
+				default:
+					status = connectedStyle.Render("✓ Working")
+				}
+				statusText = status + labelStyle.Render(channelInfo)
+				if tested && !test.testing {
+					statusText += labelStyle.Render(" · tested " + test.testedAt.Format("15:04"))
 				}
-				statusText = connectedStyle.Render("✓ Connected") + labelStyle.Render(channelInfo)
 			} else if acc.IsConfigured() {
 				statusText = notConnectedStyle.Render("○ Not connected")
 			} else {
 				statusText = notConnectedStyle.Render("○ Not configured")
 			}
 
+			if m.cfg.YouTube.IsDefaultAccount(acc.ID) {
+				displayName += " ★"
+			}
+
 			row := prefix + nameStyle.Render(displayName) + "  " + statusText
 			rows = append(rows, row)
+			if test := m.accountTests[acc.ID]; test.err != nil {
+				rows = append(rows, failedStyle.Render("    "+truncateStr(youtube.FriendlyError(test.err), 57)))
+			}
+		}
+		if m.cfg.YouTube.GetAccount(m.cfg.YouTube.DefaultAccountID) != nil {
+			rows = append(rows, "")
+			rows = append(rows, labelStyle.Render("★ default account, preselected for uploads"))
 		}
 	}
 
@@ -2055,7 +2721,7 @@ func (m *YouTubeSetupModel) renderAccounts() string {
 		Foreground(ColorGray).
 		Italic(true)
 
-	helpText := helpStyle.Render("n: add • e: edit • d: delete • c: connect • enter: back")
+	helpText := helpStyle.Render("n: add • e: edit • d: delete • c: connect • r: re-auth • t: test • s: default • J/K: move • enter: back")
 
 	fullContent := lipgloss.JoinVertical(
 		lipgloss.Center,
@@ -2120,7 +2786,7 @@ func (m *YouTubeSetupModel) renderAccountForm() string {
 		rows = append(rows, labelStyle.Render("  Client ID:"))
 	}
 	rows = append(rows, "  "+m.accountClientID.View())
-	rows = append(rows, hintStyle.Render("  (ends with .apps.googleusercontent.com)"))
+	rows = append(rows, renderCredentialHint(m.accountClientID.Value(), youtube.CheckClientIDFormat, "ends with .apps.googleusercontent.com"))
 	rows = append(rows, "")
 
 	// Client Secret
@@ -2130,7 +2796,17 @@ func (m *YouTubeSetupModel) renderAccountForm() string {
 		rows = append(rows, labelStyle.Render("  Client Secret:"))
 	}
 	rows = append(rows, "  "+m.accountClientSecret.View())
-	rows = append(rows, hintStyle.Render("  (starts with GOCSPX-)"))
+	rows = append(rows, renderCredentialHint(m.accountClientSecret.Value(), youtube.CheckClientSecretFormat, "usually starts with GOCSPX-"))
+	rows = append(rows, "")
+
+	// Redirect URI
+	if m.accountFormFocus == 3 {
+		rows = append(rows, focusedLabelStyle.Render("▶ Redirect URI (optional):"))
+	} else {
+		rows = append(rows, labelStyle.Render("  Redirect URI (optional):"))
+	}
+	rows = append(rows, "  "+m.accountRedirectURI.View())
+	rows = append(rows, renderRedirectURIHint(m.accountRedirectURI.Value()))
 
 	// Error message
 	if m.errorMessage != "" {
@@ -2147,7 +2823,7 @@ func (m *YouTubeSetupModel) renderAccountForm() string {
 		Foreground(ColorGray).
 		Italic(true)
 
-	helpText := helpStyle.Render("tab: next field • enter: save • esc: cancel")
+	helpText := helpStyle.Render("tab: next field • ctrl+o: import client_secret.json • enter: save • esc: cancel")
 
 	footer := RenderHelpFooter(helpText, m.width)
 
@@ -2197,6 +2873,8 @@ func (m *YouTubeSetupModel) renderAccountDelete() string {
 		"",
 		labelStyle.Render("This will remove the account and its stored credentials."),
 		labelStyle.Render("You will need to re-authenticate if you add it again."),
+		"",
+		m.renderRevokeCheckbox(),
 	)
 
 	content := warningStyle.Render(warningContent)
@@ -2221,7 +2899,107 @@ func (m *YouTubeSetupModel) renderAccountDelete() string {
 		Foreground(ColorGray).
 		Italic(true)
 
-	helpText := helpStyle.Render("y: confirm delete • n/esc: cancel")
+	helpText := helpStyle.Render("y: confirm delete • r/space: toggle revoke • n/esc: cancel")
+
+	fullContent := lipgloss.JoinVertical(
+		lipgloss.Center,
+		header,
+		"",
+		content,
+		"",
+		buttonRow,
+		"",
+		helpText,
+	)
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, fullContent)
+}
+
+// renderRevokeCheckbox renders the option to also revoke access at Google,
+// or the progress line while the revocation runs
+func (m *YouTubeSetupModel) renderRevokeCheckbox() string {
+	labelStyle := lipgloss.NewStyle().
+		Foreground(ColorGray)
+
+	if m.isRevoking {
+		return lipgloss.NewStyle().Foreground(ColorOrange).Bold(true).Render("Revoking access at Google...")
+	}
+
+	checkbox := "[ ]"
+	checkStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	if m.revokeAtGoogle {
+		checkbox = "[✓]"
+		checkStyle = lipgloss.NewStyle().Foreground(ColorGreen).Bold(true)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		checkStyle.Render(checkbox)+" "+lipgloss.NewStyle().Foreground(ColorWhite).Render("Also revoke this app's access at Google"),
+		labelStyle.Render("    Otherwise the app stays listed under third-party"),
+		labelStyle.Render("    access in your Google account until removed there."),
+	)
+}
+
+// renderDisconnect renders the disconnect confirmation
+func (m *YouTubeSetupModel) renderDisconnect() string {
+	header := RenderHeader("YouTube - Disconnect")
+
+	warningStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorRed).
+		Padding(1, 3).
+		Width(60)
+
+	titleStyle := lipgloss.NewStyle().
+		Foreground(ColorRed).
+		Bold(true)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(ColorGray)
+
+	valueStyle := lipgloss.NewStyle().
+		Foreground(ColorWhite).
+		Bold(true)
+
+	channelName := m.channelName
+	if channelName == "" {
+		channelName = "Unknown channel"
+	}
+
+	warningContent := lipgloss.JoinVertical(lipgloss.Left,
+		titleStyle.Render("⚠ Disconnect YouTube?"),
+		"",
+		labelStyle.Render("Channel: ")+valueStyle.Render(channelName),
+		labelStyle.Render("Account: ")+valueStyle.Render(accountEmailOrUnknown(m.accountEmail)),
+		"",
+		labelStyle.Render("This will delete the stored login token. Your credentials"),
+		labelStyle.Render("are kept, so you can connect again later."),
+		"",
+		m.renderRevokeCheckbox(),
+	)
+
+	content := warningStyle.Render(warningContent)
+
+	buttonRow := lipgloss.JoinHorizontal(lipgloss.Center,
+		lipgloss.NewStyle().
+			Padding(0, 2).
+			Background(ColorRed).
+			Foreground(ColorWhite).
+			Bold(true).
+			Render("Y - Disconnect"),
+		"    ",
+		lipgloss.NewStyle().
+			Padding(0, 2).
+			Background(ColorGray).
+			Foreground(ColorWhite).
+			Bold(true).
+			Render("N - Cancel"),
+	)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(ColorGray).
+		Italic(true)
+
+	helpText := helpStyle.Render("y: confirm disconnect • r/space: toggle revoke • n/esc: cancel")
 
 	fullContent := lipgloss.JoinVertical(
 		lipgloss.Center,
@@ -0,0 +1,199 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
+)
+
+// youtubeSetupForTest returns a setup model with credentials configured in
+// an isolated config directory
+func youtubeSetupForTest(t *testing.T) *YouTubeSetupModel {
+	t.Helper()
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	youtube.BrowserDisabled = true
+	t.Cleanup(func() { youtube.BrowserDisabled = false })
+
+	m := NewYouTubeSetupModel()
+	m.cfg.YouTube.ClientID = "123-abc.apps.googleusercontent.com"
+	m.cfg.YouTube.ClientSecret = "GOCSPX-abc123"
+	return m
+}
+
+// freeLoopbackPort returns a port on 127.0.0.1 nothing listens on
+func freeLoopbackPort(t *testing.T) int {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := l.Addr().(*net.TCPAddr).Port
+	_ = l.Close()
+	return port
+}
+
+func TestYouTubeSetup_AbortAuthStopsCallbackServer(t *testing.T) {
+	m := youtubeSetupForTest(t)
+	addr := fmt.Sprintf("127.0.0.1:%d", freeLoopbackPort(t))
+	m.cfg.YouTube.RedirectURI = "http://" + addr + "/callback"
+	t.Cleanup(func() { currentAuthState = nil })
+
+	m.Update(m.startAuth()())
+	if m.step != YouTubeStepAuthenticating || !m.isAuthenticating || currentAuthState == nil {
+		t.Fatalf("step %v, want the pending authentication", m.step)
+	}
+	if m.authURL == "" {
+		t.Error("expected the authorization URL to be shown")
+	}
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("expected the callback server to listen on %s: %v", addr, err)
+	}
+	_ = conn.Close()
+	wait := m.waitForAuthResult()
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.step != YouTubeStepCredentials || m.isAuthenticating || m.authURL != "" || currentAuthState != nil {
+		t.Fatalf("step %v, want the pending authentication cleared", m.step)
+	}
+
+	// The aborted flow reports the cancellation, which changes nothing
+	msg := wait()
+	if done, ok := msg.(youtubeAuthCompleteMsg); !ok || !errors.Is(done.err, context.Canceled) {
+		t.Fatalf("result %#v, want the flow canceled", msg)
+	}
+	m.Update(msg)
+	if m.step != YouTubeStepCredentials || m.errorMessage != "" {
+		t.Errorf("step %v error %q, want the credentials step", m.step, m.errorMessage)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			break
+		}
+		_ = conn.Close()
+		if time.Now().After(deadline) {
+			t.Fatal("expected the callback server to stop listening")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestYouTubeSetup_IgnoresResultsAfterCancel(t *testing.T) {
+	late := []youtube.Playlist{{ID: "PL1", Title: "Tutorials"}}
+	tests := []struct {
+		name     string
+		start    func(m *YouTubeSetupModel) tea.Cmd
+		wantStep YouTubeSetupStep
+		results  []tea.Msg
+	}{
+		{"verify", func(m *YouTubeSetupModel) tea.Cmd {
+			_, cmd := m.Update(bulkKey("v"))
+			return cmd
+		}, YouTubeStepConnected, []tea.Msg{
+			youtubeVerifyCompleteMsg{err: context.Canceled},
+			youtubeVerifyCompleteMsg{channelName: "Late channel", playlists: late},
+		}},
+		{"load playlists", func(m *YouTubeSetupModel) tea.Cmd {
+			_, cmd := m.Update(bulkKey("p"))
+			return cmd
+		}, YouTubeStepConnected, []tea.Msg{
+			youtubePlaylistsLoadedMsg{err: context.Canceled},
+			youtubePlaylistsLoadedMsg{playlists: late},
+		}},
+		{"create playlist", func(m *YouTubeSetupModel) tea.Cmd {
+			m.step = YouTubeStepCreatePlaylist
+			m.newPlaylistTitle.SetValue("Tutorials")
+			m.isCreatingPlaylist = true
+			return m.createPlaylist(m.startNetworkCall(YouTubeStepCreatePlaylist))
+		}, YouTubeStepCreatePlaylist, []tea.Msg{
+			youtubePlaylistCreatedMsg{err: context.Canceled},
+			youtubePlaylistCreatedMsg{playlist: &late[0]},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := youtubeSetupForTest(t)
+			m.step = YouTubeStepConnected
+			m.channelName = "Kartoza"
+
+			if cmd := tt.start(m); cmd == nil || !m.waiting() {
+				t.Fatal("expected a call to Google to start")
+			}
+			m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+			if m.step != tt.wantStep || m.waiting() {
+				t.Fatalf("step %v, want %v after esc", m.step, tt.wantStep)
+			}
+
+			for _, msg := range tt.results {
+				m.Update(msg)
+				if m.step != tt.wantStep {
+					t.Errorf("step %v after %#v, want the result ignored", m.step, msg)
+				}
+			}
+			if m.channelName != "Kartoza" || m.playlists != nil || m.verifyError != "" || m.playlistsError != "" {
+				t.Errorf("channel %q playlists %v, want nothing from the canceled call", m.channelName, m.playlists)
+			}
+		})
+	}
+}
+
+func TestYouTubeSetup_AccountConnectionTest(t *testing.T) {
+	m := youtubeSetupForTest(t)
+	m.width, m.height = 120, 40
+	m.cfg.YouTube.ClientID, m.cfg.YouTube.ClientSecret = "", "" // No legacy account
+	for _, id := range []string{"personal", "brand"} {
+		m.cfg.YouTube.AddAccount(youtube.Account{ID: id, Name: id, ClientID: id + ".apps.googleusercontent.com", ClientSecret: "secret"})
+		token := &youtube.Token{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer", Expiry: time.Now().Add(time.Hour).Format(time.RFC3339)}
+		if err := youtube.SaveTokenForAccount(config.GetConfigDir(), id, token); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m.accounts = m.cfg.YouTube.GetAccounts()
+	m.step = YouTubeStepAccounts
+
+	// personal passes
+	_, cmd := m.Update(bulkKey("t"))
+	if cmd == nil || !m.accountTests["personal"].testing {
+		t.Fatal("expected t to test the selected account")
+	}
+	if !strings.Contains(m.View(), "Testing...") {
+		t.Error("expected the account to show it is being tested")
+	}
+	if _, cmd := m.Update(bulkKey("t")); cmd != nil {
+		t.Error("expected no second test while the first one runs")
+	}
+	m.Update(youtubeAccountTestMsg{accountID: "personal"})
+	if test := m.accountTests["personal"]; test.testing || test.err != nil || test.testedAt.IsZero() {
+		t.Fatalf("personal test = %+v, want passed", test)
+	}
+
+	// brand fails
+	m.selectedAccountIndex = 1
+	m.Update(bulkKey("t"))
+	failure := errors.New("token revoked")
+	m.Update(youtubeAccountTestMsg{accountID: "brand", err: failure})
+	if test := m.accountTests["brand"]; test.testing || !errors.Is(test.err, failure) {
+		t.Fatalf("brand test = %+v, want failed", test)
+	}
+
+	view := m.View()
+	for _, want := range []string{"✓ Working", "✗ Not working", youtube.FriendlyError(failure), "tested "} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view is missing %q:\n%s", want, view)
+		}
+	}
+	if m.accountTests["personal"].err != nil {
+		t.Error("expected the failure not to affect the other account")
+	}
+}
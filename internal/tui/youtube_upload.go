@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/kartoza/kartoza-screencaster/internal/chapters"
 	"github.com/kartoza/kartoza-screencaster/internal/config"
 	"github.com/kartoza/kartoza-screencaster/internal/models"
 	"github.com/kartoza/kartoza-screencaster/internal/spellcheck"
@@ -28,6 +32,7 @@ const (
 	YouTubeUploadStepComplete
 	YouTubeUploadStepError
 	YouTubeUploadStepSkipped
+	YouTubeUploadStepPreview
 )
 
 // YouTubeUploadField represents which field is focused
@@ -38,10 +43,18 @@ const (
 	YouTubeUploadFieldVideoSource
 	YouTubeUploadFieldTitle
 	YouTubeUploadFieldDescription
+	YouTubeUploadFieldChapters
 	YouTubeUploadFieldTags
 	YouTubeUploadFieldPlaylist
 	YouTubeUploadFieldPrivacy
+	YouTubeUploadFieldPublishAt
+	YouTubeUploadFieldCategory
+	YouTubeUploadFieldAudience
+	YouTubeUploadFieldLicense
+	YouTubeUploadFieldLanguage
+	YouTubeUploadFieldAudioLanguage
 	YouTubeUploadFieldUpload
+	YouTubeUploadFieldPreview
 	YouTubeUploadFieldCancel
 )
 
@@ -51,6 +64,7 @@ type VideoSourceOption int
 const (
 	VideoSourceVertical VideoSourceOption = iota
 	VideoSourceMerged
+	VideoSourceWebcamOnly
 )
 
 func (v VideoSourceOption) String() string {
@@ -59,6 +73,8 @@ func (v VideoSourceOption) String() string {
 		return "Vertical (9:16)"
 	case VideoSourceMerged:
 		return "Landscape (16:9)"
+	case VideoSourceWebcamOnly:
+		return "Webcam only (1:1)"
 	default:
 		return "Unknown"
 	}
@@ -75,6 +91,7 @@ type YouTubeUploadModel struct {
 	// Account selection
 	accounts        []youtube.Account
 	selectedAccount int
+	checkedAccounts map[string]bool // Accounts ticked for multi-account upload (by ID)
 
 	// Video source selection
 	videoSourceOptions   []VideoSourceOption
@@ -83,6 +100,8 @@ type YouTubeUploadModel struct {
 	mergedVideoPath      string
 	hasVerticalVideo     bool
 	hasMergedVideo       bool
+	webcamOnlyVideoPath  string
+	hasWebcamOnlyVideo   bool
 
 	// Video info
 	videoPath     string
@@ -97,22 +116,61 @@ type YouTubeUploadModel struct {
 	descriptionInput textinput.Model
 	tagsInput        textinput.Model
 
+	// Chapters of the recording from its chapters.txt, added to the end of
+	// the description when appendChapters is set
+	chapters       []chapters.Chapter
+	appendChapters bool
+
+	// First line of the final description shown in the preview
+	previewOffset int
+
 	// Playlist selection
 	playlists        []youtube.Playlist
 	selectedPlaylist int // -1 means no playlist, 0+ is index into playlists
 	loadingPlaylists bool
+	templatePlaylist string // Playlist title from the recording template, preferred over the default
 	playlistError    string
 
 	// Privacy selection
 	privacyOptions  []youtube.PrivacyStatus
 	selectedPrivacy int
+	sensitiveTopic  bool // The recording's topic is sensitive, so private is the default
+
+	// Time YouTube publishes a private video, as PublishAtLayout in local
+	// time (empty = not scheduled)
+	publishAtInput textinput.Model
+
+	// Category selection, index into categories: the built-in list until
+	// the categories of the account's region are loaded
+	categories       []youtube.Category
+	selectedCategory int
+	// preferredCategory is the category ID to select once the region's
+	// categories are loaded, e.g. a last category the built-in list lacks.
+	// Cleared when the user picks a category.
+	preferredCategory string
+
+	// Audience: whether the video is made for kids
+	madeForKids bool
+
+	// License selection, index into youtube.Licenses
+	selectedLicense int
+
+	// Language of the title and description and language spoken in the
+	// video, indexes into youtube.Languages
+	selectedLanguage      int
+	selectedAudioLanguage int
 
 	// Upload progress
 	progress         progress.Model
 	uploadPct        float64
 	isUploading      bool
-	uploadResult     *youtube.UploadResult
+	uploadResult     *youtube.UploadResult // First successful upload
+	uploadResults    []accountUploadResult // Per-account outcome of the last upload
+	uploadTargets    []uploadTarget
+	uploadIndex      int    // Index into uploadTargets of the upload in progress
+	uploadRetry      string // Rate limit/retry notice while YouTube is retried (empty = none)
 	uploadProgressCh chan uploadUpdate
+	spinner          tickingSpinner // Animated while uploading
 
 	// Status
 	errorMessage string
@@ -141,14 +199,16 @@ func NewYouTubeUploadModel(videoPath, outputDir, title, description, topic strin
 	descInput.Width = 50
 	descInput.SetValue(description)
 
-	tagsInput := textinput.New()
-	tagsInput.Placeholder = "Tags (comma separated)"
-	tagsInput.CharLimit = 500
-	tagsInput.Width = 50
+	tagsInput := newTagsInput()
 	if topic != "" {
 		tagsInput.SetValue(topic)
 	}
 
+	publishAtInput := textinput.New()
+	publishAtInput.Placeholder = "YYYY-MM-DD HH:MM (empty = not scheduled)"
+	publishAtInput.CharLimit = len(youtube.PublishAtLayout)
+	publishAtInput.Width = 42
+
 	cfg, _ := config.Load()
 
 	prog := progress.New(progress.WithDefaultGradient())
@@ -162,16 +222,26 @@ func NewYouTubeUploadModel(videoPath, outputDir, title, description, topic strin
 		defaultPrivacyIdx = 2
 	}
 
+	// Sensitive topics are never public or unlisted by default
+	sensitiveTopic := cfg.IsSensitiveTopic(topic)
+	if sensitiveTopic {
+		defaultPrivacyIdx = 1
+	}
+
 	sc := spellcheck.NewSpellChecker()
 
+	// Default to the configured languages, else to the spell check language
+	language := youtube.DefaultLanguageFor(cfg.YouTube.DefaultLanguage, spellcheck.Language)
+	audioLanguage := youtube.DefaultLanguageFor(cfg.YouTube.DefaultAudioLanguage, language)
+
 	// Get available YouTube accounts
 	accounts := cfg.YouTube.GetAccounts()
 	selectedAccountIdx := 0
 
-	// Find the last used account
-	if cfg.YouTube.LastUsedAccountID != "" {
-		for i, acc := range accounts {
-			if acc.ID == cfg.YouTube.LastUsedAccountID {
+	// Preselect the default account (or the last used one)
+	if acc := cfg.YouTube.GetDefaultAccount(); acc != nil {
+		for i := range accounts {
+			if accounts[i].ID == acc.ID {
 				selectedAccountIdx = i
 				break
 			}
@@ -183,6 +253,7 @@ func NewYouTubeUploadModel(videoPath, outputDir, title, description, topic strin
 		focusedField:     YouTubeUploadFieldTitle,
 		accounts:         accounts,
 		selectedAccount:  selectedAccountIdx,
+		checkedAccounts:  make(map[string]bool),
 		videoPath:        videoPath,
 		outputDir:        outputDir,
 		title:            title,
@@ -191,12 +262,28 @@ func NewYouTubeUploadModel(videoPath, outputDir, title, description, topic strin
 		titleInput:       titleInput,
 		descriptionInput: descInput,
 		tagsInput:        tagsInput,
+		publishAtInput:   publishAtInput,
 		privacyOptions:   []youtube.PrivacyStatus{youtube.PrivacyUnlisted, youtube.PrivacyPrivate, youtube.PrivacyPublic},
 		selectedPrivacy:  defaultPrivacyIdx,
+		sensitiveTopic:   sensitiveTopic,
+		categories:       youtube.Categories,
+		selectedCategory: initialCategoryIndex(topic, &cfg.YouTube),
+		madeForKids:      cfg.YouTube.DefaultMadeForKids,
+		selectedLicense:  max(youtube.LicenseIndex(cfg.YouTube.DefaultLicense), 0),
 		selectedPlaylist: -1, // No playlist by default
 		progress:         prog,
+		spinner:          newTickingSpinner(),
 		spellChecker:     sc,
 		cfg:              cfg,
+
+		selectedLanguage:      youtube.LanguageIndex(language),
+		selectedAudioLanguage: youtube.LanguageIndex(audioLanguage),
+	}
+	m.preferredCategory = youtube.DefaultCategoryFor(topic, cfg.YouTube.LastCategory, cfg.YouTube.DefaultCategory)
+
+	// The chapters file is written when a paused recording is processed
+	if outputDir != "" {
+		m.chapters, _ = chapters.Load(outputDir)
 	}
 
 	// Initial spell check
@@ -205,11 +292,17 @@ func NewYouTubeUploadModel(videoPath, outputDir, title, description, topic strin
 	return m
 }
 
-// updateSpellCheck updates the spell check issues for title and description
+// updateSpellCheck updates the spell check issues for title and description.
+// Metadata in a language the spell checker does not support is not checked.
 func (m *YouTubeUploadModel) updateSpellCheck() {
 	if m.spellChecker == nil {
 		return
 	}
+	if !spellcheck.SupportsLanguage(youtube.Languages[m.selectedLanguage].Code) {
+		m.titleIssues = nil
+		m.descIssues = nil
+		return
+	}
 	m.titleIssues = m.spellChecker.Check(m.titleInput.Value())
 	m.descIssues = m.spellChecker.Check(m.descriptionInput.Value())
 }
@@ -228,6 +321,7 @@ func NewYouTubeUploadModelWithRecording(videoPath string, recordingInfo *models.
 	// Set up video source options based on available files
 	m.verticalVideoPath = recordingInfo.Files.VerticalFile
 	m.mergedVideoPath = recordingInfo.Files.MergedFile
+	m.webcamOnlyVideoPath = recordingInfo.Files.WebcamOnlyFile
 
 	// Check which video files actually exist
 	if m.verticalVideoPath != "" {
@@ -240,6 +334,11 @@ func NewYouTubeUploadModelWithRecording(videoPath string, recordingInfo *models.
 			m.hasMergedVideo = true
 		}
 	}
+	if m.webcamOnlyVideoPath != "" {
+		if _, err := os.Stat(m.webcamOnlyVideoPath); err == nil {
+			m.hasWebcamOnlyVideo = true
+		}
+	}
 
 	// Build available options list
 	m.videoSourceOptions = []VideoSourceOption{}
@@ -249,6 +348,9 @@ func NewYouTubeUploadModelWithRecording(videoPath string, recordingInfo *models.
 	if m.hasMergedVideo {
 		m.videoSourceOptions = append(m.videoSourceOptions, VideoSourceMerged)
 	}
+	if m.hasWebcamOnlyVideo {
+		m.videoSourceOptions = append(m.videoSourceOptions, VideoSourceWebcamOnly)
+	}
 
 	// Default to vertical if available, otherwise merged
 	m.selectedVideoSource = 0
@@ -256,18 +358,62 @@ func NewYouTubeUploadModelWithRecording(videoPath string, recordingInfo *models.
 		m.videoPath = m.verticalVideoPath
 	} else if m.hasMergedVideo {
 		m.videoPath = m.mergedVideoPath
+	} else if m.hasWebcamOnlyVideo {
+		m.videoPath = m.webcamOnlyVideoPath
+	}
+
+	// Upload settings from the recording template
+	if d := recordingInfo.Metadata.UploadDefaults; d != nil {
+		if len(d.Tags) > 0 {
+			m.tagsInput.SetValue(strings.Join(d.Tags, ", "))
+		}
+		for i, p := range m.privacyOptions {
+			if string(p) == d.Privacy && !m.sensitiveTopic {
+				m.selectedPrivacy = i
+			}
+		}
+		m.templatePlaylist = d.Playlist
+	}
+
+	// When uploading again, preselect an account the video is not on yet
+	if m.selectedAccount < len(m.accounts) && isUploadedTo(recordingInfo, m.accounts[m.selectedAccount]) {
+		for i := range m.accounts {
+			if !isUploadedTo(recordingInfo, m.accounts[i]) {
+				m.selectedAccount = i
+				break
+			}
+		}
 	}
 
 	return m
 }
 
+// isUploadedTo returns true if the recording has been uploaded with an account
+func isUploadedTo(rec *models.RecordingInfo, acc youtube.Account) bool {
+	for _, upload := range rec.Metadata.AllYouTubeUploads() {
+		if upload.AccountID == acc.ID || (upload.ChannelID != "" && upload.ChannelID == acc.ChannelID) {
+			return true
+		}
+	}
+	return false
+}
+
 // Init initializes the upload model
 func (m *YouTubeUploadModel) Init() tea.Cmd {
 	return textinput.Blink
 }
 
-// Update handles messages
+// Update handles messages, animating the spinner while uploading
 func (m *YouTubeUploadModel) Update(msg tea.Msg) (*YouTubeUploadModel, tea.Cmd) {
+	if tick, ok := msg.(spinner.TickMsg); ok {
+		return m, m.spinner.update(tick, m.isUploading)
+	}
+	m, cmd := m.update(msg)
+	return m, tea.Batch(cmd, m.spinner.start(m.isUploading))
+}
+
+// update handles messages other than spinner ticks
+func (m *YouTubeUploadModel) update(msg tea.Msg) (*YouTubeUploadModel, tea.Cmd) {
 	var cmd tea.Cmd
 
 	switch msg := msg.(type) {
@@ -282,7 +428,7 @@ func (m *YouTubeUploadModel) Update(msg tea.Msg) (*YouTubeUploadModel, tea.Cmd)
 	case playlistsLoadedMsg:
 		m.loadingPlaylists = false
 		if msg.err != nil {
-			m.playlistError = msg.err.Error()
+			m.playlistError = youtube.FriendlyError(msg.err)
 		} else {
 			m.playlists = msg.playlists
 			// Select default playlist if configured
@@ -294,34 +440,76 @@ func (m *YouTubeUploadModel) Update(msg tea.Msg) (*YouTubeUploadModel, tea.Cmd)
 					}
 				}
 			}
+			// The playlist of the recording template takes precedence
+			for i, pl := range m.playlists {
+				if m.templatePlaylist != "" && strings.EqualFold(pl.Title, m.templatePlaylist) {
+					m.selectedPlaylist = i
+					break
+				}
+			}
+		}
+		return m, nil
+
+	case categoriesLoadedMsg:
+		// Without them the built-in categories stay
+		if msg.err == nil {
+			m.setCategories(msg.categories)
 		}
 		return m, nil
 
 	case uploadProgressMsg:
-		m.uploadPct = msg.percent
+		m.uploadIndex = msg.targetIndex
+		m.uploadRetry = msg.retry
+		if msg.retry == "" {
+			m.uploadPct = msg.percent
+		}
 		// Continue waiting for more progress updates
 		return m, waitForUploadProgress(m.uploadProgressCh)
 
 	case uploadCompleteMsg:
 		m.isUploading = false
-		if msg.err != nil {
+		m.uploadResults = msg.results
+		m.uploadResult = nil
+
+		// Save every successful upload, even if other accounts failed
+		var failures []string
+		for _, r := range msg.results {
+			if r.err != nil {
+				failures = append(failures, r.target.displayName()+": "+youtube.FriendlyError(r.err))
+				continue
+			}
+			if r.result == nil {
+				continue
+			}
+			if m.uploadResult == nil {
+				m.uploadResult = r.result
+			}
+			if m.recordingInfo != nil {
+				m.saveYouTubeMetadata(r.target, r.result)
+			}
+		}
+		if m.recordingInfo != nil && m.uploadResult != nil {
+			_ = m.recordingInfo.Save()
+		}
+
+		if m.uploadResult == nil {
 			m.step = YouTubeUploadStepError
-			m.errorMessage = msg.err.Error()
+			m.errorMessage = strings.Join(failures, "\n")
 		} else {
 			m.step = YouTubeUploadStepComplete
-			m.uploadResult = msg.result
 
-			// Save YouTube metadata to recording
-			if m.recordingInfo != nil && msg.result != nil {
-				m.saveYouTubeMetadata(msg.result)
+			// Remember the accounts used for next time
+			if len(m.accounts) > 0 && m.selectedAccount < len(m.accounts) {
+				m.cfg.YouTube.LastUsedAccountID = m.accounts[m.selectedAccount].ID
 			}
 
 			// Update config with last used playlist
 			if m.selectedPlaylist >= 0 && m.selectedPlaylist < len(m.playlists) {
 				m.cfg.YouTube.DefaultPlaylistID = m.playlists[m.selectedPlaylist].ID
 				m.cfg.YouTube.DefaultPlaylistName = m.playlists[m.selectedPlaylist].Title
-				_ = config.Save(m.cfg)
 			}
+			m.cfg.YouTube.LastCategory = m.categories[m.selectedCategory].ID
+			_ = config.Save(m.cfg)
 		}
 		// Refresh YouTube status
 		updateGlobalAppState(GlobalAppState.IsRecording, GlobalAppState.BlinkOn, GlobalAppState.Status)
@@ -334,67 +522,63 @@ func (m *YouTubeUploadModel) Update(msg tea.Msg) (*YouTubeUploadModel, tea.Cmd)
 		oldValue := m.titleInput.Value()
 		m.titleInput, cmd = m.titleInput.Update(msg)
 		if m.titleInput.Value() != oldValue {
-			m.titleIssues = m.spellChecker.Check(m.titleInput.Value())
+			m.updateSpellCheck()
 		}
 	case YouTubeUploadFieldDescription:
 		oldValue := m.descriptionInput.Value()
 		m.descriptionInput, cmd = m.descriptionInput.Update(msg)
 		if m.descriptionInput.Value() != oldValue {
-			m.descIssues = m.spellChecker.Check(m.descriptionInput.Value())
+			m.updateSpellCheck()
 		}
 	case YouTubeUploadFieldTags:
 		m.tagsInput, cmd = m.tagsInput.Update(msg)
+	case YouTubeUploadFieldPublishAt:
+		m.publishAtInput, cmd = m.publishAtInput.Update(msg)
 	}
 
 	return m, cmd
 }
 
-// saveYouTubeMetadata saves YouTube upload details to the recording metadata
-func (m *YouTubeUploadModel) saveYouTubeMetadata(result *youtube.UploadResult) {
+// saveYouTubeMetadata adds the details of one account's upload to the recording metadata.
+// The caller saves the recording once all results have been added.
+func (m *YouTubeUploadModel) saveYouTubeMetadata(target uploadTarget, result *youtube.UploadResult) {
 	if m.recordingInfo == nil {
 		return
 	}
+	m.recordingInfo.Metadata.AddYouTubeUpload(uploadMetadata(m.uploadOptions(target), target, result))
+}
 
-	ytMeta := &models.YouTubeMetadata{
-		VideoID:    result.VideoID,
-		VideoURL:   result.VideoURL,
-		Privacy:    string(m.privacyOptions[m.selectedPrivacy]),
-		UploadedAt: time.Now().Format(time.RFC3339),
-	}
-
-	// Add playlist info if selected
-	if m.selectedPlaylist >= 0 && m.selectedPlaylist < len(m.playlists) {
-		ytMeta.PlaylistID = m.playlists[m.selectedPlaylist].ID
-		ytMeta.PlaylistName = m.playlists[m.selectedPlaylist].Title
-	}
-
-	// Add channel info from selected account
-	if len(m.accounts) > 0 && m.selectedAccount < len(m.accounts) {
-		acc := m.accounts[m.selectedAccount]
-		if acc.ChannelName != "" {
-			ytMeta.ChannelName = acc.ChannelName
-		}
-		if acc.ChannelID != "" {
-			ytMeta.ChannelID = acc.ChannelID
-		}
-	} else {
-		// Fallback to legacy config
-		if m.cfg.YouTube.ChannelName != "" {
-			ytMeta.ChannelName = m.cfg.YouTube.ChannelName
-		}
-		if m.cfg.YouTube.ChannelID != "" {
-			ytMeta.ChannelID = m.cfg.YouTube.ChannelID
-		}
+// uploadMetadata returns the details of an upload kept in the recording
+// metadata
+func uploadMetadata(opts youtube.UploadOptions, target uploadTarget, result *youtube.UploadResult) models.YouTubeUpload {
+	return models.YouTubeUpload{
+		VideoID:      result.VideoID,
+		VideoURL:     result.VideoURL,
+		Privacy:      string(opts.PrivacyStatus),
+		MadeForKids:  opts.MadeForKids,
+		CategoryID:   opts.CategoryID,
+		UploadedAt:   time.Now().Format(time.RFC3339),
+		PlaylistID:   target.playlistID,
+		PlaylistName: target.playlistName,
+		ChannelName:  target.account.ChannelName,
+		ChannelID:    target.account.ChannelID,
+		AccountID:    target.account.ID,
+
+		Language:      opts.Language,
+		AudioLanguage: opts.AudioLanguage,
+		License:       string(opts.License),
+		Tags:          opts.Tags,
+		PublishAt:     formatPublishAt(opts.PublishAt),
 	}
+}
 
-	m.recordingInfo.Metadata.YouTube = ytMeta
-	_ = m.recordingInfo.Save()
-
-	// Save last used account ID
-	if len(m.accounts) > 0 && m.selectedAccount < len(m.accounts) {
-		m.cfg.YouTube.LastUsedAccountID = m.accounts[m.selectedAccount].ID
-		_ = config.Save(m.cfg)
+// formatPublishAt returns the scheduled publish time kept in the recording
+// metadata, empty if the video is not scheduled
+func formatPublishAt(t time.Time) string {
+	if t.IsZero() {
+		return ""
 	}
+	return t.Format(time.RFC3339)
 }
 
 // handleKeyMsg handles keyboard input
@@ -413,6 +597,10 @@ func (m *YouTubeUploadModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeUploadModel,
 			m.step = YouTubeUploadStepSkipped
 			return m, func() tea.Msg { return youtubeUploadSkippedMsg{} }
 		}
+		if m.step == YouTubeUploadStepPreview {
+			m.step = YouTubeUploadStepMetadata
+			return m, nil
+		}
 		// Go back to prompt
 		m.step = YouTubeUploadStepPrompt
 		return m, nil
@@ -441,7 +629,7 @@ func (m *YouTubeUploadModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeUploadModel,
 				m.titleInput.Focus()
 			}
 			m.loadingPlaylists = true
-			return m, tea.Batch(textinput.Blink, m.loadPlaylists())
+			return m, tea.Batch(textinput.Blink, m.loadPlaylists(), m.loadCategories())
 
 		case "n", "N":
 			m.step = YouTubeUploadStepSkipped
@@ -475,7 +663,7 @@ func (m *YouTubeUploadModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeUploadModel,
 				m.playlists = nil
 				m.selectedPlaylist = -1
 				m.loadingPlaylists = true
-				return m, m.loadPlaylists()
+				return m, tea.Batch(m.loadPlaylists(), m.loadCategories())
 			}
 			if m.focusedField == YouTubeUploadFieldVideoSource && len(m.videoSourceOptions) > 1 {
 				if msg.String() == "left" {
@@ -507,6 +695,78 @@ func (m *YouTubeUploadModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeUploadModel,
 				}
 				return m, nil
 			}
+			if m.focusedField == YouTubeUploadFieldCategory {
+				m.preferredCategory = ""
+				if msg.String() == "left" {
+					m.selectedCategory--
+					if m.selectedCategory < 0 {
+						m.selectedCategory = len(m.categories) - 1
+					}
+				} else {
+					m.selectedCategory++
+					if m.selectedCategory >= len(m.categories) {
+						m.selectedCategory = 0
+					}
+				}
+				return m, nil
+			}
+			if m.focusedField == YouTubeUploadFieldAudience {
+				m.madeForKids = !m.madeForKids
+				return m, nil
+			}
+			if m.focusedField == YouTubeUploadFieldChapters {
+				m.appendChapters = !m.appendChapters
+				return m, nil
+			}
+			if m.focusedField == YouTubeUploadFieldLicense {
+				if msg.String() == "left" {
+					m.selectedLicense--
+					if m.selectedLicense < 0 {
+						m.selectedLicense = len(youtube.Licenses) - 1
+					}
+				} else {
+					m.selectedLicense++
+					if m.selectedLicense >= len(youtube.Licenses) {
+						m.selectedLicense = 0
+					}
+				}
+				return m, nil
+			}
+			if m.focusedField == YouTubeUploadFieldLanguage {
+				// The spoken language follows the metadata language until it
+				// is changed separately
+				follow := m.selectedAudioLanguage == m.selectedLanguage
+				if msg.String() == "left" {
+					m.selectedLanguage--
+					if m.selectedLanguage < 0 {
+						m.selectedLanguage = len(youtube.Languages) - 1
+					}
+				} else {
+					m.selectedLanguage++
+					if m.selectedLanguage >= len(youtube.Languages) {
+						m.selectedLanguage = 0
+					}
+				}
+				if follow {
+					m.selectedAudioLanguage = m.selectedLanguage
+				}
+				m.updateSpellCheck()
+				return m, nil
+			}
+			if m.focusedField == YouTubeUploadFieldAudioLanguage {
+				if msg.String() == "left" {
+					m.selectedAudioLanguage--
+					if m.selectedAudioLanguage < 0 {
+						m.selectedAudioLanguage = len(youtube.Languages) - 1
+					}
+				} else {
+					m.selectedAudioLanguage++
+					if m.selectedAudioLanguage >= len(youtube.Languages) {
+						m.selectedAudioLanguage = 0
+					}
+				}
+				return m, nil
+			}
 			if m.focusedField == YouTubeUploadFieldPlaylist {
 				// Navigate through playlists: -1 (none), 0, 1, 2, ...
 				totalOptions := len(m.playlists) + 1 // +1 for "None"
@@ -527,6 +787,15 @@ func (m *YouTubeUploadModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeUploadModel,
 		case "enter":
 			return m.handleEnter()
 
+		case " ":
+			// Tick/untick the highlighted account for multi-account upload
+			if m.focusedField == YouTubeUploadFieldAccount && len(m.accounts) > 1 {
+				acc := m.accounts[m.selectedAccount]
+				m.checkedAccounts[acc.ID] = !m.checkedAccounts[acc.ID]
+				return m, nil
+			}
+			fallthrough
+
 		default:
 			// Forward all other keys to the focused text input
 			var cmd tea.Cmd
@@ -537,14 +806,35 @@ func (m *YouTubeUploadModel) handleKeyMsg(msg tea.KeyMsg) (*YouTubeUploadModel,
 				m.descriptionInput, cmd = m.descriptionInput.Update(msg)
 			case YouTubeUploadFieldTags:
 				m.tagsInput, cmd = m.tagsInput.Update(msg)
+			case YouTubeUploadFieldPublishAt:
+				m.publishAtInput, cmd = m.publishAtInput.Update(msg)
 			}
 			return m, cmd
 		}
 
+	case YouTubeUploadStepPreview:
+		switch msg.String() {
+		case "up", "k":
+			m.previewOffset = max(m.previewOffset-1, 0)
+		case "down", "j":
+			m.previewOffset++
+		case "pgup":
+			m.previewOffset = max(m.previewOffset-10, 0)
+		case "pgdown":
+			m.previewOffset += 10
+		case "home", "g":
+			m.previewOffset = 0
+		case "enter", "q":
+			m.step = YouTubeUploadStepMetadata
+		}
+
 	case YouTubeUploadStepComplete, YouTubeUploadStepError:
 		if msg.String() == "enter" {
 			return m, func() tea.Msg { return youtubeUploadDoneMsg{} }
 		}
+		if msg.String() == "r" && m.step == YouTubeUploadStepError {
+			return m, m.resumeUpload()
+		}
 	}
 
 	return m, nil
@@ -568,7 +858,7 @@ func (m *YouTubeUploadModel) handleEnter() (*YouTubeUploadModel, tea.Cmd) {
 			m.titleInput.Focus()
 		}
 		m.loadingPlaylists = true
-		return m, tea.Batch(textinput.Blink, m.loadPlaylists())
+		return m, tea.Batch(textinput.Blink, m.loadPlaylists(), m.loadCategories())
 
 	case YouTubeUploadStepMetadata:
 		switch m.focusedField {
@@ -578,7 +868,15 @@ func (m *YouTubeUploadModel) handleEnter() (*YouTubeUploadModel, tea.Cmd) {
 				m.errorMessage = "Title is required"
 				return m, nil
 			}
+			if _, err := youtube.ParsePublishAt(m.publishAtInput.Value(), time.Now()); err != nil && m.isPrivate() {
+				m.errorMessage = "Cannot schedule the video: " + err.Error()
+				return m, nil
+			}
 			return m, m.startUpload()
+		case YouTubeUploadFieldPreview:
+			m.step = YouTubeUploadStepPreview
+			m.previewOffset = 0
+			return m, nil
 		case YouTubeUploadFieldCancel:
 			m.step = YouTubeUploadStepPrompt
 			return m, nil
@@ -606,6 +904,10 @@ func (m *YouTubeUploadModel) nextField() {
 	if m.focusedField == YouTubeUploadFieldVideoSource && len(m.videoSourceOptions) <= 1 {
 		m.focusedField++
 	}
+	// Skip chapters if the recording has none
+	if m.focusedField == YouTubeUploadFieldChapters && len(m.chapters) == 0 {
+		m.focusedField++
+	}
 	if m.focusedField > YouTubeUploadFieldCancel {
 		m.focusedField = m.getFirstField()
 	}
@@ -616,6 +918,10 @@ func (m *YouTubeUploadModel) nextField() {
 func (m *YouTubeUploadModel) prevField() {
 	m.unfocusAll()
 	m.focusedField--
+	// Skip chapters if the recording has none
+	if m.focusedField == YouTubeUploadFieldChapters && len(m.chapters) == 0 {
+		m.focusedField--
+	}
 	// Skip video source if only one option available
 	if m.focusedField == YouTubeUploadFieldVideoSource && len(m.videoSourceOptions) <= 1 {
 		m.focusedField--
@@ -640,6 +946,8 @@ func (m *YouTubeUploadModel) updateVideoPath() {
 		m.videoPath = m.verticalVideoPath
 	case VideoSourceMerged:
 		m.videoPath = m.mergedVideoPath
+	case VideoSourceWebcamOnly:
+		m.videoPath = m.webcamOnlyVideoPath
 	}
 }
 
@@ -648,6 +956,7 @@ func (m *YouTubeUploadModel) unfocusAll() {
 	m.titleInput.Blur()
 	m.descriptionInput.Blur()
 	m.tagsInput.Blur()
+	m.publishAtInput.Blur()
 }
 
 // focusCurrent focuses the current field
@@ -659,6 +968,8 @@ func (m *YouTubeUploadModel) focusCurrent() {
 		m.descriptionInput.Focus()
 	case YouTubeUploadFieldTags:
 		m.tagsInput.Focus()
+	case YouTubeUploadFieldPublishAt:
+		m.publishAtInput.Focus()
 	}
 }
 
@@ -710,20 +1021,194 @@ func (m *YouTubeUploadModel) loadPlaylists() tea.Cmd {
 	}
 }
 
+// loadCategories fetches the categories of the selected account's region
+func (m *YouTubeUploadModel) loadCategories() tea.Cmd {
+	clientID, clientSecret, accountID := m.cfg.YouTube.ClientID, m.cfg.YouTube.ClientSecret, "legacy"
+	if len(m.accounts) > 0 && m.selectedAccount < len(m.accounts) {
+		acc := m.accounts[m.selectedAccount]
+		clientID, clientSecret, accountID = acc.ClientID, acc.ClientSecret, acc.ID
+	}
+
+	return func() tea.Msg {
+		ctx := context.Background()
+		auth := youtube.NewAuthForAccount(clientID, clientSecret, config.GetConfigDir(), accountID)
+		uploader, err := youtube.NewUploader(ctx, auth)
+		if err != nil {
+			return categoriesLoadedMsg{err: err}
+		}
+		region, err := uploader.ChannelRegion(ctx)
+		if err != nil {
+			return categoriesLoadedMsg{err: err}
+		}
+		categories, err := uploader.ListCategories(ctx, region)
+		return categoriesLoadedMsg{categories: categories, err: err}
+	}
+}
+
+// initialCategoryIndex returns the index in the built-in categories to
+// preselect. A last category only the region's list has falls back to the
+// topic's category until the region's categories are loaded.
+func initialCategoryIndex(topic string, cfg *youtube.Config) int {
+	if i := youtube.CategoryIndex(youtube.DefaultCategoryFor(topic, cfg.LastCategory, cfg.DefaultCategory)); i >= 0 {
+		return i
+	}
+	return youtube.CategoryIndex(youtube.DefaultCategoryFor(topic, "", cfg.DefaultCategory))
+}
+
+// setCategories replaces the categories offered, selecting the preferred
+// category if the user has not picked one, else keeping the selected one.
+// If the region has neither, the default category is selected.
+func (m *YouTubeUploadModel) setCategories(categories []youtube.Category) {
+	if len(categories) == 0 {
+		return
+	}
+	selected := m.categories[m.selectedCategory].ID
+	m.categories = categories
+	m.selectedCategory = 0
+	for _, id := range []string{youtube.DefaultCategoryID, selected, m.preferredCategory} {
+		for i, c := range categories {
+			if c.ID == id {
+				m.selectedCategory = i
+			}
+		}
+	}
+}
+
+// uploadTarget is one account (channel) a video is uploaded to
+type uploadTarget struct {
+	account      youtube.Account
+	playlistID   string
+	playlistName string
+}
+
+// displayName returns a short human-readable name for the target account
+func (t uploadTarget) displayName() string {
+	return accountDisplayName(t.account)
+}
+
+// accountUploadResult is the outcome of uploading to a single account
+type accountUploadResult struct {
+	target uploadTarget
+	result *youtube.UploadResult
+	err    error
+}
+
 // uploadUpdate carries progress or completion info from the upload goroutine
 type uploadUpdate struct {
-	percent  float64
-	done     bool
-	err      error
-	result   *youtube.UploadResult
+	percent     float64
+	targetIndex int
+	retry       string // Set when a call is retried after a rate limit or failure
+	done        bool
+	results     []accountUploadResult
+}
+
+// accountDisplayName returns the name shown for an account in the UI
+func accountDisplayName(acc youtube.Account) string {
+	if acc.Name != "" {
+		return acc.Name
+	}
+	if acc.ChannelName != "" {
+		return acc.ChannelName
+	}
+	id := acc.ID
+	if len(id) > 8 {
+		id = id[:8]
+	}
+	return "Account " + id
 }
 
-// startUpload begins the YouTube upload
+// buildUploadTargets returns the accounts to upload to: every ticked account,
+// or just the highlighted one if none are ticked. The playlist picked in the
+// form applies to the highlighted account; other accounts use their default playlist.
+func (m *YouTubeUploadModel) buildUploadTargets() []uploadTarget {
+	var playlistID, playlistName string
+	if m.selectedPlaylist >= 0 && m.selectedPlaylist < len(m.playlists) {
+		playlistID = m.playlists[m.selectedPlaylist].ID
+		playlistName = m.playlists[m.selectedPlaylist].Title
+	}
+
+	if len(m.accounts) == 0 || m.selectedAccount >= len(m.accounts) {
+		// Fallback to legacy config
+		return []uploadTarget{{
+			account: youtube.Account{
+				ID:           "legacy",
+				ClientID:     m.cfg.YouTube.ClientID,
+				ClientSecret: m.cfg.YouTube.ClientSecret,
+				ChannelName:  m.cfg.YouTube.ChannelName,
+				ChannelID:    m.cfg.YouTube.ChannelID,
+			},
+			playlistID:   playlistID,
+			playlistName: playlistName,
+		}}
+	}
+	highlightedID := m.accounts[m.selectedAccount].ID
+
+	checked := m.countCheckedAccounts()
+	var targets []uploadTarget
+	for _, acc := range m.accounts {
+		if checked > 0 && !m.checkedAccounts[acc.ID] {
+			continue
+		}
+		if checked == 0 && acc.ID != highlightedID {
+			continue
+		}
+		target := uploadTarget{account: acc}
+		if acc.ID == highlightedID {
+			target.playlistID = playlistID
+			target.playlistName = playlistName
+		} else {
+			target.playlistID = acc.DefaultPlaylistID
+			target.playlistName = acc.DefaultPlaylistName
+		}
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+// countCheckedAccounts returns how many accounts are ticked for upload
+func (m *YouTubeUploadModel) countCheckedAccounts() int {
+	count := 0
+	for _, acc := range m.accounts {
+		if m.checkedAccounts[acc.ID] {
+			count++
+		}
+	}
+	return count
+}
+
+// startUpload begins the YouTube upload to every target account.
+// Accounts are uploaded to one after another; a failure for one account is
+// recorded and the remaining accounts are still attempted.
 func (m *YouTubeUploadModel) startUpload() tea.Cmd {
+	return m.runUpload(m.buildUploadTargets())
+}
+
+// resumeUpload uploads again to the accounts the last upload failed for.
+// Uploads that stopped partway continue from their saved session instead of
+// sending the whole video again.
+func (m *YouTubeUploadModel) resumeUpload() tea.Cmd {
+	var targets []uploadTarget
+	for _, r := range m.uploadResults {
+		if r.err != nil {
+			targets = append(targets, r.target)
+		}
+	}
+	if len(targets) == 0 {
+		targets = m.uploadTargets
+	}
+	return m.runUpload(targets)
+}
+
+// runUpload uploads the video to the given accounts
+func (m *YouTubeUploadModel) runUpload(targets []uploadTarget) tea.Cmd {
 	m.step = YouTubeUploadStepUploading
 	m.isUploading = true
 	m.uploadPct = 0
+	m.uploadIndex = 0
+	m.uploadRetry = ""
+	m.uploadResults = nil
 	m.errorMessage = ""
+	m.uploadTargets = targets
 
 	// Create progress channel that will be used to send updates
 	m.uploadProgressCh = make(chan uploadUpdate, 100)
@@ -731,80 +1216,68 @@ func (m *YouTubeUploadModel) startUpload() tea.Cmd {
 	// Capture values needed by the goroutine
 	progressCh := m.uploadProgressCh
 	videoPath := m.videoPath
-	title := m.titleInput.Value()
-	description := m.descriptionInput.Value()
-	topic := m.topic
-	tags := youtube.ParseTags(m.tagsInput.Value())
-	privacy := m.privacyOptions[m.selectedPrivacy]
-	var playlistID string
-	if m.selectedPlaylist >= 0 && m.selectedPlaylist < len(m.playlists) {
-		playlistID = m.playlists[m.selectedPlaylist].ID
-	}
-
-	// Get selected account credentials
-	var clientID, clientSecret, accountID string
-	if len(m.accounts) > 0 && m.selectedAccount < len(m.accounts) {
-		acc := m.accounts[m.selectedAccount]
-		clientID = acc.ClientID
-		clientSecret = acc.ClientSecret
-		accountID = acc.ID
-	} else {
-		// Fallback to legacy config
-		clientID = m.cfg.YouTube.ClientID
-		clientSecret = m.cfg.YouTube.ClientSecret
-		accountID = "legacy"
+	options := make([]youtube.UploadOptions, len(targets))
+	for i, target := range targets {
+		options[i] = m.uploadOptions(target)
 	}
+	ytCfg := m.cfg.YouTube
 
 	// Start the upload in a goroutine
 	go func() {
 		ctx := context.Background()
 
-		// Create auth for selected account
-		auth := youtube.NewAuthForAccount(clientID, clientSecret, config.GetConfigDir(), accountID)
-
-		// Create uploader
-		uploader, err := youtube.NewUploader(ctx, auth)
-		if err != nil {
-			progressCh <- uploadUpdate{done: true, err: err}
-			close(progressCh)
-			return
+		// Extract the thumbnail once for all accounts
+		thumbnailPath := youtube.GetThumbnailPath(config.GetCacheDir(), videoPath)
+		if err := youtube.ExtractThumbnailForYouTube(videoPath, thumbnailPath); err != nil {
+			thumbnailPath = ""
 		}
 
-		// Build upload options
-		opts := youtube.BuildUploadOptions(
-			videoPath,
-			title,
-			description,
-			topic,
-			tags,
-			privacy,
-		)
-
-		// Add playlist if selected
-		if playlistID != "" {
-			opts.PlaylistID = playlistID
-		}
+		results := make([]accountUploadResult, 0, len(targets))
+		for i, target := range targets {
+			acc := target.account
+
+			if acc.ID != "legacy" && !youtube.IsAccountAuthenticated(&ytCfg, config.GetConfigDir(), acc.ID) {
+				results = append(results, accountUploadResult{
+					target: target,
+					err:    fmt.Errorf("account not connected, authenticate it in Options > YouTube"),
+				})
+				continue
+			}
+
+			// Create auth and uploader for this account
+			auth := youtube.NewAuthForAccount(acc.ClientID, acc.ClientSecret, config.GetConfigDir(), acc.ID)
+			uploader, err := youtube.NewUploader(ctx, auth)
+			if err != nil {
+				results = append(results, accountUploadResult{target: target, err: err})
+				continue
+			}
 
-		// First extract thumbnail if it doesn't exist
-		thumbnailPath := youtube.GetThumbnailPath(videoPath)
-		if err := youtube.ExtractThumbnailForYouTube(videoPath, thumbnailPath); err == nil {
+			opts := options[i]
 			opts.ThumbnailPath = thumbnailPath
-		}
 
-		// Upload with progress callback
-		result, err := uploader.Upload(ctx, opts, func(read, total int64) {
-			if total > 0 {
-				pct := float64(read) / float64(total)
+			// Upload with progress callback, showing retries after rate limits
+			index := i
+			uploader.SetRetryNotify(func(info youtube.RetryInfo) {
 				select {
-				case progressCh <- uploadUpdate{percent: pct}:
+				case progressCh <- uploadUpdate{targetIndex: index, retry: info.String()}:
 				default:
-					// Channel full, skip this update
 				}
-			}
-		})
+			})
+			result, err := uploader.Upload(ctx, opts, func(read, total int64) {
+				if total > 0 {
+					pct := float64(read) / float64(total)
+					select {
+					case progressCh <- uploadUpdate{percent: pct, targetIndex: index}:
+					default:
+						// Channel full, skip this update
+					}
+				}
+			})
+			results = append(results, accountUploadResult{target: target, result: result, err: err})
+		}
 
 		// Send completion
-		progressCh <- uploadUpdate{done: true, err: err, result: result}
+		progressCh <- uploadUpdate{done: true, results: results}
 		close(progressCh)
 	}()
 
@@ -812,6 +1285,54 @@ func (m *YouTubeUploadModel) startUpload() tea.Cmd {
 	return waitForUploadProgress(m.uploadProgressCh)
 }
 
+// finalDescription returns the description as it is sent to YouTube: the
+// typed description followed by what is added to it, such as the chapters.
+// The upload and its preview both use it.
+func (m *YouTubeUploadModel) finalDescription() string {
+	description := m.descriptionInput.Value()
+	if m.appendChapters {
+		description = chapters.AppendToDescription(description, m.chapters)
+	}
+	return description
+}
+
+// uploadOptions returns the upload options the form describes for a target
+func (m *YouTubeUploadModel) uploadOptions(target uploadTarget) youtube.UploadOptions {
+	opts := youtube.BuildUploadOptions(
+		m.videoPath,
+		m.titleInput.Value(),
+		m.finalDescription(),
+		m.topic,
+		youtube.ParseTags(m.tagsInput.Value()),
+		m.privacyOptions[m.selectedPrivacy],
+		m.madeForKids,
+		m.categories[m.selectedCategory].ID,
+	)
+	opts.PlaylistID = target.playlistID
+	opts.Language = youtube.Languages[m.selectedLanguage].Code
+	opts.AudioLanguage = youtube.Languages[m.selectedAudioLanguage].Code
+	opts.License = youtube.Licenses[m.selectedLicense]
+	opts.PublishAt = m.scheduledPublishAt()
+	return opts
+}
+
+// isPrivate returns true if the video is uploaded as private, the only
+// privacy a publish time applies to
+func (m *YouTubeUploadModel) isPrivate() bool {
+	return m.privacyOptions[m.selectedPrivacy] == youtube.PrivacyPrivate
+}
+
+// scheduledPublishAt returns the time YouTube publishes the video, zero if
+// it is not scheduled. The form checked it is in the future before the
+// upload started, so a retry later still sends it.
+func (m *YouTubeUploadModel) scheduledPublishAt() time.Time {
+	if !m.isPrivate() {
+		return time.Time{}
+	}
+	publishAt, _ := youtube.ParsePublishAt(m.publishAtInput.Value(), time.Time{})
+	return publishAt
+}
+
 // waitForUploadProgress waits for the next upload progress update
 func waitForUploadProgress(ch chan uploadUpdate) tea.Cmd {
 	if ch == nil {
@@ -821,12 +1342,12 @@ func waitForUploadProgress(ch chan uploadUpdate) tea.Cmd {
 		update, ok := <-ch
 		if !ok {
 			// Channel closed unexpectedly
-			return uploadCompleteMsg{err: nil}
+			return uploadCompleteMsg{}
 		}
 		if update.done {
-			return uploadCompleteMsg{err: update.err, result: update.result}
+			return uploadCompleteMsg{results: update.results}
 		}
-		return uploadProgressMsg{percent: update.percent}
+		return uploadProgressMsg{percent: update.percent, targetIndex: update.targetIndex, retry: update.retry}
 	}
 }
 
@@ -848,6 +1369,8 @@ func (m *YouTubeUploadModel) View() string {
 		content = m.renderError()
 	case YouTubeUploadStepSkipped:
 		content = m.renderSkipped()
+	case YouTubeUploadStepPreview:
+		content = m.renderPreview()
 	}
 
 	helpText := m.getHelpText()
@@ -922,14 +1445,14 @@ func (m *YouTubeUploadModel) renderMetadata() string {
 		}
 		var accountValues []string
 		for i, acc := range m.accounts {
-			displayName := acc.Name
-			if displayName == "" {
-				displayName = acc.ChannelName
-			}
-			if displayName == "" {
-				displayName = "Account " + acc.ID[:8]
+			displayName := accountDisplayName(acc)
+			if m.checkedAccounts[acc.ID] {
+				displayName = "✓ " + displayName
 			}
 			style := lipgloss.NewStyle().Foreground(ColorGray)
+			if m.checkedAccounts[acc.ID] {
+				style = lipgloss.NewStyle().Foreground(ColorGreen)
+			}
 			if i == m.selectedAccount {
 				if m.focusedField == YouTubeUploadFieldAccount {
 					style = lipgloss.NewStyle().Background(ColorOrange).Foreground(lipgloss.Color("#000000"))
@@ -941,6 +1464,14 @@ func (m *YouTubeUploadModel) renderMetadata() string {
 		}
 		accountValue := lipgloss.JoinHorizontal(lipgloss.Center, accountValues...)
 		accountRow = lipgloss.JoinHorizontal(lipgloss.Center, accountLabel, accountValue)
+		if m.focusedField == YouTubeUploadFieldAccount {
+			hint := "space: tick accounts to upload to several channels"
+			if n := m.countCheckedAccounts(); n > 0 {
+				hint = fmt.Sprintf("uploading to %d accounts • playlist applies to highlighted account", n)
+			}
+			accountRow = lipgloss.JoinVertical(lipgloss.Left, accountRow,
+				lipgloss.NewStyle().Foreground(ColorGray).Italic(true).PaddingLeft(15).Render(hint))
+		}
 	}
 
 	// Video source row (only show if multiple options available)
@@ -1020,6 +1551,16 @@ func (m *YouTubeUploadModel) renderMetadata() string {
 		descWarnings = lipgloss.JoinVertical(lipgloss.Left, warnings...)
 	}
 
+	// Chapters row, only for recordings with a chapters file
+	var chaptersRow string
+	if len(m.chapters) > 0 {
+		chaptersRow = m.renderToggleRow("Chapters: ", YouTubeUploadFieldChapters, "Not added",
+			fmt.Sprintf("Add %d to description", len(m.chapters)), m.appendChapters, labelStyle, labelActiveStyle)
+		if err := chapters.Validate(m.chapters); err != nil && m.appendChapters {
+			chaptersRow += lipgloss.NewStyle().Foreground(ColorGray).Render("  " + err.Error())
+		}
+	}
+
 	// Tags row
 	tagsLabel := labelStyle.Render("Tags: ")
 	if m.focusedField == YouTubeUploadFieldTags {
@@ -1079,17 +1620,107 @@ func (m *YouTubeUploadModel) renderMetadata() string {
 	}
 	privacyValue := lipgloss.JoinHorizontal(lipgloss.Center, privacyOptions...)
 	privacyRow := lipgloss.JoinHorizontal(lipgloss.Center, privacyLabel, privacyValue)
+	if m.sensitiveTopic {
+		reminderStyle := lipgloss.NewStyle().Foreground(ColorOrange).Italic(true)
+		reminder := "🔒 " + m.topic + " is a sensitive topic, private by default"
+		if privacy := m.privacyOptions[m.selectedPrivacy]; privacy != youtube.PrivacyPrivate {
+			reminder = fmt.Sprintf("🔒 %s is a sensitive topic, make sure it may be %s", m.topic, privacy)
+		}
+		privacyRow = lipgloss.JoinVertical(lipgloss.Left, privacyRow, strings.Repeat(" ", lipgloss.Width(privacyLabel))+reminderStyle.Render(reminder))
+	}
+
+	// Publish at row, only used for private videos
+	publishAtLabel := labelStyle.Render("Publish at: ")
+	if m.focusedField == YouTubeUploadFieldPublishAt {
+		publishAtLabel = labelActiveStyle.Render("Publish at: ")
+	}
+	publishAtRow := lipgloss.JoinHorizontal(lipgloss.Center, publishAtLabel, m.publishAtInput.View())
+	if !m.isPrivate() {
+		publishAtRow += lipgloss.NewStyle().Foreground(ColorGray).Render("  (private videos only)")
+	} else if m.focusedField == YouTubeUploadFieldPublishAt {
+		publishAtRow += lipgloss.NewStyle().Foreground(ColorGray).Render("  (local time)")
+	}
+
+	// Category row
+	categoryLabel := labelStyle.Render("Category: ")
+	categoryStyle := lipgloss.NewStyle().Foreground(ColorWhite).Bold(true)
+	if m.focusedField == YouTubeUploadFieldCategory {
+		categoryLabel = labelActiveStyle.Render("Category: ")
+		categoryStyle = lipgloss.NewStyle().Background(ColorOrange).Foreground(lipgloss.Color("#000000"))
+	}
+	categoryValue := categoryStyle.Render(" " + m.categories[m.selectedCategory].Name + " ")
+	if m.focusedField == YouTubeUploadFieldCategory {
+		categoryValue += lipgloss.NewStyle().Foreground(ColorGray).Render(" (←/→ to change)")
+	}
+	categoryRow := lipgloss.JoinHorizontal(lipgloss.Center, categoryLabel, categoryValue)
+
+	// Audience row
+	audienceLabel := labelStyle.Render("Audience: ")
+	if m.focusedField == YouTubeUploadFieldAudience {
+		audienceLabel = labelActiveStyle.Render("Audience: ")
+	}
+	var audienceOptions []string
+	for _, kids := range []bool{false, true} {
+		text := "Not made for kids"
+		if kids {
+			text = "Made for kids"
+		}
+		style := lipgloss.NewStyle().Foreground(ColorGray)
+		if kids == m.madeForKids {
+			if m.focusedField == YouTubeUploadFieldAudience {
+				style = lipgloss.NewStyle().Background(ColorOrange).Foreground(lipgloss.Color("#000000"))
+			} else {
+				style = lipgloss.NewStyle().Foreground(ColorWhite).Bold(true)
+			}
+		}
+		audienceOptions = append(audienceOptions, style.Render(" "+text+" "))
+	}
+	audienceValue := lipgloss.JoinHorizontal(lipgloss.Center, audienceOptions...)
+	audienceRow := lipgloss.JoinHorizontal(lipgloss.Center, audienceLabel, audienceValue)
+
+	// License row
+	licenseLabel := labelStyle.Render("License: ")
+	if m.focusedField == YouTubeUploadFieldLicense {
+		licenseLabel = labelActiveStyle.Render("License: ")
+	}
+	var licenseOptions []string
+	for i, license := range youtube.Licenses {
+		style := lipgloss.NewStyle().Foreground(ColorGray)
+		if i == m.selectedLicense {
+			if m.focusedField == YouTubeUploadFieldLicense {
+				style = lipgloss.NewStyle().Background(ColorOrange).Foreground(lipgloss.Color("#000000"))
+			} else {
+				style = lipgloss.NewStyle().Foreground(ColorWhite).Bold(true)
+			}
+		}
+		licenseOptions = append(licenseOptions, style.Render(" "+license.Label()+" "))
+	}
+	licenseValue := lipgloss.JoinHorizontal(lipgloss.Center, licenseOptions...)
+	licenseRow := lipgloss.JoinHorizontal(lipgloss.Center, licenseLabel, licenseValue)
+
+	// Language rows
+	languageCode := youtube.Languages[m.selectedLanguage].Code
+	languageHint := ""
+	if !spellcheck.SupportsLanguage(languageCode) {
+		languageHint = "spell check is English only"
+	}
+	languageRow := m.renderLanguageRow("Language: ", YouTubeUploadFieldLanguage, m.selectedLanguage, languageHint, labelStyle, labelActiveStyle)
+	audioLanguageRow := m.renderLanguageRow("Spoken in: ", YouTubeUploadFieldAudioLanguage, m.selectedAudioLanguage, "", labelStyle, labelActiveStyle)
 
 	// Buttons
 	uploadBtn := inactiveButtonStyle.Render("Upload")
 	if m.focusedField == YouTubeUploadFieldUpload {
 		uploadBtn = activeButtonStyle.Render("Upload")
 	}
+	previewBtn := inactiveButtonStyle.Render("Preview Description")
+	if m.focusedField == YouTubeUploadFieldPreview {
+		previewBtn = activeButtonStyle.Render("Preview Description")
+	}
 	cancelBtn := inactiveButtonStyle.Render("Cancel")
 	if m.focusedField == YouTubeUploadFieldCancel {
 		cancelBtn = activeButtonStyle.Render("Cancel")
 	}
-	buttonRow := lipgloss.JoinHorizontal(lipgloss.Center, uploadBtn, "  ", cancelBtn)
+	buttonRow := lipgloss.JoinHorizontal(lipgloss.Center, uploadBtn, "  ", previewBtn, "  ", cancelBtn)
 
 	var errorLine string
 	if m.errorMessage != "" {
@@ -1114,31 +1745,145 @@ func (m *YouTubeUploadModel) renderMetadata() string {
 	if descWarnings != "" {
 		rows = append(rows, descWarnings)
 	}
-	rows = append(rows, tagsRow, playlistRow, privacyRow, "", buttonRow, "", errorLine)
+	if chaptersRow != "" {
+		rows = append(rows, chaptersRow)
+	}
+	rows = append(rows, tagsRow, playlistRow, privacyRow, publishAtRow, categoryRow, audienceRow, licenseRow, languageRow, audioLanguageRow, "", buttonRow, "", errorLine)
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// renderPreview renders the final description, as the upload sends it, for
+// proofreading before publishing. Long descriptions scroll.
+func (m *YouTubeUploadModel) renderPreview() string {
+	opts := m.uploadOptions(uploadTarget{})
+	width := max(min(m.width-10, 90), 20)
+
+	description := opts.Description
+	if description == "" {
+		description = "(no description)"
+	}
+	lines := strings.Split(lipgloss.NewStyle().Width(width).Render(description), "\n")
+
+	visible := max(m.height-16, 5)
+	m.previewOffset = min(m.previewOffset, max(len(lines)-visible, 0))
+	end := min(m.previewOffset+visible, len(lines))
+
+	scrollInfo := fmt.Sprintf("Lines %d-%d of %d · %d characters", m.previewOffset+1, end, len(lines), utf8.RuneCountInString(opts.Description))
+	if m.previewOffset > 0 {
+		scrollInfo = "↑ " + scrollInfo
+	}
+	if end < len(lines) {
+		scrollInfo += " ↓"
+	}
 
+	rows := []string{
+		lipgloss.NewStyle().Bold(true).Foreground(ColorOrange).Render(opts.Title),
+		lipgloss.NewStyle().Foreground(ColorGray).Italic(true).Render(scrollInfo),
+		"",
+		lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(ColorGray).
+			Padding(0, 1).
+			Render(strings.Join(lines[m.previewOffset:end], "\n")),
+	}
+	if err := youtube.ValidateVideoMetadata(opts.Title, opts.Description, opts.Tags); err != nil {
+		rows = append(rows, "", lipgloss.NewStyle().Foreground(ColorRed).Render("YouTube will refuse this: "+err.Error()))
+	}
 	return lipgloss.JoinVertical(lipgloss.Left, rows...)
 }
 
+// renderToggleRow renders an on/off choice of the metadata form as two
+// options, off first, with the current choice highlighted
+func (m *YouTubeUploadModel) renderToggleRow(label string, field YouTubeUploadField, off, on string, value bool, labelStyle, labelActiveStyle lipgloss.Style) string {
+	if m.focusedField == field {
+		label = labelActiveStyle.Render(label)
+	} else {
+		label = labelStyle.Render(label)
+	}
+	var options []string
+	for _, opt := range []bool{false, true} {
+		text := off
+		if opt {
+			text = on
+		}
+		style := lipgloss.NewStyle().Foreground(ColorGray)
+		if opt == value {
+			if m.focusedField == field {
+				style = lipgloss.NewStyle().Background(ColorOrange).Foreground(lipgloss.Color("#000000"))
+			} else {
+				style = lipgloss.NewStyle().Foreground(ColorWhite).Bold(true)
+			}
+		}
+		options = append(options, style.Render(" "+text+" "))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Center, label, lipgloss.JoinHorizontal(lipgloss.Center, options...))
+}
+
+// renderLanguageRow renders a language selector row of the metadata form,
+// with an optional gray hint after the selected language
+func (m *YouTubeUploadModel) renderLanguageRow(label string, field YouTubeUploadField, selected int, hint string, labelStyle, labelActiveStyle lipgloss.Style) string {
+	lang := youtube.Languages[selected]
+	valueStyle := lipgloss.NewStyle().Foreground(ColorWhite).Bold(true)
+	if m.focusedField == field {
+		label = labelActiveStyle.Render(label)
+		valueStyle = lipgloss.NewStyle().Background(ColorOrange).Foreground(lipgloss.Color("#000000"))
+	} else {
+		label = labelStyle.Render(label)
+	}
+	value := valueStyle.Render(fmt.Sprintf(" %s (%s) ", lang.Name, lang.Code))
+	if m.focusedField == field {
+		if hint != "" {
+			hint += ", "
+		}
+		hint += "←/→ to change"
+	}
+	if hint != "" {
+		value += lipgloss.NewStyle().Foreground(ColorGray).Render(" (" + hint + ")")
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Center, label, value)
+}
+
 // renderUploading renders the upload progress
 func (m *YouTubeUploadModel) renderUploading() string {
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
 		Foreground(ColorOrange)
 
-	spinnerFrames := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
-	frame := spinnerFrames[int(time.Now().UnixMilli()/100)%len(spinnerFrames)]
-
-	pctText := lipgloss.NewStyle().
+	status := " Uploading to YouTube..."
+	if len(m.uploadTargets) > 1 && m.uploadIndex < len(m.uploadTargets) {
+		status = fmt.Sprintf(" Uploading to %s (%d/%d)...",
+			m.uploadTargets[m.uploadIndex].displayName(), m.uploadIndex+1, len(m.uploadTargets))
+	}
+	pctText := m.spinner.View() + lipgloss.NewStyle().
 		Foreground(ColorWhite).
-		Render(frame + " Uploading to YouTube...")
+		Render(status)
 
-	return lipgloss.JoinVertical(lipgloss.Center,
+	rows := []string{
 		titleStyle.Render("Uploading"),
 		"",
 		m.progress.ViewAs(m.uploadPct),
 		"",
 		pctText,
-	)
+	}
+	if m.uploadRetry != "" {
+		rows = append(rows, "", lipgloss.NewStyle().
+			Foreground(ColorOrange).
+			Render("⚠ "+m.uploadRetry))
+	}
+	return lipgloss.JoinVertical(lipgloss.Center, rows...)
+}
+
+// jobLabel describes the running upload for the status footer
+func (m *YouTubeUploadModel) jobLabel() string {
+	if m.uploadRetry != "" {
+		return "Upload retrying"
+	}
+	label := fmt.Sprintf("Uploading %d%%", int(m.uploadPct*100))
+	if len(m.uploadTargets) > 1 {
+		label += fmt.Sprintf(" (%d/%d)", m.uploadIndex+1, len(m.uploadTargets))
+	}
+	return label
 }
 
 // renderComplete renders the success message
@@ -1166,6 +1911,27 @@ func (m *YouTubeUploadModel) renderComplete() string {
 			Render("Added to playlist: " + m.playlists[m.selectedPlaylist].Title)
 	}
 
+	// Multi-account upload: list the outcome for every account
+	if len(m.uploadResults) > 1 {
+		rows := []string{
+			titleStyle.Render("Upload Complete!"),
+			"",
+		}
+		for _, r := range m.uploadResults {
+			if r.err != nil {
+				rows = append(rows, lipgloss.NewStyle().Foreground(ColorRed).Render(
+					"✗ "+r.target.displayName()+": "+youtube.FriendlyError(r.err)))
+				continue
+			}
+			rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Center,
+				lipgloss.NewStyle().Foreground(ColorGreen).Render("✓ "+r.target.displayName()+": "),
+				linkStyle.Render(r.result.VideoURL),
+			))
+		}
+		rows = append(rows, "", lipgloss.NewStyle().Foreground(ColorGray).Render("enter: continue"))
+		return lipgloss.JoinVertical(lipgloss.Center, rows...)
+	}
+
 	return lipgloss.JoinVertical(lipgloss.Center,
 		titleStyle.Render("Upload Complete!"),
 		"",
@@ -1185,12 +1951,20 @@ func (m *YouTubeUploadModel) renderError() string {
 		Bold(true).
 		Foreground(ColorRed)
 
+	help := "enter: continue • r: retry"
+	for _, r := range m.uploadResults {
+		if r.err != nil && youtube.HasUploadSession(m.videoPath, r.target.account.ID) {
+			help = "enter: continue • r: resume upload"
+			break
+		}
+	}
+
 	return lipgloss.JoinVertical(lipgloss.Center,
 		titleStyle.Render("Upload Failed"),
 		"",
 		lipgloss.NewStyle().Foreground(ColorWhite).Render(m.errorMessage),
 		"",
-		lipgloss.NewStyle().Foreground(ColorGray).Render("enter: continue • r: retry"),
+		lipgloss.NewStyle().Foreground(ColorGray).Render(help),
 	)
 }
 
@@ -1207,13 +1981,15 @@ func (m *YouTubeUploadModel) getHelpText() string {
 	case YouTubeUploadStepPrompt:
 		return "y: upload • n: skip • esc: skip"
 	case YouTubeUploadStepMetadata:
-		return "tab: next field • enter: select • ←/→: change playlist/privacy • esc: back"
+		return "tab: next field • enter: select • ←/→: change account/playlist/privacy/category/audience • space: tick account • esc: back"
 	case YouTubeUploadStepUploading:
 		return "uploading..."
 	case YouTubeUploadStepComplete:
 		return "enter: continue"
 	case YouTubeUploadStepError:
 		return "enter: continue • r: retry"
+	case YouTubeUploadStepPreview:
+		return "↑/↓: scroll • PgUp/PgDn: page • enter/esc: back to the form"
 	default:
 		return ""
 	}
@@ -1226,13 +2002,19 @@ type playlistsLoadedMsg struct {
 	err       error
 }
 
+type categoriesLoadedMsg struct {
+	categories []youtube.Category
+	err        error
+}
+
 type uploadProgressMsg struct {
-	percent float64
+	percent     float64
+	targetIndex int
+	retry       string
 }
 
 type uploadCompleteMsg struct {
-	result *youtube.UploadResult
-	err    error
+	results []accountUploadResult
 }
 
 type youtubeUploadSkippedMsg struct{}
@@ -0,0 +1,332 @@
+package tui
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kartoza/kartoza-screencaster/internal/chapters"
+	"github.com/kartoza/kartoza-screencaster/internal/config"
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+	"github.com/kartoza/kartoza-screencaster/internal/youtube"
+)
+
+func TestYouTubeUpload_PreselectsAnotherAccount(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	cfg, _ := config.Load()
+	cfg.YouTube.AddAccount(youtube.Account{ID: "personal", Name: "Personal", ChannelID: "UC1"})
+	cfg.YouTube.AddAccount(youtube.Account{ID: "brand", Name: "Brand", ChannelID: "UC2"})
+	cfg.YouTube.SetDefaultAccount("personal")
+	if err := config.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := &models.RecordingInfo{}
+	if m := NewYouTubeUploadModelWithRecording("", rec); m.accounts[m.selectedAccount].ID != "personal" {
+		t.Errorf("selected %q, want the default account for a first upload", m.accounts[m.selectedAccount].ID)
+	}
+
+	// A legacy upload identified by channel only
+	rec.Metadata.LegacyYouTube = &models.YouTubeUpload{VideoID: "abc123", ChannelID: "UC1"}
+	if m := NewYouTubeUploadModelWithRecording("", rec); m.accounts[m.selectedAccount].ID != "brand" {
+		t.Errorf("selected %q, want the account the video is not on yet", m.accounts[m.selectedAccount].ID)
+	}
+}
+
+func TestHistoryDetail_UploadAgain(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	h := historyWithRecordings("Published", "Local only")
+	h.recordings[0].Metadata.YouTubeUploads = []models.YouTubeUpload{{VideoID: "abc123"}}
+	h.mode = HistoryDetailMode
+
+	// u only uploads recordings that are not on YouTube yet, U only
+	// published ones. No account is connected, so an attempt shows an error.
+	h.selectedRecording = &h.recordings[0]
+	h.Update(bulkKey("u"))
+	if h.youtubeActionError != "" {
+		t.Errorf("expected u to be ignored for a published recording, got %q", h.youtubeActionError)
+	}
+	h.Update(bulkKey("U"))
+	if h.youtubeActionError == "" {
+		t.Error("expected U to try to upload a published recording again")
+	}
+
+	h.youtubeActionError = ""
+	h.selectedRecording = &h.recordings[1]
+	h.Update(bulkKey("U"))
+	if h.youtubeActionError != "" {
+		t.Errorf("expected U to be ignored for an unpublished recording, got %q", h.youtubeActionError)
+	}
+}
+
+func TestYouTubeUpload_SensitiveTopicDefaultsToPrivate(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	cfg, _ := config.Load()
+	cfg.YouTube.DefaultPrivacy = youtube.PrivacyPublic
+	cfg.Topics = []models.Topic{{ID: "internal", Name: "Internal", Sensitive: true}, {ID: "demo", Name: "Demo"}}
+	if err := config.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := &models.RecordingInfo{}
+	rec.Metadata.Topic = "Demo"
+	rec.Metadata.UploadDefaults = &models.UploadDefaults{Privacy: "public"}
+	if m := NewYouTubeUploadModelWithRecording("", rec); m.privacyOptions[m.selectedPrivacy] != youtube.PrivacyPublic || m.sensitiveTopic {
+		t.Errorf("privacy = %s, want public for a topic that is not sensitive", m.privacyOptions[m.selectedPrivacy])
+	}
+
+	// Neither the configured default nor a template make it public
+	rec.Metadata.Topic = "Internal"
+	m := NewYouTubeUploadModelWithRecording("", rec)
+	if m.privacyOptions[m.selectedPrivacy] != youtube.PrivacyPrivate {
+		t.Errorf("privacy = %s, want private for a sensitive topic", m.privacyOptions[m.selectedPrivacy])
+	}
+	if !strings.Contains(m.renderMetadata(), "sensitive topic") {
+		t.Error("expected a reminder that the topic is sensitive")
+	}
+}
+
+func TestYouTubeUpload_ResumeFailedAccounts(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	m := NewYouTubeUploadModelWithRecording(filepath.Join(t.TempDir(), "final.mp4"), &models.RecordingInfo{})
+	personal := uploadTarget{account: youtube.Account{ID: "personal"}}
+	brand := uploadTarget{account: youtube.Account{ID: "brand"}}
+	m.step = YouTubeUploadStepError
+	m.uploadTargets = []uploadTarget{personal, brand}
+	m.uploadResults = []accountUploadResult{
+		{target: personal, result: &youtube.UploadResult{VideoID: "abc123"}},
+		{target: brand, err: errors.New("connection reset")},
+	}
+
+	if _, cmd := m.Update(bulkKey("r")); cmd == nil {
+		t.Fatal("expected r to start the upload again")
+	}
+	if m.step != YouTubeUploadStepUploading || len(m.uploadTargets) != 1 || m.uploadTargets[0].account.ID != "brand" {
+		t.Errorf("step %v with targets %+v, want only the failed account uploaded again", m.step, m.uploadTargets)
+	}
+}
+
+func TestYouTubeUpload_AppendsChapters(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	rec := &models.RecordingInfo{}
+	rec.Files.FolderPath = t.TempDir()
+	rec.Metadata.Title = "QGIS intro"
+	rec.Metadata.Description = "Learn QGIS."
+
+	// Without a chapters file the field is skipped
+	m := NewYouTubeUploadModelWithRecording("", rec)
+	m.focusedField = YouTubeUploadFieldDescription
+	m.nextField()
+	if m.focusedField != YouTubeUploadFieldTags {
+		t.Errorf("focused %v, want the chapters skipped", m.focusedField)
+	}
+
+	if err := chapters.Save(rec.Files.FolderPath, chapters.FromPauses([]time.Duration{time.Minute, 3 * time.Minute})); err != nil {
+		t.Fatal(err)
+	}
+	m = NewYouTubeUploadModelWithRecording("", rec)
+	if opts := m.uploadOptions(uploadTarget{}); opts.Description != "Learn QGIS." {
+		t.Errorf("description = %q, want the chapters only added when asked", opts.Description)
+	}
+	m.focusedField = YouTubeUploadFieldDescription
+	m.nextField()
+	if m.focusedField != YouTubeUploadFieldChapters {
+		t.Fatalf("focused %v, want the chapters", m.focusedField)
+	}
+	m.step = YouTubeUploadStepMetadata
+	m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if !strings.Contains(m.renderMetadata(), "Add 3 to description") {
+		t.Error("expected the chapters row in the form")
+	}
+	want := "Learn QGIS.\n\nChapters:\n00:00 Intro\n01:00 Part 2\n03:00 Part 3"
+	if opts := m.uploadOptions(uploadTarget{}); opts.Description != want {
+		t.Errorf("description = %q, want %q", opts.Description, want)
+	}
+}
+
+func TestYouTubeUpload_PreviewsFinalDescription(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	rec := &models.RecordingInfo{}
+	rec.Files.FolderPath = t.TempDir()
+	rec.Metadata.Title = "QGIS intro"
+	rec.Metadata.Description = "Learn QGIS."
+	if err := chapters.Save(rec.Files.FolderPath, chapters.FromPauses([]time.Duration{time.Minute})); err != nil {
+		t.Fatal(err)
+	}
+	m := NewYouTubeUploadModelWithRecording("", rec)
+	m.width, m.height = 120, 40
+	m.step = YouTubeUploadStepMetadata
+	m.appendChapters = true
+
+	m.focusedField = YouTubeUploadFieldUpload
+	m.nextField()
+	if m.focusedField != YouTubeUploadFieldPreview {
+		t.Fatalf("focused %v, want the preview after the upload button", m.focusedField)
+	}
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil || m.step != YouTubeUploadStepPreview {
+		t.Fatalf("step %v, want the preview without uploading", m.step)
+	}
+
+	// The preview shows what the upload sends, chapters included
+	if m.finalDescription() != m.uploadOptions(uploadTarget{}).Description {
+		t.Error("expected the preview and the upload to share the description")
+	}
+	view := m.View()
+	for _, want := range []string{"Learn QGIS.", "Chapters:", "01:00 Part 2"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected the preview to show %q", want)
+		}
+	}
+
+	// Text YouTube refuses is pointed out
+	m.descriptionInput.SetValue("Use <layer> names")
+	if !strings.Contains(m.View(), "YouTube will refuse this") {
+		t.Error("expected the preview to warn about < and >")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.step != YouTubeUploadStepMetadata {
+		t.Errorf("step %v, want back on the form", m.step)
+	}
+}
+
+func TestYouTubeUpload_RemembersRegionCategory(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	cfg, _ := config.Load()
+	cfg.YouTube.LastCategory = "26"
+	if err := config.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	// The category of the last upload is preselected
+	m := NewYouTubeUploadModelWithRecording("", &models.RecordingInfo{})
+	if id := m.categories[m.selectedCategory].ID; id != "26" {
+		t.Fatalf("category %s, want the last upload's 26", id)
+	}
+
+	// The categories of the region replace the built-in ones, keeping the
+	// selection if the region has it
+	m.Update(categoriesLoadedMsg{categories: []youtube.Category{{ID: "28", Name: "Science & Technology"}, {ID: "26", Name: "Howto & Style"}}})
+	if len(m.categories) != 2 || m.categories[m.selectedCategory].ID != "26" {
+		t.Errorf("categories %v selected %d, want the region's with 26 kept", m.categories, m.selectedCategory)
+	}
+	m.Update(categoriesLoadedMsg{categories: []youtube.Category{{ID: "27", Name: "Education"}, {ID: "28", Name: "Science & Technology"}}})
+	if id := m.categories[m.selectedCategory].ID; id != youtube.DefaultCategoryID {
+		t.Errorf("category %s, want the default when the region lacks the selected one", id)
+	}
+
+	// The category of a successful upload is remembered
+	m.selectedCategory = 0
+	m.Update(uploadCompleteMsg{results: []accountUploadResult{{result: &youtube.UploadResult{VideoID: "abc123"}}}})
+	if cfg, _ := config.Load(); cfg.YouTube.LastCategory != "27" {
+		t.Errorf("last category = %q, want 27 saved", cfg.YouTube.LastCategory)
+	}
+
+	// The last category wins over the topic's, and one only the region's
+	// list has is selected once it is loaded
+	cfg, _ = config.Load()
+	cfg.YouTube.LastCategory = "30"
+	if err := config.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+	m = NewYouTubeUploadModelWithRecording("", &models.RecordingInfo{Metadata: models.RecordingMetadata{Topic: "tutorial"}})
+	if id := m.categories[m.selectedCategory].ID; id != "27" {
+		t.Fatalf("category %s, want the tutorial topic's 27 until the region's list is loaded", id)
+	}
+	m.Update(categoriesLoadedMsg{categories: []youtube.Category{{ID: "27", Name: "Education"}, {ID: "30", Name: "Movies"}}})
+	if id := m.categories[m.selectedCategory].ID; id != "30" {
+		t.Errorf("category %s, want the last upload's 30 from the region's list", id)
+	}
+
+	// A category the user picked is kept when the region's list is loaded
+	m = NewYouTubeUploadModelWithRecording("", &models.RecordingInfo{})
+	m.step = YouTubeUploadStepMetadata
+	m.focusedField = YouTubeUploadFieldCategory
+	m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	picked := m.categories[m.selectedCategory].ID
+	m.Update(categoriesLoadedMsg{categories: []youtube.Category{{ID: picked, Name: "Picked"}, {ID: "30", Name: "Movies"}}})
+	if id := m.categories[m.selectedCategory].ID; id != picked {
+		t.Errorf("category %s, want the picked %s kept", id, picked)
+	}
+}
+
+func TestYouTubeUpload_SchedulesPublishTime(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	m := NewYouTubeUploadModelWithRecording("", &models.RecordingInfo{})
+	m.step = YouTubeUploadStepMetadata
+	m.titleInput.SetValue("QGIS intro")
+	m.focusedField = YouTubeUploadFieldPrivacy
+	for m.privacyOptions[m.selectedPrivacy] != youtube.PrivacyPrivate {
+		m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	}
+	m.nextField()
+	if m.focusedField != YouTubeUploadFieldPublishAt {
+		t.Fatalf("focused %v, want the publish time after the privacy", m.focusedField)
+	}
+
+	// A time in the past is refused before uploading
+	m.Update(bulkKey("2020-01-05 09:00"))
+	m.focusedField = YouTubeUploadFieldUpload
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil || !strings.Contains(m.errorMessage, "future") {
+		t.Errorf("error = %q, want a past publish time refused", m.errorMessage)
+	}
+
+	publishAt := time.Now().Add(48 * time.Hour).Truncate(time.Minute)
+	m.publishAtInput.SetValue(publishAt.Format(youtube.PublishAtLayout))
+	opts := m.uploadOptions(uploadTarget{})
+	if !opts.PublishAt.Equal(publishAt) {
+		t.Errorf("PublishAt = %v, want %v", opts.PublishAt, publishAt)
+	}
+	upload := uploadMetadata(opts, uploadTarget{}, &youtube.UploadResult{VideoID: "abc123"})
+	if want := "Scheduled for " + publishAt.Format("Jan 2 15:04"); upload.PrivacyLabel(time.Now()) != want {
+		t.Errorf("privacy = %q, want %q", upload.PrivacyLabel(time.Now()), want)
+	}
+
+	// Only private videos are scheduled
+	m.selectedPrivacy = 0
+	if opts := m.uploadOptions(uploadTarget{}); !opts.PublishAt.IsZero() {
+		t.Errorf("PublishAt = %v, want none for an unlisted video", opts.PublishAt)
+	}
+	if !strings.Contains(m.renderMetadata(), "private videos only") {
+		t.Error("expected the form to say the publish time is for private videos")
+	}
+}
+
+func TestYouTubeUpload_KeepsResultsWhenAnAccountFails(t *testing.T) {
+	t.Setenv(config.ConfigDirEnvVar, t.TempDir())
+	rec := &models.RecordingInfo{}
+	rec.Files.FolderPath = t.TempDir()
+	m := NewYouTubeUploadModelWithRecording(filepath.Join(rec.Files.FolderPath, "final.mp4"), rec)
+	personal := uploadTarget{account: youtube.Account{ID: "personal", Name: "Personal", ChannelID: "UC1"}}
+	brand := uploadTarget{account: youtube.Account{ID: "brand", Name: "Brand", ChannelID: "UC2"}}
+	company := uploadTarget{account: youtube.Account{ID: "company", Name: "Company", ChannelID: "UC3"}}
+
+	// The failure comes first, between two successful uploads
+	m.Update(uploadCompleteMsg{results: []accountUploadResult{
+		{target: brand, err: errors.New("connection reset")},
+		{target: personal, result: &youtube.UploadResult{VideoID: "abc123", VideoURL: "https://youtu.be/abc123"}},
+		{target: company, result: &youtube.UploadResult{VideoID: "def456"}},
+	}})
+
+	if m.step != YouTubeUploadStepComplete || m.uploadResult == nil || m.uploadResult.VideoID != "abc123" {
+		t.Fatalf("step %v with result %+v, want complete with the first successful upload", m.step, m.uploadResult)
+	}
+	if len(m.uploadResults) != 3 || m.uploadResults[0].err == nil {
+		t.Errorf("results = %+v, want the failure kept for a retry", m.uploadResults)
+	}
+
+	saved, err := models.LoadRecordingInfo(rec.Files.FolderPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uploads := saved.Metadata.AllYouTubeUploads()
+	if len(uploads) != 2 || uploads[0].AccountID != "personal" || uploads[1].AccountID != "company" {
+		t.Fatalf("saved uploads = %+v, want both successful accounts", uploads)
+	}
+	if uploads[0].ChannelID != "UC1" || uploads[1].VideoID != "def456" {
+		t.Errorf("saved uploads = %+v, want each account's own result", uploads)
+	}
+}
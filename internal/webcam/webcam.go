@@ -19,6 +19,20 @@ type Options struct {
 	OutputFile string
 }
 
+// Device is a webcam that can be recorded from
+type Device struct {
+	Name  string // Device passed in Options.Device, e.g. video0
+	Label string // Human-readable name, e.g. the camera model
+}
+
+// DisplayName returns the label of the device followed by its name
+func (d Device) DisplayName() string {
+	if d.Label == "" || d.Label == d.Name {
+		return d.Name
+	}
+	return fmt.Sprintf("%s (%s)", d.Label, d.Name)
+}
+
 // DefaultOptions returns default webcam recording options
 func DefaultOptions() Options {
 	return Options{
@@ -107,6 +107,17 @@ func (w *Webcam) IsRecording() bool {
 	return err == nil
 }
 
+// ListCameras returns the webcams that can be recorded from. Listing them
+// is not supported on macOS yet, so the detected device is used.
+func ListCameras() ([]Device, error) {
+	return nil, nil
+}
+
+// Preview opens a live view of a webcam. It is not supported on macOS yet.
+func Preview(device string) (*exec.Cmd, error) {
+	return nil, fmt.Errorf("webcam preview is not supported on macOS")
+}
+
 // ListDevices returns a list of available webcam devices on macOS
 func ListDevices() ([]string, error) {
 	cmd := exec.Command("ffmpeg", "-f", "avfoundation", "-list_devices", "true", "-i", "")
@@ -6,10 +6,16 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
 	"syscall"
 )
 
+// video4linuxDir is where the kernel lists video devices with their names
+const video4linuxDir = "/sys/class/video4linux"
+
 // Webcam represents a webcam recording session
 type Webcam struct {
 	device     string
@@ -134,6 +140,68 @@ func (w *Webcam) IsRecording() bool {
 	return err == nil
 }
 
+// ListCameras returns the webcams with their names from video4linux. Only
+// the first device node of each camera is listed, the others carry metadata.
+func ListCameras() ([]Device, error) {
+	return listCameras(video4linuxDir)
+}
+
+// listCameras lists the cameras in a video4linux sysfs directory
+func listCameras(dir string) ([]Device, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []Device
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, "video") {
+			continue
+		}
+		if index, err := os.ReadFile(filepath.Join(dir, name, "index")); err == nil && strings.TrimSpace(string(index)) != "0" {
+			continue
+		}
+		device := Device{Name: name, Label: name}
+		if label, err := os.ReadFile(filepath.Join(dir, name, "name")); err == nil && strings.TrimSpace(string(label)) != "" {
+			device.Label = strings.TrimSpace(string(label))
+		}
+		devices = append(devices, device)
+	}
+
+	// video10 sorts after video2
+	number := func(d Device) int {
+		n, _ := strconv.Atoi(strings.TrimPrefix(d.Name, "video"))
+		return n
+	}
+	sort.Slice(devices, func(i, j int) bool { return number(devices[i]) < number(devices[j]) })
+	return devices, nil
+}
+
+// Preview opens a live view of a webcam (the first one if device is empty)
+// in an ffplay window, to check the framing before recording. The webcam
+// cannot be recorded while the window is open, so the returned command
+// should be killed first.
+func Preview(device string) (*exec.Cmd, error) {
+	if device == "" {
+		var err error
+		device, err = DetectDevice()
+		if err != nil {
+			return nil, err
+		}
+	}
+	cmd := exec.Command("ffplay",
+		"-loglevel", "error",
+		"-window_title", "Webcam preview: "+device,
+		"-f", "v4l2",
+		"-i", "/dev/"+device,
+	)
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start webcam preview: %w", err)
+	}
+	return cmd, nil
+}
+
 // ListDevices returns a list of available webcam devices on Linux
 func ListDevices() ([]string, error) {
 	var devices []string
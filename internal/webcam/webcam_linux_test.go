@@ -0,0 +1,64 @@
+//go:build linux
+
+package webcam
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestListCameras(t *testing.T) {
+	dir := t.TempDir()
+	nodes := []struct {
+		name, label, index string
+	}{
+		{"video10", "USB Camera", "0"},
+		{"video2", "Integrated Camera: Integrated C", "0"},
+		{"video3", "Integrated Camera: Integrated C", "1"}, // Metadata node
+		{"video0", "", ""},
+	}
+	for _, n := range nodes {
+		nodeDir := filepath.Join(dir, n.name)
+		if err := os.MkdirAll(nodeDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if n.label != "" {
+			if err := os.WriteFile(filepath.Join(nodeDir, "name"), []byte(n.label+"\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if n.index != "" {
+			if err := os.WriteFile(filepath.Join(nodeDir, "index"), []byte(n.index+"\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "v4l-subdev0"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := listCameras(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Device{
+		{Name: "video0", Label: "video0"},
+		{Name: "video2", Label: "Integrated Camera: Integrated C"},
+		{Name: "video10", Label: "USB Camera"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("listCameras() = %+v, want %+v", got, want)
+	}
+	if name := got[1].DisplayName(); name != "Integrated Camera: Integrated C (video2)" {
+		t.Errorf("DisplayName() = %q", name)
+	}
+	if name := got[0].DisplayName(); name != "video0" {
+		t.Errorf("DisplayName() = %q, want just the device without a label", name)
+	}
+
+	if _, err := listCameras(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected an error without video4linux")
+	}
+}
@@ -102,6 +102,17 @@ func (w *Webcam) IsRecording() bool {
 	return w.cmd.ProcessState == nil
 }
 
+// ListCameras returns the webcams that can be recorded from. Listing them
+// is not supported on Windows yet, so the detected device is used.
+func ListCameras() ([]Device, error) {
+	return nil, nil
+}
+
+// Preview opens a live view of a webcam. It is not supported on Windows yet.
+func Preview(device string) (*exec.Cmd, error) {
+	return nil, fmt.Errorf("webcam preview is not supported on Windows")
+}
+
 // ListDevices returns a list of available webcam devices on Windows
 func ListDevices() ([]string, error) {
 	cmd := exec.Command("ffmpeg", "-f", "dshow", "-list_devices", "true", "-i", "dummy")
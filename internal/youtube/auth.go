@@ -10,9 +10,12 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"os"
 	"os/exec"
+	"regexp"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/oauth2"
@@ -24,15 +27,34 @@ import (
 // OAuth2 scopes required for YouTube upload
 var oauthScopes = []string{
 	"https://www.googleapis.com/auth/youtube.upload",
-	"https://www.googleapis.com/auth/youtube", // For playlist management
+	"https://www.googleapis.com/auth/youtube",        // For playlist management
+	"https://www.googleapis.com/auth/userinfo.email", // To show which Google account is connected
 }
 
+// userInfoURL is the OpenID Connect endpoint returning the account email (a
+// variable so tests can point it at a local server)
+var userInfoURL = "https://openidconnect.googleapis.com/v1/userinfo"
+
 // Auth handles YouTube OAuth2 authentication
 type Auth struct {
 	config    *oauth2.Config
 	configDir string
 	accountID string // Account ID for multi-account support
 	token     *oauth2.Token
+
+	// forceConsent shows the consent screen even if access was granted
+	// before, so Google issues a new refresh token
+	forceConsent bool
+
+	// redirectURI is the fixed callback URI registered for the OAuth client
+	// (empty = any free loopback port)
+	redirectURI string
+
+	// Pending authorization, used to exchange a manually pasted code
+	mu              sync.Mutex
+	pendingVerifier string
+	pendingState    string
+	manualDone      chan struct{}
 }
 
 // NewAuth creates a new YouTube authenticator (legacy, uses default account)
@@ -55,8 +77,26 @@ func NewAuthForAccount(clientID, clientSecret, configDir, accountID string) *Aut
 	}
 }
 
+// SetForceConsent makes the next authentication show the consent screen
+// again, so Google issues a new refresh token. Used to re-authenticate an
+// account whose token was revoked (e.g. after a password change); the stored
+// token is only replaced once the new authorization succeeds.
+func (a *Auth) SetForceConsent(force bool) {
+	a.forceConsent = force
+}
+
+// SetRedirectURI makes authentication listen on the given loopback redirect
+// URI, which must be registered for the OAuth client in Google Cloud.
+// Empty picks a free port, which Desktop app clients accept.
+func (a *Auth) SetRedirectURI(uri string) {
+	a.redirectURI = strings.TrimSpace(uri)
+}
+
 // IsAuthenticated returns true if we have valid tokens
 func (a *Auth) IsAuthenticated() bool {
+	if a.accountID == EnvAccountID {
+		return HasEnvCredentials()
+	}
 	if a.token != nil && a.token.Valid() {
 		return true
 	}
@@ -121,6 +161,41 @@ func (a *Auth) GetChannelID(ctx context.Context) (string, error) {
 	return response.Items[0].Id, nil
 }
 
+// GetAccountEmail returns the email address of the authenticated Google
+// account. Tokens obtained before the email scope was requested return an
+// error until the account is re-authenticated.
+func (a *Auth) GetAccountEmail(ctx context.Context) (string, error) {
+	client, err := a.GetClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, userInfoURL, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get account info: %s", resp.Status)
+	}
+
+	var info struct {
+		Email string `json:"email"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return "", fmt.Errorf("failed to decode account info: %w", err)
+	}
+	if info.Email == "" {
+		return "", fmt.Errorf("no email in account info")
+	}
+	return info.Email, nil
+}
+
 // AuthenticateWithCallback starts the OAuth2 flow and calls the callback with the auth URL
 // This allows the UI to display the URL while authentication proceeds
 func (a *Auth) AuthenticateWithCallback(ctx context.Context, onURL func(string)) error {
@@ -135,16 +210,27 @@ func (a *Auth) Authenticate(ctx context.Context) error {
 
 // authenticateInternal is the internal implementation of authentication
 func (a *Auth) authenticateInternal(ctx context.Context, onURL func(string)) error {
-	// Find an available port for the callback server
-	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	// Listen on the registered redirect URI, or find an available port
+	listenAddr, callbackPath := "127.0.0.1:0", "/callback"
+	if a.redirectURI != "" {
+		redirect, err := ParseRedirectURI(a.redirectURI)
+		if err != nil {
+			return err
+		}
+		listenAddr, callbackPath = redirect.ListenAddr(), redirect.Path
+	}
+	listener, err := net.Listen("tcp", listenAddr)
 	if err != nil {
-		return fmt.Errorf("failed to start callback server: %w", err)
+		return fmt.Errorf("failed to start callback server on %s: %w", listenAddr, err)
 	}
 	defer func() { _ = listener.Close() }()
 
-	port := listener.Addr().(*net.TCPAddr).Port
-	redirectURL := fmt.Sprintf("http://127.0.0.1:%d/callback", port)
-	a.config.RedirectURL = redirectURL
+	if a.redirectURI != "" {
+		a.config.RedirectURL = a.redirectURI
+	} else {
+		port := listener.Addr().(*net.TCPAddr).Port
+		a.config.RedirectURL = fmt.Sprintf("http://127.0.0.1:%d/callback", port)
+	}
 
 	// Generate PKCE code verifier and challenge
 	codeVerifier, err := generateCodeVerifier()
@@ -159,12 +245,25 @@ func (a *Auth) authenticateInternal(ctx context.Context, onURL func(string)) err
 		return fmt.Errorf("failed to generate state: %w", err)
 	}
 
+	// Remember the pending authorization so a pasted code can complete it
+	manualDone := make(chan struct{})
+	a.mu.Lock()
+	a.pendingVerifier = codeVerifier
+	a.pendingState = state
+	a.manualDone = manualDone
+	a.mu.Unlock()
+	defer a.clearPending()
+
 	// Build authorization URL with PKCE
-	authURL := a.config.AuthCodeURL(state,
+	authOpts := []oauth2.AuthCodeOption{
 		oauth2.AccessTypeOffline,
 		oauth2.SetAuthURLParam("code_challenge", codeChallenge),
 		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
-	)
+	}
+	if a.forceConsent {
+		authOpts = append(authOpts, oauth2.ApprovalForce)
+	}
+	authURL := a.config.AuthCodeURL(state, authOpts...)
 
 	// Call the URL callback if provided (for UI to display)
 	if onURL != nil {
@@ -178,7 +277,7 @@ func (a *Auth) authenticateInternal(ctx context.Context, onURL func(string)) err
 	// Start HTTP server to handle callback
 	server := &http.Server{
 		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if r.URL.Path != "/callback" {
+			if r.URL.Path != callbackPath {
 				http.NotFound(w, r)
 				return
 			}
@@ -233,12 +332,16 @@ h1{color:#f97316;}
 
 	go func() {
 		if err := server.Serve(listener); err != http.ErrServerClosed {
-			errChan <- err
+			select {
+			case errChan <- err:
+			default:
+			}
 		}
 	}()
 
-	// Open browser to authorization URL
-	if err := openBrowser(authURL); err != nil {
+	// Open browser to authorization URL. When the UI shows the URL, a missing
+	// browser is not fatal: the user can open it elsewhere and paste the code.
+	if err := openBrowser(authURL); err != nil && onURL == nil {
 		return fmt.Errorf("failed to open browser: %w (please manually visit: %s)", err, authURL)
 	}
 
@@ -247,6 +350,8 @@ h1{color:#f97316;}
 	select {
 	case code = <-codeChan:
 		// Success
+	case <-manualDone:
+		// A pasted code was already exchanged by ExchangeCode
 	case err := <-errChan:
 		return err
 	case <-ctx.Done():
@@ -260,6 +365,10 @@ h1{color:#f97316;}
 	defer cancel()
 	_ = server.Shutdown(shutdownCtx)
 
+	if code == "" {
+		return nil
+	}
+
 	// Exchange code for token with PKCE verifier
 	token, err := a.config.Exchange(ctx, code,
 		oauth2.SetAuthURLParam("code_verifier", codeVerifier),
@@ -278,8 +387,98 @@ h1{color:#f97316;}
 	return nil
 }
 
-// GetClient returns an HTTP client with valid OAuth2 credentials
+// ExchangeCode completes an in-progress authentication with a code pasted by
+// the user, for machines where the browser cannot reach the local callback
+// (headless or remote sessions). input may be the bare code or the full
+// redirect URL copied from the browser's address bar.
+func (a *Auth) ExchangeCode(ctx context.Context, input string) error {
+	code, state := ParseAuthCode(input)
+	if code == "" {
+		return fmt.Errorf("no authorization code found")
+	}
+
+	a.mu.Lock()
+	verifier, expectedState := a.pendingVerifier, a.pendingState
+	a.mu.Unlock()
+	if verifier == "" {
+		return fmt.Errorf("no authentication in progress")
+	}
+	if state != "" && state != expectedState {
+		return fmt.Errorf("invalid state parameter")
+	}
+
+	token, err := a.config.Exchange(ctx, code,
+		oauth2.SetAuthURLParam("code_verifier", verifier),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to exchange code for token: %w", err)
+	}
+
+	a.token = token
+	if err := a.saveToken(token); err != nil {
+		return fmt.Errorf("failed to save token: %w", err)
+	}
+
+	// Release the waiting authentication flow
+	a.mu.Lock()
+	done := a.manualDone
+	a.pendingVerifier = ""
+	a.pendingState = ""
+	a.manualDone = nil
+	a.mu.Unlock()
+	if done != nil {
+		close(done)
+	}
+	return nil
+}
+
+// ParseAuthCode extracts the authorization code (and state, if present) from
+// pasted input, which may be a bare code or a full redirect URL. A URL or
+// query without a code, e.g. after the user denied access, gives no code.
+func ParseAuthCode(input string) (code, state string) {
+	input = strings.TrimSpace(input)
+	if !strings.Contains(input, "code=") {
+		if strings.ContainsAny(input, "?=") {
+			return "", ""
+		}
+		return input, ""
+	}
+
+	query := input
+	if u, err := url.Parse(input); err == nil && u.RawQuery != "" {
+		query = u.RawQuery
+	}
+	values, err := url.ParseQuery(strings.TrimPrefix(query, "?"))
+	if err != nil {
+		return "", ""
+	}
+	return values.Get("code"), values.Get("state")
+}
+
+// clearPending forgets the pending authorization
+func (a *Auth) clearPending() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.pendingVerifier = ""
+	a.pendingState = ""
+	a.manualDone = nil
+}
+
+// GetClient returns an HTTP client with valid OAuth2 credentials.
+// The EnvAccountID account uses the credentials from the environment (see
+// HasEnvCredentials) instead of a stored token.
 func (a *Auth) GetClient(ctx context.Context) (*http.Client, error) {
+	if a.accountID == EnvAccountID {
+		envSource, err := envTokenSource(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if envSource == nil {
+			return nil, fmt.Errorf("not authenticated: no credentials in the environment")
+		}
+		return oauth2.NewClient(ctx, envSource), nil
+	}
+
 	if a.token == nil {
 		token, err := a.loadToken()
 		if err != nil {
@@ -367,8 +566,16 @@ func generateState() (string, error) {
 	return base64.RawURLEncoding.EncodeToString(b), nil
 }
 
+// BrowserDisabled keeps the authorization flow from opening a browser, e.g.
+// while tests run it. The URL is still passed to the UI.
+var BrowserDisabled bool
+
 // openBrowser opens the default browser to the given URL
 func openBrowser(urlStr string) error {
+	if BrowserDisabled {
+		return fmt.Errorf("opening a browser is disabled")
+	}
+
 	var cmd *exec.Cmd
 
 	switch runtime.GOOS {
@@ -392,14 +599,162 @@ func ValidateCredentials(ctx context.Context, clientID, clientSecret string) err
 		return fmt.Errorf("client ID and secret are required")
 	}
 
-	// Check if client ID looks valid (ends with .apps.googleusercontent.com)
-	if !strings.HasSuffix(clientID, ".apps.googleusercontent.com") {
-		return fmt.Errorf("client ID should end with .apps.googleusercontent.com")
+	return CheckClientIDFormat(clientID)
+}
+
+// clientIDSuffix ends every Google OAuth client ID
+const clientIDSuffix = ".apps.googleusercontent.com"
+
+// RedirectURI is a parsed loopback redirect URI
+type RedirectURI struct {
+	Host string // Loopback host as registered: 127.0.0.1, localhost or [::1]
+	Port int
+	Path string // Callback path, "/" if the URI has none
+}
+
+// ListenAddr returns the address the callback server listens on
+func (r RedirectURI) ListenAddr() string {
+	host := r.Host
+	if host == "localhost" {
+		host = "127.0.0.1"
+	}
+	return net.JoinHostPort(strings.Trim(host, "[]"), fmt.Sprint(r.Port))
+}
+
+// ParseRedirectURI checks that uri is a redirect URI the local callback
+// server can receive: plain http on a loopback host with an explicit port,
+// e.g. "http://127.0.0.1:8085/callback". The same URI has to be registered
+// as an authorized redirect URI of the OAuth client in Google Cloud.
+func ParseRedirectURI(uri string) (RedirectURI, error) {
+	u, err := url.Parse(strings.TrimSpace(uri))
+	if err != nil || u.Host == "" {
+		return RedirectURI{}, fmt.Errorf("redirect URI should look like http://127.0.0.1:8085/callback")
+	}
+	if u.Scheme != "http" {
+		return RedirectURI{}, fmt.Errorf("redirect URI must use http://, the callback is received locally")
+	}
+	host := u.Hostname()
+	if host != "127.0.0.1" && host != "localhost" && host != "::1" {
+		return RedirectURI{}, fmt.Errorf("redirect URI must point to 127.0.0.1 or localhost")
+	}
+	if u.Port() == "" {
+		return RedirectURI{}, fmt.Errorf("redirect URI needs a port, e.g. http://%s:8085%s", u.Host, u.EscapedPath())
+	}
+	var port int
+	if _, err := fmt.Sscan(u.Port(), &port); err != nil || port < 1 || port > 65535 {
+		return RedirectURI{}, fmt.Errorf("invalid redirect URI port %q", u.Port())
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return RedirectURI{}, fmt.Errorf("redirect URI must not have a query or fragment")
+	}
+	if host == "::1" {
+		host = "[::1]"
+	}
+	path := u.Path
+	if path == "" {
+		path = "/"
+	}
+	return RedirectURI{Host: host, Port: port, Path: path}, nil
+}
+
+// credentialLabel matches a label copied along with a credential, such as
+// "Client ID:", "Client secret:" or the "client_id": key of client_secret.json
+var credentialLabel = regexp.MustCompile(`(?i)^["']?client[ _-]?(id|secret)["']?[:=]`)
+
+// SanitizeCredential cleans up a pasted OAuth client ID or secret. It removes
+// whitespace (including line breaks from wrapped copies), a leading label
+// such as "Client ID:", a trailing comma and surrounding quotes. Neither
+// client IDs nor secrets contain any of these, so it is safe to apply while
+// the value is being typed.
+func SanitizeCredential(value string) string {
+	value = strings.Join(strings.Fields(value), "")
+	value = credentialLabel.ReplaceAllString(value, "")
+	value = strings.TrimSuffix(value, ",")
+	for len(value) >= 2 {
+		first, last := value[0], value[len(value)-1]
+		if first != last || !strings.ContainsRune("\"'`", rune(first)) {
+			break
+		}
+		value = value[1 : len(value)-1]
+	}
+	return value
+}
+
+// CheckClientIDFormat checks that clientID looks like a Google OAuth client
+// ID. It does not contact Google, so it is cheap enough to run on every
+// keystroke.
+func CheckClientIDFormat(clientID string) error {
+	clientID = strings.TrimSpace(clientID)
+	if clientID == "" {
+		return fmt.Errorf("client ID is required")
+	}
+	if !strings.HasSuffix(clientID, clientIDSuffix) || clientID == clientIDSuffix {
+		return fmt.Errorf("client ID should end with %s", clientIDSuffix)
 	}
+	return nil
+}
 
+// CheckClientSecretFormat checks that a client secret was entered. Secrets
+// of newer clients start with GOCSPX-, but older clients have secrets without
+// a prefix, so nothing else about the format is checked.
+func CheckClientSecretFormat(clientSecret string) error {
+	if strings.TrimSpace(clientSecret) == "" {
+		return fmt.Errorf("client secret is required")
+	}
 	return nil
 }
 
+// clientSecretFile is the client_secret.json downloaded from the Google
+// Cloud Console. Desktop app clients are stored under "installed", web
+// application clients under "web".
+type clientSecretFile struct {
+	Installed *clientSecretEntry `json:"installed"`
+	Web       *clientSecretEntry `json:"web"`
+}
+
+type clientSecretEntry struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+// ParseClientSecretJSON extracts the client ID and secret from the contents
+// of a client_secret.json file. Only Desktop app clients are accepted, as
+// the authorization flow redirects to a local port. The secret only has to be
+// present: clients created before Google adopted the GOCSPX- prefix are still
+// valid.
+func ParseClientSecretJSON(data []byte) (clientID, clientSecret string, err error) {
+	var file clientSecretFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return "", "", fmt.Errorf("not a valid client_secret.json: %w", err)
+	}
+	if file.Installed == nil {
+		if file.Web != nil {
+			return "", "", fmt.Errorf("this is a Web application client; create a Desktop app OAuth client instead")
+		}
+		return "", "", fmt.Errorf("not a client_secret.json: no \"installed\" client found")
+	}
+
+	clientID = SanitizeCredential(file.Installed.ClientID)
+	clientSecret = SanitizeCredential(file.Installed.ClientSecret)
+	if err := CheckClientIDFormat(clientID); err != nil {
+		return "", "", err
+	}
+	if clientSecret == "" {
+		return "", "", fmt.Errorf("client secret is required")
+	}
+	return clientID, clientSecret, nil
+}
+
+// LoadClientSecretFile reads the client ID and secret from a downloaded
+// client_secret.json file
+func LoadClientSecretFile(path string) (clientID, clientSecret string, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", "", err
+	}
+	return ParseClientSecretJSON(data)
+}
+
 // GetSetupInstructions returns instructions for setting up YouTube API credentials
 func GetSetupInstructions() string {
 	return `To upload videos to YouTube, you need to create OAuth credentials:
@@ -450,6 +805,13 @@ func GetAuthStatus(cfg *Config, configDir string) AuthStatus {
 
 // GetAuthStatusForAccount returns the authentication status for a specific account
 func GetAuthStatusForAccount(cfg *Config, configDir, accountID string) AuthStatus {
+	if accountID == EnvAccountID {
+		if HasEnvCredentials() {
+			return AuthStatusAuthenticated
+		}
+		return AuthStatusNotConfigured
+	}
+
 	var account *Account
 
 	if accountID == "legacy" {
@@ -523,7 +885,14 @@ func (a *Auth) TestConnection(ctx context.Context) error {
 	return err
 }
 
-// RevokeToken revokes the current access token
+// revokeURL is Google's OAuth token revocation endpoint (a variable so tests
+// can point it at a local server)
+var revokeURL = "https://oauth2.googleapis.com/revoke"
+
+// RevokeToken revokes the app's access at Google and then removes the local
+// token. The refresh token is revoked when there is one, as that ends the
+// whole grant and, unlike the access token, does not expire after an hour.
+// A token Google no longer knows counts as revoked.
 func (a *Auth) RevokeToken(ctx context.Context) error {
 	if a.token == nil {
 		token, err := a.loadToken()
@@ -533,20 +902,35 @@ func (a *Auth) RevokeToken(ctx context.Context) error {
 		a.token = token
 	}
 
-	// Revoke the token
-	revokeURL := fmt.Sprintf("https://oauth2.googleapis.com/revoke?token=%s",
-		url.QueryEscape(a.token.AccessToken))
-
-	resp, err := http.Post(revokeURL, "application/x-www-form-urlencoded", nil)
+	token := a.token.RefreshToken
+	if token == "" {
+		token = a.token.AccessToken
+	}
+	form := url.Values{"token": {token}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, revokeURL, strings.NewReader(form.Encode()))
 	if err != nil {
 		return err
 	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("revoke failed: %w", err)
+	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		var result map[string]interface{}
+		var result struct {
+			Error       string `json:"error"`
+			Description string `json:"error_description"`
+		}
 		_ = json.NewDecoder(resp.Body).Decode(&result)
-		return fmt.Errorf("revoke failed: %v", result)
+		if result.Error != "invalid_token" {
+			if result.Description == "" {
+				result.Description = resp.Status
+			}
+			return fmt.Errorf("revoke failed: %s", result.Description)
+		}
 	}
 
 	// Delete local token
@@ -0,0 +1,261 @@
+package youtube
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSanitizeCredential(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"clean", "123-abc.apps.googleusercontent.com", "123-abc.apps.googleusercontent.com"},
+		{"whitespace", "  GOCSPX-abc123 \n", "GOCSPX-abc123"},
+		{"wrapped", "123-abc.apps.\ngoogleusercontent.com", "123-abc.apps.googleusercontent.com"},
+		{"double quotes", `"GOCSPX-abc123"`, "GOCSPX-abc123"},
+		{"single quotes", "'GOCSPX-abc123'", "GOCSPX-abc123"},
+		{"unmatched quote", `"GOCSPX-abc123`, `"GOCSPX-abc123`},
+		{"client ID label", "Client ID: 123-abc.apps.googleusercontent.com", "123-abc.apps.googleusercontent.com"},
+		{"client secret label", "Client secret:GOCSPX-abc123", "GOCSPX-abc123"},
+		{"json key", `"client_id": "123-abc.apps.googleusercontent.com",`, "123-abc.apps.googleusercontent.com"},
+		{"env style", "CLIENT_SECRET=GOCSPX-abc123", "GOCSPX-abc123"},
+		{"empty", "   ", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SanitizeCredential(tt.value); got != tt.want {
+				t.Errorf("SanitizeCredential(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckCredentialFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		check   func(string) error
+		value   string
+		wantErr bool
+	}{
+		{"client ID", CheckClientIDFormat, "123-abc.apps.googleusercontent.com", false},
+		{"client ID without suffix", CheckClientIDFormat, "123-abc", true},
+		{"client ID only suffix", CheckClientIDFormat, ".apps.googleusercontent.com", true},
+		{"blank client ID", CheckClientIDFormat, "  ", true},
+		{"secret", CheckClientSecretFormat, "GOCSPX-abc123", false},
+		{"legacy secret without prefix", CheckClientSecretFormat, "a1B2c3D4e5F6g7H8i9J0k1L2", false},
+		{"blank secret", CheckClientSecretFormat, " \n", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.check(tt.value); (err != nil) != tt.wantErr {
+				t.Errorf("check(%q) = %v, want error %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseClientSecretJSON(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		wantID     string
+		wantSecret string
+		wantErr    bool
+	}{
+		{
+			name:       "desktop client",
+			data:       `{"installed":{"client_id":"123-abc.apps.googleusercontent.com","project_id":"p","client_secret":"GOCSPX-abc123","redirect_uris":["http://localhost"]}}`,
+			wantID:     "123-abc.apps.googleusercontent.com",
+			wantSecret: "GOCSPX-abc123",
+		},
+		{
+			name:       "legacy secret without prefix",
+			data:       `{"installed":{"client_id":"123-abc.apps.googleusercontent.com","client_secret":"a1B2c3D4e5F6g7H8i9J0k1L2"}}`,
+			wantID:     "123-abc.apps.googleusercontent.com",
+			wantSecret: "a1B2c3D4e5F6g7H8i9J0k1L2",
+		},
+		{"web client", `{"web":{"client_id":"123-abc.apps.googleusercontent.com","client_secret":"GOCSPX-abc123"}}`, "", "", true},
+		{"other json", `{"type":"service_account"}`, "", "", true},
+		{"invalid json", `client_id=123`, "", "", true},
+		{"bad client ID", `{"installed":{"client_id":"123","client_secret":"GOCSPX-abc123"}}`, "", "", true},
+		{"missing secret", `{"installed":{"client_id":"123-abc.apps.googleusercontent.com"}}`, "", "", true},
+		{"blank secret", `{"installed":{"client_id":"123-abc.apps.googleusercontent.com","client_secret":" \" \" "}}`, "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, secret, err := ParseClientSecretJSON([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseClientSecretJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if id != tt.wantID || secret != tt.wantSecret {
+				t.Errorf("ParseClientSecretJSON() = %q, %q, want %q, %q", id, secret, tt.wantID, tt.wantSecret)
+			}
+		})
+	}
+}
+
+func TestParseRedirectURI(t *testing.T) {
+	tests := []struct {
+		name       string
+		uri        string
+		want       RedirectURI
+		wantListen string
+		wantErr    bool
+	}{
+		{"ipv4", "http://127.0.0.1:8085/callback", RedirectURI{"127.0.0.1", 8085, "/callback"}, "127.0.0.1:8085", false},
+		{"localhost without path", "http://localhost:9000", RedirectURI{"localhost", 9000, "/"}, "127.0.0.1:9000", false},
+		{"ipv6", "http://[::1]:8085/oauth", RedirectURI{"[::1]", 8085, "/oauth"}, "[::1]:8085", false},
+		{"surrounding whitespace", "  http://127.0.0.1:8085/callback\n", RedirectURI{"127.0.0.1", 8085, "/callback"}, "127.0.0.1:8085", false},
+		{"https", "https://127.0.0.1:8085/callback", RedirectURI{}, "", true},
+		{"remote host", "http://example.com:8085/callback", RedirectURI{}, "", true},
+		{"no port", "http://127.0.0.1/callback", RedirectURI{}, "", true},
+		{"port out of range", "http://127.0.0.1:70000/callback", RedirectURI{}, "", true},
+		{"query", "http://127.0.0.1:8085/callback?x=1", RedirectURI{}, "", true},
+		{"not a URL", "127.0.0.1:8085", RedirectURI{}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRedirectURI(tt.uri)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRedirectURI(%q) error = %v, wantErr %v", tt.uri, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseRedirectURI(%q) = %+v, want %+v", tt.uri, got, tt.want)
+			}
+			if !tt.wantErr && got.ListenAddr() != tt.wantListen {
+				t.Errorf("ListenAddr() = %q, want %q", got.ListenAddr(), tt.wantListen)
+			}
+		})
+	}
+}
+
+func TestParseAuthCode(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantCode  string
+		wantState string
+	}{
+		{"bare code", "  4/0AeanS0abc-def_123\n", "4/0AeanS0abc-def_123", ""},
+		{"redirect URL", "http://127.0.0.1:8085/callback?code=4/0AeanS0abc&scope=https://www.googleapis.com/auth/youtube", "4/0AeanS0abc", ""},
+		{"redirect URL with state", "http://127.0.0.1:8085/callback?state=xyz789&code=4%2F0AeanS0abc", "4/0AeanS0abc", "xyz789"},
+		{"query only", "?code=4/0AeanS0abc&state=xyz789", "4/0AeanS0abc", "xyz789"},
+		{"access denied", "http://127.0.0.1:8085/callback?error=access_denied&state=xyz789", "", ""},
+		{"empty", "   ", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, state := ParseAuthCode(tt.input)
+			if code != tt.wantCode || state != tt.wantState {
+				t.Errorf("ParseAuthCode(%q) = %q, %q, want %q, %q", tt.input, code, state, tt.wantCode, tt.wantState)
+			}
+		})
+	}
+}
+
+func TestExchangeCode_Errors(t *testing.T) {
+	a := NewAuthForAccount("id", "secret", t.TempDir(), "test")
+	ctx := context.Background()
+
+	if err := a.ExchangeCode(ctx, "http://127.0.0.1:8085/callback?error=access_denied"); err == nil || err.Error() != "no authorization code found" {
+		t.Errorf("ExchangeCode without a code = %v, want no authorization code found", err)
+	}
+	if err := a.ExchangeCode(ctx, "4/0AeanS0abc"); err == nil || err.Error() != "no authentication in progress" {
+		t.Errorf("ExchangeCode without a pending authorization = %v, want no authentication in progress", err)
+	}
+
+	a.pendingVerifier, a.pendingState = "verifier", "expected"
+	if err := a.ExchangeCode(ctx, "?code=4/0AeanS0abc&state=forged"); err == nil || err.Error() != "invalid state parameter" {
+		t.Errorf("ExchangeCode with another state = %v, want invalid state parameter", err)
+	}
+}
+
+func TestRevokeToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		wantErr   bool
+		wantToken bool // Local token still present afterwards
+	}{
+		{"revoked", http.StatusOK, `{}`, false, false},
+		{"already revoked", http.StatusBadRequest, `{"error":"invalid_token","error_description":"Token expired or revoked"}`, false, false},
+		{"server error", http.StatusInternalServerError, `{"error":"internal_failure","error_description":"Backend Error"}`, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotToken string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.ParseForm()
+				gotToken = r.PostForm.Get("token")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+			defer func(url string) { revokeURL = url }(revokeURL)
+			revokeURL = server.URL
+
+			dir := t.TempDir()
+			token := &Token{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer"}
+			if err := SaveTokenForAccount(dir, "acc", token); err != nil {
+				t.Fatal(err)
+			}
+
+			err := NewAuthForAccount("id", "secret", dir, "acc").RevokeToken(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RevokeToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotToken != "refresh" {
+				t.Errorf("revoked token = %q, want the refresh token", gotToken)
+			}
+			if got := HasTokenForAccount(dir, "acc"); got != tt.wantToken {
+				t.Errorf("local token present = %v, want %v", got, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestGetAccountEmail(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    string
+		wantErr bool
+	}{
+		{"email", http.StatusOK, `{"sub":"123","email":"tim@kartoza.com","email_verified":true}`, "tim@kartoza.com", false},
+		{"no email", http.StatusOK, `{"sub":"123"}`, "", true},
+		{"missing scope", http.StatusForbidden, `{"error":"insufficient_scope"}`, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotAuth string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotAuth = r.Header.Get("Authorization")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+			defer func(url string) { userInfoURL = url }(userInfoURL)
+			userInfoURL = server.URL
+
+			dir := t.TempDir()
+			token := &Token{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer"}
+			if err := SaveTokenForAccount(dir, "acc", token); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := NewAuthForAccount("id", "secret", dir, "acc").GetAccountEmail(context.Background())
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("GetAccountEmail() = %q, %v, want %q (error %v)", got, err, tt.want, tt.wantErr)
+			}
+			if gotAuth != "Bearer access" {
+				t.Errorf("Authorization = %q, want the account's access token", gotAuth)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/api/youtube/v3"
+)
+
+// Category is a YouTube video category that uploads can be assigned to
+type Category struct {
+	ID   string
+	Name string
+}
+
+// Categories lists the assignable YouTube video categories in the order they
+// are offered in the upload form
+var Categories = []Category{
+	{"27", "Education"},
+	{"28", "Science & Technology"},
+	{"26", "Howto & Style"},
+	{"22", "People & Blogs"},
+	{"25", "News & Politics"},
+	{"29", "Nonprofits & Activism"},
+	{"24", "Entertainment"},
+	{"1", "Film & Animation"},
+	{"10", "Music"},
+	{"20", "Gaming"},
+	{"17", "Sports"},
+	{"19", "Travel & Events"},
+	{"15", "Pets & Animals"},
+	{"2", "Autos & Vehicles"},
+	{"23", "Comedy"},
+}
+
+// VideoCategories maps category IDs to their names
+var VideoCategories = func() map[string]string {
+	m := make(map[string]string, len(Categories))
+	for _, c := range Categories {
+		m[c.ID] = c.Name
+	}
+	return m
+}()
+
+// DefaultCategoryID is the default category for uploads (Science & Technology)
+const DefaultCategoryID = "28"
+
+// topicCategories maps recording topics (ID or name, lower case) to the
+// category that usually fits them
+var topicCategories = map[string]string{
+	"tutorial":     "27",
+	"training":     "27",
+	"presentation": "27",
+	"demo":         "28",
+	"review":       "28",
+	"code review":  "28",
+	"meeting":      "22",
+}
+
+// CategoryName returns the name of a category ID, or the ID itself if it is
+// not a known category
+func CategoryName(id string) string {
+	if name, ok := VideoCategories[id]; ok {
+		return name
+	}
+	return id
+}
+
+// CategoryIndex returns the index of a category ID in Categories, or -1
+func CategoryIndex(id string) int {
+	for i, c := range Categories {
+		if c.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// ParseCategory returns the category ID for an ID or a (case-insensitive)
+// category name, e.g. "27" or "education"
+func ParseCategory(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	for _, c := range Categories {
+		if c.ID == value || strings.EqualFold(c.Name, value) {
+			return c.ID, true
+		}
+	}
+	return "", false
+}
+
+// DefaultCategoryFor returns the category preselected for a recording: the
+// category of the last upload, else the category matching its topic, else
+// the configured default category, else DefaultCategoryID. The last category
+// may come from a region's list, so it is not checked against Categories.
+func DefaultCategoryFor(topic, last, configured string) string {
+	if last != "" {
+		return last
+	}
+	if id, ok := topicCategories[strings.ToLower(strings.TrimSpace(topic))]; ok {
+		return id
+	}
+	if CategoryIndex(configured) >= 0 {
+		return configured
+	}
+	return DefaultCategoryID
+}
+
+// CategoryRegion is the region categories are listed for when the channel
+// has no country set
+const CategoryRegion = "US"
+
+// categoryCache keeps the categories listed for each region, which rarely
+// change, so they are fetched once per session
+var categoryCache = struct {
+	sync.Mutex
+	byRegion map[string][]Category
+}{byRegion: make(map[string][]Category)}
+
+// ChannelRegion returns the country of the authenticated channel, or
+// CategoryRegion if it has none
+func (u *Uploader) ChannelRegion(ctx context.Context) (string, error) {
+	var response *youtube.ChannelListResponse
+	err := withRetry(ctx, true, u.onRetry, func() error {
+		var err error
+		response, err = u.service.Channels.List([]string{"snippet"}).Mine(true).Context(ctx).Do()
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get channel: %w", err)
+	}
+	if len(response.Items) > 0 && response.Items[0].Snippet != nil && response.Items[0].Snippet.Country != "" {
+		return response.Items[0].Snippet.Country, nil
+	}
+	return CategoryRegion, nil
+}
+
+// ListCategories returns the categories videos can be assigned to in a
+// region, fetched once and cached
+func (u *Uploader) ListCategories(ctx context.Context, region string) ([]Category, error) {
+	categoryCache.Lock()
+	defer categoryCache.Unlock()
+	if categories, ok := categoryCache.byRegion[region]; ok {
+		return categories, nil
+	}
+
+	var response *youtube.VideoCategoryListResponse
+	err := withRetry(ctx, true, u.onRetry, func() error {
+		var err error
+		response, err = u.service.VideoCategories.List([]string{"snippet"}).RegionCode(region).Context(ctx).Do()
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	var categories []Category
+	for _, item := range response.Items {
+		if item.Snippet != nil && item.Snippet.Assignable {
+			categories = append(categories, Category{ID: item.Id, Name: item.Snippet.Title})
+		}
+	}
+	if len(categories) == 0 {
+		return nil, fmt.Errorf("no categories for region %s", region)
+	}
+	categoryCache.byRegion[region] = categories
+	return categories, nil
+}
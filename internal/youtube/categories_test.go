@@ -0,0 +1,148 @@
+package youtube
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/youtube/v3"
+)
+
+func TestCategories(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, c := range Categories {
+		if c.ID == "" || c.Name == "" {
+			t.Errorf("category %+v has an empty ID or name", c)
+		}
+		if seen[c.ID] {
+			t.Errorf("category ID %s is listed twice", c.ID)
+		}
+		seen[c.ID] = true
+	}
+	if !seen[DefaultCategoryID] {
+		t.Errorf("DefaultCategoryID %s is not in Categories", DefaultCategoryID)
+	}
+	if len(VideoCategories) != len(Categories) {
+		t.Errorf("VideoCategories has %d entries, want %d", len(VideoCategories), len(Categories))
+	}
+}
+
+func TestCategoryMapping(t *testing.T) {
+	tests := []struct {
+		id   string
+		name string
+	}{
+		{"27", "Education"},
+		{"28", "Science & Technology"},
+		{"26", "Howto & Style"},
+		{"22", "People & Blogs"},
+		{"10", "Music"},
+	}
+	for _, tt := range tests {
+		if got := CategoryName(tt.id); got != tt.name {
+			t.Errorf("CategoryName(%q) = %q, want %q", tt.id, got, tt.name)
+		}
+		if i := CategoryIndex(tt.id); i < 0 || Categories[i].Name != tt.name {
+			t.Errorf("CategoryIndex(%q) = %d, want the index of %q", tt.id, i, tt.name)
+		}
+	}
+	if got := CategoryName("999"); got != "999" {
+		t.Errorf("CategoryName(unknown) = %q, want the ID", got)
+	}
+	if got := CategoryIndex("999"); got != -1 {
+		t.Errorf("CategoryIndex(unknown) = %d, want -1", got)
+	}
+}
+
+func TestParseCategory(t *testing.T) {
+	tests := []struct {
+		value  string
+		wantID string
+		wantOK bool
+	}{
+		{"27", "27", true},
+		{"education", "27", true},
+		{" Science & Technology ", "28", true},
+		{"HOWTO & STYLE", "26", true},
+		{"cooking", "", false},
+		{"999", "", false},
+	}
+	for _, tt := range tests {
+		id, ok := ParseCategory(tt.value)
+		if id != tt.wantID || ok != tt.wantOK {
+			t.Errorf("ParseCategory(%q) = %q, %v, want %q, %v", tt.value, id, ok, tt.wantID, tt.wantOK)
+		}
+	}
+}
+
+func TestDefaultCategoryFor(t *testing.T) {
+	tests := []struct {
+		topic      string
+		last       string
+		configured string
+		want       string
+	}{
+		{"Tutorial", "", "", "27"},
+		{"demo", "", "10", "28"},
+		{"Code Review", "", "", "28"},
+		{"QGIS", "", "26", "26"},
+		{"QGIS", "", "999", DefaultCategoryID},
+		{"", "", "", DefaultCategoryID},
+		{"Tutorial", "26", "", "26"},
+		{"demo", "30", "27", "30"},
+	}
+	for _, tt := range tests {
+		if got := DefaultCategoryFor(tt.topic, tt.last, tt.configured); got != tt.want {
+			t.Errorf("DefaultCategoryFor(%q, %q, %q) = %q, want %q", tt.topic, tt.last, tt.configured, got, tt.want)
+		}
+	}
+}
+
+func TestListCategories(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/channels"):
+			_, _ = w.Write([]byte(`{"items":[{"snippet":{"title":"Kartoza","country":"ZA"}}]}`))
+		case strings.HasSuffix(r.URL.Path, "/videoCategories"):
+			calls++
+			if got := r.URL.Query().Get("regionCode"); got != "ZA" {
+				t.Errorf("regionCode = %q, want the channel's country", got)
+			}
+			_, _ = w.Write([]byte(`{"items":[
+				{"id":"28","snippet":{"title":"Science & Technology","assignable":true}},
+				{"id":"30","snippet":{"title":"Movies","assignable":false}},
+				{"id":"27","snippet":{"title":"Education","assignable":true}}]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	defer func() { categoryCache.byRegion = make(map[string][]Category) }()
+
+	ctx := context.Background()
+	service, err := youtube.NewService(ctx, option.WithHTTPClient(server.Client()), option.WithEndpoint(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &Uploader{service: service, client: server.Client()}
+
+	region, err := u.ChannelRegion(ctx)
+	if err != nil || region != "ZA" {
+		t.Fatalf("ChannelRegion() = %q, %v, want ZA", region, err)
+	}
+	want := []Category{{"28", "Science & Technology"}, {"27", "Education"}}
+	for i := 0; i < 2; i++ {
+		got, err := u.ListCategories(ctx, region)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Fatalf("ListCategories() = %v, %v, want the assignable categories %v", got, err, want)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("categories fetched %d times, want once and then cached", calls)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 // PrivacyStatus represents YouTube video privacy settings
@@ -28,6 +29,8 @@ type Account struct {
 	DefaultPlaylistName string       `json:"default_playlist_name,omitempty"` // For display
 	ChannelName        string        `json:"channel_name,omitempty"`          // Cached channel name
 	ChannelID          string        `json:"channel_id,omitempty"`            // Cached channel ID
+	Email              string        `json:"email,omitempty"`                 // Cached Google account email
+	RedirectURI        string        `json:"redirect_uri,omitempty"`          // Registered loopback redirect URI (empty = any port)
 }
 
 // IsConfigured returns true if OAuth credentials are set for this account
@@ -44,14 +47,23 @@ type Config struct {
 	DefaultPlaylistName string       `json:"default_playlist_name,omitempty"`
 	ChannelName        string        `json:"channel_name,omitempty"`
 	ChannelID          string        `json:"channel_id,omitempty"`
+	Email              string        `json:"email,omitempty"`
+	RedirectURI        string        `json:"redirect_uri,omitempty"`
 
 	// Multi-account support
 	Accounts          []Account     `json:"accounts,omitempty"`
 	LastUsedAccountID string        `json:"last_used_account_id,omitempty"`
+	DefaultAccountID  string        `json:"default_account_id,omitempty"` // Preselected for uploads
 
 	// Global settings
-	DefaultPrivacy     PrivacyStatus `json:"default_privacy,omitempty"`
-	AutoPromptUpload   bool          `json:"auto_prompt_upload,omitempty"`
+	DefaultPrivacy       PrivacyStatus `json:"default_privacy,omitempty"`
+	DefaultMadeForKids   bool          `json:"default_made_for_kids,omitempty"`  // Preselected audience for uploads
+	DefaultCategory      string        `json:"default_category,omitempty"`       // Category ID preselected when the topic has none
+	LastCategory         string        `json:"last_category,omitempty"`          // Category ID of the last upload, preferred over the topic and DefaultCategory
+	DefaultLanguage      string        `json:"default_language,omitempty"`       // BCP-47 language of titles and descriptions
+	DefaultAudioLanguage string        `json:"default_audio_language,omitempty"` // BCP-47 language spoken in the videos
+	DefaultLicense       License       `json:"default_license,omitempty"`        // Preselected license (empty = standard YouTube license)
+	AutoPromptUpload     bool          `json:"auto_prompt_upload,omitempty"`
 }
 
 // Token represents stored OAuth2 tokens
@@ -82,6 +94,20 @@ type UploadOptions struct {
 	PlaylistID        string // Optional: add to playlist after upload
 	ThumbnailPath     string // Optional: custom thumbnail
 	NotifySubscribers bool
+	MadeForKids       bool    // Audience: self-declared made for kids, required by YouTube (COPPA)
+	Language          string  // Optional: BCP-47 language of the title and description (snippet.defaultLanguage)
+	AudioLanguage     string  // Optional: BCP-47 language spoken in the video (snippet.defaultAudioLanguage)
+	License           License // Optional: empty uses the standard YouTube license
+
+	// PublishAt is when YouTube makes a private video public. It is only
+	// sent for private uploads (zero = not scheduled).
+	PublishAt time.Time
+}
+
+// Localization is a translated title and description of a video
+type Localization struct {
+	Title       string
+	Description string
 }
 
 // UploadResult contains the result of a successful upload
@@ -146,6 +172,7 @@ func (c *Config) GetAccounts() []Account {
 				DefaultPlaylistName: c.DefaultPlaylistName,
 				ChannelName:         c.ChannelName,
 				ChannelID:           c.ChannelID,
+				RedirectURI:         c.RedirectURI,
 			}
 			if legacyAccount.Name == "" {
 				legacyAccount.Name = "Default Account"
@@ -171,6 +198,7 @@ func (c *Config) GetAccount(id string) *Account {
 			DefaultPlaylistName: c.DefaultPlaylistName,
 			ChannelName:         c.ChannelName,
 			ChannelID:           c.ChannelID,
+			RedirectURI:         c.RedirectURI,
 		}
 	}
 
@@ -182,19 +210,19 @@ func (c *Config) GetAccount(id string) *Account {
 	return nil
 }
 
-// GetLastUsedAccount returns the last used account, or the first available account
+
+// GetLastUsedAccount returns the last used account, falling back to the
+// default account and then the first available account
 func (c *Config) GetLastUsedAccount() *Account {
 	accounts := c.GetAccounts()
 	if len(accounts) == 0 {
 		return nil
 	}
 
-	// Try to find the last used account
-	if c.LastUsedAccountID != "" {
-		for i := range accounts {
-			if accounts[i].ID == c.LastUsedAccountID {
-				return &accounts[i]
-			}
+	// Try to find the last used account, then the default account
+	for _, id := range []string{c.LastUsedAccountID, c.DefaultAccountID} {
+		if acc := findAccount(accounts, id); acc != nil {
+			return acc
 		}
 	}
 
@@ -202,6 +230,57 @@ func (c *Config) GetLastUsedAccount() *Account {
 	return &accounts[0]
 }
 
+// GetDefaultAccount returns the account marked as default, falling back to
+// the last used account and then the first available account. Uploads
+// preselect this account.
+func (c *Config) GetDefaultAccount() *Account {
+	if acc := findAccount(c.GetAccounts(), c.DefaultAccountID); acc != nil {
+		return acc
+	}
+	return c.GetLastUsedAccount()
+}
+
+// IsDefaultAccount returns true if id is the account marked as default
+func (c *Config) IsDefaultAccount(id string) bool {
+	return id != "" && id == c.DefaultAccountID
+}
+
+// SetDefaultAccount marks an account as default. An empty id clears it.
+func (c *Config) SetDefaultAccount(id string) {
+	c.DefaultAccountID = id
+}
+
+// MoveAccount moves an account up (delta < 0) or down (delta > 0) in the
+// account list. The legacy account always stays first and cannot be moved.
+// Returns false if the account cannot move that way.
+func (c *Config) MoveAccount(id string, delta int) bool {
+	for i := range c.Accounts {
+		if c.Accounts[i].ID != id {
+			continue
+		}
+		j := i + delta
+		if j < 0 || j >= len(c.Accounts) {
+			return false
+		}
+		c.Accounts[i], c.Accounts[j] = c.Accounts[j], c.Accounts[i]
+		return true
+	}
+	return false
+}
+
+// findAccount returns the account with the given ID from accounts, or nil
+func findAccount(accounts []Account, id string) *Account {
+	if id == "" {
+		return nil
+	}
+	for i := range accounts {
+		if accounts[i].ID == id {
+			return &accounts[i]
+		}
+	}
+	return nil
+}
+
 // GetAccountByChannelID finds an account by its channel ID
 func (c *Config) GetAccountByChannelID(channelID string) *Account {
 	if channelID == "" {
@@ -235,6 +314,7 @@ func (c *Config) UpdateAccount(account Account) bool {
 		c.DefaultPlaylistName = account.DefaultPlaylistName
 		c.ChannelName = account.ChannelName
 		c.ChannelID = account.ChannelID
+		c.RedirectURI = account.RedirectURI
 		return true
 	}
 
@@ -249,6 +329,10 @@ func (c *Config) UpdateAccount(account Account) bool {
 
 // RemoveAccount removes an account by ID
 func (c *Config) RemoveAccount(id string) bool {
+	if c.DefaultAccountID == id {
+		c.DefaultAccountID = ""
+	}
+
 	// Handle legacy account removal
 	if id == "legacy" {
 		c.ClientID = ""
@@ -360,28 +444,6 @@ func HasTokenForAccount(configDir, accountID string) bool {
 	return err == nil
 }
 
-// YouTube video categories (common ones)
-var VideoCategories = map[string]string{
-	"1":  "Film & Animation",
-	"2":  "Autos & Vehicles",
-	"10": "Music",
-	"15": "Pets & Animals",
-	"17": "Sports",
-	"19": "Travel & Events",
-	"20": "Gaming",
-	"22": "People & Blogs",
-	"23": "Comedy",
-	"24": "Entertainment",
-	"25": "News & Politics",
-	"26": "Howto & Style",
-	"27": "Education",
-	"28": "Science & Technology",
-	"29": "Nonprofits & Activism",
-}
-
-// DefaultCategoryID is the default category for uploads (Science & Technology)
-const DefaultCategoryID = "28"
-
 // ParseTags parses a comma-separated string of tags into a slice
 func ParseTags(tagsStr string) []string {
 	if tagsStr == "" {
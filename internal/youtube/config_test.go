@@ -0,0 +1,145 @@
+package youtube
+
+import (
+	"reflect"
+	"testing"
+)
+
+// configWithAccounts returns a config holding accounts with the given IDs
+func configWithAccounts(ids ...string) *Config {
+	cfg := DefaultConfig()
+	for _, id := range ids {
+		cfg.AddAccount(Account{ID: id, Name: id, ClientID: id + "-client", ClientSecret: "secret"})
+	}
+	return &cfg
+}
+
+func accountIDs(accounts []Account) []string {
+	ids := make([]string, len(accounts))
+	for i, acc := range accounts {
+		ids[i] = acc.ID
+	}
+	return ids
+}
+
+func TestMoveAccount(t *testing.T) {
+	tests := []struct {
+		name  string
+		id    string
+		delta int
+		moved bool
+		want  []string
+	}{
+		{"first up", "a", -1, false, []string{"a", "b", "c"}},
+		{"first down", "a", 1, true, []string{"b", "a", "c"}},
+		{"middle up", "b", -1, true, []string{"b", "a", "c"}},
+		{"middle down", "b", 1, true, []string{"a", "c", "b"}},
+		{"last up", "c", -1, true, []string{"a", "c", "b"}},
+		{"last down", "c", 1, false, []string{"a", "b", "c"}},
+		{"unknown", "missing", 1, false, []string{"a", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := configWithAccounts("a", "b", "c")
+			if got := cfg.MoveAccount(tt.id, tt.delta); got != tt.moved {
+				t.Errorf("MoveAccount(%q, %d) = %v, want %v", tt.id, tt.delta, got, tt.moved)
+			}
+			if got := accountIDs(cfg.GetAccounts()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("accounts = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoveAccount_LegacyStaysFirst(t *testing.T) {
+	cfg := configWithAccounts("a", "b")
+	cfg.ClientID = "legacy-client"
+	cfg.ClientSecret = "secret"
+
+	if cfg.MoveAccount("legacy", 1) {
+		t.Error("MoveAccount moved the legacy account")
+	}
+	// a is the first stored account, so it cannot move above the legacy one
+	if cfg.MoveAccount("a", -1) {
+		t.Error("MoveAccount moved an account above the legacy account")
+	}
+	if got, want := accountIDs(cfg.GetAccounts()), []string{"legacy", "a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("accounts = %v, want %v", got, want)
+	}
+}
+
+func TestSetDefaultAccount(t *testing.T) {
+	cfg := configWithAccounts("a", "b")
+
+	cfg.SetDefaultAccount("b")
+	if !cfg.IsDefaultAccount("b") || cfg.IsDefaultAccount("a") {
+		t.Errorf("IsDefaultAccount does not report b as the only default")
+	}
+	if acc := cfg.GetDefaultAccount(); acc == nil || acc.ID != "b" {
+		t.Errorf("GetDefaultAccount() = %v, want b", acc)
+	}
+
+	// The default wins over the last used account
+	cfg.LastUsedAccountID = "a"
+	if acc := cfg.GetDefaultAccount(); acc == nil || acc.ID != "b" {
+		t.Errorf("GetDefaultAccount() with last used a = %v, want b", acc)
+	}
+
+	cfg.SetDefaultAccount("")
+	if cfg.IsDefaultAccount("") || cfg.IsDefaultAccount("b") {
+		t.Error("clearing the default still reports a default account")
+	}
+	if acc := cfg.GetDefaultAccount(); acc == nil || acc.ID != "a" {
+		t.Errorf("GetDefaultAccount() without default = %v, want last used a", acc)
+	}
+
+	cfg.SetDefaultAccount("b")
+	cfg.RemoveAccount("b")
+	if cfg.DefaultAccountID != "" {
+		t.Errorf("removing the default account left DefaultAccountID = %q", cfg.DefaultAccountID)
+	}
+}
+
+func TestGetLastUsedAccount(t *testing.T) {
+	tests := []struct {
+		name     string
+		lastUsed string
+		def      string
+		remove   string
+		want     string
+	}{
+		{"last used", "b", "c", "", "b"},
+		{"last used removed falls back to default", "b", "c", "b", "c"},
+		{"last used and default removed falls back to first", "c", "c", "c", "a"},
+		{"unknown last used falls back to default", "gone", "b", "", "b"},
+		{"nothing set falls back to first", "", "", "", "a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := configWithAccounts("a", "b", "c")
+			cfg.LastUsedAccountID = tt.lastUsed
+			cfg.SetDefaultAccount(tt.def)
+			if tt.remove != "" && !cfg.RemoveAccount(tt.remove) {
+				t.Fatalf("RemoveAccount(%q) = false", tt.remove)
+			}
+
+			acc := cfg.GetLastUsedAccount()
+			if acc == nil {
+				t.Fatalf("GetLastUsedAccount() = nil, want %s", tt.want)
+			}
+			if acc.ID != tt.want {
+				t.Errorf("GetLastUsedAccount() = %s, want %s", acc.ID, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetLastUsedAccount_NoAccounts(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.LastUsedAccountID = "a"
+	if acc := cfg.GetLastUsedAccount(); acc != nil {
+		t.Errorf("GetLastUsedAccount() = %v, want nil", acc)
+	}
+}
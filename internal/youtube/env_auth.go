@@ -0,0 +1,116 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+// Environment variables for non-interactive authentication, so uploads can
+// run unattended on servers and in CI. They are only used by the EnvAccountID
+// account; configured accounts always use their own stored tokens.
+const (
+	// EnvClientID, EnvClientSecret and EnvRefreshToken provide an OAuth client
+	// and a refresh token obtained once with the interactive flow
+	EnvClientID     = "KARTOZA_SCREENCASTER_YOUTUBE_CLIENT_ID"
+	EnvClientSecret = "KARTOZA_SCREENCASTER_YOUTUBE_CLIENT_SECRET"
+	EnvRefreshToken = "KARTOZA_SCREENCASTER_YOUTUBE_REFRESH_TOKEN"
+	// EnvCredentialsFile points to an "authorized_user" credentials file
+	// (client_id, client_secret, refresh_token) or a "service_account" key file
+	EnvCredentialsFile = "KARTOZA_SCREENCASTER_YOUTUBE_CREDENTIALS"
+)
+
+// EnvAccountID is the account ID that authenticates with the credentials in
+// the environment instead of a stored token
+const EnvAccountID = "env"
+
+// EnvAccount returns the pseudo account used for headless uploads with
+// environment credentials
+func EnvAccount() *Account {
+	return &Account{ID: EnvAccountID, Name: "Environment credentials"}
+}
+
+// Supported credentials file types
+const (
+	credentialsTypeAuthorizedUser = "authorized_user"
+	credentialsTypeServiceAccount = "service_account"
+)
+
+// envCredentialsFile is the subset of a Google credentials file we read
+type envCredentialsFile struct {
+	Type         string `json:"type"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// HasEnvCredentials returns true if non-interactive credentials are provided
+// through the environment
+func HasEnvCredentials() bool {
+	if os.Getenv(EnvCredentialsFile) != "" {
+		return true
+	}
+	return os.Getenv(EnvClientID) != "" &&
+		os.Getenv(EnvClientSecret) != "" &&
+		os.Getenv(EnvRefreshToken) != ""
+}
+
+// envTokenSource returns a token source for the credentials in the
+// environment, or nil if none are set
+func envTokenSource(ctx context.Context) (oauth2.TokenSource, error) {
+	if path := os.Getenv(EnvCredentialsFile); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", EnvCredentialsFile, err)
+		}
+		return tokenSourceFromCredentialsJSON(ctx, data)
+	}
+
+	if !HasEnvCredentials() {
+		return nil, nil
+	}
+	return refreshTokenSource(ctx,
+		os.Getenv(EnvClientID), os.Getenv(EnvClientSecret), os.Getenv(EnvRefreshToken)), nil
+}
+
+// tokenSourceFromCredentialsJSON builds a token source from a credentials
+// file. Only authorized-user and service-account files are accepted.
+func tokenSourceFromCredentialsJSON(ctx context.Context, data []byte) (oauth2.TokenSource, error) {
+	var creds envCredentialsFile
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, fmt.Errorf("invalid credentials file: %w", err)
+	}
+
+	switch creds.Type {
+	case credentialsTypeAuthorizedUser:
+		if creds.ClientID == "" || creds.ClientSecret == "" || creds.RefreshToken == "" {
+			return nil, fmt.Errorf("credentials file is missing client_id, client_secret or refresh_token")
+		}
+		return refreshTokenSource(ctx, creds.ClientID, creds.ClientSecret, creds.RefreshToken), nil
+	case credentialsTypeServiceAccount:
+		jwtConfig, err := google.JWTConfigFromJSON(data, oauthScopes...)
+		if err != nil {
+			return nil, fmt.Errorf("invalid service account key: %w", err)
+		}
+		return jwtConfig.TokenSource(ctx), nil
+	default:
+		return nil, fmt.Errorf("unsupported credentials type %q (use %s or %s)",
+			creds.Type, credentialsTypeAuthorizedUser, credentialsTypeServiceAccount)
+	}
+}
+
+// refreshTokenSource returns a token source that exchanges a refresh token
+// for access tokens as needed
+func refreshTokenSource(ctx context.Context, clientID, clientSecret, refreshToken string) oauth2.TokenSource {
+	cfg := &oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scopes:       oauthScopes,
+		Endpoint:     google.Endpoint,
+	}
+	return cfg.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken})
+}
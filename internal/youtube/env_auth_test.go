@@ -0,0 +1,85 @@
+package youtube
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestTokenSourceFromCredentialsJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"authorized user", `{"type":"authorized_user","client_id":"id","client_secret":"secret","refresh_token":"token"}`, ""},
+		{"authorized user missing token", `{"type":"authorized_user","client_id":"id","client_secret":"secret"}`, "missing"},
+		{"service account", `{"type":"service_account","client_email":"uploader@example.iam.gserviceaccount.com","private_key":"key"}`, ""},
+		{"unknown type", `{"type":"external_account"}`, "unsupported credentials type"},
+		{"invalid json", `not json`, "invalid credentials file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source, err := tokenSourceFromCredentialsJSON(context.Background(), []byte(tt.data))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want one containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if source == nil {
+				t.Fatal("expected a token source")
+			}
+		})
+	}
+}
+
+func TestHasEnvCredentials(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{"none", nil, false},
+		{"credentials file", map[string]string{EnvCredentialsFile: "/tmp/creds.json"}, true},
+		{"client and refresh token", map[string]string{EnvClientID: "id", EnvClientSecret: "secret", EnvRefreshToken: "token"}, true},
+		{"missing refresh token", map[string]string{EnvClientID: "id", EnvClientSecret: "secret"}, false},
+		{"only refresh token", map[string]string{EnvRefreshToken: "token"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{EnvClientID, EnvClientSecret, EnvRefreshToken, EnvCredentialsFile} {
+				t.Setenv(key, tt.env[key])
+			}
+			if got := HasEnvCredentials(); got != tt.want {
+				t.Errorf("HasEnvCredentials() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvCredentialsOnlyForEnvAccount(t *testing.T) {
+	t.Setenv(EnvClientID, "id")
+	t.Setenv(EnvClientSecret, "secret")
+	t.Setenv(EnvRefreshToken, "token")
+	t.Setenv(EnvCredentialsFile, "")
+
+	dir := t.TempDir()
+	cfg := &Config{Accounts: []Account{{ID: "b", Name: "B", ClientID: "id.apps.googleusercontent.com", ClientSecret: "secret"}}}
+
+	if got := GetAuthStatusForAccount(cfg, dir, "b"); got == AuthStatusAuthenticated {
+		t.Error("a configured account without a token should not use the environment credentials")
+	}
+	if NewAuthForAccount("id", "secret", dir, "b").IsAuthenticated() {
+		t.Error("Auth for a configured account should not be authenticated by the environment")
+	}
+	if got := GetAuthStatusForAccount(cfg, dir, EnvAccountID); got != AuthStatusAuthenticated {
+		t.Errorf("env account status = %v, want authenticated", got)
+	}
+	if !NewAuthForAccount("", "", dir, EnvAccountID).IsAuthenticated() {
+		t.Error("env account should be authenticated by the environment")
+	}
+}
@@ -0,0 +1,125 @@
+package youtube
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+)
+
+// errorHelp is the explanation and suggested fix for a YouTube API error reason
+type errorHelp struct {
+	message string
+	fix     string
+}
+
+// errorReasons maps YouTube API and OAuth error reasons to friendly messages
+var errorReasons = map[string]errorHelp{
+	"quotaExceeded": {
+		"The YouTube API quota of your Google Cloud project is used up for today.",
+		"It resets at midnight Pacific Time; try again then, or request more quota for the YouTube Data API in the Google Cloud Console.",
+	},
+	"dailyLimitExceeded": {
+		"The YouTube API quota of your Google Cloud project is used up for today.",
+		"It resets at midnight Pacific Time; try again then, or request more quota for the YouTube Data API in the Google Cloud Console.",
+	},
+	"uploadLimitExceeded": {
+		"This channel has reached YouTube's limit on uploads for now.",
+		"Try again in 24 hours. Verifying the channel at youtube.com/verify raises the limit.",
+	},
+	"rateLimitExceeded": {
+		"YouTube is rate limiting requests from this app.",
+		"Wait a few minutes and try again.",
+	},
+	"userRateLimitExceeded": {
+		"YouTube is rate limiting requests from this account.",
+		"Wait a few minutes and try again.",
+	},
+	"forbidden": {
+		"YouTube refused the request for this account.",
+		"Check that the signed-in Google account owns the channel and video, then re-authenticate it in Options > YouTube.",
+	},
+	"insufficientPermissions": {
+		"The stored sign-in does not allow managing YouTube videos.",
+		"Re-authenticate the account in Options > YouTube and allow all requested permissions.",
+	},
+	"youtubeSignupRequired": {
+		"The signed-in Google account has no YouTube channel.",
+		"Create a channel at youtube.com for this account, or sign in with the account that owns your channel.",
+	},
+	"invalidTitle": {
+		"YouTube rejected the video title.",
+		"Use 1 to 100 characters and remove any < or > characters.",
+	},
+	"invalidDescription": {
+		"YouTube rejected the video description.",
+		"Keep it under 5000 characters and remove any < or > characters.",
+	},
+	"invalidTags": {
+		"YouTube rejected the video tags.",
+		"Keep all tags together under 500 characters and remove any < or > characters.",
+	},
+	"videoNotFound": {
+		"The video no longer exists on YouTube.",
+		"It may have been deleted in YouTube Studio; remove the link from the recording or upload it again.",
+	},
+	"playlistNotFound": {
+		"The playlist no longer exists on YouTube.",
+		"Pick another playlist or reload the playlists in Options > YouTube.",
+	},
+	"authError": {
+		"YouTube did not accept the stored sign-in.",
+		"Re-authenticate the account in Options > YouTube.",
+	},
+	"invalid_grant": {
+		"Google no longer accepts the stored sign-in; access was revoked, expired or the password changed.",
+		"Re-authenticate the account in Options > YouTube.",
+	},
+	"invalid_client": {
+		"Google does not recognize the Client ID or Client Secret.",
+		"Check the credentials in Options > YouTube against your OAuth client in the Google Cloud Console.",
+	},
+}
+
+// ErrorReason returns the YouTube API or OAuth reason of err, e.g.
+// "quotaExceeded" or "invalid_grant", or "" if it has none
+func ErrorReason(err error) string {
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		for _, item := range apiErr.Errors {
+			if item.Reason != "" {
+				return item.Reason
+			}
+		}
+		if apiErr.Code == http.StatusUnauthorized {
+			return "authError"
+		}
+		return ""
+	}
+
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		return retrieveErr.ErrorCode
+	}
+	return ""
+}
+
+// FriendlyError turns a YouTube API error into a message explaining what
+// went wrong and how to fix it. Errors without a known reason are returned
+// as they are, using the API's own message where there is one.
+func FriendlyError(err error) string {
+	if err == nil {
+		return ""
+	}
+	if help, ok := errorReasons[ErrorReason(err)]; ok {
+		return help.message + " " + help.fix
+	}
+
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Message != "" {
+		return fmt.Sprintf("YouTube error %d: %s", apiErr.Code, apiErr.Message)
+	}
+	return err.Error()
+}
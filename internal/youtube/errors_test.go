@@ -0,0 +1,44 @@
+package youtube
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+)
+
+func TestFriendlyError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantReason string
+		wantText   string
+	}{
+		{"quota exceeded", apiError(http.StatusForbidden, "quotaExceeded"), "quotaExceeded", "quota"},
+		{"upload limit", apiError(http.StatusBadRequest, "uploadLimitExceeded"), "uploadLimitExceeded", "youtube.com/verify"},
+		{"forbidden", apiError(http.StatusForbidden, "forbidden"), "forbidden", "re-authenticate"},
+		{"invalid title", apiError(http.StatusBadRequest, "invalidTitle"), "invalidTitle", "100 characters"},
+		{"unauthorized", fmt.Errorf("upload failed: %w", &googleapi.Error{Code: http.StatusUnauthorized}), "authError", "re-authenticate"},
+		{"revoked token", fmt.Errorf("token: %w", &oauth2.RetrieveError{ErrorCode: "invalid_grant"}), "invalid_grant", "revoked"},
+		{"unknown reason", &googleapi.Error{Code: http.StatusBadRequest, Message: "Something odd", Errors: []googleapi.ErrorItem{{Reason: "odd"}}}, "odd", "YouTube error 400: Something odd"},
+		{"plain error", errors.New("connection refused"), "", "connection refused"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorReason(tt.err); got != tt.wantReason {
+				t.Errorf("ErrorReason() = %q, want %q", got, tt.wantReason)
+			}
+			if got := FriendlyError(tt.err); !strings.Contains(strings.ToLower(got), strings.ToLower(tt.wantText)) {
+				t.Errorf("FriendlyError() = %q, want it to mention %q", got, tt.wantText)
+			}
+		})
+	}
+
+	if got := FriendlyError(nil); got != "" {
+		t.Errorf("FriendlyError(nil) = %q, want empty", got)
+	}
+}
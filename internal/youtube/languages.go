@@ -0,0 +1,77 @@
+package youtube
+
+import "strings"
+
+// Language is a language YouTube accepts for a video's metadata or audio,
+// identified by its BCP-47 code
+type Language struct {
+	Code string
+	Name string
+}
+
+// Languages lists the languages offered in the upload form, in order
+var Languages = []Language{
+	{"en-GB", "English (United Kingdom)"},
+	{"en-US", "English (United States)"},
+	{"en", "English"},
+	{"af", "Afrikaans"},
+	{"zu", "Zulu"},
+	{"xh", "Xhosa"},
+	{"st", "Sesotho"},
+	{"sw", "Swahili"},
+	{"fr", "French"},
+	{"de", "German"},
+	{"nl", "Dutch"},
+	{"es", "Spanish"},
+	{"pt", "Portuguese"},
+	{"pt-BR", "Portuguese (Brazil)"},
+	{"it", "Italian"},
+	{"pl", "Polish"},
+	{"ru", "Russian"},
+	{"ar", "Arabic"},
+	{"hi", "Hindi"},
+	{"id", "Indonesian"},
+	{"ja", "Japanese"},
+	{"zh-CN", "Chinese (Simplified)"},
+}
+
+// LanguageName returns the name of a language code, or the code itself if it
+// is not a listed language
+func LanguageName(code string) string {
+	if i := LanguageIndex(code); i >= 0 {
+		return Languages[i].Name
+	}
+	return code
+}
+
+// LanguageIndex returns the index of a language code in Languages
+// (case-insensitive), or -1
+func LanguageIndex(code string) int {
+	for i, l := range Languages {
+		if strings.EqualFold(l.Code, code) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ParseLanguage returns the language code for a code or a (case-insensitive)
+// language name, e.g. "fr" or "french"
+func ParseLanguage(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	for _, l := range Languages {
+		if strings.EqualFold(l.Code, value) || strings.EqualFold(l.Name, value) {
+			return l.Code, true
+		}
+	}
+	return "", false
+}
+
+// DefaultLanguageFor returns the configured language if it is a listed
+// language, else fallback
+func DefaultLanguageFor(configured, fallback string) string {
+	if i := LanguageIndex(configured); i >= 0 {
+		return Languages[i].Code
+	}
+	return fallback
+}
@@ -0,0 +1,52 @@
+package youtube
+
+import "testing"
+
+func TestLanguages(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, l := range Languages {
+		if l.Code == "" || l.Name == "" {
+			t.Errorf("language %+v has an empty code or name", l)
+		}
+		if seen[l.Code] {
+			t.Errorf("language %s is listed twice", l.Code)
+		}
+		seen[l.Code] = true
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   string
+		wantOK bool
+	}{
+		{"en-GB", "en-GB", true},
+		{"en-gb", "en-GB", true},
+		{"French", "fr", true},
+		{" af ", "af", true},
+		{"klingon", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseLanguage(tt.value)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("ParseLanguage(%q) = %q, %v, want %q, %v", tt.value, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestDefaultLanguageFor(t *testing.T) {
+	if got := DefaultLanguageFor("de", "en-GB"); got != "de" {
+		t.Errorf("DefaultLanguageFor(de) = %q, want de", got)
+	}
+	if got := DefaultLanguageFor("", "en-GB"); got != "en-GB" {
+		t.Errorf("DefaultLanguageFor(\"\") = %q, want the fallback en-GB", got)
+	}
+	if got := DefaultLanguageFor("xx", "en-GB"); got != "en-GB" {
+		t.Errorf("DefaultLanguageFor(xx) = %q, want the fallback en-GB", got)
+	}
+	if got := LanguageName("xx"); got != "xx" {
+		t.Errorf("LanguageName(xx) = %q, want the code itself", got)
+	}
+}
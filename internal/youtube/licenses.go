@@ -0,0 +1,48 @@
+package youtube
+
+import "strings"
+
+// License represents the license a YouTube video is published under
+type License string
+
+const (
+	LicenseYouTube         License = "youtube"        // Standard YouTube License
+	LicenseCreativeCommons License = "creativeCommon" // Creative Commons Attribution (CC BY)
+)
+
+// Licenses lists the licenses offered in the upload form, in order
+var Licenses = []License{LicenseYouTube, LicenseCreativeCommons}
+
+// Label returns the name YouTube shows for the license. Uploads without a
+// stored license used the standard license.
+func (l License) Label() string {
+	switch l {
+	case LicenseCreativeCommons:
+		return "Creative Commons - Attribution"
+	case LicenseYouTube, "":
+		return "Standard YouTube License"
+	}
+	return string(l)
+}
+
+// LicenseIndex returns the index of a license in Licenses, or -1
+func LicenseIndex(l License) int {
+	for i, license := range Licenses {
+		if license == l {
+			return i
+		}
+	}
+	return -1
+}
+
+// ParseLicense returns the license for its API value or a short name:
+// "youtube" or "standard", "creativeCommon", "cc" or "cc-by"
+func ParseLicense(value string) (License, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "youtube", "standard":
+		return LicenseYouTube, true
+	case "creativecommon", "creative-commons", "cc", "cc-by":
+		return LicenseCreativeCommons, true
+	}
+	return "", false
+}
@@ -0,0 +1,36 @@
+package youtube
+
+import "testing"
+
+func TestParseLicense(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   License
+		wantOK bool
+	}{
+		{"youtube", LicenseYouTube, true},
+		{"Standard", LicenseYouTube, true},
+		{"creativeCommon", LicenseCreativeCommons, true},
+		{"cc", LicenseCreativeCommons, true},
+		{" CC-BY ", LicenseCreativeCommons, true},
+		{"gpl", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseLicense(tt.value)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("ParseLicense(%q) = %q, %v, want %q, %v", tt.value, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestLicenseLabel(t *testing.T) {
+	if got := License("").Label(); got != LicenseYouTube.Label() {
+		t.Errorf("empty license label = %q, want the standard license %q", got, LicenseYouTube.Label())
+	}
+	for _, l := range Licenses {
+		if LicenseIndex(l) < 0 || l.Label() == string(l) {
+			t.Errorf("license %q has no index or label", l)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package youtube
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kartoza/kartoza-screencaster/internal/models"
+)
+
+// QueueItem is a video waiting in the upload queue
+type QueueItem struct {
+	ID           int                   // Assigned when the item is queued
+	Recording    *models.RecordingInfo // Recording the video belongs to
+	VideoPath    string
+	Options      UploadOptions
+	Account      Account // Account (channel) the video is uploaded to
+	PlaylistName string  // Title of the playlist, looked up if Options has no playlist ID
+}
+
+// QueueUploadFunc uploads one queued item, reporting the bytes sent
+type QueueUploadFunc func(ctx context.Context, item QueueItem, progress func(read, total int64)) (*UploadResult, error)
+
+// QueueEvent reports the progress or outcome of the item being uploaded
+type QueueEvent struct {
+	Item    QueueItem
+	Percent float64 // Share of the video sent, 0-1
+	Done    bool
+	Result  *UploadResult // Set when done and the upload succeeded
+	Err     error         // Set when done and the upload failed
+}
+
+// queueEventBuffer is the number of events kept while nobody reads them.
+// Progress events are dropped when the buffer is full, completions are not.
+const queueEventBuffer = 100
+
+// UploadQueue uploads videos one after another in the background. A single
+// worker goroutine runs while items are queued and reports on Events.
+type UploadQueue struct {
+	upload QueueUploadFunc
+	events chan QueueEvent
+	ctx    context.Context // Cancelled by Close
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	pending []QueueItem
+	active  *QueueItem
+	nextID  int
+	running bool
+}
+
+// NewUploadQueue creates an empty queue that uploads each item with upload
+func NewUploadQueue(upload QueueUploadFunc) *UploadQueue {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &UploadQueue{
+		upload: upload,
+		events: make(chan QueueEvent, queueEventBuffer),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Add queues an item and starts the worker if it is idle. It returns false
+// if the video is already queued for the same account or the queue is closed.
+func (q *UploadQueue) Add(item QueueItem) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.ctx.Err() != nil {
+		return false
+	}
+
+	queued := q.pending
+	if q.active != nil {
+		queued = append([]QueueItem{*q.active}, queued...)
+	}
+	for _, other := range queued {
+		if other.VideoPath == item.VideoPath && other.Account.ID == item.Account.ID {
+			return false
+		}
+	}
+
+	q.nextID++
+	item.ID = q.nextID
+	if item.Options.VideoPath == "" {
+		item.Options.VideoPath = item.VideoPath
+	}
+	q.pending = append(q.pending, item)
+	if !q.running {
+		q.running = true
+		go q.work()
+	}
+	return true
+}
+
+// Active returns the item being uploaded, or nil
+func (q *UploadQueue) Active() *QueueItem {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.active == nil {
+		return nil
+	}
+	item := *q.active
+	return &item
+}
+
+// Pending returns the items waiting to be uploaded, in upload order
+func (q *UploadQueue) Pending() []QueueItem {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return append([]QueueItem(nil), q.pending...)
+}
+
+// Len returns the number of items waiting or being uploaded
+func (q *UploadQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	n := len(q.pending)
+	if q.active != nil {
+		n++
+	}
+	return n
+}
+
+// Events returns the channel progress and completions are reported on
+func (q *UploadQueue) Events() <-chan QueueEvent {
+	return q.events
+}
+
+// Close cancels the upload in progress and drops the waiting items, e.g.
+// when the application quits. Nothing is reported for them.
+func (q *UploadQueue) Close() {
+	q.cancel()
+}
+
+// work uploads the queued items in order until the queue is empty
+func (q *UploadQueue) work() {
+	for {
+		q.mu.Lock()
+		if len(q.pending) == 0 || q.ctx.Err() != nil {
+			q.pending = nil
+			q.running = false
+			q.mu.Unlock()
+			return
+		}
+		item := q.pending[0]
+		q.pending = q.pending[1:]
+		q.active = &item
+		q.mu.Unlock()
+
+		result, err := q.upload(q.ctx, item, func(read, total int64) {
+			if total <= 0 {
+				return
+			}
+			select {
+			case q.events <- QueueEvent{Item: item, Percent: float64(read) / float64(total)}:
+			default:
+				// Nobody is reading, skip this update
+			}
+		})
+
+		// The item leaves the queue before its completion is reported, so
+		// Len is 0 when the last completion is read
+		q.mu.Lock()
+		q.active = nil
+		q.mu.Unlock()
+		select {
+		case q.events <- QueueEvent{Item: item, Percent: 1, Done: true, Result: result, Err: err}:
+		case <-q.ctx.Done():
+			// Closed, nobody waits for the completion
+		}
+	}
+}
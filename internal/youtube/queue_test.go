@@ -0,0 +1,127 @@
+package youtube
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// nextEvent returns the next completion of the queue, skipping progress
+func nextEvent(t *testing.T, q *UploadQueue) QueueEvent {
+	t.Helper()
+	for {
+		select {
+		case event := <-q.Events():
+			if event.Done {
+				return event
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the queue")
+		}
+	}
+}
+
+func TestUploadQueue(t *testing.T) {
+	release := make(chan struct{})
+	var uploaded []string
+	q := NewUploadQueue(func(ctx context.Context, item QueueItem, progress func(read, total int64)) (*UploadResult, error) {
+		if item.VideoPath == "first.mp4" {
+			<-release
+		}
+		uploaded = append(uploaded, item.Options.VideoPath)
+		progress(50, 100)
+		if item.VideoPath == "broken.mp4" {
+			return nil, errors.New("upload failed")
+		}
+		return &UploadResult{VideoID: item.VideoPath}, nil
+	})
+
+	personal := Account{ID: "personal"}
+	for _, path := range []string{"first.mp4", "broken.mp4", "last.mp4"} {
+		if !q.Add(QueueItem{VideoPath: path, Account: personal}) {
+			t.Fatalf("Add(%s) = false, want it queued", path)
+		}
+	}
+	if q.Add(QueueItem{VideoPath: "first.mp4", Account: personal}) {
+		t.Error("expected a video already queued for the account to be refused")
+	}
+	if !q.Add(QueueItem{VideoPath: "first.mp4", Account: Account{ID: "brand"}}) {
+		t.Error("expected the same video to be queued for another account")
+	}
+
+	// The first item is being uploaded, the rest wait
+	deadline := time.Now().Add(5 * time.Second)
+	for q.Active() == nil && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if active := q.Active(); active == nil || active.VideoPath != "first.mp4" || active.ID != 1 {
+		t.Fatalf("Active() = %+v, want the first item", active)
+	}
+	if len(q.Pending()) != 3 || q.Len() != 4 {
+		t.Fatalf("pending %+v, want the other items waiting", q.Pending())
+	}
+	close(release)
+
+	want := []struct {
+		path   string
+		failed bool
+	}{{"first.mp4", false}, {"broken.mp4", true}, {"last.mp4", false}, {"first.mp4", false}}
+	for _, w := range want {
+		event := nextEvent(t, q)
+		if event.Item.VideoPath != w.path || (event.Err != nil) != w.failed {
+			t.Errorf("completed %s with error %v, want %s (failed %v)", event.Item.VideoPath, event.Err, w.path, w.failed)
+		}
+		if !w.failed && (event.Result == nil || event.Result.VideoID != w.path) {
+			t.Errorf("result = %+v, want the upload of %s", event.Result, w.path)
+		}
+	}
+	if q.Len() != 0 || len(uploaded) != 4 {
+		t.Errorf("%d left in the queue after %d uploads, want all 4 uploaded one after another", q.Len(), len(uploaded))
+	}
+
+	// The worker starts again for items queued later
+	q.Add(QueueItem{VideoPath: "later.mp4", Account: personal})
+	if event := nextEvent(t, q); event.Item.VideoPath != "later.mp4" || event.Item.ID != 5 {
+		t.Errorf("completed %+v, want the item queued later", event.Item)
+	}
+}
+
+func TestUploadQueue_Close(t *testing.T) {
+	started := make(chan struct{})
+	cancelled := make(chan error, 1)
+	var calls int
+	q := NewUploadQueue(func(ctx context.Context, item QueueItem, progress func(read, total int64)) (*UploadResult, error) {
+		calls++
+		close(started)
+		<-ctx.Done()
+		cancelled <- ctx.Err()
+		return nil, ctx.Err()
+	})
+
+	personal := Account{ID: "personal"}
+	q.Add(QueueItem{VideoPath: "first.mp4", Account: personal})
+	q.Add(QueueItem{VideoPath: "second.mp4", Account: personal})
+	<-started
+
+	q.Close()
+	select {
+	case err := <-cancelled:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("upload context error = %v, want it cancelled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the upload to be cancelled")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for q.Len() != 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if q.Len() != 0 || calls != 1 {
+		t.Errorf("%d left in the queue after %d uploads, want the waiting item dropped", q.Len(), calls)
+	}
+	if q.Add(QueueItem{VideoPath: "later.mp4", Account: personal}) {
+		t.Error("expected a closed queue to refuse items")
+	}
+}
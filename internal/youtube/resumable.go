@@ -0,0 +1,260 @@
+package youtube
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/youtube/v3"
+)
+
+// uploadURL is the endpoint resumable upload sessions are started at, a
+// variable so tests can point it at a local server
+var uploadURL = "https://www.googleapis.com/upload/youtube/v3/videos"
+
+// errSessionExpired is returned when YouTube no longer knows an upload session
+var errSessionExpired = errors.New("upload session expired")
+
+// uploadSession is a resumable upload in progress. It is saved next to the
+// video so an upload that failed partway continues where it stopped.
+type uploadSession struct {
+	URI      string    `json:"uri"`
+	Size     int64     `json:"size"`     // Size of the video when the session was started
+	ModTime  time.Time `json:"mod_time"` // Modification time of the video then
+	Metadata string    `json:"metadata"` // SHA-256 of the video metadata sent
+}
+
+// SessionPath returns the file the resumable upload session of a video to
+// an account is kept in
+func SessionPath(videoPath, accountID string) string {
+	if accountID == "" {
+		accountID = "default"
+	}
+	dir, name := filepath.Split(videoPath)
+	return filepath.Join(dir, fmt.Sprintf(".%s.%s.upload-session", name, accountID))
+}
+
+// HasUploadSession reports whether an earlier upload of a video to an
+// account can be resumed
+func HasUploadSession(videoPath, accountID string) bool {
+	_, err := os.Stat(SessionPath(videoPath, accountID))
+	return err == nil
+}
+
+// HasAnyUploadSession reports whether an earlier upload of a video to any
+// account stopped before it finished and can be resumed
+func HasAnyUploadSession(videoPath string) bool {
+	dir, name := filepath.Split(videoPath)
+	if name == "" {
+		return false
+	}
+	if dir == "" {
+		dir = "."
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	prefix := "." + name + "."
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), prefix) && strings.HasSuffix(entry.Name(), ".upload-session") {
+			return true
+		}
+	}
+	return false
+}
+
+// loadSession returns the saved session if it was started for the same file
+// and metadata, or an empty session to start a new one
+func loadSession(path string, info os.FileInfo, metadata string) uploadSession {
+	fresh := uploadSession{Size: info.Size(), ModTime: info.ModTime(), Metadata: metadata}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fresh
+	}
+	var saved uploadSession
+	if err := json.Unmarshal(data, &saved); err != nil || saved.URI == "" {
+		return fresh
+	}
+	if saved.Size != fresh.Size || !saved.ModTime.Equal(fresh.ModTime) || saved.Metadata != fresh.Metadata {
+		// The video or its details changed, the session would upload the old ones
+		return fresh
+	}
+	return saved
+}
+
+// saveSession writes the session next to the video
+func saveSession(path string, session uploadSession) error {
+	data, err := json.MarshalIndent(session, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// metadataHash returns the hash a session records of the metadata it was
+// started with
+func metadataHash(metadata []byte, notifySubscribers bool) string {
+	sum := sha256.Sum256(append(metadata, strconv.FormatBool(notifySubscribers)...))
+	return hex.EncodeToString(sum[:])
+}
+
+// uploadResumable sends the video in session, continuing at the offset
+// YouTube has already received. A session that has expired is replaced by
+// a new one, which is saved to sessionPath before any bytes are sent.
+func (u *Uploader) uploadResumable(ctx context.Context, file io.Seeker, reader *ProgressReader, session *uploadSession, sessionPath string, metadata []byte, notifySubscribers bool) (*youtube.Video, error) {
+	offset := int64(0)
+	if session.URI != "" {
+		received, video, err := u.queryOffset(ctx, session.URI, session.Size)
+		switch {
+		case errors.Is(err, errSessionExpired):
+			session.URI = ""
+		case err != nil:
+			return nil, err
+		case video != nil:
+			// Every byte arrived before the connection dropped
+			return video, nil
+		default:
+			offset = received
+		}
+	}
+
+	if session.URI == "" {
+		uri, err := u.startSession(ctx, metadata, session.Size, notifySubscribers)
+		if err != nil {
+			return nil, err
+		}
+		session.URI = uri
+		// Resuming is best effort, the upload goes ahead without it
+		_ = saveSession(sessionPath, *session)
+	}
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	reader.read = offset
+	return u.sendFrom(ctx, session.URI, reader, offset, session.Size)
+}
+
+// startSession starts a resumable upload and returns its session URI
+func (u *Uploader) startSession(ctx context.Context, metadata []byte, size int64, notifySubscribers bool) (string, error) {
+	query := url.Values{
+		"uploadType":        {"resumable"},
+		"part":              {"snippet,status"},
+		"notifySubscribers": {strconv.FormatBool(notifySubscribers)},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadURL+"?"+query.Encode(), bytes.NewReader(metadata))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	req.Header.Set("X-Upload-Content-Length", strconv.FormatInt(size, 10))
+	req.Header.Set("X-Upload-Content-Type", "video/*")
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if err := googleapi.CheckResponse(resp); err != nil {
+		return "", err
+	}
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return "", fmt.Errorf("YouTube did not return an upload session")
+	}
+	return location, nil
+}
+
+// queryOffset asks YouTube how much of the video it has received. The video
+// is returned instead if the upload already completed.
+func (u *Uploader) queryOffset(ctx context.Context, sessionURI string, size int64) (int64, *youtube.Video, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, sessionURI, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		video, err := decodeVideo(resp)
+		return size, video, err
+	case http.StatusPermanentRedirect:
+		return receivedBytes(resp.Header.Get("Range")), nil, nil
+	case http.StatusNotFound, http.StatusGone:
+		return 0, nil, errSessionExpired
+	}
+	if err := googleapi.CheckResponse(resp); err != nil {
+		return 0, nil, err
+	}
+	return 0, nil, fmt.Errorf("unexpected upload status %s", resp.Status)
+}
+
+// sendFrom sends the rest of the video from offset
+func (u *Uploader) sendFrom(ctx context.Context, sessionURI string, reader io.Reader, offset, size int64) (*youtube.Video, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, sessionURI, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = size - offset
+	req.Header.Set("Content-Type", "video/*")
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, size-1, size))
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return decodeVideo(resp)
+	case http.StatusPermanentRedirect:
+		// YouTube stored part of the video, the rest is sent when retried
+		return nil, fmt.Errorf("upload incomplete: %w", io.ErrUnexpectedEOF)
+	}
+	return nil, googleapi.CheckResponse(resp)
+}
+
+// receivedBytes returns how many bytes a Range header of a 308 response
+// says were received ("bytes=0-N"), 0 without one
+func receivedBytes(header string) int64 {
+	_, last, ok := strings.Cut(strings.TrimPrefix(header, "bytes="), "-")
+	if !ok {
+		return 0
+	}
+	n, err := strconv.ParseInt(last, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n + 1
+}
+
+// decodeVideo reads the video YouTube returns when an upload completes
+func decodeVideo(resp *http.Response) (*youtube.Video, error) {
+	var video youtube.Video
+	if err := json.NewDecoder(resp.Body).Decode(&video); err != nil {
+		return nil, fmt.Errorf("failed to read uploaded video: %w", err)
+	}
+	return &video, nil
+}
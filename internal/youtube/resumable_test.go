@@ -0,0 +1,140 @@
+package youtube
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUploadResumesAfterFailure(t *testing.T) {
+	defer func(attempts int) { retryMaxAttempts = attempts }(retryMaxAttempts)
+	retryMaxAttempts = 1
+
+	video := bytes.Repeat([]byte("0123456789"), 1000)
+	var (
+		received []byte
+		sessions int
+		failOnce = true
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			sessions++
+			w.Header().Set("Location", "http://"+r.Host+"/session")
+		case r.Header.Get("Content-Range") == fmt.Sprintf("bytes */%d", len(video)):
+			if len(received) > 0 {
+				w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", len(received)-1))
+			}
+			w.WriteHeader(http.StatusPermanentRedirect)
+		default:
+			want := fmt.Sprintf("bytes %d-%d/%d", len(received), len(video)-1, len(video))
+			if got := r.Header.Get("Content-Range"); got != want {
+				t.Errorf("Content-Range = %q, want %q", got, want)
+			}
+			body, _ := io.ReadAll(r.Body)
+			if failOnce {
+				// The connection drops after half of the video arrived
+				failOnce = false
+				received = append(received, body[:len(body)/2]...)
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			received = append(received, body...)
+			_, _ = w.Write([]byte(`{"id":"abc123"}`))
+		}
+	}))
+	defer server.Close()
+	defer func(url string) { uploadURL = url }(uploadURL)
+	uploadURL = server.URL
+
+	videoPath := filepath.Join(t.TempDir(), "final.mp4")
+	if err := os.WriteFile(videoPath, video, 0644); err != nil {
+		t.Fatal(err)
+	}
+	u := &Uploader{client: server.Client()}
+	opts := UploadOptions{VideoPath: videoPath, Title: "Demo"}
+
+	if _, err := u.Upload(context.Background(), opts, nil); err == nil {
+		t.Fatal("expected the first upload to fail")
+	}
+	if !HasUploadSession(videoPath, "") {
+		t.Fatal("expected the session to be kept for a retry")
+	}
+
+	var progress int64
+	result, err := u.Upload(context.Background(), opts, func(read, total int64) {
+		if progress == 0 {
+			progress = read
+		}
+	})
+	if err != nil {
+		t.Fatalf("Upload() error: %v", err)
+	}
+	if result.VideoID != "abc123" {
+		t.Errorf("VideoID = %q, want abc123", result.VideoID)
+	}
+	if sessions != 1 || !bytes.Equal(received, video) {
+		t.Errorf("%d sessions and %d of %d bytes received, want the rest sent in the same session", sessions, len(received), len(video))
+	}
+	if progress <= int64(len(video)/2) {
+		t.Errorf("progress started at %d, want it to continue from the received half", progress)
+	}
+	if HasUploadSession(videoPath, "") {
+		t.Error("expected the session file to be removed after the upload")
+	}
+
+	// A changed title starts a new session instead of resuming the old one
+	received, failOnce = nil, true
+	if _, err := u.Upload(context.Background(), opts, nil); err == nil {
+		t.Fatal("expected the upload to fail")
+	}
+	opts.Title = "Renamed"
+	received = nil
+	if _, err := u.Upload(context.Background(), opts, nil); err != nil {
+		t.Fatalf("Upload() error: %v", err)
+	}
+	if sessions != 3 {
+		t.Errorf("sessions = %d, want a new one for the new title", sessions)
+	}
+}
+
+func TestReceivedBytes(t *testing.T) {
+	tests := map[string]int64{
+		"bytes=0-499": 500,
+		"0-0":         1,
+		"":            0,
+		"bytes=junk":  0,
+	}
+	for header, want := range tests {
+		if got := receivedBytes(header); got != want {
+			t.Errorf("receivedBytes(%q) = %d, want %d", header, got, want)
+		}
+	}
+}
+
+func TestHasAnyUploadSession(t *testing.T) {
+	dir := t.TempDir()
+	video := filepath.Join(dir, "screen-merged.mp4")
+	if HasAnyUploadSession(video) {
+		t.Error("expected no session before an upload")
+	}
+	// A session of another video in the folder does not count
+	if err := os.WriteFile(SessionPath(filepath.Join(dir, "screen-vertical.mp4"), "work"), []byte("{}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if HasAnyUploadSession(video) {
+		t.Error("expected the session of another video to be ignored")
+	}
+	if err := os.WriteFile(SessionPath(video, "work"), []byte("{}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if !HasAnyUploadSession(video) {
+		t.Error("expected the session of the video to any account to be found")
+	}
+}
@@ -0,0 +1,153 @@
+package youtube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+// Backoff settings for YouTube API calls, variables so tests can shorten them
+var (
+	retryMaxAttempts = 5
+	retryBaseDelay   = 2 * time.Second
+	retryMaxDelay    = time.Minute
+)
+
+// RetryInfo describes a failed attempt of a YouTube API call that is about to
+// be retried
+type RetryInfo struct {
+	Attempt     int           // Failed attempt, starting at 1
+	MaxAttempts int           // Attempts made before giving up
+	Wait        time.Duration // Delay before the next attempt
+	RateLimited bool          // YouTube rejected the call with a rate limit (403/429)
+	Err         error         // Error of the failed attempt
+}
+
+// String returns a short status line for the UI
+func (r RetryInfo) String() string {
+	reason := "YouTube is temporarily unavailable"
+	if r.RateLimited {
+		reason = "Rate limited by YouTube"
+	}
+	return fmt.Sprintf("%s, retrying in %s (attempt %d of %d)",
+		reason, r.Wait.Round(time.Second), r.Attempt+1, r.MaxAttempts)
+}
+
+// IsRateLimited reports whether err is YouTube rejecting a call because too
+// many requests were made. The daily quota (quotaExceeded) is not a rate
+// limit: it only resets the next day.
+func IsRateLimited(err error) bool {
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.Code == http.StatusTooManyRequests {
+		return true
+	}
+	if apiErr.Code == http.StatusForbidden {
+		for _, item := range apiErr.Errors {
+			if item.Reason == "rateLimitExceeded" || item.Reason == "userRateLimitExceeded" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsRetryable reports whether a failed YouTube API call may succeed when
+// repeated: rate limits, server errors and dropped connections. Invalid
+// requests, missing permissions, an exhausted quota and canceled calls are
+// fatal.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if IsRateLimited(err) {
+		return true
+	}
+
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		switch apiErr.Code {
+		case http.StatusInternalServerError, http.StatusBadGateway,
+			http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET)
+}
+
+// retryDelay returns how long to wait after the given failed attempt: the
+// Retry-After header if YouTube sent one, otherwise exponential backoff with
+// jitter
+func retryDelay(err error, attempt int) time.Duration {
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Header != nil {
+		if secs, convErr := strconv.Atoi(apiErr.Header.Get("Retry-After")); convErr == nil && secs > 0 {
+			if delay := time.Duration(secs) * time.Second; delay < retryMaxDelay {
+				return delay
+			}
+			return retryMaxDelay
+		}
+	}
+
+	delay := retryBaseDelay << (attempt - 1)
+	if delay <= 0 || delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	if half := int64(delay / 2); half > 0 {
+		delay = delay/2 + time.Duration(rand.Int63n(half+1))
+	}
+	return delay
+}
+
+// withRetry runs call until it succeeds, fails with a fatal error or runs out
+// of attempts, waiting between attempts. Calls that are not idempotent (e.g.
+// creating a playlist) are only repeated after a rate limit, where YouTube
+// rejected the request without acting on it. notify (optional) is told about
+// every retry.
+func withRetry(ctx context.Context, idempotent bool, notify func(RetryInfo), call func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := call()
+		if err == nil {
+			return nil
+		}
+		if attempt >= retryMaxAttempts || !IsRetryable(err) || (!idempotent && !IsRateLimited(err)) {
+			return err
+		}
+
+		info := RetryInfo{
+			Attempt:     attempt,
+			MaxAttempts: retryMaxAttempts,
+			Wait:        retryDelay(err, attempt),
+			RateLimited: IsRateLimited(err),
+			Err:         err,
+		}
+		if notify != nil {
+			notify(info)
+		}
+
+		timer := time.NewTimer(info.Wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
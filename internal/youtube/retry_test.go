@@ -0,0 +1,127 @@
+package youtube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+func apiError(code int, reason string) error {
+	err := &googleapi.Error{Code: code}
+	if reason != "" {
+		err.Errors = []googleapi.ErrorItem{{Reason: reason}}
+	}
+	return fmt.Errorf("wrapped: %w", err)
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		wantRetryable bool
+		wantRateLimit bool
+	}{
+		{"too many requests", apiError(http.StatusTooManyRequests, ""), true, true},
+		{"rate limit exceeded", apiError(http.StatusForbidden, "rateLimitExceeded"), true, true},
+		{"user rate limit exceeded", apiError(http.StatusForbidden, "userRateLimitExceeded"), true, true},
+		{"daily quota exceeded", apiError(http.StatusForbidden, "quotaExceeded"), false, false},
+		{"forbidden", apiError(http.StatusForbidden, "forbidden"), false, false},
+		{"server error", apiError(http.StatusInternalServerError, ""), true, false},
+		{"service unavailable", apiError(http.StatusServiceUnavailable, "backendError"), true, false},
+		{"not found", apiError(http.StatusNotFound, "videoNotFound"), false, false},
+		{"connection dropped", fmt.Errorf("read: %w", io.ErrUnexpectedEOF), true, false},
+		{"canceled", context.Canceled, false, false},
+		{"other", errors.New("boom"), false, false},
+		{"nil", nil, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.wantRetryable {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.wantRetryable)
+			}
+			if got := IsRateLimited(tt.err); got != tt.wantRateLimit {
+				t.Errorf("IsRateLimited() = %v, want %v", got, tt.wantRateLimit)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	retryAfter := &googleapi.Error{Code: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"7"}}}
+	if got := retryDelay(retryAfter, 1); got != 7*time.Second {
+		t.Errorf("retryDelay() with Retry-After = %v, want 7s", got)
+	}
+
+	for attempt := 1; attempt <= 10; attempt++ {
+		got := retryDelay(errors.New("boom"), attempt)
+		if got <= 0 || got > retryMaxDelay {
+			t.Errorf("retryDelay(attempt %d) = %v, want within (0, %v]", attempt, got, retryMaxDelay)
+		}
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	oldBase, oldMax := retryBaseDelay, retryMaxDelay
+	retryBaseDelay, retryMaxDelay = time.Millisecond, time.Millisecond
+	t.Cleanup(func() { retryBaseDelay, retryMaxDelay = oldBase, oldMax })
+
+	rateLimited := apiError(http.StatusTooManyRequests, "")
+	serverError := apiError(http.StatusServiceUnavailable, "")
+
+	tests := []struct {
+		name         string
+		idempotent   bool
+		errs         []error // Results of successive attempts, nil = success
+		wantErr      bool
+		wantAttempts int
+	}{
+		{"succeeds first time", true, []error{nil}, false, 1},
+		{"recovers from rate limit", true, []error{rateLimited, rateLimited, nil}, false, 3},
+		{"gives up after max attempts", true, []error{serverError, serverError, serverError, serverError, serverError, nil}, true, 5},
+		{"fatal error is not retried", true, []error{apiError(http.StatusNotFound, ""), nil}, true, 1},
+		{"non-idempotent retries rate limit", false, []error{rateLimited, nil}, false, 2},
+		{"non-idempotent does not retry server error", false, []error{serverError, nil}, true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts, notified := 0, 0
+			err := withRetry(context.Background(), tt.idempotent, func(info RetryInfo) {
+				notified++
+				if info.Attempt != notified || info.MaxAttempts != retryMaxAttempts {
+					t.Errorf("notify got %+v on retry %d", info, notified)
+				}
+			}, func() error {
+				err := tt.errs[attempts]
+				attempts++
+				return err
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("withRetry() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+			if notified != tt.wantAttempts-1 {
+				t.Errorf("notified %d times, want %d", notified, tt.wantAttempts-1)
+			}
+		})
+	}
+}
+
+func TestWithRetryCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	err := withRetry(ctx, true, func(RetryInfo) { cancel() }, func() error {
+		attempts++
+		return apiError(http.StatusTooManyRequests, "")
+	})
+	if err == nil || attempts != 1 {
+		t.Errorf("withRetry() = %v after %d attempts, want the rate limit error after 1", err, attempts)
+	}
+}
@@ -147,11 +147,13 @@ func ExtractThumbnailForYouTube(videoPath, outputPath string) error {
 	return ExtractThumbnail(videoPath, opts, outputPath)
 }
 
-// GetThumbnailPath returns the standard thumbnail path for a video
-func GetThumbnailPath(videoPath string) string {
-	dir := filepath.Dir(videoPath)
+// GetThumbnailPath returns the path of the upload thumbnail for a video in
+// cacheDir. The thumbnail is disposable, so it is kept out of the recording
+// folder; the folder name keeps thumbnails of same-named videos apart.
+func GetThumbnailPath(cacheDir, videoPath string) string {
+	folder := filepath.Base(filepath.Dir(videoPath))
 	base := strings.TrimSuffix(filepath.Base(videoPath), filepath.Ext(videoPath))
-	return filepath.Join(dir, base+"_thumbnail.jpg")
+	return filepath.Join(cacheDir, "thumbnails", folder+"_"+base+".jpg")
 }
 
 // formatDuration formats a duration for ffmpeg (HH:MM:SS.mmm)
@@ -2,11 +2,15 @@ package youtube
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"google.golang.org/api/option"
 	"google.golang.org/api/youtube/v3"
@@ -15,7 +19,9 @@ import (
 // Uploader handles YouTube video uploads
 type Uploader struct {
 	service *youtube.Service
+	client  *http.Client // Authenticated client for resumable uploads
 	auth    *Auth
+	onRetry func(RetryInfo)
 }
 
 // NewUploader creates a new YouTube uploader
@@ -32,10 +38,17 @@ func NewUploader(ctx context.Context, auth *Auth) (*Uploader, error) {
 
 	return &Uploader{
 		service: service,
+		client:  client,
 		auth:    auth,
 	}, nil
 }
 
+// SetRetryNotify sets a function that is called whenever an API call is
+// retried after a rate limit or temporary failure, so the caller can show it
+func (u *Uploader) SetRetryNotify(fn func(RetryInfo)) {
+	u.onRetry = fn
+}
+
 // ProgressReader wraps an io.Reader to report progress
 type ProgressReader struct {
 	reader       io.Reader
@@ -101,22 +114,49 @@ func (u *Uploader) Upload(ctx context.Context, opts UploadOptions, progressFunc
 			Description: opts.Description,
 			Tags:        opts.Tags,
 			CategoryId:  categoryID,
+
+			DefaultLanguage:      opts.Language,
+			DefaultAudioLanguage: opts.AudioLanguage,
 		},
 		Status: &youtube.VideoStatus{
-			PrivacyStatus: privacyStatus,
+			PrivacyStatus:           privacyStatus,
+			License:                 string(opts.License),
+			SelfDeclaredMadeForKids: opts.MadeForKids,
+			// Declare "not made for kids" explicitly instead of omitting it
+			ForceSendFields: []string{"SelfDeclaredMadeForKids"},
 		},
 	}
+	// YouTube only releases private videos at a scheduled time
+	if !opts.PublishAt.IsZero() && privacyStatus == string(PrivacyPrivate) {
+		video.Status.PublishAt = opts.PublishAt.UTC().Format(time.RFC3339)
+	}
 
-	// Perform upload
-	call := u.service.Videos.Insert([]string{"snippet", "status"}, video)
-	call = call.NotifySubscribers(opts.NotifySubscribers)
-	call = call.Media(reader)
-	call = call.Context(ctx)
-
-	response, err := call.Do()
+	metadata, err := json.Marshal(video)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode video metadata: %w", err)
+	}
+
+	// Perform a resumable upload. Its session is saved next to the video, so
+	// a retry, here or in a later upload of the same file, sends only what
+	// YouTube has not received yet. The video is only created once every
+	// byte has arrived, so any temporary failure can be retried.
+	accountID := ""
+	if u.auth != nil {
+		accountID = u.auth.accountID
+	}
+	sessionPath := SessionPath(opts.VideoPath, accountID)
+	session := loadSession(sessionPath, fileInfo, metadataHash(metadata, opts.NotifySubscribers))
+
+	var response *youtube.Video
+	err = withRetry(ctx, true, u.onRetry, func() error {
+		var err error
+		response, err = u.uploadResumable(ctx, file, reader, &session, sessionPath, metadata, opts.NotifySubscribers)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("upload failed: %w", err)
 	}
+	_ = os.Remove(sessionPath)
 
 	result := &UploadResult{
 		VideoID:  response.Id,
@@ -203,7 +243,12 @@ func (u *Uploader) ListPlaylists(ctx context.Context) ([]Playlist, error) {
 			call = call.PageToken(pageToken)
 		}
 
-		response, err := call.Do()
+		var response *youtube.PlaylistListResponse
+		err := withRetry(ctx, true, u.onRetry, func() error {
+			var err error
+			response, err = call.Do()
+			return err
+		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to list playlists: %w", err)
 		}
@@ -247,7 +292,12 @@ func (u *Uploader) CreatePlaylist(ctx context.Context, title, description string
 	call := u.service.Playlists.Insert([]string{"snippet", "status"}, playlist)
 	call = call.Context(ctx)
 
-	response, err := call.Do()
+	var response *youtube.Playlist
+	err := withRetry(ctx, false, u.onRetry, func() error {
+		var err error
+		response, err = call.Do()
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create playlist: %w", err)
 	}
@@ -268,8 +318,10 @@ func (u *Uploader) GetUploadQuota(ctx context.Context) (string, error) {
 	return "Unknown (check Google Cloud Console)", nil
 }
 
-// BuildUploadOptions creates UploadOptions from recording metadata
-func BuildUploadOptions(videoPath, title, description, topic string, tags []string, privacy PrivacyStatus) UploadOptions {
+// BuildUploadOptions creates UploadOptions from recording metadata.
+// madeForKids is the audience YouTube requires every upload to declare;
+// an empty categoryID uses DefaultCategoryID.
+func BuildUploadOptions(videoPath, title, description, topic string, tags []string, privacy PrivacyStatus, madeForKids bool, categoryID string) UploadOptions {
 	// Add topic to tags if not already present
 	topicTag := strings.ToLower(strings.ReplaceAll(topic, " ", "-"))
 	hasTopicTag := false
@@ -298,18 +350,19 @@ func BuildUploadOptions(videoPath, title, description, topic string, tags []stri
 		}
 	}
 
-	// Ensure we have a thumbnail path
-	thumbnailPath := GetThumbnailPath(videoPath)
+	if categoryID == "" {
+		categoryID = DefaultCategoryID
+	}
 
 	return UploadOptions{
 		VideoPath:         videoPath,
 		Title:             title,
 		Description:       description,
 		Tags:              tags,
-		CategoryID:        DefaultCategoryID,
+		CategoryID:        categoryID,
 		PrivacyStatus:     privacy,
-		ThumbnailPath:     thumbnailPath,
 		NotifySubscribers: privacy == PrivacyPublic, // Only notify for public videos
+		MadeForKids:       madeForKids,
 	}
 }
 
@@ -380,7 +433,12 @@ func (u *Uploader) UpdateVideoPrivacy(ctx context.Context, videoID string, priva
 	call = call.Id(videoID)
 	call = call.Context(ctx)
 
-	response, err := call.Do()
+	var response *youtube.VideoListResponse
+	err := withRetry(ctx, true, u.onRetry, func() error {
+		var err error
+		response, err = call.Do()
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to get video: %w", err)
 	}
@@ -391,12 +449,18 @@ func (u *Uploader) UpdateVideoPrivacy(ctx context.Context, videoID string, priva
 
 	video := response.Items[0]
 	video.Status.PrivacyStatus = string(privacy)
+	// Choosing the privacy by hand cancels a scheduled publish time, which
+	// YouTube only accepts for private videos anyway
+	video.Status.PublishAt = ""
 
 	// Update the video
 	updateCall := u.service.Videos.Update([]string{"status"}, video)
 	updateCall = updateCall.Context(ctx)
 
-	_, err = updateCall.Do()
+	err = withRetry(ctx, true, u.onRetry, func() error {
+		_, err := updateCall.Do()
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to update video privacy: %w", err)
 	}
@@ -404,12 +468,216 @@ func (u *Uploader) UpdateVideoPrivacy(ctx context.Context, videoID string, priva
 	return nil
 }
 
+// SetLocalizations replaces the translated titles and descriptions of a
+// video, keyed by BCP-47 language. YouTube only accepts localizations for a
+// video with a default language; defaultLanguage is set when it has none.
+func (u *Uploader) SetLocalizations(ctx context.Context, videoID, defaultLanguage string, localizations map[string]Localization) error {
+	// Get the current snippet, which is required when updating it
+	call := u.service.Videos.List([]string{"snippet", "localizations"})
+	call = call.Id(videoID)
+	call = call.Context(ctx)
+
+	var response *youtube.VideoListResponse
+	err := withRetry(ctx, true, u.onRetry, func() error {
+		var err error
+		response, err = call.Do()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get video: %w", err)
+	}
+
+	if len(response.Items) == 0 {
+		return fmt.Errorf("video not found: %s", videoID)
+	}
+
+	video := response.Items[0]
+	if video.Snippet.DefaultLanguage == "" {
+		if defaultLanguage == "" {
+			return fmt.Errorf("video %s has no default language", videoID)
+		}
+		video.Snippet.DefaultLanguage = defaultLanguage
+	}
+
+	video.Localizations = make(map[string]youtube.VideoLocalization, len(localizations))
+	for lang, l := range localizations {
+		video.Localizations[lang] = youtube.VideoLocalization{
+			Title:       l.Title,
+			Description: l.Description,
+		}
+	}
+
+	updateCall := u.service.Videos.Update([]string{"snippet", "localizations"}, video)
+	updateCall = updateCall.Context(ctx)
+
+	err = withRetry(ctx, true, u.onRetry, func() error {
+		_, err := updateCall.Do()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update video localizations: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateVideoDescription changes the description of a video. edit is given
+// the current description and returns the new one; the video is only
+// updated if it differs. The description before the change is returned.
+func (u *Uploader) UpdateVideoDescription(ctx context.Context, videoID string, edit func(string) string) (string, error) {
+	// Get the current snippet, which is required when updating it
+	call := u.service.Videos.List([]string{"snippet"})
+	call = call.Id(videoID)
+	call = call.Context(ctx)
+
+	var response *youtube.VideoListResponse
+	err := withRetry(ctx, true, u.onRetry, func() error {
+		var err error
+		response, err = call.Do()
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get video: %w", err)
+	}
+
+	if len(response.Items) == 0 {
+		return "", fmt.Errorf("video not found: %s", videoID)
+	}
+
+	video := response.Items[0]
+	before := video.Snippet.Description
+	after := edit(before)
+	if after == before {
+		return before, nil
+	}
+	video.Snippet.Description = after
+
+	updateCall := u.service.Videos.Update([]string{"snippet"}, video)
+	updateCall = updateCall.Context(ctx)
+
+	err = withRetry(ctx, true, u.onRetry, func() error {
+		_, err := updateCall.Do()
+		return err
+	})
+	if err != nil {
+		return before, fmt.Errorf("failed to update video description: %w", err)
+	}
+
+	return before, nil
+}
+
+// Limits YouTube sets on the metadata of a video
+const (
+	maxTitleLength       = 100
+	maxDescriptionLength = 5000
+	maxTagsLength        = 500
+)
+
+// ValidateVideoMetadata checks a title, description and tags against the
+// limits of YouTube, which refuses the whole update if one is broken
+func ValidateVideoMetadata(title, description string, tags []string) error {
+	if strings.TrimSpace(title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	if n := utf8.RuneCountInString(title); n > maxTitleLength {
+		return fmt.Errorf("title is %d characters, YouTube allows %d", n, maxTitleLength)
+	}
+	if len(description) > maxDescriptionLength {
+		return fmt.Errorf("description is %d bytes, YouTube allows %d", len(description), maxDescriptionLength)
+	}
+	if strings.ContainsAny(title+description, "<>") {
+		return fmt.Errorf("title and description cannot contain < or >")
+	}
+	// Tags with a space count with the quotes YouTube adds around them
+	total := 0
+	for _, tag := range tags {
+		total += utf8.RuneCountInString(tag)
+		if strings.Contains(tag, " ") {
+			total += 2
+		}
+	}
+	if total += max(len(tags)-1, 0); total > maxTagsLength {
+		return fmt.Errorf("tags are %d characters, YouTube allows %d", total, maxTagsLength)
+	}
+	return nil
+}
+
+// PublishAtLayout is how a scheduled publish time is typed, in local time
+const PublishAtLayout = "2006-01-02 15:04"
+
+// ParsePublishAt parses a scheduled publish time typed as PublishAtLayout in
+// local time. An empty value is no schedule; a time that is not after now is
+// refused, as YouTube would publish the video straight away.
+func ParsePublishAt(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.ParseInLocation(PublishAtLayout, value, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("publish time must be like %s", PublishAtLayout)
+	}
+	if !t.After(now) {
+		return time.Time{}, fmt.Errorf("publish time must be in the future")
+	}
+	return t, nil
+}
+
+// UpdateVideoMetadata replaces the title, description and tags of a video,
+// keeping the rest of its snippet such as the category and language
+func (u *Uploader) UpdateVideoMetadata(ctx context.Context, videoID, title, description string, tags []string) error {
+	if err := ValidateVideoMetadata(title, description, tags); err != nil {
+		return err
+	}
+
+	// Get the current snippet, which is required when updating it
+	call := u.service.Videos.List([]string{"snippet"})
+	call = call.Id(videoID)
+	call = call.Context(ctx)
+
+	var response *youtube.VideoListResponse
+	err := withRetry(ctx, true, u.onRetry, func() error {
+		var err error
+		response, err = call.Do()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get video: %w", err)
+	}
+
+	if len(response.Items) == 0 {
+		return fmt.Errorf("video not found: %s", videoID)
+	}
+
+	video := response.Items[0]
+	video.Snippet.Title = title
+	video.Snippet.Description = description
+	video.Snippet.Tags = tags
+	// An empty list would otherwise be left out and keep the old tags
+	video.Snippet.ForceSendFields = append(video.Snippet.ForceSendFields, "Tags")
+
+	updateCall := u.service.Videos.Update([]string{"snippet"}, video)
+	updateCall = updateCall.Context(ctx)
+
+	err = withRetry(ctx, true, u.onRetry, func() error {
+		_, err := updateCall.Do()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update video metadata: %w", err)
+	}
+
+	return nil
+}
+
 // DeleteVideo deletes a video from YouTube
 func (u *Uploader) DeleteVideo(ctx context.Context, videoID string) error {
 	call := u.service.Videos.Delete(videoID)
 	call = call.Context(ctx)
 
-	err := call.Do()
+	err := withRetry(ctx, true, u.onRetry, func() error {
+		return call.Do()
+	})
 	if err != nil {
 		return fmt.Errorf("failed to delete video: %w", err)
 	}
@@ -435,6 +703,99 @@ func (u *Uploader) GetVideoInfo(ctx context.Context, videoID string) (*youtube.V
 	return response.Items[0], nil
 }
 
+// maxVideoIDsPerList is the number of video IDs a single videos.list call accepts
+const maxVideoIDsPerList = 50
+
+// ExistingVideos returns which of the video IDs still exist on YouTube.
+// Private videos are only found with the account of their channel. Costs one
+// quota unit per 50 videos.
+func (u *Uploader) ExistingVideos(ctx context.Context, videoIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool, len(videoIDs))
+	for start := 0; start < len(videoIDs); start += maxVideoIDsPerList {
+		end := min(start+maxVideoIDsPerList, len(videoIDs))
+		call := u.service.Videos.List([]string{"id"})
+		call = call.Id(videoIDs[start:end]...)
+		call = call.Context(ctx)
+
+		var response *youtube.VideoListResponse
+		err := withRetry(ctx, true, u.onRetry, func() error {
+			var err error
+			response, err = call.Do()
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list videos: %w", err)
+		}
+		for _, video := range response.Items {
+			existing[video.Id] = true
+		}
+	}
+	return existing, nil
+}
+
+// VideoState is the title and privacy of an uploaded video as YouTube has them
+type VideoState struct {
+	Title   string
+	Privacy string
+}
+
+// VideoStates returns the current title and privacy of the videos that still
+// exist on YouTube. Costs one quota unit per 50 videos.
+func (u *Uploader) VideoStates(ctx context.Context, videoIDs []string) (map[string]VideoState, error) {
+	states := make(map[string]VideoState, len(videoIDs))
+	for start := 0; start < len(videoIDs); start += maxVideoIDsPerList {
+		end := min(start+maxVideoIDsPerList, len(videoIDs))
+		call := u.service.Videos.List([]string{"snippet", "status"})
+		call = call.Id(videoIDs[start:end]...)
+		call = call.Context(ctx)
+
+		var response *youtube.VideoListResponse
+		err := withRetry(ctx, true, u.onRetry, func() error {
+			var err error
+			response, err = call.Do()
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list videos: %w", err)
+		}
+		for _, video := range response.Items {
+			var state VideoState
+			if video.Snippet != nil {
+				state.Title = video.Snippet.Title
+			}
+			if video.Status != nil {
+				state.Privacy = video.Status.PrivacyStatus
+			}
+			states[video.Id] = state
+		}
+	}
+	return states, nil
+}
+
+// PlaylistContains reports whether a playlist contains a video. A playlist
+// that no longer exists contains nothing.
+func (u *Uploader) PlaylistContains(ctx context.Context, playlistID, videoID string) (bool, error) {
+	call := u.service.PlaylistItems.List([]string{"id"})
+	call = call.PlaylistId(playlistID)
+	call = call.VideoId(videoID)
+	call = call.Context(ctx)
+
+	var response *youtube.PlaylistItemListResponse
+	err := withRetry(ctx, true, u.onRetry, func() error {
+		var err error
+		response, err = call.Do()
+		return err
+	})
+	if err != nil {
+		// A deleted playlist contains nothing
+		if ErrorReason(err) == "playlistNotFound" {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to list playlist items: %w", err)
+	}
+	return len(response.Items) > 0, nil
+}
+
 // RemoveFromPlaylist removes a video from a playlist
 func (u *Uploader) RemoveFromPlaylist(ctx context.Context, playlistItemID string) error {
 	call := u.service.PlaylistItems.Delete(playlistItemID)
@@ -0,0 +1,157 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateVideoMetadata(t *testing.T) {
+	manyTags := make([]string, 0, 50)
+	for range 50 {
+		manyTags = append(manyTags, "tag one") // 9 characters with the quotes
+	}
+
+	tests := []struct {
+		name        string
+		title, desc string
+		tags        []string
+		wantErr     string
+	}{
+		{"valid", "QGIS intro", "Getting started", []string{"qgis", "gis"}, ""},
+		{"no tags", "QGIS intro", "", nil, ""},
+		{"empty title", "  ", "", nil, "title is required"},
+		{"long title", strings.Repeat("é", 101), "", nil, "title is 101 characters"},
+		{"title at limit", strings.Repeat("é", 100), "", nil, ""},
+		{"long description", "QGIS", strings.Repeat("a", 5001), nil, "description is 5001 bytes"},
+		{"angle brackets", "QGIS <3", "", nil, "cannot contain < or >"},
+		{"tags at limit", "QGIS", "", manyTags, ""},
+		{"too many tags", "QGIS", "", append(manyTags, "x"), "tags are 501 characters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateVideoMetadata(tt.title, tt.desc, tt.tags)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParsePublishAt(t *testing.T) {
+	now := time.Date(2026, 1, 4, 12, 0, 0, 0, time.Local)
+	if got, err := ParsePublishAt(" ", now); err != nil || !got.IsZero() {
+		t.Errorf("ParsePublishAt(empty) = %v, %v; want no schedule", got, err)
+	}
+	got, err := ParsePublishAt("2026-01-05 09:00", now)
+	if err != nil || !got.Equal(time.Date(2026, 1, 5, 9, 0, 0, 0, time.Local)) {
+		t.Errorf("ParsePublishAt() = %v, %v; want Jan 5 09:00 local time", got, err)
+	}
+	if _, err := ParsePublishAt("2026-01-04 11:59", now); err == nil || !strings.Contains(err.Error(), "future") {
+		t.Errorf("error = %v, want a past time refused", err)
+	}
+	if _, err := ParsePublishAt("tomorrow", now); err == nil || !strings.Contains(err.Error(), PublishAtLayout) {
+		t.Errorf("error = %v, want the expected format", err)
+	}
+}
+
+func TestUploadSendsPublishAt(t *testing.T) {
+	var status map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var video struct {
+				Status map[string]any `json:"status"`
+			}
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &video); err != nil {
+				t.Errorf("metadata: %v", err)
+			}
+			status = video.Status
+			w.Header().Set("Location", "http://"+r.Host+"/session")
+			return
+		}
+		_, _ = io.Copy(io.Discard, r.Body)
+		_, _ = w.Write([]byte(`{"id":"abc123"}`))
+	}))
+	defer server.Close()
+	defer func(url string) { uploadURL = url }(uploadURL)
+	uploadURL = server.URL
+
+	videoPath := filepath.Join(t.TempDir(), "final.mp4")
+	if err := os.WriteFile(videoPath, []byte("video"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	u := &Uploader{client: server.Client()}
+	publishAt := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+
+	opts := UploadOptions{VideoPath: videoPath, Title: "Demo", PrivacyStatus: PrivacyPrivate, PublishAt: publishAt}
+	if _, err := u.Upload(context.Background(), opts, nil); err != nil {
+		t.Fatalf("Upload() error: %v", err)
+	}
+	if status["publishAt"] != "2026-01-05T09:00:00Z" {
+		t.Errorf("publishAt = %v, want the scheduled time", status["publishAt"])
+	}
+
+	// Only private videos can be scheduled
+	opts.PrivacyStatus = PrivacyUnlisted
+	if _, err := u.Upload(context.Background(), opts, nil); err != nil {
+		t.Fatalf("Upload() error: %v", err)
+	}
+	if _, ok := status["publishAt"]; ok {
+		t.Errorf("publishAt = %v, want none for an unlisted video", status["publishAt"])
+	}
+}
+
+func TestUploadSendsMadeForKids(t *testing.T) {
+	for _, madeForKids := range []bool{false, true} {
+		var status map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				var video struct {
+					Status map[string]any `json:"status"`
+				}
+				body, _ := io.ReadAll(r.Body)
+				if err := json.Unmarshal(body, &video); err != nil {
+					t.Errorf("metadata: %v", err)
+				}
+				status = video.Status
+				w.Header().Set("Location", "http://"+r.Host+"/session")
+				return
+			}
+			_, _ = io.Copy(io.Discard, r.Body)
+			_, _ = w.Write([]byte(`{"id":"abc123"}`))
+		}))
+		defer server.Close()
+		defer func(url string) { uploadURL = url }(uploadURL)
+		uploadURL = server.URL
+
+		videoPath := filepath.Join(t.TempDir(), "final.mp4")
+		if err := os.WriteFile(videoPath, []byte("video"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		u := &Uploader{client: server.Client()}
+
+		opts := UploadOptions{VideoPath: videoPath, Title: "Demo", MadeForKids: madeForKids}
+		if _, err := u.Upload(context.Background(), opts, nil); err != nil {
+			t.Fatalf("Upload() error: %v", err)
+		}
+		// false has to be sent too, YouTube treats a missing declaration
+		// as not set rather than not made for kids
+		if got, ok := status["selfDeclaredMadeForKids"]; !ok || got != madeForKids {
+			t.Errorf("selfDeclaredMadeForKids = %v (sent %v), want %v", got, ok, madeForKids)
+		}
+	}
+}